@@ -0,0 +1,287 @@
+package accuracy
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// Version is the semantic version of the contract returned by Current.
+const Version = "1.0.0"
+
+// Metric selects how a Bound measures error.
+type Metric int
+
+const (
+	// Absolute measures |got - want|.
+	Absolute Metric = iota + 1
+
+	// Relative measures |got - want| / |want|.
+	Relative
+)
+
+func (m Metric) String() string {
+	switch m {
+	case Absolute:
+		return "abs"
+	case Relative:
+		return "rel"
+	default:
+		return "unknown"
+	}
+}
+
+// Error returns the error of got against the exact value want. Relative
+// error falls back to absolute error where want is zero.
+func (m Metric) Error(got, want float64) float64 {
+	err := math.Abs(got - want)
+	if m == Relative && want != 0 {
+		err /= math.Abs(want)
+	}
+
+	return err
+}
+
+// MarshalText encodes m as its String form.
+func (m Metric) MarshalText() ([]byte, error) {
+	if m != Absolute && m != Relative {
+		return nil, fmt.Errorf("accuracy: marshal Metric(%d): %w", int(m), approx.ErrDomainError)
+	}
+
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText decodes a name produced by MarshalText.
+func (m *Metric) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "abs":
+		*m = Absolute
+	case "rel":
+		*m = Relative
+	default:
+		return fmt.Errorf("accuracy: unknown metric %q: %w", text, approx.ErrDomainError)
+	}
+
+	return nil
+}
+
+// Domain is the closed input interval [Lo, Hi] a Bound holds on. Log marks
+// domains spanning many orders of magnitude, which Samples spaces
+// logarithmically; it requires Lo > 0.
+type Domain struct {
+	Lo  float64 `json:"lo"`
+	Hi  float64 `json:"hi"`
+	Log bool    `json:"log,omitempty"`
+}
+
+// Contains reports whether x lies in d.
+func (d Domain) Contains(x float64) bool { return x >= d.Lo && x <= d.Hi }
+
+// covers reports whether d contains all of o.
+func (d Domain) covers(o Domain) bool { return d.Lo <= o.Lo && d.Hi >= o.Hi }
+
+// Samples returns n points spanning d with both endpoints included, evenly
+// spaced or, for Log domains, evenly spaced in log scale. n < 2 is treated
+// as 2.
+func (d Domain) Samples(n int) []float64 {
+	n = max(n, 2)
+	xs := make([]float64, n)
+	last := float64(n - 1)
+
+	for i := range xs {
+		t := float64(i) / last
+		if d.Log {
+			xs[i] = d.Lo * math.Pow(d.Hi/d.Lo, t)
+		} else {
+			xs[i] = d.Lo + (d.Hi-d.Lo)*t
+		}
+	}
+
+	xs[n-1] = d.Hi
+
+	return xs
+}
+
+// Bound is the guarantee for one Function at one precision tier: every input
+// in Domain is within MaxError of the exact result, measured by Metric.
+type Bound struct {
+	Function  approx.Function  `json:"function"`
+	Precision approx.Precision `json:"precision"`
+	Metric    Metric           `json:"metric"`
+	MaxError  float64          `json:"maxError"`
+	Domain    Domain           `json:"domain"`
+}
+
+// Error returns the error of got against the exact value want under b's
+// metric.
+func (b Bound) Error(got, want float64) float64 { return b.Metric.Error(got, want) }
+
+// Measure evaluates fn against the reference ref at n Samples of b's domain
+// and returns the worst error and the input where it occurred. A NaN result
+// counts as an infinite error.
+//
+// It lets a caller check a replacement backend, or a new release, against
+// the bound before relying on it.
+func (b Bound) Measure(fn, ref func(float64) float64, n int) (worst, at float64) {
+	at = math.NaN()
+
+	for _, x := range b.Domain.Samples(n) {
+		err := b.Error(fn(x), ref(x))
+		if err != err { //nolint:gocritic
+			err = math.Inf(1)
+		}
+
+		if err > worst || at != at { //nolint:gocritic
+			worst, at = err, x
+		}
+	}
+
+	return worst, at
+}
+
+// Contract is a versioned set of bounds, at most one per Function and tier.
+type Contract struct {
+	Version string  `json:"version"`
+	Bounds  []Bound `json:"bounds"`
+}
+
+// Lookup returns the bound for fn at prec. PrecisionAuto resolves to
+// PrecisionBalanced, as in the approx package.
+func (c Contract) Lookup(fn approx.Function, prec approx.Precision) (Bound, bool) {
+	if prec == approx.PrecisionAuto {
+		prec = approx.PrecisionBalanced
+	}
+
+	for _, b := range c.Bounds {
+		if b.Function == fn && b.Precision == prec {
+			return b, true
+		}
+	}
+
+	return Bound{}, false
+}
+
+// Change classifies the difference between two contracts under the
+// versioning policy.
+type Change int
+
+const (
+	// ChangeNone means every bound is unchanged.
+	ChangeNone Change = iota
+
+	// ChangeMinor means guarantees were only strengthened or added.
+	ChangeMinor
+
+	// ChangeMajor means at least one guarantee was weakened or removed.
+	ChangeMajor
+)
+
+func (c Change) String() string {
+	switch c {
+	case ChangeNone:
+		return "none"
+	case ChangeMinor:
+		return "minor"
+	case ChangeMajor:
+		return "major"
+	default:
+		return "unknown"
+	}
+}
+
+// Compare classifies the move from contract old to contract cur. Versions
+// are ignored; only the bounds are compared.
+func Compare(old, cur Contract) Change {
+	change := ChangeNone
+
+	for _, ob := range old.Bounds {
+		cb, ok := cur.Lookup(ob.Function, ob.Precision)
+
+		switch {
+		case !ok, cb.Metric != ob.Metric, cb.MaxError > ob.MaxError, !cb.Domain.covers(ob.Domain):
+			return ChangeMajor
+		case cb.MaxError < ob.MaxError, !ob.Domain.covers(cb.Domain):
+			change = ChangeMinor
+		}
+	}
+
+	for _, cb := range cur.Bounds {
+		if _, ok := old.Lookup(cb.Function, cb.Precision); !ok {
+			change = ChangeMinor
+		}
+	}
+
+	return change
+}
+
+// Current returns the contract of this release. The result is a copy and may
+// be modified freely.
+func Current() Contract {
+	return Contract{Version: Version, Bounds: slices.Clone(bounds)}
+}
+
+// Measured returns the worst error of fn at prec measured over the bound's
+// domain when this release was generated; see the Measured constants. It is
+// the margin a Bound leaves: MaxError is at or above it. PrecisionAuto
+// resolves to PrecisionBalanced.
+func Measured(fn approx.Function, prec approx.Precision) (float64, bool) {
+	errs, ok := measured[fn]
+
+	switch prec {
+	case approx.PrecisionFast:
+		return errs[0], ok
+	case approx.PrecisionAuto, approx.PrecisionBalanced:
+		return errs[1], ok
+	case approx.PrecisionHigh:
+		return errs[2], ok
+	default:
+		return 0, false
+	}
+}
+
+// Bounds are measured maxima over 10^5 samples of each domain, rounded up
+// with some margin.
+//
+//nolint:gochecknoglobals // read-only contract data
+var bounds = slices.Concat(
+	tier(approx.FuncSqrt, Relative, positiveDomain, 2e-3, 2e-6, 2e-12),
+	tier(approx.FuncInvSqrt, Relative, positiveDomain, 2e-3, 6e-6, 5e-11),
+	tier(approx.FuncLog, Absolute, positiveDomain, 2.5e-3, 1.5e-5, 1.5e-7),
+	tier(approx.FuncLog2, Absolute, positiveDomain, 1e-4, 6e-8, 4e-11),
+	tier(approx.FuncExp, Relative, Domain{Lo: -20, Hi: 20}, 1e-3, 4e-6, 1e-8),
+	tier(approx.FuncExp2, Relative, Domain{Lo: -30, Hi: 30}, 1e-3, 4e-6, 1e-8),
+	tier(approx.FuncSin, Absolute, Domain{Lo: -math.Pi, Hi: math.Pi}, 6e-3, 5e-6, 1e-9),
+	tier(approx.FuncCos, Absolute, Domain{Lo: -math.Pi / 2, Hi: math.Pi / 2}, 3e-2, 3e-5, 1e-8),
+	tier(approx.FuncTan, Absolute, Domain{Lo: -math.Pi / 4, Hi: math.Pi / 4}, 6e-2, 1.5e-2, 3e-4),
+	tier(approx.FuncCotan, Absolute, Domain{Lo: math.Pi / 4, Hi: 3 * math.Pi / 4}, 7e-2, 1.5e-2, 3e-4),
+	tier(approx.FuncSec, Relative, Domain{Lo: -1, Hi: 1}, 3e-3, 1e-6, 5e-11),
+	tier(approx.FuncCsc, Relative, Domain{Lo: 0.5, Hi: 2.5}, 6e-3, 5e-6, 1e-9),
+	tier(approx.FuncArctan, Absolute, arctanDomain, 1.5e-5, 1.5e-5, 3e-9),
+	tier(approx.FuncArccotan, Absolute, arctanDomain, 1.5e-5, 1.5e-5, 3e-9),
+	tier(approx.FuncArccos, Absolute, Domain{Lo: -1, Hi: 1}, 1e-3, 7e-6, 1.5e-8),
+	tier(approx.FuncArcsec, Absolute, reciprocalDomain, 1e-3, 7e-6, 1.5e-8),
+	tier(approx.FuncArccsc, Absolute, reciprocalDomain, 1e-3, 7e-6, 1.5e-8),
+)
+
+// Shared domains. Arctan is only contracted on the core interval its series
+// covers; see approx.FastArctan.
+//
+//nolint:gochecknoglobals // read-only contract data
+var (
+	positiveDomain   = Domain{Lo: 1e-6, Hi: 1e6, Log: true}
+	reciprocalDomain = Domain{Lo: 1, Hi: 1e6, Log: true}
+	arctanDomain     = Domain{Lo: -math.Pi / 12, Hi: math.Pi / 12}
+)
+
+// tier expands one row of the contract table into its three tiers' bounds;
+// the table keeps one row per Function so loosening a tier stands out in
+// review.
+func tier(fn approx.Function, m Metric, d Domain, fast, balanced, high float64) []Bound {
+	return []Bound{
+		{Function: fn, Precision: approx.PrecisionFast, Metric: m, MaxError: fast, Domain: d},
+		{Function: fn, Precision: approx.PrecisionBalanced, Metric: m, MaxError: balanced, Domain: d},
+		{Function: fn, Precision: approx.PrecisionHigh, Metric: m, MaxError: high, Domain: d},
+	}
+}
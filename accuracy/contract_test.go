@@ -0,0 +1,234 @@
+package accuracy
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/internal/reference"
+)
+
+//nolint:gochecknoglobals // test flag
+var update = flag.Bool("update", false, "rewrite testdata/contract.json from Current")
+
+// releasedPath holds the contract of the last release.
+const releasedPath = "testdata/contract.json"
+
+// TestContractHolds is the enforcement test: every bound of Current must hold
+// on a dense sampling of its domain.
+func TestContractHolds(t *testing.T) {
+	t.Parallel()
+
+	for _, b := range Current().Bounds {
+		impl, ok := reference.ForFunction(b.Function)
+		if !ok {
+			t.Fatalf("no implementation registered for %v", b.Function)
+		}
+
+		fn := func(x float64) float64 { return impl.Approx(x, b.Precision) }
+
+		worst, at := b.Measure(fn, impl.Ref, 50001)
+		if !(worst <= b.MaxError) { //nolint:staticcheck // also catches NaN
+			t.Errorf("%v/%v: %v error %.3g at x=%g exceeds contract %.3g",
+				b.Function, b.Precision, b.Metric, worst, at, b.MaxError)
+		}
+	}
+}
+
+// TestMeasuredWithinContract checks the generated measurements against the
+// bounds they document.
+func TestMeasuredWithinContract(t *testing.T) {
+	t.Parallel()
+
+	for _, b := range Current().Bounds {
+		m, ok := Measured(b.Function, b.Precision)
+		if !ok || !(m > 0) || m > b.MaxError {
+			t.Errorf("%v/%v: measured %.2g (ok %v) against contract %.3g", b.Function, b.Precision, m, ok, b.MaxError)
+		}
+	}
+
+	if m, _ := Measured(approx.FuncExp, approx.PrecisionAuto); m != MeasuredExpBalanced {
+		t.Fatalf("Auto must resolve to Balanced, got %g", m)
+	}
+
+	if _, ok := Measured(approx.Function(0), approx.PrecisionFast); ok {
+		t.Fatal("unknown Function must not be measured")
+	}
+}
+
+func TestContractCoverage(t *testing.T) {
+	t.Parallel()
+
+	c := Current()
+
+	for _, fn := range approx.Functions() {
+		for _, prec := range []approx.Precision{approx.PrecisionFast, approx.PrecisionBalanced, approx.PrecisionHigh} {
+			b, ok := c.Lookup(fn, prec)
+			if !ok {
+				t.Fatalf("no bound for %v/%v", fn, prec)
+			}
+
+			if !(b.MaxError > 0) || !(b.Domain.Lo < b.Domain.Hi) || (b.Domain.Log && b.Domain.Lo <= 0) {
+				t.Fatalf("malformed bound %+v", b)
+			}
+		}
+
+		fast, _ := c.Lookup(fn, approx.PrecisionFast)
+		balanced, _ := c.Lookup(fn, approx.PrecisionBalanced)
+		high, _ := c.Lookup(fn, approx.PrecisionHigh)
+
+		if fast.MaxError < balanced.MaxError || balanced.MaxError < high.MaxError {
+			t.Fatalf("%v: tiers not ordered: %g %g %g", fn, fast.MaxError, balanced.MaxError, high.MaxError)
+		}
+	}
+
+	if a, _ := c.Lookup(approx.FuncExp, approx.PrecisionAuto); a.Precision != approx.PrecisionBalanced {
+		t.Fatalf("Auto must resolve to Balanced, got %v", a.Precision)
+	}
+
+	if len(c.Bounds) != 3*len(approx.Functions()) {
+		t.Fatalf("got %d bounds, want one per Function and tier", len(c.Bounds))
+	}
+}
+
+func TestCurrentIsCopy(t *testing.T) {
+	t.Parallel()
+
+	c := Current()
+	c.Bounds[0].MaxError = 1
+
+	if Current().Bounds[0].MaxError == 1 {
+		t.Fatalf("Current must not expose the package table")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	base := Current()
+
+	edit := func(f func(*Contract)) Contract {
+		c := Current()
+		f(&c)
+
+		return c
+	}
+
+	cases := []struct {
+		name string
+		cur  Contract
+		want Change
+	}{
+		{"same", Current(), ChangeNone},
+		{"tighten", edit(func(c *Contract) { c.Bounds[0].MaxError /= 2 }), ChangeMinor},
+		{"widen domain", edit(func(c *Contract) { c.Bounds[0].Domain.Hi *= 2 }), ChangeMinor},
+		{"add", edit(func(c *Contract) { c.Bounds = append(c.Bounds, Bound{Function: approx.Function(99)}) }), ChangeMinor},
+		{"loosen", edit(func(c *Contract) { c.Bounds[0].MaxError *= 2 }), ChangeMajor},
+		{"narrow domain", edit(func(c *Contract) { c.Bounds[0].Domain.Lo *= 2 }), ChangeMajor},
+		{"metric", edit(func(c *Contract) { c.Bounds[0].Metric = Absolute }), ChangeMajor},
+		{"remove", edit(func(c *Contract) { c.Bounds = c.Bounds[1:] }), ChangeMajor},
+		{"tighten and loosen", edit(func(c *Contract) {
+			c.Bounds[0].MaxError /= 2
+			c.Bounds[1].MaxError *= 2
+		}), ChangeMajor},
+	}
+
+	for _, tc := range cases {
+		if got := Compare(base, tc.cur); got != tc.want {
+			t.Errorf("%s: Compare = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestContractJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(Current())
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	if !strings.Contains(string(data), `"function":"Sqrt","precision":"fast","metric":"rel"`) {
+		t.Fatalf("bounds must serialize by name: %.200s", data)
+	}
+
+	var back Contract
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if back.Version != Version || Compare(Current(), back) != ChangeNone || Compare(back, Current()) != ChangeNone {
+		t.Fatalf("round trip changed the contract")
+	}
+
+	if err := json.Unmarshal([]byte(`{"bounds":[{"metric":"ulp"}]}`), &back); err == nil {
+		t.Fatalf("unknown metric must fail to decode")
+	}
+}
+
+// TestVersionPolicy rejects a Current whose Version bump is smaller than its
+// change against the released snapshot. Run with -update after a release to
+// move the snapshot forward.
+//
+//nolint:paralleltest // may rewrite testdata
+func TestVersionPolicy(t *testing.T) {
+	if *update {
+		data, err := json.MarshalIndent(Current(), "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filepath.FromSlash(releasedPath), append(data, '\n'), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.FromSlash(releasedPath))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var released Contract
+	if err := json.Unmarshal(data, &released); err != nil {
+		t.Fatal(err)
+	}
+
+	cur := Current()
+	oldMajor, oldMinor := parseVersion(t, released.Version)
+	newMajor, newMinor := parseVersion(t, cur.Version)
+
+	switch change := Compare(released, cur); change {
+	case ChangeMajor:
+		if newMajor <= oldMajor {
+			t.Fatalf("contract loosened against %s; bump the major version (now %s)", released.Version, cur.Version)
+		}
+	case ChangeMinor:
+		if newMajor < oldMajor || (newMajor == oldMajor && newMinor <= oldMinor) {
+			t.Fatalf("contract tightened against %s; bump at least the minor version (now %s)", released.Version, cur.Version)
+		}
+	case ChangeNone:
+	}
+}
+
+func parseVersion(t *testing.T, v string) (major, minor int) {
+	t.Helper()
+
+	parts := strings.Split(v, ".")
+	if len(parts) != 3 {
+		t.Fatalf("malformed version %q", v)
+	}
+
+	major, err1 := strconv.Atoi(parts[0])
+	minor, err2 := strconv.Atoi(parts[1])
+
+	if err1 != nil || err2 != nil {
+		t.Fatalf("malformed version %q", v)
+	}
+
+	return major, minor
+}
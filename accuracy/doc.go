@@ -0,0 +1,37 @@
+// Package accuracy publishes the accuracy contract of the approx package: for
+// every scalar Function and precision tier, the worst-case error the
+// float64 implementation guarantees over a stated input domain.
+//
+// The contract is data, not documentation. The package's own tests evaluate
+// every bound densely and fail if a kernel exceeds it, and downstream users
+// can query it (Current, Contract.Lookup), serialize it as JSON, and diff two
+// versions with Compare before upgrading.
+//
+// The errors actually measured for this release are generated into the
+// Measured constants (go generate in the module root runs
+// internal/gen/accuracy), so the table in their documentation is produced by
+// the same measurement the tests enforce.
+//
+// WithErr and its per-function variants (ExpWithErr, SinWithErr, ...) return
+// a ValueWithError: the result together with the contract bound converted to
+// an absolute error at that input, ready for interval or uncertainty
+// propagation.
+//
+// Since worst-case bounds are often dominated by a domain edge a program
+// never touches, MeasureWeighted and MeasureDistribution report the error
+// percentiles (p50, p99, max) over weighted samples or over draws from a
+// Distribution (Uniform, LogUniform, Gaussian) instead.
+//
+// # Versioning
+//
+// Contract.Version follows semantic versioning with a fixed policy:
+//
+//   - tightening a bound, widening a domain or adding a bound is a minor
+//     change;
+//   - loosening a bound, narrowing a domain, changing a metric or removing a
+//     bound is a major change.
+//
+// Compare classifies the difference between two contracts accordingly, and a
+// test in this package rejects a contract whose version bump is smaller than
+// the change against the last released snapshot in testdata.
+package accuracy
@@ -0,0 +1,46 @@
+package accuracy_test
+
+import (
+	"fmt"
+	"math"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/accuracy"
+)
+
+func ExampleContract_Lookup() {
+	b, _ := accuracy.Current().Lookup(approx.FuncExp, approx.PrecisionBalanced)
+	fmt.Printf("%v %v %.0e on [%g, %g]\n", b.Function, b.Metric, b.MaxError, b.Domain.Lo, b.Domain.Hi)
+	// Output:
+	// Exp rel 4e-06 on [-20, 20]
+}
+
+func ExampleExpWithErr() {
+	v := accuracy.ExpWithErr(2.0, approx.PrecisionBalanced)
+	lo, hi := v.Interval()
+	fmt.Printf("%.6f ± %.1e, exact in [%.5f, %.5f]\n", v.Value, v.AbsErrBound, lo, hi)
+	// Output:
+	// 7.389056 ± 3.0e-05, exact in [7.38903, 7.38909]
+}
+
+func ExampleCompare() {
+	old := accuracy.Current()
+	cur := accuracy.Current()
+	cur.Bounds[0].MaxError *= 2
+
+	fmt.Println(accuracy.Compare(old, cur))
+	// Output:
+	// major
+}
+
+func ExampleMeasureDistribution() {
+	exp := func(x float64) float64 { return approx.FastExpPrec(x, approx.PrecisionFast) }
+
+	// Inputs clustered around zero, as in a softmax over centered logits.
+	d := accuracy.Gaussian(0, 1, -8, 8)
+	rep := accuracy.MeasureDistribution(exp, math.Exp, d, 10000, approx.Seeded(1), accuracy.Relative)
+
+	fmt.Printf("p50 %.0e  p99 %.0e  max %.0e\n", rep.P50, rep.P99, rep.Max)
+	// Output:
+	// p50 4e-05  p99 7e-04  max 8e-04
+}
@@ -0,0 +1,145 @@
+package accuracy
+
+import (
+	"bufio"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"strconv"
+)
+
+// Grid is the error of a two-argument function over a rectangle of inputs,
+// e.g. FastPower over (base, exponent), where a single worst-case number
+// hides which region is weak.
+type Grid struct {
+	Metric Metric
+
+	// X and Y are the sample coordinates of the two arguments.
+	X, Y []float64
+
+	// Err[j][i] is the error at (X[i], Y[j]). NaN results are stored as +Inf.
+	Err [][]float64
+}
+
+// ErrorGrid evaluates fn against ref on nx×ny points spanning dx and dy
+// (see Domain.Samples) under metric m.
+func ErrorGrid(fn, ref func(x, y float64) float64, dx, dy Domain, nx, ny int, m Metric) Grid {
+	g := Grid{Metric: m, X: dx.Samples(nx), Y: dy.Samples(ny)}
+	g.Err = make([][]float64, len(g.Y))
+
+	for j, y := range g.Y {
+		row := make([]float64, len(g.X))
+
+		for i, x := range g.X {
+			err := m.Error(fn(x, y), ref(x, y))
+			if err != err { //nolint:gocritic
+				err = math.Inf(1)
+			}
+
+			row[i] = err
+		}
+
+		g.Err[j] = row
+	}
+
+	return g
+}
+
+// Worst returns the largest error in g and where it occurs.
+func (g Grid) Worst() (err, x, y float64) {
+	err, x, y = math.Inf(-1), math.NaN(), math.NaN()
+
+	for j, row := range g.Err {
+		for i, e := range row {
+			if e > err {
+				err, x, y = e, g.X[i], g.Y[j]
+			}
+		}
+	}
+
+	return err, x, y
+}
+
+// WriteCSV writes g as a matrix: the header row holds the X coordinates
+// after an empty corner cell, and each following row starts with its Y
+// coordinate.
+func (g Grid) WriteCSV(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	buf := make([]byte, 0, 32)
+
+	writeRow := func(first string, vals []float64) {
+		bw.WriteString(first)
+
+		for _, v := range vals {
+			buf = strconv.AppendFloat(append(buf[:0], ','), v, 'g', 6, 64)
+			bw.Write(buf)
+		}
+
+		bw.WriteByte('\n')
+	}
+
+	writeRow(g.Metric.String(), g.X)
+
+	for j, row := range g.Err {
+		writeRow(strconv.FormatFloat(g.Y[j], 'g', 6, 64), row)
+	}
+
+	return bw.Flush()
+}
+
+// Image renders g as a heatmap, one pixel per grid point with Y growing
+// upwards. Colors run from black (smallest error) through red and yellow to
+// white (largest) on a log scale; exact results take the smallest color and
+// infinite errors are drawn magenta.
+func (g Grid) Image() *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, len(g.X), len(g.Y)))
+	lo, hi := g.logRange()
+
+	for j, row := range g.Err {
+		for i, e := range row {
+			c := color.RGBA{R: 255, B: 255, A: 255}
+			if !math.IsInf(e, 1) {
+				t := 0.0
+				if e > 0 && hi > lo {
+					t = (math.Log10(e) - lo) / (hi - lo)
+				}
+
+				c = heat(min(max(t, 0), 1))
+			}
+
+			img.SetRGBA(i, len(g.Y)-1-j, c)
+		}
+	}
+
+	return img
+}
+
+// WritePNG encodes g.Image as PNG.
+func (g Grid) WritePNG(w io.Writer) error { return png.Encode(w, g.Image()) }
+
+// logRange returns the range of log10 over the positive, finite errors.
+func (g Grid) logRange() (lo, hi float64) {
+	lo, hi = math.Inf(1), math.Inf(-1)
+
+	for _, row := range g.Err {
+		for _, e := range row {
+			if e > 0 && !math.IsInf(e, 1) {
+				l := math.Log10(e)
+				lo, hi = min(lo, l), max(hi, l)
+			}
+		}
+	}
+
+	return lo, hi
+}
+
+// heat maps t in [0, 1] to the black-red-yellow-white color ramp.
+func heat(t float64) color.RGBA {
+	channel := func(from float64) uint8 {
+		return uint8(255 * min(max(3*t-from, 0), 1)) //nolint:gosec // clamped to [0, 255]
+	}
+
+	return color.RGBA{R: channel(0), G: channel(1), B: channel(2), A: 255}
+}
@@ -0,0 +1,85 @@
+package accuracy
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestErrorGrid(t *testing.T) {
+	t.Parallel()
+
+	// The error at (x, y) is x·y.
+	fn := func(x, y float64) float64 { return x * y }
+	ref := func(float64, float64) float64 { return 0 }
+
+	g := ErrorGrid(fn, ref, Domain{Lo: 1, Hi: 3}, Domain{Lo: 1, Hi: 100, Log: true}, 3, 3, Absolute)
+	if len(g.X) != 3 || len(g.Y) != 3 || len(g.Err) != 3 || len(g.Err[0]) != 3 {
+		t.Fatalf("grid shape %dx%d", len(g.X), len(g.Y))
+	}
+
+	if g.Y[1] != 10 || g.Err[1][2] != 30 {
+		t.Fatalf("Err[1][2] = %g at y=%g, want 30 at y=10", g.Err[1][2], g.Y[1])
+	}
+
+	if err, x, y := g.Worst(); err != 300 || x != 3 || y != 100 {
+		t.Fatalf("Worst = %g at (%g, %g)", err, x, y)
+	}
+
+	var buf bytes.Buffer
+	if err := g.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 || lines[0] != "abs,1,2,3" || lines[2] != "10,10,20,30" {
+		t.Fatalf("CSV:\n%s", buf.String())
+	}
+}
+
+func TestGridImage(t *testing.T) {
+	t.Parallel()
+
+	g := Grid{
+		Metric: Relative,
+		X:      []float64{0, 1},
+		Y:      []float64{0, 1},
+		Err:    [][]float64{{1e-9, 1e-3}, {0, math.Inf(1)}},
+	}
+
+	img := g.Image()
+	if b := img.Bounds(); b.Dx() != 2 || b.Dy() != 2 {
+		t.Fatalf("image size %v", b)
+	}
+
+	black := color.RGBA{A: 255}
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	magenta := color.RGBA{R: 255, B: 255, A: 255}
+
+	// Row 0 of the grid (y = 0) is the bottom row of the image.
+	for _, tc := range []struct {
+		x, y int
+		want color.RGBA
+	}{
+		{0, 1, black},
+		{1, 1, white},
+		{0, 0, black},
+		{1, 0, magenta},
+	} {
+		if got := img.RGBAAt(tc.x, tc.y); got != tc.want {
+			t.Fatalf("pixel (%d, %d) = %v, want %v", tc.x, tc.y, got, tc.want)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := g.WritePNG(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := png.Decode(&buf); err != nil {
+		t.Fatalf("WritePNG produced an undecodable image: %v", err)
+	}
+}
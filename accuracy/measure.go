@@ -0,0 +1,168 @@
+package accuracy
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// Distribution draws one input from a user's input distribution. Worst-case
+// error at a domain edge often says little about real workloads; measuring
+// over the inputs a program actually sees does.
+type Distribution func(r *rand.Rand) float64
+
+// Uniform returns the uniform distribution on [lo, hi).
+func Uniform(lo, hi float64) Distribution {
+	return func(r *rand.Rand) float64 { return lo + (hi-lo)*r.Float64() }
+}
+
+// LogUniform returns the distribution whose logarithm is uniform on
+// [ln lo, ln hi), for inputs spanning orders of magnitude. It requires
+// 0 < lo < hi.
+func LogUniform(lo, hi float64) Distribution {
+	ratio := hi / lo
+
+	return func(r *rand.Rand) float64 { return lo * math.Pow(ratio, r.Float64()) }
+}
+
+// maxRejections bounds the retries of Gaussian before it clamps.
+const maxRejections = 1000
+
+// Gaussian returns the normal distribution with the given mean and standard
+// deviation truncated to [lo, hi] by rejection. The range should hold a
+// non-negligible part of the mass; after maxRejections misses the draw is
+// clamped into the range.
+func Gaussian(mean, stddev, lo, hi float64) Distribution {
+	return func(r *rand.Rand) float64 {
+		x := mean
+
+		for range maxRejections {
+			x = mean + stddev*r.NormFloat64()
+			if x >= lo && x <= hi {
+				return x
+			}
+		}
+
+		return min(max(x, lo), hi)
+	}
+}
+
+// WeightedSample is one input with the relative frequency it stands for.
+type WeightedSample struct {
+	X      float64
+	Weight float64
+}
+
+// Report summarizes the error distribution of a measurement. Percentiles are
+// weighted: P99 is the smallest error that at least 99% of the total weight
+// does not exceed.
+type Report struct {
+	Metric Metric
+
+	// N counts the samples with positive weight.
+	N int
+
+	Mean float64 // weighted mean error
+	P50  float64
+	P99  float64
+	Max  float64
+
+	// WorstX is the input with the largest error, NaN for an empty report.
+	WorstX float64
+}
+
+// weightedErr is one sample's error and weight.
+type weightedErr struct {
+	err, weight float64
+}
+
+// percentile returns the weighted q-quantile of errs, where errs is sorted by
+// error; q is clamped to [0, 1].
+func percentile(errs []weightedErr, total, q float64) float64 {
+	if len(errs) == 0 {
+		return math.NaN()
+	}
+
+	limit := min(max(q, 0), 1) * total
+	acc := 0.0
+
+	for _, e := range errs {
+		acc += e.weight
+		if acc >= limit {
+			return e.err
+		}
+	}
+
+	return errs[len(errs)-1].err
+}
+
+// MeasureWeighted evaluates fn against the reference ref on weighted samples
+// and reports the error distribution under metric m. Samples with a weight
+// that is not positive are skipped. A NaN result counts as an infinite
+// error.
+func MeasureWeighted(fn, ref func(float64) float64, samples []WeightedSample, m Metric) Report {
+	rep := Report{Metric: m, WorstX: math.NaN()}
+	errs := make([]weightedErr, 0, len(samples))
+	total, sum := 0.0, 0.0
+
+	for _, s := range samples {
+		if !(s.Weight > 0) { //nolint:staticcheck // also skips NaN weights
+			continue
+		}
+
+		err := m.Error(fn(s.X), ref(s.X))
+		if err != err { //nolint:gocritic
+			err = math.Inf(1)
+		}
+
+		if rep.N == 0 || err > rep.Max {
+			rep.Max, rep.WorstX = err, s.X
+		}
+
+		rep.N++
+		total += s.Weight
+		sum += s.Weight * err
+		errs = append(errs, weightedErr{err: err, weight: s.Weight})
+	}
+
+	if rep.N == 0 {
+		rep.Mean, rep.P50, rep.P99, rep.Max = math.NaN(), math.NaN(), math.NaN(), math.NaN()
+
+		return rep
+	}
+
+	slices.SortFunc(errs, func(a, b weightedErr) int {
+		switch {
+		case a.err < b.err:
+			return -1
+		case a.err > b.err:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	rep.Mean = sum / total
+	rep.P50 = percentile(errs, total, 0.5)
+	rep.P99 = percentile(errs, total, 0.99)
+
+	return rep
+}
+
+// MeasureDistribution draws n inputs from d using r and reports the error of
+// fn against ref over them, with equal weights. A nil r draws from
+// approx.Default.
+func MeasureDistribution(fn, ref func(float64) float64, d Distribution, n int, r *rand.Rand, m Metric) Report {
+	if r == nil {
+		r = approx.Default()
+	}
+
+	samples := make([]WeightedSample, n)
+	for i := range samples {
+		samples[i] = WeightedSample{X: d(r), Weight: 1}
+	}
+
+	return MeasureWeighted(fn, ref, samples, m)
+}
@@ -0,0 +1,111 @@
+package accuracy
+
+import (
+	"math"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func TestMeasureWeightedPercentiles(t *testing.T) {
+	t.Parallel()
+
+	// fn is off by x from ref, so the error of sample x is x itself.
+	fn := func(x float64) float64 { return 2 * x }
+	ref := func(x float64) float64 { return x }
+
+	samples := make([]WeightedSample, 0, 101)
+	for i := range 100 {
+		samples = append(samples, WeightedSample{X: float64(i + 1), Weight: 1})
+	}
+
+	rep := MeasureWeighted(fn, ref, samples, Absolute)
+	if rep.N != 100 || rep.P50 != 50 || rep.P99 != 99 || rep.Max != 100 || rep.WorstX != 100 || rep.Mean != 50.5 {
+		t.Fatalf("equal weights: %+v", rep)
+	}
+
+	// Give the largest error all but a sliver of the weight.
+	samples[99].Weight = 1e6
+	if rep = MeasureWeighted(fn, ref, samples, Absolute); rep.P50 != 100 {
+		t.Fatalf("heavy sample must move the median: %+v", rep)
+	}
+
+	// Zero and NaN weights are skipped.
+	samples = append(samples[:2], WeightedSample{X: 1e9, Weight: 0}, WeightedSample{X: 1e9, Weight: math.NaN()})
+	if rep = MeasureWeighted(fn, ref, samples, Absolute); rep.N != 2 || rep.Max != 2 {
+		t.Fatalf("non-positive weights must be skipped: %+v", rep)
+	}
+
+	if rep = MeasureWeighted(fn, ref, nil, Relative); rep.N != 0 || !math.IsNaN(rep.Max) || !math.IsNaN(rep.WorstX) {
+		t.Fatalf("empty report: %+v", rep)
+	}
+
+	nan := func(float64) float64 { return math.NaN() }
+	if rep = MeasureWeighted(nan, ref, samples[:1], Relative); !math.IsInf(rep.Max, 1) {
+		t.Fatalf("NaN results must count as infinite error: %+v", rep)
+	}
+}
+
+func TestDistributionsStayInRange(t *testing.T) {
+	t.Parallel()
+
+	r := approx.Seeded(3)
+
+	for name, tc := range map[string]struct {
+		d      Distribution
+		lo, hi float64
+	}{
+		"uniform":    {Uniform(-2, 3), -2, 3},
+		"log":        {LogUniform(1e-4, 1e4), 1e-4, 1e4},
+		"gaussian":   {Gaussian(0, 1, -0.5, 2), -0.5, 2},
+		"far tail":   {Gaussian(0, 1, 50, 51), 50, 51},
+		"degenerate": {Gaussian(1, 0, 0, 2), 0, 2},
+	} {
+		below := 0
+
+		for range 10000 {
+			x := tc.d(r)
+			if x < tc.lo || x > tc.hi {
+				t.Fatalf("%s: draw %g outside [%g, %g]", name, x, tc.lo, tc.hi)
+			}
+
+			if x < (tc.lo+tc.hi)/2 {
+				below++
+			}
+		}
+
+		if name == "uniform" && (below < 4800 || below > 5200) {
+			t.Fatalf("uniform: %d of 10000 draws below the midpoint", below)
+		}
+	}
+
+	// Half of a log-uniform distribution's mass lies below the geometric mean.
+	below := 0
+	d := LogUniform(1e-4, 1e4)
+
+	for range 10000 {
+		if d(r) < 1 {
+			below++
+		}
+	}
+
+	if below < 4800 || below > 5200 {
+		t.Fatalf("log-uniform: %d of 10000 draws below 1", below)
+	}
+}
+
+func TestMeasureDistributionPercentilesBelowMax(t *testing.T) {
+	t.Parallel()
+
+	fn := func(x float64) float64 { return approx.FastExpPrec(x, approx.PrecisionFast) }
+
+	rep := MeasureDistribution(fn, math.Exp, Gaussian(0, 1, -10, 10), 20000, approx.Seeded(4), Relative)
+	if rep.N != 20000 || !(rep.P50 <= rep.P99 && rep.P99 <= rep.Max) {
+		t.Fatalf("percentiles not ordered: %+v", rep)
+	}
+
+	b, _ := Current().Lookup(approx.FuncExp, approx.PrecisionFast)
+	if rep.Max > b.MaxError {
+		t.Fatalf("max %g exceeds the contract %g", rep.Max, b.MaxError)
+	}
+}
@@ -0,0 +1,198 @@
+package accuracy
+
+import (
+	"math"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// ValueWithError is an approximation annotated with a bound on its absolute
+// error, for interval arithmetic and uncertainty propagation downstream: the
+// exact result lies in [Value-AbsErrBound, Value+AbsErrBound].
+//
+// The bound is +Inf when nothing is guaranteed, that is outside the
+// contract domain or for a NaN input.
+type ValueWithError[T approx.Float] struct {
+	Value       T
+	AbsErrBound T
+}
+
+// Interval returns the range holding the exact result.
+func (v ValueWithError[T]) Interval() (lo, hi T) {
+	return v.Value - v.AbsErrBound, v.Value + v.AbsErrBound
+}
+
+// WithErr evaluates fn at x with the given precision and annotates the result
+// with the contract bound scaled to the input: Absolute bounds hold as they
+// are, Relative bounds are scaled by the magnitude of the result. float32
+// results add four float32 ulps of the result for the kernels that compute
+// in float32. An unknown fn returns NaN with an infinite bound.
+func WithErr[T approx.Float](fn approx.Function, x T, prec approx.Precision) ValueWithError[T] {
+	v := eval(fn, x, prec)
+
+	if prec == approx.PrecisionAuto || !prec.IsValid() {
+		prec = approx.PrecisionBalanced
+	}
+
+	b, ok := boundIndex[boundKey{fn, prec}]
+	if !ok || !b.Domain.Contains(float64(x)) || v != v { //nolint:gocritic
+		return ValueWithError[T]{Value: v, AbsErrBound: T(math.Inf(1))}
+	}
+
+	vf := math.Abs(float64(v))
+
+	e := b.MaxError
+	if b.Metric == Relative {
+		// |v - y| <= e|y| gives |y| <= |v| / (1 - e).
+		e *= vf / (1 - e)
+	}
+
+	var zero T
+	if _, single := any(zero).(float32); single {
+		e += vf * 0x1p-21
+	}
+
+	return ValueWithError[T]{Value: v, AbsErrBound: T(e)}
+}
+
+// SqrtWithErr is WithErr for approx.FuncSqrt.
+func SqrtWithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncSqrt, x, prec)
+}
+
+// InvSqrtWithErr is WithErr for approx.FuncInvSqrt.
+func InvSqrtWithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncInvSqrt, x, prec)
+}
+
+// LogWithErr is WithErr for approx.FuncLog.
+func LogWithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncLog, x, prec)
+}
+
+// Log2WithErr is WithErr for approx.FuncLog2.
+func Log2WithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncLog2, x, prec)
+}
+
+// ExpWithErr is WithErr for approx.FuncExp.
+func ExpWithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncExp, x, prec)
+}
+
+// Exp2WithErr is WithErr for approx.FuncExp2.
+func Exp2WithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncExp2, x, prec)
+}
+
+// SinWithErr is WithErr for approx.FuncSin.
+func SinWithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncSin, x, prec)
+}
+
+// CosWithErr is WithErr for approx.FuncCos.
+func CosWithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncCos, x, prec)
+}
+
+// TanWithErr is WithErr for approx.FuncTan.
+func TanWithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncTan, x, prec)
+}
+
+// CotanWithErr is WithErr for approx.FuncCotan.
+func CotanWithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncCotan, x, prec)
+}
+
+// SecWithErr is WithErr for approx.FuncSec.
+func SecWithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncSec, x, prec)
+}
+
+// CscWithErr is WithErr for approx.FuncCsc.
+func CscWithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncCsc, x, prec)
+}
+
+// ArctanWithErr is WithErr for approx.FuncArctan.
+func ArctanWithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncArctan, x, prec)
+}
+
+// ArccotanWithErr is WithErr for approx.FuncArccotan.
+func ArccotanWithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncArccotan, x, prec)
+}
+
+// ArccosWithErr is WithErr for approx.FuncArccos.
+func ArccosWithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncArccos, x, prec)
+}
+
+// ArcsecWithErr is WithErr for approx.FuncArcsec.
+func ArcsecWithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncArcsec, x, prec)
+}
+
+// ArccscWithErr is WithErr for approx.FuncArccsc.
+func ArccscWithErr[T approx.Float](x T, prec approx.Precision) ValueWithError[T] {
+	return WithErr(approx.FuncArccsc, x, prec)
+}
+
+// eval calls the public entry point of fn for T.
+func eval[T approx.Float](fn approx.Function, x T, prec approx.Precision) T {
+	switch fn {
+	case approx.FuncSqrt:
+		return approx.FastSqrtPrec(x, prec)
+	case approx.FuncInvSqrt:
+		return approx.FastInvSqrtPrec(x, prec)
+	case approx.FuncLog:
+		return approx.FastLogPrec(x, prec)
+	case approx.FuncLog2:
+		return approx.FastLog2Prec(x, prec)
+	case approx.FuncExp:
+		return approx.FastExpPrec(x, prec)
+	case approx.FuncExp2:
+		return approx.FastExp2Prec(x, prec)
+	case approx.FuncSin:
+		return approx.FastSinPrec(x, prec)
+	case approx.FuncCos:
+		return approx.FastCosPrec(x, prec)
+	case approx.FuncTan:
+		return approx.FastTanPrec(x, prec)
+	case approx.FuncCotan:
+		return approx.FastCotanPrec(x, prec)
+	case approx.FuncSec:
+		return approx.FastSecPrec(x, prec)
+	case approx.FuncCsc:
+		return approx.FastCscPrec(x, prec)
+	case approx.FuncArctan:
+		return approx.FastArctanPrec(x, prec)
+	case approx.FuncArccotan:
+		return approx.FastArccotanPrec(x, prec)
+	case approx.FuncArccos:
+		return approx.FastArccosPrec(x, prec)
+	case approx.FuncArcsec:
+		return approx.FastArcsecPrec(x, prec)
+	case approx.FuncArccsc:
+		return approx.FastArccscPrec(x, prec)
+	default:
+		return T(math.NaN())
+	}
+}
+
+type boundKey struct {
+	fn   approx.Function
+	prec approx.Precision
+}
+
+//nolint:gochecknoglobals // read-only index of the contract
+var boundIndex = func() map[boundKey]Bound {
+	m := make(map[boundKey]Bound, len(bounds))
+	for _, b := range bounds {
+		m[boundKey{b.Function, b.Precision}] = b
+	}
+
+	return m
+}()
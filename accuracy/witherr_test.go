@@ -0,0 +1,74 @@
+package accuracy
+
+import (
+	"math"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/internal/reference"
+)
+
+func TestWithErr_BoundsHold(t *testing.T) {
+	t.Parallel()
+
+	for _, b := range Current().Bounds {
+		impl, _ := reference.ForFunction(b.Function)
+
+		for _, x := range b.Domain.Samples(2001) {
+			ref := impl.Ref(x)
+			if math.IsInf(ref, 0) {
+				continue
+			}
+
+			v := WithErr(b.Function, x, b.Precision)
+			if lo, hi := v.Interval(); !(ref >= lo && ref <= hi) {
+				t.Fatalf("%v/%v at %g: %v ± %g misses %v", b.Function, b.Precision, x, v.Value, v.AbsErrBound, ref)
+			}
+
+			x32 := float32(x)
+			if !b.Domain.Contains(float64(x32)) {
+				continue
+			}
+
+			v32 := WithErr(b.Function, x32, b.Precision)
+			ref32 := impl.Ref(float64(x32))
+
+			if math.IsInf(float64(v32.AbsErrBound), 1) || math.IsInf(ref32, 0) || math.Abs(ref32) > math.MaxFloat32 {
+				continue
+			}
+
+			if d := math.Abs(float64(v32.Value) - ref32); !(d <= float64(v32.AbsErrBound)) {
+				t.Fatalf("float32 %v/%v at %g: %v ± %g misses %v", b.Function, b.Precision, x32, v32.Value, v32.AbsErrBound, ref32)
+			}
+		}
+	}
+}
+
+func TestWithErr_Regimes(t *testing.T) {
+	t.Parallel()
+
+	// Relative bounds scale with the result.
+	small, large := ExpWithErr(-10.0, approx.PrecisionFast), ExpWithErr(10.0, approx.PrecisionFast)
+	if !(large.AbsErrBound > 1e8*small.AbsErrBound) {
+		t.Fatalf("exp bounds %g and %g do not scale with the result", small.AbsErrBound, large.AbsErrBound)
+	}
+
+	// Absolute bounds do not.
+	if a, b := SinWithErr(0.1, approx.PrecisionHigh), SinWithErr(1.5, approx.PrecisionHigh); a.AbsErrBound != b.AbsErrBound {
+		t.Fatalf("sin bounds differ: %g, %g", a.AbsErrBound, b.AbsErrBound)
+	}
+
+	if v := ExpWithErr(1.0, approx.PrecisionAuto); v.AbsErrBound != ExpWithErr(1.0, approx.PrecisionBalanced).AbsErrBound {
+		t.Fatalf("Auto must use the Balanced bound")
+	}
+
+	for _, v := range []ValueWithError[float64]{
+		ExpWithErr(100.0, approx.PrecisionHigh), // outside the contract domain
+		LogWithErr(math.NaN(), approx.PrecisionHigh),
+		WithErr(approx.Function(0), 1.0, approx.PrecisionHigh),
+	} {
+		if !math.IsInf(v.AbsErrBound, 1) {
+			t.Fatalf("%+v must carry an infinite bound", v)
+		}
+	}
+}
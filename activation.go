@@ -0,0 +1,90 @@
+package approx
+
+import iapprox "github.com/meko-christian/algo-approx/internal/approx"
+
+// FastGELU returns the tanh-form GELU activation
+// 0.5·x·(1 + tanh(√(2/π)·(x + 0.044715·x³))) using the default precision.
+//
+// This is the form used by GPT-2 and BERT. It differs from the exact
+// x·Φ(x) definition by at most about 4.7e-4; the approximation of the
+// exponential inside adds a relative error of about 1e-3 (Fast), 5e-6
+// (Balanced) and 1e-8 (High).
+func FastGELU[T Float](x T) T { return FastGELUPrec(x, PrecisionAuto) }
+
+// FastGELUPrec returns the tanh-form GELU activation using the requested
+// precision.
+func FastGELUPrec[T Float](x T, prec Precision) T {
+	return iapprox.GELU(x, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastGELU32(x float32) float32 { return FastGELU[float32](x) }
+func FastGELU64(x float64) float64 { return FastGELU[float64](x) }
+
+// FastGELUInto stores FastGELUPrec(src[i], prec) in dst[i]. dst may alias
+// src. It panics with ErrLengthMismatch if the slices differ in length.
+func FastGELUInto[T Float](dst, src []T, prec Precision) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("FastGELUInto")
+	}
+
+	p := iapprox.Precision(normalizePrecision(prec))
+	for i, x := range src {
+		dst[i] = iapprox.GELU(x, p)
+	}
+}
+
+// FastGELUIntoClamp is FastGELUInto with each result limited by c. It panics
+// with ErrDomainError if c.Lo > c.Hi or a bound is NaN.
+func FastGELUIntoClamp[T Float](dst, src []T, prec Precision, c Clamp[T]) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("FastGELUIntoClamp")
+	}
+
+	c.check("FastGELUIntoClamp")
+
+	p := iapprox.Precision(normalizePrecision(prec))
+	for i, x := range src {
+		dst[i] = c.Apply(iapprox.GELU(x, p))
+	}
+}
+
+// FastSiLU returns the SiLU (swish) activation x·sigmoid(x) using the default
+// precision. The relative error follows FastExp: about 1e-3 (Fast), 5e-6
+// (Balanced) and 1e-8 (High).
+func FastSiLU[T Float](x T) T { return FastSiLUPrec(x, PrecisionAuto) }
+
+// FastSiLUPrec returns x·sigmoid(x) using the requested precision.
+func FastSiLUPrec[T Float](x T, prec Precision) T {
+	return iapprox.SiLU(x, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastSiLU32(x float32) float32 { return FastSiLU[float32](x) }
+func FastSiLU64(x float64) float64 { return FastSiLU[float64](x) }
+
+// FastSiLUInto stores FastSiLUPrec(src[i], prec) in dst[i]. dst may alias
+// src. It panics with ErrLengthMismatch if the slices differ in length.
+func FastSiLUInto[T Float](dst, src []T, prec Precision) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("FastSiLUInto")
+	}
+
+	p := iapprox.Precision(normalizePrecision(prec))
+	for i, x := range src {
+		dst[i] = iapprox.SiLU(x, p)
+	}
+}
+
+// FastSiLUIntoClamp is FastSiLUInto with each result limited by c. It panics
+// with ErrDomainError if c.Lo > c.Hi or a bound is NaN.
+func FastSiLUIntoClamp[T Float](dst, src []T, prec Precision, c Clamp[T]) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("FastSiLUIntoClamp")
+	}
+
+	c.check("FastSiLUIntoClamp")
+
+	p := iapprox.Precision(normalizePrecision(prec))
+	for i, x := range src {
+		dst[i] = c.Apply(iapprox.SiLU(x, p))
+	}
+}
@@ -0,0 +1,47 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFastGELU_AgainstErfForm(t *testing.T) {
+	t.Parallel()
+
+	for x := -8.0; x <= 8; x += 0.01 {
+		want := 0.5 * x * (1 + math.Erf(x/math.Sqrt2))
+		if got := FastGELU(x); math.Abs(got-want) > 5e-4 {
+			t.Fatalf("FastGELU(%g) = %.8g, erf form %.8g", x, got, want)
+		}
+	}
+}
+
+func TestFastActivationInto(t *testing.T) {
+	t.Parallel()
+
+	src := []float32{-3, -0.5, 0, 0.5, 3}
+	gelu := make([]float32, len(src))
+	FastGELUInto(gelu, src, PrecisionHigh)
+
+	silu := append([]float32(nil), src...)
+	FastSiLUInto(silu, silu, PrecisionHigh)
+
+	for i, x := range src {
+		if gelu[i] != FastGELUPrec(x, PrecisionHigh) {
+			t.Fatalf("FastGELUInto[%d] = %g, want %g", i, gelu[i], FastGELUPrec(x, PrecisionHigh))
+		}
+
+		want := float64(x) / (1 + math.Exp(-float64(x)))
+		if math.Abs(float64(silu[i])-want) > 1e-6 {
+			t.Fatalf("FastSiLUInto[%d] = %g, want %g", i, silu[i], want)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("FastSiLUInto did not panic on length mismatch")
+		}
+	}()
+
+	FastSiLUInto(make([]float64, 1), make([]float64, 2), PrecisionFast)
+}
@@ -0,0 +1,183 @@
+package approx
+
+import (
+	"math"
+
+	"github.com/meko-christian/algo-approx/dd"
+	iapprox "github.com/meko-christian/algo-approx/internal/approx"
+)
+
+// GeometricMean returns the geometric mean (x[0]·x[1]···x[n-1])^(1/n) using
+// the default precision, for example to aggregate multiplicative scoring
+// factors without the product overflowing.
+func GeometricMean[T Float](x []T) T { return GeometricMeanPrec(x, PrecisionAuto) }
+
+// GeometricMeanPrec is GeometricMean using the requested precision. It is
+// exp of the mean of FastLog in one pass, with the logarithms summed in
+// compensated arithmetic so that long inputs add no error beyond that of
+// FastLog and FastExp.
+//
+// A zero element makes the result 0 and a +Inf element makes it +Inf; both
+// together, an empty slice, a negative element or NaN return NaN.
+func GeometricMeanPrec[T Float](x []T, prec Precision) T {
+	p := iapprox.Precision(normalizePrecision(prec))
+
+	var sum, comp float64
+
+	zero, inf := false, false
+
+	for _, v := range x {
+		f := float64(v)
+
+		switch {
+		case !(f >= 0): //nolint:staticcheck // also catches NaN
+			return T(math.NaN())
+		case f == 0:
+			zero = true
+
+			continue
+		case math.IsInf(f, 1):
+			// Kept out of the compensated sum, whose error term is NaN
+			// once an infinity enters.
+			inf = true
+
+			continue
+		}
+
+		var e float64
+		sum, e = dd.TwoSum(sum, iapprox.Log(f, p))
+		comp += e
+	}
+
+	switch {
+	case len(x) == 0 || zero && inf:
+		return T(math.NaN())
+	case zero:
+		return 0
+	case inf:
+		return T(math.Inf(1))
+	}
+
+	return T(iapprox.Exp((sum+comp)/float64(len(x)), p))
+}
+
+// WeightedGeometricMean returns Π x[i]^w[i] raised to 1/Σw[i], the
+// geometric mean of x with weights w, using the default precision.
+func WeightedGeometricMean[T Float](x, w []T) T {
+	return WeightedGeometricMeanPrec(x, w, PrecisionAuto)
+}
+
+// WeightedGeometricMeanPrec is WeightedGeometricMean using the requested
+// precision, computed like GeometricMeanPrec from the weighted sum of the
+// logarithms.
+//
+// Elements with zero weight are ignored; a zero element with positive
+// weight makes the result 0 and a +Inf one makes it +Inf. Both together, a
+// negative element or weight, NaN, or a total weight of zero returns NaN. It panics with ErrLengthMismatch if the slices
+// differ in length.
+func WeightedGeometricMeanPrec[T Float](x, w []T, prec Precision) T {
+	if len(x) != len(w) {
+		panicLengthMismatch("WeightedGeometricMean")
+	}
+
+	p := iapprox.Precision(normalizePrecision(prec))
+
+	var sum, comp, total float64
+
+	zero, inf := false, false
+
+	for i, v := range x {
+		f, wf := float64(v), float64(w[i])
+
+		switch {
+		case !(f >= 0) || !(wf >= 0): //nolint:staticcheck // also catches NaN
+			return T(math.NaN())
+		case wf == 0:
+			continue
+		case f == 0:
+			zero = true
+		case math.IsInf(f, 1):
+			inf = true
+		}
+
+		total += wf
+
+		if !zero && !inf {
+			l := iapprox.Log(f, p)
+			t, e := dd.TwoProd(wf, l)
+
+			var se float64
+			sum, se = dd.TwoSum(sum, t)
+			comp += e + se
+		}
+	}
+
+	switch {
+	case !(total > 0) || math.IsInf(total, 1) || zero && inf:
+		return T(math.NaN())
+	case zero:
+		return 0
+	case inf:
+		return T(math.Inf(1))
+	}
+
+	return T(iapprox.Exp((sum+comp)/total, p))
+}
+
+// LogMeanExp returns ln((e^x[0] + ... + e^x[n-1]) / n) using the default
+// precision, the log-domain mean of, for example, per-sample likelihoods.
+func LogMeanExp[T Float](x []T) T { return LogMeanExpPrec(x, PrecisionAuto) }
+
+// LogMeanExpPrec is LogMeanExp using the requested precision. One pass keeps
+// a running maximum m and the compensated sum of e^(x[i]-m), rescaled when m
+// grows, so nothing overflows and the result is m + ln(sum) - ln(n) with the
+// error of FastExp and FastLog.
+//
+// An empty slice or NaN returns NaN; all -Inf returns -Inf, and +Inf returns
+// +Inf.
+func LogMeanExpPrec[T Float](x []T, prec Precision) T {
+	if len(x) == 0 {
+		return T(math.NaN())
+	}
+
+	p := iapprox.Precision(normalizePrecision(prec))
+
+	m := math.Inf(-1)
+
+	var sum, comp float64
+
+	for _, v := range x {
+		f := float64(v)
+
+		switch {
+		case f != f: //nolint:gocritic
+			return T(math.NaN())
+		case f == m:
+			sum, comp = addComp(sum, comp, 1)
+		case f < m:
+			sum, comp = addComp(sum, comp, iapprox.Exp(f-m, p))
+		default:
+			// A new maximum; the rescale of an empty sum (m = -Inf) is 0.
+			if sum != 0 {
+				r := iapprox.Exp(m-f, p)
+				sum, comp = sum*r, comp*r
+			}
+
+			m = f
+			sum, comp = addComp(sum, comp, 1)
+		}
+	}
+
+	if math.IsInf(m, 0) {
+		return T(m)
+	}
+
+	return T(m + iapprox.Log(sum+comp, p) - iapprox.Log(float64(len(x)), p))
+}
+
+// addComp adds x to the compensated sum (sum, comp).
+func addComp(sum, comp, x float64) (s, c float64) {
+	s, e := dd.TwoSum(sum, x)
+
+	return s, comp + e
+}
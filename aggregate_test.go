@@ -0,0 +1,145 @@
+package approx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+func TestGeometricMean(t *testing.T) {
+	t.Parallel()
+
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		tol := contractBounds[FuncExp].maxError[prec-1] + contractBounds[FuncLog].maxError[prec-1]
+
+		if got := GeometricMeanPrec([]float64{1, 2, 4, 8}, prec); !testutil.Close(got, math.Sqrt(8), tol) {
+			t.Errorf("%v: GeometricMean(1, 2, 4, 8) = %g, want √8", prec, got)
+		}
+	}
+
+	// The product of these overflows float64; the log sum does not.
+	big := make([]float64, 1000)
+	for i := range big {
+		big[i] = 1e300
+	}
+
+	if got := GeometricMeanPrec(big, PrecisionHigh); !testutil.Close(got, 1e300, 1e-6) {
+		t.Errorf("GeometricMean(1e300 × 1000) = %g", got)
+	}
+
+	if got := GeometricMean([]float32{2, 0, 8}); got != 0 {
+		t.Errorf("GeometricMean with a zero = %v", got)
+	}
+
+	if got := GeometricMean([]float64{2, math.Inf(1)}); !math.IsInf(got, 1) {
+		t.Errorf("GeometricMean with +Inf = %v, want +Inf", got)
+	}
+
+	for _, x := range [][]float64{nil, {1, -2}, {1, math.NaN()}, {0, -1}, {0, math.Inf(1)}, {math.Inf(1), -1}} {
+		if got := GeometricMean(x); !math.IsNaN(got) {
+			t.Errorf("GeometricMean(%v) = %v, want NaN", x, got)
+		}
+	}
+}
+
+func TestWeightedGeometricMean(t *testing.T) {
+	t.Parallel()
+
+	// 2^1 · 8^3 = 2^10 over weight 4: 2^2.5.
+	x, w := []float64{2, 8, 5}, []float64{1, 3, 0}
+	if got := WeightedGeometricMeanPrec(x, w, PrecisionHigh); !testutil.Close(got, math.Pow(2, 2.5), 1e-7) {
+		t.Errorf("WeightedGeometricMean = %g, want 2^2.5", got)
+	}
+
+	// Equal weights reduce to GeometricMean.
+	if got, want := WeightedGeometricMean([]float64{3, 7, 11}, []float64{2, 2, 2}),
+		GeometricMean([]float64{3, 7, 11}); !testutil.Close(got, want, 1e-12) {
+		t.Errorf("equal weights: %g, GeometricMean %g", got, want)
+	}
+
+	if got := WeightedGeometricMean([]float64{0, 4}, []float64{0, 1}); !testutil.Close(got, 4, 5e-5) {
+		t.Errorf("zero element with zero weight: %g, want 4", got)
+	}
+
+	if got := WeightedGeometricMean([]float64{0, 4}, []float64{1, 1}); got != 0 {
+		t.Errorf("zero element with weight: %g, want 0", got)
+	}
+
+	if got := WeightedGeometricMean([]float64{2, math.Inf(1)}, []float64{1, 1}); !math.IsInf(got, 1) {
+		t.Errorf("+Inf element with weight: %g, want +Inf", got)
+	}
+
+	if got := WeightedGeometricMean([]float64{2, math.Inf(1)}, []float64{1, 0}); !testutil.Close(got, 2, 5e-5) {
+		t.Errorf("+Inf element with zero weight: %g, want 2", got)
+	}
+
+	for _, c := range [][2][]float64{
+		{{1, 2}, {0, 0}}, {{1, 2}, {1, -1}}, {{-1, 2}, {1, 1}}, {nil, nil}, {{0, math.Inf(1)}, {1, 1}},
+	} {
+		if got := WeightedGeometricMean(c[0], c[1]); !math.IsNaN(got) {
+			t.Errorf("WeightedGeometricMean(%v, %v) = %g, want NaN", c[0], c[1], got)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("length mismatch did not panic")
+		}
+	}()
+
+	WeightedGeometricMean([]float64{1, 2}, []float64{1})
+}
+
+func TestLogMeanExp(t *testing.T) {
+	t.Parallel()
+
+	x := []float64{-3, 1.5, 0, 2, -50}
+
+	var s float64
+	for _, v := range x {
+		s += math.Exp(v)
+	}
+
+	want := math.Log(s / float64(len(x)))
+
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		tol := 2*contractBounds[FuncLog].maxError[prec-1] + contractBounds[FuncExp].maxError[prec-1]
+
+		if got := LogMeanExpPrec(x, prec); math.Abs(got-want) > tol {
+			t.Errorf("%v: LogMeanExp = %.10g, want %.10g", prec, got, want)
+		}
+	}
+
+	// Naive evaluation overflows; the running maximum keeps it at 1000.
+	if got := LogMeanExpPrec([]float64{1000, 1000, 1000}, PrecisionHigh); !testutil.Close(got, 1000, 1e-9) {
+		t.Errorf("LogMeanExp(1000, 1000, 1000) = %g", got)
+	}
+
+	// An increasing input rescales on every element.
+	if got := LogMeanExpPrec([]float32{-800, -790, -780}, PrecisionHigh); !testutil.Close(got, float32(-780+math.Log((math.Exp(-20)+math.Exp(-10)+1)/3)), 1e-6) {
+		t.Errorf("LogMeanExp(-800, -790, -780) = %g", got)
+	}
+
+	inf := math.Inf(1)
+	cases := []struct {
+		x    []float64
+		want float64
+	}{
+		{[]float64{-inf, -inf}, -inf},
+		{[]float64{-inf, 0}, -math.Ln2},
+		{[]float64{3, inf, 1}, inf},
+	}
+
+	for _, c := range cases {
+		if got := LogMeanExp(c.x); !testutil.Close(got, c.want, 5e-5) {
+			t.Errorf("LogMeanExp(%v) = %g, want %g", c.x, got, c.want)
+		}
+	}
+
+	for _, x := range [][]float64{nil, {1, math.NaN()}} {
+		if got := LogMeanExp(x); !math.IsNaN(got) {
+			t.Errorf("LogMeanExp(%v) = %g, want NaN", x, got)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package approx
+
+import (
+	"math"
+
+	iapprox "github.com/meko-christian/algo-approx/internal/approx"
+)
+
+// AngleBetween2 returns the angle in radians in [0, π] between the 2D vectors
+// a and b using the default precision.
+func AngleBetween2[T Float](a, b [2]T) T { return AngleBetween2Prec(a, b, PrecisionAuto) }
+
+// AngleBetween2Prec is AngleBetween2 using the requested precision.
+func AngleBetween2Prec[T Float](a, b [2]T, prec Precision) T {
+	ab := compensatedDot(a[:], b[:])
+	aa := compensatedDot(a[:], a[:])
+	bb := compensatedDot(b[:], b[:])
+
+	return T(angleFromDots(ab, aa, bb, iapprox.Precision(normalizePrecision(prec))))
+}
+
+// AngleBetween3 returns the angle in radians in [0, π] between the 3D vectors
+// a and b using the default precision.
+//
+// The dot products are compensated, the normalization uses FastInvSqrt and
+// the cosine is clamped to [-1, 1] before FastArccos, so rounding noise for
+// (anti)parallel vectors cannot produce NaN. A zero-length vector yields NaN.
+func AngleBetween3[T Float](a, b [3]T) T { return AngleBetween3Prec(a, b, PrecisionAuto) }
+
+// AngleBetween3Prec is AngleBetween3 using the requested precision.
+func AngleBetween3Prec[T Float](a, b [3]T, prec Precision) T {
+	ab := compensatedDot(a[:], b[:])
+	aa := compensatedDot(a[:], a[:])
+	bb := compensatedDot(b[:], b[:])
+
+	return T(angleFromDots(ab, aa, bb, iapprox.Precision(normalizePrecision(prec))))
+}
+
+func angleFromDots(ab, aa, bb float64, prec iapprox.Precision) float64 {
+	den := aa * bb
+	if den == 0 {
+		return math.NaN()
+	}
+
+	var cosTheta float64
+	if den > minNormalFloat64 && den < maxSquareFloat64 {
+		cosTheta = ab * iapprox.InvSqrt(den, prec)
+	} else {
+		// Normalize each length separately when the product would leave the
+		// normal range.
+		cosTheta = ab * iapprox.InvSqrt(aa, prec) * iapprox.InvSqrt(bb, prec)
+	}
+
+	return iapprox.Arccos(min(max(cosTheta, -1), 1), prec)
+}
+
+// compensatedDot returns the dot product of a and b evaluated in float64
+// with an error-free product transformation (FMA) and Kahan-style summation
+// of the rounding terms, so the result is as accurate as if computed in twice
+// the working precision.
+func compensatedDot[T Float](a, b []T) float64 {
+	var sum, comp float64
+
+	for i := range a {
+		x, y := float64(a[i]), float64(b[i])
+
+		p := x * y
+		pErr := math.FMA(x, y, -p)
+
+		// TwoSum of the running sum and p.
+		s := sum + p
+		bv := s - sum
+		sErr := (sum - (s - bv)) + (p - bv)
+
+		sum = s
+		comp += pErr + sErr
+	}
+
+	return sum + comp
+}
@@ -0,0 +1,71 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAngleBetween3(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		a, b [3]float64
+		want float64
+	}{
+		{[3]float64{1, 0, 0}, [3]float64{0, 1, 0}, math.Pi / 2},
+		{[3]float64{1, 0, 0}, [3]float64{1, 1, 0}, math.Pi / 4},
+		{[3]float64{1, 2, 3}, [3]float64{-1, -2, -3}, math.Pi},
+		{[3]float64{1, 2, 3}, [3]float64{2, 4, 6}, 0},
+		{[3]float64{1, 0, 0}, [3]float64{-1, 1, 0}, 3 * math.Pi / 4},
+	}
+
+	for _, tc := range cases {
+		got := AngleBetween3Prec(tc.a, tc.b, PrecisionHigh)
+		if math.IsNaN(got) || math.Abs(got-tc.want) > 1e-4 {
+			t.Errorf("AngleBetween3(%v, %v) = %v, want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestAngleBetween_ParallelNeverNaN(t *testing.T) {
+	t.Parallel()
+
+	// Rounding pushes the naive normalized dot slightly above 1 for these.
+	for _, v := range [][3]float32{{0.1, 0.2, 0.3}, {1e-3, 7, 3.3}, {1e10, 1e-10, 5}} {
+		for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+			if got := AngleBetween3Prec(v, v, prec); math.IsNaN(float64(got)) {
+				t.Fatalf("AngleBetween3(%v, %v) at %v is NaN", v, v, prec)
+			}
+
+			neg := [3]float32{-v[0], -v[1], -v[2]}
+			if got := AngleBetween3Prec(v, neg, prec); math.IsNaN(float64(got)) {
+				t.Fatalf("AngleBetween3(%v, -v) at %v is NaN", v, prec)
+			}
+		}
+	}
+}
+
+func TestAngleBetween2(t *testing.T) {
+	t.Parallel()
+
+	got := AngleBetween2([2]float64{3, 0}, [2]float64{0, -2})
+	if math.Abs(got-math.Pi/2) > 1e-3 {
+		t.Fatalf("AngleBetween2 = %v, want π/2", got)
+	}
+
+	if got := AngleBetween2([2]float64{0, 0}, [2]float64{1, 0}); !math.IsNaN(got) {
+		t.Fatalf("AngleBetween2 with zero vector = %v, want NaN", got)
+	}
+}
+
+func TestCompensatedDot(t *testing.T) {
+	t.Parallel()
+
+	// Naive summation loses the small terms entirely.
+	a := []float64{1e16, 1, -1e16}
+	b := []float64{1, 1, 1}
+
+	if got := compensatedDot(a, b); got != 1 {
+		t.Fatalf("compensatedDot = %v, want 1", got)
+	}
+}
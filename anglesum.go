@@ -0,0 +1,57 @@
+package approx
+
+import "math"
+
+// SinSumOf returns sin(a+b) from the sines and cosines of a and b, by
+// sin(a+b) = sin a·cos b + cos a·sin b. It suits code that already holds
+// them, such as a cached per-object orientation turned by a small delta,
+// and costs two multiplications and a fused multiply-add.
+func SinSumOf[T Float](sinA, cosA, sinB, cosB T) T {
+	return T(math.FMA(float64(sinA), float64(cosB), float64(cosA)*float64(sinB)))
+}
+
+// CosSumOf returns cos(a+b) = cos a·cos b - sin a·sin b from the sines and
+// cosines of a and b; see SinSumOf.
+func CosSumOf[T Float](sinA, cosA, sinB, cosB T) T {
+	return T(math.FMA(float64(cosA), float64(cosB), -float64(sinA)*float64(sinB)))
+}
+
+// SinDiffOf returns sin(a-b) = sin a·cos b - cos a·sin b from the sines and
+// cosines of a and b; see SinSumOf.
+func SinDiffOf[T Float](sinA, cosA, sinB, cosB T) T { return SinSumOf(sinA, cosA, -sinB, cosB) }
+
+// CosDiffOf returns cos(a-b) = cos a·cos b + sin a·sin b from the sines and
+// cosines of a and b; see SinSumOf.
+func CosDiffOf[T Float](sinA, cosA, sinB, cosB T) T { return CosSumOf(sinA, cosA, -sinB, cosB) }
+
+// FastSinSum returns an approximate sin(a+b) using the default precision.
+func FastSinSum[T Float](a, b T) T { return FastSinSumPrec(a, b, PrecisionAuto) }
+
+// FastSinSumPrec returns an approximate sin(a+b) using the requested
+// precision. It evaluates FastSinCosPrec of a and b separately and combines
+// them with SinSumOf, so a small b is not lost to rounding in a+b when a is
+// large; where the sum is exact, FastSinPrec(a+b, prec) is cheaper.
+func FastSinSumPrec[T Float](a, b T, prec Precision) T {
+	sa, ca := FastSinCosPrec(a, prec)
+	sb, cb := FastSinCosPrec(b, prec)
+
+	return SinSumOf(sa, ca, sb, cb)
+}
+
+func FastSinSum32(a, b float32) float32 { return FastSinSum[float32](a, b) }
+func FastSinSum64(a, b float64) float64 { return FastSinSum[float64](a, b) }
+
+// FastCosSum returns an approximate cos(a+b) using the default precision.
+func FastCosSum[T Float](a, b T) T { return FastCosSumPrec(a, b, PrecisionAuto) }
+
+// FastCosSumPrec returns an approximate cos(a+b) using the requested
+// precision; see FastSinSumPrec.
+func FastCosSumPrec[T Float](a, b T, prec Precision) T {
+	sa, ca := FastSinCosPrec(a, prec)
+	sb, cb := FastSinCosPrec(b, prec)
+
+	return CosSumOf(sa, ca, sb, cb)
+}
+
+func FastCosSum32(a, b float32) float32 { return FastCosSum[float32](a, b) }
+func FastCosSum64(a, b float64) float64 { return FastCosSum[float64](a, b) }
@@ -0,0 +1,85 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSumOf(t *testing.T) {
+	t.Parallel()
+
+	for _, ab := range [][2]float64{{0.3, 0.4}, {-2, 5}, {1e-9, 3}, {math.Pi, -math.Pi / 2}} {
+		a, b := ab[0], ab[1]
+		sa, ca := math.Sincos(a)
+		sb, cb := math.Sincos(b)
+
+		for _, tc := range []struct {
+			name      string
+			got, want float64
+		}{
+			{"SinSumOf", SinSumOf(sa, ca, sb, cb), math.Sin(a + b)},
+			{"CosSumOf", CosSumOf(sa, ca, sb, cb), math.Cos(a + b)},
+			{"SinDiffOf", SinDiffOf(sa, ca, sb, cb), math.Sin(a - b)},
+			{"CosDiffOf", CosDiffOf(sa, ca, sb, cb), math.Cos(a - b)},
+		} {
+			if math.Abs(tc.got-tc.want) > 1e-15 {
+				t.Errorf("%s(%g, %g) = %.17g, want %.17g", tc.name, a, b, tc.got, tc.want)
+			}
+		}
+	}
+}
+
+func TestFastSinSum(t *testing.T) {
+	t.Parallel()
+
+	tols := map[Precision]float64{PrecisionFast: 1e-3, PrecisionBalanced: 1e-7, PrecisionHigh: 1e-11}
+
+	for prec, tol := range tols {
+		for a := -6.0; a <= 6; a += 0.37 {
+			for b := -3.0; b <= 3; b += 0.29 {
+				if got, want := FastSinSumPrec(a, b, prec), math.Sin(a+b); math.Abs(got-want) > tol {
+					t.Fatalf("%v: FastSinSum(%g, %g) = %g, want %g", prec, a, b, got, want)
+				}
+
+				if got, want := FastCosSumPrec(a, b, prec), math.Cos(a+b); math.Abs(got-want) > tol {
+					t.Fatalf("%v: FastCosSum(%g, %g) = %g, want %g", prec, a, b, got, want)
+				}
+			}
+		}
+	}
+}
+
+// TestFastSinSum_SmallDelta checks that a delta below half an ulp of a still
+// turns the result, unlike FastSin(a+b).
+func TestFastSinSum_SmallDelta(t *testing.T) {
+	t.Parallel()
+
+	a, b := float32(1000), float32(1e-5)
+	if a+b != a {
+		t.Fatal("the delta no longer vanishes in a+b")
+	}
+
+	base := FastSinSumPrec(a, 0, PrecisionHigh)
+	got := FastSinSumPrec(a, b, PrecisionHigh)
+	want := float64(base) + float64(b)*math.Cos(1000)
+
+	if math.Abs(float64(got)-want) > 1e-6 || got == base {
+		t.Errorf("FastSinSum32(1000, 1e-5) = %v, want %v", got, want)
+	}
+
+	if got := FastSinSum32(1, 2); math.Abs(float64(got)-math.Sin(3)) > 1e-6 {
+		t.Errorf("FastSinSum32(1, 2) = %v", got)
+	}
+
+	if got := FastSinSum64(1, 2); math.Abs(got-math.Sin(3)) > 1e-6 {
+		t.Errorf("FastSinSum64(1, 2) = %v", got)
+	}
+
+	if got := FastCosSum32(1, 2); math.Abs(float64(got)-math.Cos(3)) > 1e-6 {
+		t.Errorf("FastCosSum32(1, 2) = %v", got)
+	}
+
+	if got := FastCosSum64(1, 2); math.Abs(got-math.Cos(3)) > 1e-6 {
+		t.Errorf("FastCosSum64(1, 2) = %v", got)
+	}
+}
@@ -7,7 +7,9 @@ func FastSqrt[T Float](x T) T { return FastSqrtPrec(x, PrecisionAuto) }
 
 // FastSqrtPrec returns an approximate square root using the requested precision.
 func FastSqrtPrec[T Float](x T, prec Precision) T {
-	return iapprox.Sqrt(x, iapprox.Precision(normalizePrecision(prec)))
+	checkNonNegative("FastSqrt", x, prec)
+
+	return conform(FuncSqrt, x, prec, iapprox.Sqrt(x, iapprox.Precision(normalizePrecision(prec))))
 }
 
 func FastSqrt32(x float32) float32 { return FastSqrt[float32](x) }
@@ -18,18 +20,52 @@ func FastInvSqrt[T Float](x T) T { return FastInvSqrtPrec(x, PrecisionAuto) }
 
 // FastInvSqrtPrec returns an approximate inverse square root using the requested precision.
 func FastInvSqrtPrec[T Float](x T, prec Precision) T {
-	return iapprox.InvSqrt(x, iapprox.Precision(normalizePrecision(prec)))
+	checkPositive("FastInvSqrt", x, prec)
+
+	return conform(FuncInvSqrt, x, prec, iapprox.InvSqrt(x, iapprox.Precision(normalizePrecision(prec))))
 }
 
 func FastInvSqrt32(x float32) float32 { return FastInvSqrt[float32](x) }
 func FastInvSqrt64(x float64) float64 { return FastInvSqrt[float64](x) }
 
+// FastInvSqrt4 returns approximate inverse square roots of four float32 lanes
+// using the default precision. The fixed-size form stays in registers and
+// needs no slice, which suits vec3/vec4 math.
+func FastInvSqrt4(x [4]float32) [4]float32 { return FastInvSqrt4Prec(x, PrecisionAuto) }
+
+// FastInvSqrt4Prec is FastInvSqrt4 with the requested precision. Each lane
+// matches FastInvSqrtPrec bit for bit.
+func FastInvSqrt4Prec(x [4]float32, prec Precision) [4]float32 {
+	for _, v := range x {
+		checkPositive("FastInvSqrt4", v, prec)
+	}
+
+	return iapprox.InvSqrt4(x, iapprox.Precision(normalizePrecision(prec)))
+}
+
+// FastInvSqrt2 returns approximate inverse square roots of two float64 lanes
+// using the default precision.
+func FastInvSqrt2(x [2]float64) [2]float64 { return FastInvSqrt2Prec(x, PrecisionAuto) }
+
+// FastInvSqrt2Prec is FastInvSqrt2 with the requested precision. Each lane
+// matches FastInvSqrtPrec bit for bit.
+func FastInvSqrt2Prec(x [2]float64, prec Precision) [2]float64 {
+	for _, v := range x {
+		checkPositive("FastInvSqrt2", v, prec)
+	}
+
+	return iapprox.InvSqrt2(x, iapprox.Precision(normalizePrecision(prec)))
+}
+
 // FastLog returns an approximate natural logarithm ln(x) using the default precision.
 func FastLog[T Float](x T) T { return FastLogPrec(x, PrecisionAuto) }
 
 // FastLogPrec returns an approximate natural logarithm ln(x) using the requested precision.
 func FastLogPrec[T Float](x T, prec Precision) T {
-	return iapprox.Log(x, iapprox.Precision(normalizePrecision(prec)))
+	checkPositive("FastLog", x, prec)
+	noteSubnormalLog("FastLog", x, prec)
+
+	return conform(FuncLog, x, prec, iapprox.Log(x, iapprox.Precision(normalizePrecision(prec))))
 }
 
 func FastLog32(x float32) float32 { return FastLog[float32](x) }
@@ -40,19 +76,65 @@ func FastExp[T Float](x T) T { return FastExpPrec(x, PrecisionAuto) }
 
 // FastExpPrec returns an approximate exponential e^x using the requested precision.
 func FastExpPrec[T Float](x T, prec Precision) T {
-	return iapprox.Exp(x, iapprox.Precision(normalizePrecision(prec)))
+	checkExpRange("FastExp", x, prec)
+
+	return conform(FuncExp, x, prec, iapprox.Exp(x, iapprox.Precision(normalizePrecision(prec))))
 }
 
 func FastExp32(x float32) float32 { return FastExp[float32](x) }
 func FastExp64(x float64) float64 { return FastExp[float64](x) }
 
+// FastLog2 returns an approximate base-2 logarithm using the default precision.
+func FastLog2[T Float](x T) T { return FastLog2Prec(x, PrecisionAuto) }
+
+// FastLog2Prec returns an approximate base-2 logarithm using the requested precision.
+func FastLog2Prec[T Float](x T, prec Precision) T {
+	checkPositive("FastLog2", x, prec)
+	noteSubnormalLog("FastLog2", x, prec)
+
+	return conform(FuncLog2, x, prec, iapprox.Log2(x, iapprox.Precision(normalizePrecision(prec))))
+}
+
+func FastLog232(x float32) float32 { return FastLog2[float32](x) }
+func FastLog264(x float64) float64 { return FastLog2[float64](x) }
+
+// FastExp2 returns an approximate base-2 exponential 2^x using the default precision.
+func FastExp2[T Float](x T) T { return FastExp2Prec(x, PrecisionAuto) }
+
+// FastExp2Prec returns an approximate base-2 exponential 2^x using the requested precision.
+// Integer arguments produce exact powers of two.
+func FastExp2Prec[T Float](x T, prec Precision) T {
+	return conform(FuncExp2, x, prec, iapprox.Exp2(x, iapprox.Precision(normalizePrecision(prec))))
+}
+
+func FastExp232(x float32) float32 { return FastExp2[float32](x) }
+func FastExp264(x float64) float64 { return FastExp2[float64](x) }
+
+// FastTanh returns an approximate hyperbolic tangent using the default precision.
+func FastTanh[T Float](x T) T { return FastTanhPrec(x, PrecisionAuto) }
+
+// FastTanhPrec returns an approximate hyperbolic tangent using the requested
+// precision: a Taylor series for |x| < 0.55 and 1 - 2/(e^2|x|+1) with the
+// exponential polynomial of the tier above, saturating to ±1 from |x| = 19.1 where tanh rounds to
+// it. Relative error is about 3e-4 (Fast), 3e-6 (Balanced) or 5e-9 (High)
+// everywhere.
+func FastTanhPrec[T Float](x T, prec Precision) T {
+	return iapprox.Tanh(x, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastTanh32(x float32) float32 { return FastTanh[float32](x) }
+func FastTanh64(x float64) float64 { return FastTanh[float64](x) }
+
 // FastSin returns an approximate sine using the default precision.
 func FastSin[T Float](x T) T { return FastSinPrec(x, PrecisionAuto) }
 
 // FastSinPrec returns an approximate sine using the requested precision.
 // Fast=3-term (~3.2 digits), Balanced=5-term (~7.3 digits), High=7-term (~12.1 digits).
 func FastSinPrec[T Float](x T, prec Precision) T {
-	return iapprox.Sin(x, iapprox.Precision(normalizePrecision(prec)))
+	checkFinite("FastSin", x, prec)
+	noteReduction("FastSin", x, prec)
+
+	return conform(FuncSin, x, prec, iapprox.Sin(x, iapprox.Precision(normalizePrecision(prec))))
 }
 
 func FastSin32(x float32) float32 { return FastSin[float32](x) }
@@ -64,18 +146,100 @@ func FastCos[T Float](x T) T { return FastCosPrec(x, PrecisionAuto) }
 // FastCosPrec returns an approximate cosine using the requested precision.
 // Fast=3-term (~3.2 digits), Balanced=5-term (~7.3 digits), High=7-term (~12.1 digits).
 func FastCosPrec[T Float](x T, prec Precision) T {
-	return iapprox.Cos(x, iapprox.Precision(normalizePrecision(prec)))
+	checkFinite("FastCos", x, prec)
+	noteReduction("FastCos", x, prec)
+
+	return conform(FuncCos, x, prec, iapprox.Cos(x, iapprox.Precision(normalizePrecision(prec))))
 }
 
 func FastCos32(x float32) float32 { return FastCos[float32](x) }
 func FastCos64(x float64) float64 { return FastCos[float64](x) }
 
+// FastSinPi returns an approximate sin(πx) using the default precision.
+func FastSinPi[T Float](x T) T { return FastSinPiPrec(x, PrecisionAuto) }
+
+// FastSinPiPrec returns an approximate sin(πx) using the requested precision.
+// The argument is reduced exactly via the fractional part of x, so integers and
+// half-integers produce exact zeros and ±1 regardless of magnitude.
+// Fast=3-term (~3.5 digits), Balanced=5-term (~7.6 digits), High=7-term (~12.4 digits).
+func FastSinPiPrec[T Float](x T, prec Precision) T {
+	checkFinite("FastSinPi", x, prec)
+
+	return iapprox.SinPi(x, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastSinPi32(x float32) float32 { return FastSinPi[float32](x) }
+func FastSinPi64(x float64) float64 { return FastSinPi[float64](x) }
+
+// FastCosPi returns an approximate cos(πx) using the default precision.
+func FastCosPi[T Float](x T) T { return FastCosPiPrec(x, PrecisionAuto) }
+
+// FastCosPiPrec returns an approximate cos(πx) using the requested precision.
+// Fast=3-term (~3.5 digits), Balanced=5-term (~7.6 digits), High=7-term (~12.4 digits).
+func FastCosPiPrec[T Float](x T, prec Precision) T {
+	checkFinite("FastCosPi", x, prec)
+
+	return iapprox.CosPi(x, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastCosPi32(x float32) float32 { return FastCosPi[float32](x) }
+func FastCosPi64(x float64) float64 { return FastCosPi[float64](x) }
+
+// FastSinTurns returns an approximate sin(2πx) for an angle x in full
+// revolutions using the default precision.
+func FastSinTurns[T Float](x T) T { return FastSinTurnsPrec(x, PrecisionAuto) }
+
+// FastSinTurnsPrec returns an approximate sin(2πx) using the requested precision.
+// Reduction by the fractional part of x is exact; see FastSinPiPrec for tiers.
+func FastSinTurnsPrec[T Float](x T, prec Precision) T {
+	checkFinite("FastSinTurns", x, prec)
+
+	return iapprox.SinTurns(x, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastSinTurns32(x float32) float32 { return FastSinTurns[float32](x) }
+func FastSinTurns64(x float64) float64 { return FastSinTurns[float64](x) }
+
+// FastCosTurns returns an approximate cos(2πx) for an angle x in full
+// revolutions using the default precision.
+func FastCosTurns[T Float](x T) T { return FastCosTurnsPrec(x, PrecisionAuto) }
+
+// FastCosTurnsPrec returns an approximate cos(2πx) using the requested precision.
+func FastCosTurnsPrec[T Float](x T, prec Precision) T {
+	checkFinite("FastCosTurns", x, prec)
+
+	return iapprox.CosTurns(x, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastCosTurns32(x float32) float32 { return FastCosTurns[float32](x) }
+func FastCosTurns64(x float64) float64 { return FastCosTurns[float64](x) }
+
+// FastSinCos returns approximate sin(x) and cos(x) using the default precision.
+func FastSinCos[T Float](x T) (sin, cos T) { return FastSinCosPrec(x, PrecisionAuto) }
+
+// FastSinCosPrec returns approximate sin(x) and cos(x) using the requested
+// precision. Both share one range reduction to |y| <= π/4, which makes the pair
+// cheaper and more accurate than separate FastSin and FastCos calls.
+// Fast=3-term (~3.5 digits), Balanced=5-term (~7.6 digits), High=7-term (~12.4 digits).
+func FastSinCosPrec[T Float](x T, prec Precision) (sin, cos T) {
+	checkFinite("FastSinCos", x, prec)
+	noteReduction("FastSinCos", x, prec)
+
+	return iapprox.SinCos(x, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastSinCos32(x float32) (sin, cos float32) { return FastSinCos[float32](x) }
+func FastSinCos64(x float64) (sin, cos float64) { return FastSinCos[float64](x) }
+
 // FastSec returns an approximate secant using the default precision.
 func FastSec[T Float](x T) T { return FastSecPrec(x, PrecisionAuto) }
 
 // FastSecPrec returns an approximate secant using the requested precision.
 func FastSecPrec[T Float](x T, prec Precision) T {
-	return iapprox.Sec(x, iapprox.Precision(normalizePrecision(prec)))
+	checkFinite("FastSec", x, prec)
+	noteReduction("FastSec", x, prec)
+
+	return conform(FuncSec, x, prec, iapprox.Sec(x, iapprox.Precision(normalizePrecision(prec))))
 }
 
 func FastSec32(x float32) float32 { return FastSec[float32](x) }
@@ -86,7 +250,10 @@ func FastCsc[T Float](x T) T { return FastCscPrec(x, PrecisionAuto) }
 
 // FastCscPrec returns an approximate cosecant using the requested precision.
 func FastCscPrec[T Float](x T, prec Precision) T {
-	return iapprox.Csc(x, iapprox.Precision(normalizePrecision(prec)))
+	checkFinite("FastCsc", x, prec)
+	noteReduction("FastCsc", x, prec)
+
+	return conform(FuncCsc, x, prec, iapprox.Csc(x, iapprox.Precision(normalizePrecision(prec))))
 }
 
 func FastCsc32(x float32) float32 { return FastCsc[float32](x) }
@@ -97,7 +264,10 @@ func FastTan[T Float](x T) T { return FastTanPrec(x, PrecisionAuto) }
 
 // FastTanPrec returns an approximate tangent using the requested precision.
 func FastTanPrec[T Float](x T, prec Precision) T {
-	return iapprox.Tan(x, iapprox.Precision(normalizePrecision(prec)))
+	checkFinite("FastTan", x, prec)
+	noteReduction("FastTan", x, prec)
+
+	return conform(FuncTan, x, prec, iapprox.Tan(x, iapprox.Precision(normalizePrecision(prec))))
 }
 
 func FastTan32(x float32) float32 { return FastTan[float32](x) }
@@ -108,7 +278,10 @@ func FastCotan[T Float](x T) T { return FastCotanPrec(x, PrecisionAuto) }
 
 // FastCotanPrec returns an approximate cotangent using the requested precision.
 func FastCotanPrec[T Float](x T, prec Precision) T {
-	return iapprox.Cotan(x, iapprox.Precision(normalizePrecision(prec)))
+	checkFinite("FastCotan", x, prec)
+	noteReduction("FastCotan", x, prec)
+
+	return conform(FuncCotan, x, prec, iapprox.Cotan(x, iapprox.Precision(normalizePrecision(prec))))
 }
 
 func FastCotan32(x float32) float32 { return FastCotan[float32](x) }
@@ -120,7 +293,10 @@ func FastArctan[T Float](x T) T { return FastArctanPrec(x, PrecisionAuto) }
 // FastArctanPrec returns an approximate arctangent using the requested precision.
 // Fast/Balanced=3-term (~6.6 digits), High=6-term (~13.7 digits).
 func FastArctanPrec[T Float](x T, prec Precision) T {
-	return iapprox.Arctan(x, iapprox.Precision(normalizePrecision(prec)))
+	checkArctanRange("FastArctan", x, prec)
+	noteArctanRange("FastArctan", x, prec)
+
+	return conform(FuncArctan, x, prec, iapprox.Arctan(x, iapprox.Precision(normalizePrecision(prec))))
 }
 
 func FastArctan32(x float32) float32 { return FastArctan[float32](x) }
@@ -132,7 +308,10 @@ func FastArccotan[T Float](x T) T { return FastArccotanPrec(x, PrecisionAuto) }
 // FastArccotanPrec returns an approximate arccotangent using the requested precision.
 // Fast/Balanced=3-term (~6.6 digits), High=6-term (~13.7 digits).
 func FastArccotanPrec[T Float](x T, prec Precision) T {
-	return iapprox.Arccotan(x, iapprox.Precision(normalizePrecision(prec)))
+	checkArctanRange("FastArccotan", x, prec)
+	noteArctanRange("FastArccotan", x, prec)
+
+	return conform(FuncArccotan, x, prec, iapprox.Arccotan(x, iapprox.Precision(normalizePrecision(prec))))
 }
 
 func FastArccotan32(x float32) float32 { return FastArccotan[float32](x) }
@@ -142,18 +321,65 @@ func FastArccotan64(x float64) float64 { return FastArccotan[float64](x) }
 func FastArccos[T Float](x T) T { return FastArccosPrec(x, PrecisionAuto) }
 
 // FastArccosPrec returns an approximate arccosine using the requested precision.
-// Fast/Balanced=3-term (~6.6 digits), High=6-term (~13.7 digits).
+// The error is uniform over [-1, 1], including near ±1: at most about 9e-4
+// (Fast), 5e-6 (Balanced) and 1e-8 (High). Inputs outside [-1, 1] return NaN.
 func FastArccosPrec[T Float](x T, prec Precision) T {
-	return iapprox.Arccos(x, iapprox.Precision(normalizePrecision(prec)))
+	checkSignedUnitInterval("FastArccos", x, prec)
+
+	return conform(FuncArccos, x, prec, iapprox.Arccos(x, iapprox.Precision(normalizePrecision(prec))))
 }
 
 func FastArccos32(x float32) float32 { return FastArccos[float32](x) }
 func FastArccos64(x float64) float64 { return FastArccos[float64](x) }
 
-// FastPower returns an approximate power base^exponent.
+// FastArcsec returns an approximate arcsecant using the default precision.
+func FastArcsec[T Float](x T) T { return FastArcsecPrec(x, PrecisionAuto) }
+
+// FastArcsecPrec returns an approximate arcsec(x) = arccos(1/x) in [0, π]
+// using the requested precision. The domain is |x| >= 1; other inputs return
+// NaN. The error matches FastArccosPrec.
+func FastArcsecPrec[T Float](x T, prec Precision) T {
+	checkOutsideUnitInterval("FastArcsec", x, prec)
+
+	return conform(FuncArcsec, x, prec, iapprox.Arcsec(x, iapprox.Precision(normalizePrecision(prec))))
+}
+
+func FastArcsec32(x float32) float32 { return FastArcsec[float32](x) }
+func FastArcsec64(x float64) float64 { return FastArcsec[float64](x) }
+
+// FastArccsc returns an approximate arccosecant using the default precision.
+func FastArccsc[T Float](x T) T { return FastArccscPrec(x, PrecisionAuto) }
+
+// FastArccscPrec returns an approximate arccsc(x) = arcsin(1/x) in
+// [-π/2, π/2] using the requested precision. The domain is |x| >= 1; other
+// inputs return NaN. The error matches FastArccosPrec, and small results for
+// large |x| keep their relative accuracy.
+func FastArccscPrec[T Float](x T, prec Precision) T {
+	checkOutsideUnitInterval("FastArccsc", x, prec)
+
+	return conform(FuncArccsc, x, prec, iapprox.Arccsc(x, iapprox.Precision(normalizePrecision(prec))))
+}
+
+func FastArccsc32(x float32) float32 { return FastArccsc[float32](x) }
+func FastArccsc64(x float64) float64 { return FastArccsc[float64](x) }
+
+// FastPower returns an approximate power base^exponent using the default
+// precision.
 // Uses exp/log composition: base^exponent = exp(exponent * ln(base)).
-func FastPower[T Float](base, exponent T) T {
-	return iapprox.Power(base, exponent)
+func FastPower[T Float](base, exponent T) T { return FastPowerPrec(base, exponent, PrecisionAuto) }
+
+// FastPowerPrec returns an approximate base^exponent using the requested
+// precision. Fast and Balanced compose FastLog and FastExp, so the absolute
+// error of the log is multiplied by the exponent: FastPower(3, 2) is only good
+// to about 1e-6. High carries the log and its product with the exponent in
+// double-double arithmetic, for a relative error of about 1e-15 wherever the
+// result is a normal float64.
+//
+// Negative bases and NaN return NaN; 0^0 is 1.
+func FastPowerPrec[T Float](base, exponent T, prec Precision) T {
+	checkNonNegative("FastPower", base, prec)
+
+	return iapprox.PowerPrec(base, exponent, iapprox.Precision(normalizePrecision(prec)))
 }
 
 func FastPower32(base, exponent float32) float32 { return FastPower[float32](base, exponent) }
@@ -162,12 +388,54 @@ func FastPower64(base, exponent float64) float64 { return FastPower[float64](bas
 // FastRoot returns an approximate nth root of value.
 // Uses the identity: root(value, n) = value^(1/n).
 func FastRoot[T Float](value T, n int) T {
+	if debugEnabled && n == 0 {
+		reportViolation("FastRoot", float64(value), PrecisionAuto, "zeroth root")
+	}
+
+	checkNonNegative("FastRoot", value, PrecisionAuto)
+
 	return iapprox.Root(value, n)
 }
 
 func FastRoot32(value float32, n int) float32 { return FastRoot[float32](value, n) }
 func FastRoot64(value float64, n int) float64 { return FastRoot[float64](value, n) }
 
+// FastRootF returns an approximate nth root value^(1/n) for real n using the
+// default precision, for example FastRootF(x, 2.4) in a gamma curve.
+func FastRootF[T Float](value T, n float64) T { return FastRootFPrec(value, n, PrecisionAuto) }
+
+// FastRootFPrec returns an approximate value^(1/n) using the requested
+// precision; the error is that of FastPowerPrec with exponent 1/n, whose
+// rounding does not show at High. A negative value has a real root only for
+// odd integer n; other negative values, n = 0 and NaN return NaN.
+func FastRootFPrec[T Float](value T, n float64, prec Precision) T {
+	checkRoot("FastRootF", value, n, prec)
+
+	return iapprox.RootF(value, n, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastRootF32(value float32, n float64) float32 { return FastRootF[float32](value, n) }
+func FastRootF64(value, n float64) float64         { return FastRootF[float64](value, n) }
+
+// FastInvRoot returns an approximate value^(-1/n) using the default
+// precision, such as 1/∛x for n = 3, without a root followed by a division.
+func FastInvRoot[T Float](value T, n int) T { return FastInvRootPrec(value, n, PrecisionAuto) }
+
+// FastInvRootPrec returns an approximate value^(-1/n) using the requested
+// precision. For 1 <= n <= 8 it refines a seed from the bits of value by
+// Newton steps, with a relative error of about 1e-3 (Fast), 1e-8 (Balanced)
+// or a few ulps (High); larger n use FastRootFPrec(value, -n). 0 returns +Inf.
+// A negative value returns -FastInvRootPrec(-value, n) for odd n and NaN
+// otherwise, as do n = 0 and NaN.
+func FastInvRootPrec[T Float](value T, n int, prec Precision) T {
+	checkRoot("FastInvRoot", value, float64(n), prec)
+
+	return iapprox.InvRoot(value, n, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastInvRoot32(value float32, n int) float32 { return FastInvRoot[float32](value, n) }
+func FastInvRoot64(value float64, n int) float64 { return FastInvRoot[float64](value, n) }
+
 // FastIntPower returns an approximate integer power base^exponent.
 // Uses efficient binary exponentiation for integer exponents.
 func FastIntPower[T Float](base T, exponent int) T {
@@ -181,3 +449,15 @@ func FastIntPower32(base float32, exponent int) float32 {
 func FastIntPower64(base float64, exponent int) float64 {
 	return FastIntPower[float64](base, exponent)
 }
+
+// FastHypot returns an approximate sqrt(a*a + b*b) using the default precision.
+func FastHypot[T Float](a, b T) T { return FastHypotPrec(a, b, PrecisionAuto) }
+
+// FastHypotPrec returns an approximate sqrt(a*a + b*b) using the requested precision.
+// The computation is scaled so it does not overflow or underflow for extreme inputs.
+func FastHypotPrec[T Float](a, b T, prec Precision) T {
+	return iapprox.Hypot(a, b, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastHypot32(a, b float32) float32 { return FastHypot[float32](a, b) }
+func FastHypot64(a, b float64) float64 { return FastHypot[float64](a, b) }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/accuracy"
 	"github.com/meko-christian/algo-approx/internal/reference"
 )
 
@@ -73,3 +74,107 @@ func TestAccuracy_Balanced_MinimumDigits(t *testing.T) {
 		t.Fatalf("exp balanced too inaccurate: digits=%g metrics=%+v", mExp.DecimalDigits, mExp)
 	}
 }
+
+func TestAccuracy_NormCDF_Percentiles(t *testing.T) {
+	t.Parallel()
+
+	samples := make([]float64, 0, 2001)
+	for i := range 2001 {
+		samples = append(samples, -8.0+16.0*float64(i)/2000.0)
+	}
+
+	ref := func(z float64) float64 { return 0.5 * math.Erfc(-z/math.Sqrt2) }
+
+	tests := []struct {
+		prec     approx.Precision
+		p99, max float64
+	}{
+		{approx.PrecisionFast, 1e-4, 2e-4},
+		{approx.PrecisionBalanced, 5e-7, 1e-6},
+		{approx.PrecisionHigh, 2e-9, 5e-9},
+	}
+
+	for _, tc := range tests {
+		m := reference.MeasureAccuracy(samples, ref, func(z float64) float64 { return approx.FastNormCDFPrec(z, tc.prec) })
+		t.Logf("normcdf %v: %+v", tc.prec, m)
+
+		if m.P99AbsError > tc.p99 || m.MaxAbsError > tc.max {
+			t.Errorf("normcdf %v: p99=%g max=%g, want <= %g, %g", tc.prec, m.P99AbsError, m.MaxAbsError, tc.p99, tc.max)
+		}
+	}
+}
+
+// float32Digits are the minimum decimal digits, -log10 of the worst error in
+// the contract metric, of the float32 instantiations at Fast, Balanced and
+// High over the contract domains. They sit a little below the measured
+// values. High cannot reach its float64 bounds: the result alone is rounded
+// to 24 bits, and ranges such as Log2's [-20, 20] or Arccos's [0, π] cost
+// another digit in the absolute metric. Tan and Cotan are limited by their
+// kernels, as in float64.
+//
+//nolint:gochecknoglobals // read-only test table
+var float32Digits = map[approx.Function][3]float64{
+	approx.FuncSqrt:     {2.6, 5.6, 6.8},
+	approx.FuncInvSqrt:  {2.6, 5.1, 6.6},
+	approx.FuncLog:      {2.6, 4.7, 6.0},
+	approx.FuncLog2:     {3.8, 5.8, 5.8},
+	approx.FuncExp:      {2.9, 5.3, 7.0},
+	approx.FuncExp2:     {2.9, 5.3, 7.0},
+	approx.FuncSin:      {2.1, 5.2, 7.3},
+	approx.FuncCos:      {1.5, 4.4, 7.3},
+	approx.FuncTan:      {1.0, 1.6, 3.4},
+	approx.FuncCotan:    {1.0, 1.6, 3.4},
+	approx.FuncSec:      {2.4, 6.0, 6.8},
+	approx.FuncCsc:      {2.1, 5.2, 6.8},
+	approx.FuncArctan:   {4.7, 4.7, 7.0},
+	approx.FuncArccotan: {4.7, 4.7, 6.6},
+	approx.FuncArccos:   {2.8, 5.0, 6.6},
+	approx.FuncArcsec:   {2.8, 5.0, 6.9},
+	approx.FuncArccsc:   {2.8, 5.0, 7.0},
+}
+
+// TestAccuracy_Float32_Matrix measures the float32 instantiation of every
+// Function at every tier on float32 inputs from its contract domain, against
+// the float64 reference at those inputs. The generic code computes in
+// float64 for some tiers and in float32 for others, so conversion error can
+// show here while the float64 contract tests pass.
+func TestAccuracy_Float32_Matrix(t *testing.T) {
+	t.Parallel()
+
+	c := accuracy.Current()
+	tiers := []approx.Precision{approx.PrecisionFast, approx.PrecisionBalanced, approx.PrecisionHigh}
+
+	for _, fn := range approx.Functions() {
+		f32, ok := reference.ForFunction32(fn)
+		want, wok := float32Digits[fn]
+
+		if !ok || !wok {
+			t.Fatalf("%v: float32 instantiation registered %v, digit contract %v", fn, ok, wok)
+		}
+
+		pair, _ := reference.ForFunction(fn)
+
+		for i, prec := range tiers {
+			b, _ := c.Lookup(fn, prec)
+
+			xs := b.Domain.Samples(20001)
+			for j, x := range xs {
+				xs[j] = float64(float32(x))
+			}
+
+			m := reference.MeasureAccuracy(xs, pair.Ref, func(x float64) float64 { return float64(f32(float32(x), prec)) })
+
+			worst, p99 := m.MaxAbsError, m.P99AbsError
+			if b.Metric == accuracy.Relative {
+				worst, p99 = m.MaxRelError, m.P99RelError
+			}
+
+			digits := -math.Log10(worst)
+			t.Logf("%v/%v float32: %v max %.3g p99 %.3g (%.2f digits)", fn, prec, b.Metric, worst, p99, digits)
+
+			if !(digits >= want[i]) { //nolint:staticcheck // also catches NaN
+				t.Errorf("%v/%v float32: %.2f digits, want >= %.1f", fn, prec, digits, want[i])
+			}
+		}
+	}
+}
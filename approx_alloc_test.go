@@ -1,10 +1,22 @@
 package approx
 
-import "testing"
+import (
+	"context"
+	"testing"
+)
 
-func TestNoAllocs_PublicAPI_Float64(t *testing.T) {
-	t.Parallel()
+//nolint:gochecknoglobals // shared fixtures keep the closures allocation-free
+var (
+	allocLogits = []float64{0.1, 2, -1, 0.5}
+	allocRand   = Seeded(1)
+	allocBatch  = make([]float64, 10)
+	allocBytes  = make([]byte, 32)
+	allocCtx    = context.Background()
+	allocEval   = Instrument(NewEvaluator(PrecisionFast), nil)
+)
 
+//nolint:paralleltest // testing.AllocsPerRun must not run in parallel tests
+func TestNoAllocs_PublicAPI_Float64(t *testing.T) {
 	cases := []struct {
 		name string
 		run  func()
@@ -13,10 +25,32 @@ func TestNoAllocs_PublicAPI_Float64(t *testing.T) {
 		{"FastInvSqrt", func() { _ = FastInvSqrt(2.0) }},
 		{"FastLog", func() { _ = FastLog(2.0) }},
 		{"FastExp", func() { _ = FastExp(2.0) }},
+		{"FastTanh", func() { _ = FastTanh(0.7) }},
 		{"FastSqrtPrec", func() { _ = FastSqrtPrec(2.0, PrecisionHigh) }},
 		{"FastInvSqrtPrec", func() { _ = FastInvSqrtPrec(2.0, PrecisionHigh) }},
 		{"FastLogPrec", func() { _ = FastLogPrec(2.0, PrecisionHigh) }},
 		{"FastExpPrec", func() { _ = FastExpPrec(2.0, PrecisionHigh) }},
+		{"FastInvSqrt2", func() { _ = FastInvSqrt2([2]float64{2, 3}) }},
+		{"Vec3.Normalize", func() { _ = Vec3[float64]{1, 2, 3}.Normalize() }},
+		{"Vec4.Angle", func() { _ = Vec4[float64]{1, 2, 3, 4}.Angle(Vec4[float64]{4, 3, 2, 1}) }},
+		{"SampleCategorical", func() { _ = SampleCategorical(allocLogits, 0.8, allocRand) }},
+		{"SampleCategoricalGumbel", func() { _ = SampleCategoricalGumbel(allocLogits, 0.8, allocRand) }},
+		{"RunBatch", func() { _, _ = RunBatch(allocCtx, allocBatch, allocBatch, copyKernel, BatchOptions{Chunk: 3}) }},
+		{"GeometricMean", func() { _ = GeometricMean(allocBatch) }},
+		{"WeightedGeometricMean", func() { _ = WeightedGeometricMean(allocLogits, allocLogits) }},
+		{"LogMeanExp", func() { _ = LogMeanExp(allocLogits) }},
+		{"Axpy", func() { Axpy(0.5, allocLogits, allocLogits) }},
+		{"FMAInto", func() { FMAInto(allocBatch, allocBatch, allocBatch, allocBatch) }},
+		{"AxpyParallel", func() { AxpyParallel(0.5, allocLogits, allocLogits, 4) }},
+		{"Rescale", func() { Rescale(allocBatch, allocBatch, 0, 1, 0, 1) }},
+		{"MinMax", func() { _, _ = MinMax(allocLogits) }},
+		{"Normalize", func() { Normalize(allocLogits, allocLogits, -1, 1) }},
+		{"NormCDFInto", func() { NormCDFInto(allocBatch, allocBatch) }},
+		{"Instrument.Exp", func() { _ = allocEval.Exp(1) }},
+		{"FastRoundDecimalInto", func() { FastRoundDecimalInto(allocBatch, allocBatch, 2, PrecisionHigh) }},
+		{"AppendFastFtoa", func() { _ = AppendFastFtoa(allocBytes[:0], -0.000731234, 6) }},
+		{"Pow10", func() { _ = Pow10(-7) + Pow2(-7) }},
+		{"FastTruncDecimalInto", func() { FastTruncDecimalInto(allocBatch, allocBatch, 2, PrecisionFast) }},
 	}
 
 	for _, tc := range cases {
@@ -27,9 +61,8 @@ func TestNoAllocs_PublicAPI_Float64(t *testing.T) {
 	}
 }
 
+//nolint:paralleltest // testing.AllocsPerRun must not run in parallel tests
 func TestNoAllocs_PublicAPI_Float32(t *testing.T) {
-	t.Parallel()
-
 	cases := []struct {
 		name string
 		run  func()
@@ -42,6 +75,7 @@ func TestNoAllocs_PublicAPI_Float32(t *testing.T) {
 		{"FastInvSqrtPrec32", func() { _ = FastInvSqrtPrec(float32(2), PrecisionHigh) }},
 		{"FastLogPrec32", func() { _ = FastLogPrec(float32(2), PrecisionHigh) }},
 		{"FastExpPrec32", func() { _ = FastExpPrec(float32(2), PrecisionHigh) }},
+		{"FastInvSqrt4", func() { _ = FastInvSqrt4([4]float32{1, 2, 3, 4}) }},
 	}
 
 	for _, tc := range cases {
@@ -2,6 +2,7 @@ package approx
 
 import (
 	"math"
+	"strconv"
 	"testing"
 )
 
@@ -43,6 +44,36 @@ func BenchmarkFastInvSqrt_Float64(b *testing.B) {
 	benchSink64 = acc
 }
 
+func BenchmarkFastSqrtPrec_Float64(b *testing.B) {
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		b.Run(prec.String(), func(b *testing.B) {
+			b.ReportAllocs()
+
+			var acc float64
+			for i := range b.N {
+				x := float64((i%1000)+1) * 1.001
+				acc += FastSqrtPrec(x, prec) + FastInvSqrtPrec(x, prec)
+			}
+
+			benchSink64 = acc
+		})
+	}
+}
+
+func BenchmarkFastInvSqrt_HardwareSeed_Float64(b *testing.B) {
+	defer SetDeterministic(SetDeterministic(false))
+
+	b.ReportAllocs()
+
+	var acc float64
+	for i := range b.N {
+		x := float64((i%1000)+1) * 1.001
+		acc += float64(FastInvSqrt(x))
+	}
+
+	benchSink64 = acc
+}
+
 func BenchmarkMathInvSqrt_Float64(b *testing.B) {
 	b.ReportAllocs()
 
@@ -67,6 +98,19 @@ func BenchmarkFastLog_Float64(b *testing.B) {
 	benchSink64 = acc
 }
 
+// BenchmarkFastLog_NearOne_Float64 models logs of ratios close to 1.
+func BenchmarkFastLog_NearOne_Float64(b *testing.B) {
+	b.ReportAllocs()
+
+	var acc float64
+	for i := range b.N {
+		x := 0.8 + float64(i%1000)*0.0005
+		acc += FastLog(x)
+	}
+
+	benchSink64 = acc
+}
+
 func BenchmarkMathLog_Float64(b *testing.B) {
 	b.ReportAllocs()
 
@@ -91,6 +135,19 @@ func BenchmarkFastExp_Float64(b *testing.B) {
 	benchSink64 = acc
 }
 
+// BenchmarkFastExp_Tiny_Float64 models discount factors with tiny rates.
+func BenchmarkFastExp_Tiny_Float64(b *testing.B) {
+	b.ReportAllocs()
+
+	var acc float64
+	for i := range b.N {
+		x := float64(i%1000) * -1e-12
+		acc += FastExp(x)
+	}
+
+	benchSink64 = acc
+}
+
 func BenchmarkMathExp_Float64(b *testing.B) {
 	b.ReportAllocs()
 
@@ -102,3 +159,221 @@ func BenchmarkMathExp_Float64(b *testing.B) {
 
 	benchSink64 = acc
 }
+
+func BenchmarkFastTanhPrec_Float64(b *testing.B) {
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		b.Run(prec.String(), func(b *testing.B) {
+			b.ReportAllocs()
+
+			var acc float64
+			for i := range b.N {
+				x := float64((i%1000)-500) * 0.01
+				acc += FastTanhPrec(x, prec)
+			}
+
+			benchSink64 = acc
+		})
+	}
+}
+
+func BenchmarkMathTanh_Float64(b *testing.B) {
+	b.ReportAllocs()
+
+	var acc float64
+	for i := range b.N {
+		x := float64((i%1000)-500) * 0.01
+		acc += math.Tanh(x)
+	}
+
+	benchSink64 = acc
+}
+
+func BenchmarkSinTable(b *testing.B) {
+	for _, size := range []int{256, 4096, 1 << 16, 1 << 20} {
+		tab, err := NewSinTable(size)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(strconv.Itoa(size), func(b *testing.B) {
+			b.ReportAllocs()
+
+			var acc float64
+			for i := range b.N {
+				x := float64(i%1000) * 0.0123
+				acc += tab.Sin(x)
+			}
+
+			benchSink64 = acc
+		})
+	}
+}
+
+func BenchmarkFastSinPrec_Float64(b *testing.B) {
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		b.Run(prec.String(), func(b *testing.B) {
+			b.ReportAllocs()
+
+			var acc float64
+			for i := range b.N {
+				x := float64(i%1000) * 0.0123
+				acc += FastSinPrec(x, prec)
+			}
+
+			benchSink64 = acc
+		})
+	}
+}
+
+func BenchmarkMathSin_Float64(b *testing.B) {
+	b.ReportAllocs()
+
+	var acc float64
+	for i := range b.N {
+		x := float64(i%1000) * 0.0123
+		acc += math.Sin(x)
+	}
+
+	benchSink64 = acc
+}
+
+func BenchmarkScaledSoftmaxRows(b *testing.B) {
+	const rows, cols = 512, 512
+
+	src := make([]float32, rows*cols)
+	for i := range src {
+		src[i] = float32(i%97) * 0.01
+	}
+
+	dst := make([]float32, len(src))
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(4 * len(src)))
+
+		for range b.N {
+			ScaledSoftmaxRowsPrec(dst, src, rows, cols, 0.125, PrecisionFast)
+		}
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.SetBytes(int64(4 * len(src)))
+
+		for range b.N {
+			ScaledSoftmaxRowsParallel(dst, src, rows, cols, 0.125, PrecisionFast, 0)
+		}
+	})
+}
+
+func BenchmarkF32ToF16(b *testing.B) {
+	src := make([]float32, 4096)
+	for i := range src {
+		src[i] = float32(i) * 0.37
+	}
+
+	dst := make([]uint16, len(src))
+
+	b.ReportAllocs()
+	b.SetBytes(int64(4 * len(src)))
+
+	for range b.N {
+		F32ToF16(dst, src)
+	}
+}
+
+func BenchmarkFlushSubnormals_Float32(b *testing.B) {
+	x := make([]float32, 4096)
+	for i := range x {
+		x[i] = math.Float32frombits(uint32(i * 977)) //nolint:gosec
+	}
+
+	b.SetBytes(int64(4 * len(x)))
+
+	for b.Loop() {
+		FlushSubnormals(x)
+	}
+}
+
+func BenchmarkAxpy(b *testing.B) {
+	x, y := make([]float32, 1<<20), make([]float32, 1<<20)
+
+	b.Run("sequential", func(b *testing.B) {
+		b.SetBytes(int64(8 * len(x)))
+
+		for b.Loop() {
+			Axpy(0.5, x, y)
+		}
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.SetBytes(int64(8 * len(x)))
+
+		for b.Loop() {
+			AxpyParallel(0.5, x, y, 0)
+		}
+	})
+}
+
+func BenchmarkFMAInto(b *testing.B) {
+	x := make([]float64, 1<<16)
+
+	b.SetBytes(int64(32 * len(x)))
+
+	for b.Loop() {
+		FMAInto(x, x, x, x)
+	}
+}
+
+func BenchmarkRescale(b *testing.B) {
+	x := make([]float32, 1<<16)
+
+	b.SetBytes(int64(8 * len(x)))
+
+	for b.Loop() {
+		Rescale(x, x, 0, 1, 0, 1)
+	}
+}
+
+func BenchmarkFastRoundDecimalInto(b *testing.B) {
+	x := make([]float64, 4096)
+	for i := range x {
+		x[i] = float64(i) * 1.00037
+	}
+
+	dst := make([]float64, len(x))
+
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		b.Run(prec.String(), func(b *testing.B) {
+			b.SetBytes(int64(16 * len(x)))
+
+			for b.Loop() {
+				FastRoundDecimalInto(dst, x, 2, prec)
+			}
+		})
+	}
+}
+
+func BenchmarkFastFtoa(b *testing.B) {
+	x := make([]float64, 1024)
+	for i := range x {
+		x[i] = math.Pow(10, float64(i%97)/12-3) * float64(i%7-2)
+	}
+
+	buf := make([]byte, 0, 32)
+
+	b.Run("approx", func(b *testing.B) {
+		for b.Loop() {
+			for _, v := range x {
+				buf = AppendFastFtoa(buf[:0], v, 6)
+			}
+		}
+	})
+
+	b.Run("strconv", func(b *testing.B) {
+		for b.Loop() {
+			for _, v := range x {
+				buf = strconv.AppendFloat(buf[:0], v, 'g', 6, 64)
+			}
+		}
+	})
+}
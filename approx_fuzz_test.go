@@ -2,7 +2,10 @@ package approx
 
 import (
 	"math"
+	"path/filepath"
 	"testing"
+
+	"github.com/meko-christian/algo-approx/internal/fuzzcorpus"
 )
 
 //nolint:cyclop
@@ -51,6 +54,8 @@ func FuzzFastSqrt(f *testing.F) {
 		if !math.IsNaN(float64(got)) && float64(got) < 0 {
 			t.Fatalf("sqrt(x) should be non-negative")
 		}
+
+		checkContractAt(t, FuncSqrt, x, FastSqrtPrec[float64], math.Sqrt)
 	})
 }
 
@@ -100,6 +105,8 @@ func FuzzFastInvSqrt(f *testing.F) {
 		if !math.IsNaN(float64(got)) && float64(got) <= 0 {
 			t.Fatalf("invsqrt(x) should be positive for x>0")
 		}
+
+		checkContractAt(t, FuncInvSqrt, x, FastInvSqrtPrec[float64], func(x float64) float64 { return 1 / math.Sqrt(x) })
 	})
 }
 
@@ -144,6 +151,8 @@ func FuzzFastLog(f *testing.F) {
 
 			return
 		}
+
+		checkContractAt(t, FuncLog, x, FastLogPrec[float64], math.Log)
 	})
 }
 
@@ -184,5 +193,55 @@ func FuzzFastExp(f *testing.F) {
 		if !math.IsNaN(float64(got)) && float64(got) < 0 {
 			t.Fatalf("exp(x) should be >= 0")
 		}
+
+		checkContractAt(t, FuncExp, x, FastExpPrec[float64], math.Exp)
 	})
 }
+
+// checkContractAt fails t if x lies in the contract domain of fn and some
+// tier of eval is further from ref than the contract allows. The generated
+// seeds under testdata/fuzz are the worst inputs the accuracy generator
+// measured, so they exercise this first.
+func checkContractAt(t *testing.T, fn Function, x float64, eval func(float64, Precision) float64, ref func(float64) float64) {
+	t.Helper()
+
+	b := &contractBounds[fn]
+	if !(x >= b.lo && x <= b.hi) {
+		return
+	}
+
+	want := ref(x)
+
+	for i, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		err := math.Abs(eval(x, prec) - want)
+		if b.relative && want != 0 {
+			err /= math.Abs(want)
+		}
+
+		if !(err <= b.maxError[i]) {
+			t.Fatalf("%v(%v) at %v: error %.3g exceeds the contract bound %.3g", fn, x, prec, err, b.maxError[i])
+		}
+	}
+}
+
+// TestFuzzCorpusDecodes checks that every seed corpus file is a float64
+// entry the fuzz targets accept.
+func TestFuzzCorpusDecodes(t *testing.T) {
+	t.Parallel()
+
+	dirs, err := filepath.Glob(fuzzcorpus.Dir(".", "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dir := range dirs {
+		xs, err := fuzzcorpus.Load(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(xs) == 0 {
+			t.Errorf("%s is empty", dir)
+		}
+	}
+}
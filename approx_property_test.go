@@ -3,6 +3,8 @@ package approx
 import (
 	"math"
 	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
 )
 
 func TestProperty_ExpLog_RoundTrip_Float64(t *testing.T) {
@@ -10,7 +12,7 @@ func TestProperty_ExpLog_RoundTrip_Float64(t *testing.T) {
 	// For x>0: exp(log(x)) ≈ x
 	for _, x := range []float64{1e-6, 1e-3, 0.1, 0.5, 1, 2, 10, 1e3, 1e6} {
 		got := FastExp(FastLog(x))
-		if !closeRel(float64(got), x, 5e-2) {
+		if !testutil.Close(float64(got), x, 5e-2) {
 			t.Fatalf("exp(log(%g)) got %g", x, got)
 		}
 	}
@@ -23,7 +25,7 @@ func TestProperty_Sqrt_Square_Float64(t *testing.T) {
 		y := FastSqrt(x)
 
 		got := float64(y * y)
-		if !closeRel(got, x, 2e-2) {
+		if !testutil.Close(got, x, 2e-2) {
 			t.Fatalf("sqrt(%g)^2 got %g", x, got)
 		}
 	}
@@ -43,26 +45,14 @@ func TestProperty_InvSqrt_Sqrt_Product_Float64(t *testing.T) {
 func TestProperty_Monotonicity_Sqrt_Float64(t *testing.T) {
 	t.Parallel()
 
-	prev := FastSqrt(0.0)
-	for _, x := range []float64{1e-12, 1e-6, 1e-3, 0.1, 1, 2, 10, 1e3, 1e6} {
-		cur := FastSqrt(x)
-		if cur < prev {
-			t.Fatalf("sqrt not monotone: sqrt(%g)=%g < prev=%g", x, cur, prev)
-		}
-
-		prev = cur
-	}
-}
-
-func closeRel(got, ref, tol float64) bool {
-	dval := math.Abs(got - ref)
+	xs := []float64{0, 1e-12, 1e-6, 1e-3, 0.1, 1, 2, 10, 1e3, 1e6}
 
-	den := math.Abs(ref)
-	if den == 0 {
-		return dval <= tol
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		ys[i] = FastSqrt(x)
 	}
 
-	return dval/den <= tol
+	testutil.AssertMonotone(t, ys, true)
 }
 
 // TestTrigIdentity_SinSquaredPlusCosSquared tests sin²(x) + cos²(x) ≈ 1.
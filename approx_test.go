@@ -3,6 +3,8 @@ package approx
 import (
 	"math"
 	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
 )
 
 func TestPublicAPI_Sqrt(t *testing.T) {
@@ -23,6 +25,22 @@ func TestPublicAPI_InvSqrt(t *testing.T) {
 	}
 }
 
+func TestPublicAPI_InvSqrtPacked(t *testing.T) {
+	t.Parallel()
+
+	got4 := FastInvSqrt4([4]float32{1, 4, 16, 0.25})
+	for i, want := range [4]float32{1, 0.5, 0.25, 2} {
+		if math.Abs(float64(got4[i]-want)) > 1e-4 {
+			t.Fatalf("FastInvSqrt4 lane %d got %g want %g", i, got4[i], want)
+		}
+	}
+
+	got2 := FastInvSqrt2Prec([2]float64{4, 100}, PrecisionHigh)
+	if !testutil.Close(got2[0], 0.5, 1e-9) || !testutil.Close(got2[1], 0.1, 1e-9) {
+		t.Fatalf("FastInvSqrt2Prec got %v", got2)
+	}
+}
+
 func TestPublicAPI_LogExp(t *testing.T) {
 	t.Parallel()
 
@@ -315,6 +333,10 @@ func TestFastArccos(t *testing.T) {
 		{"half", 0.5, 1e-3},
 		{"sqrt(2)/2", math.Sqrt(2) / 2, 2e-4},
 		{"one", 1.0, 1e-5},
+		{"negative half", -0.5, 1e-5},
+		{"near one", 0.9999, 1e-5},
+		{"near minus one", -0.9999, 1e-5},
+		{"minus one", -1.0, 1e-5},
 	}
 
 	for _, tt := range tests {
@@ -360,7 +382,7 @@ func TestFastArccosPrec(t *testing.T) {
 		want := math.Acos(x)
 
 		diff := math.Abs(got - want)
-		if diff > 1e-3 {
+		if diff > 1e-5 {
 			t.Errorf("FastArccosPrec(%v, PrecisionBalanced) diff too large: %v", x, diff)
 		}
 	})
@@ -372,12 +394,34 @@ func TestFastArccosPrec(t *testing.T) {
 		want := math.Acos(x)
 
 		diff := math.Abs(got - want)
-		if diff > 1e-5 {
+		if diff > 1e-7 {
 			t.Errorf("FastArccosPrec(%v, PrecisionHigh) diff too large: %v", x, diff)
 		}
 	})
 }
 
+func TestFastArcsecArccsc(t *testing.T) {
+	t.Parallel()
+
+	for _, x := range []float64{-1e6, -3, -1, 1, 1.0001, 2, 50} {
+		if d := math.Abs(FastArcsecPrec(x, PrecisionHigh) - math.Acos(1/x)); d > 1e-7 {
+			t.Errorf("FastArcsecPrec(%g, High) diff %g", x, d)
+		}
+
+		if d := math.Abs(FastArccsc(x) - math.Asin(1/x)); d > 1e-5 {
+			t.Errorf("FastArccsc(%g) diff %g", x, d)
+		}
+	}
+
+	if got := FastArcsec32(2); math.Abs(float64(got)-math.Pi/3) > 1e-5 {
+		t.Errorf("FastArcsec32(2) = %g", got)
+	}
+
+	if !math.IsNaN(FastArccsc64(0.5)) {
+		t.Error("FastArccsc64(0.5) is not NaN")
+	}
+}
+
 // TestFastPower tests the public FastPower API.
 func TestFastPower(t *testing.T) {
 	t.Parallel()
@@ -410,6 +454,26 @@ func TestFastPower(t *testing.T) {
 	}
 }
 
+func TestFastPowerPrec(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct{ base, exponent, want float64 }{
+		{3, 2, 9},
+		{10, 0.5, math.Sqrt(10)},
+		{2, -2, 0.25},
+		{1.0001, 1e5, 22015.456048527955}, // the float64 nearest 1.0001, to the 1e5
+	} {
+		if got := FastPowerPrec(c.base, c.exponent, PrecisionHigh); !testutil.Close(got, c.want, 1e-12) {
+			t.Errorf("FastPowerPrec(%v, %v, High) = %.17g, want %.17g", c.base, c.exponent, got, c.want)
+		}
+	}
+
+	// The default tier keeps the result of FastPower.
+	if got, want := FastPowerPrec(3.0, 2.0, PrecisionAuto), FastPower(3.0, 2.0); got != want {
+		t.Errorf("FastPowerPrec(3, 2, Auto) = %v, FastPower = %v", got, want)
+	}
+}
+
 // TestFastRoot tests the public FastRoot API.
 func TestFastRoot(t *testing.T) {
 	t.Parallel()
@@ -442,6 +506,44 @@ func TestFastRoot(t *testing.T) {
 	}
 }
 
+func TestFastRootF(t *testing.T) {
+	t.Parallel()
+
+	if got := FastRootFPrec(1024.0, 2.5, PrecisionHigh); !testutil.Close(got, 16, 1e-15) {
+		t.Errorf("FastRootFPrec(1024, 2.5, High) = %.17g, want 16", got)
+	}
+
+	if got := FastRootF64(-27, 3); !testutil.Close(got, -3, 1e-5) {
+		t.Errorf("FastRootF64(-27, 3) = %v, want -3", got)
+	}
+
+	if got := FastRootF32(100, 0.5); !testutil.Close(got, 10000, 1e-5) {
+		t.Errorf("FastRootF32(100, 0.5) = %v, want 10000", got)
+	}
+}
+
+func TestFastInvRoot(t *testing.T) {
+	t.Parallel()
+
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		tol := map[Precision]float64{PrecisionFast: 1e-3, PrecisionBalanced: 2e-8, PrecisionHigh: 1e-15}[prec]
+
+		for _, x := range []float64{1e-300, 0.001, 0.5, 1, 3, 1000, 1e300} {
+			if got, want := FastInvRootPrec(x, 3, prec), 1/math.Cbrt(x); !testutil.Close(got, want, tol) {
+				t.Errorf("%v: FastInvRootPrec(%g, 3) = %.17g, want %.17g", prec, x, got, want)
+			}
+		}
+	}
+
+	if got := FastInvRoot32(16, 4); !testutil.Close(got, 0.5, 2e-8) {
+		t.Errorf("FastInvRoot32(16, 4) = %v, want 0.5", got)
+	}
+
+	if got := FastInvRoot64(-8, 3); !testutil.Close(got, -0.5, 2e-8) {
+		t.Errorf("FastInvRoot64(-8, 3) = %v, want -0.5", got)
+	}
+}
+
 // TestFastIntPower tests the public FastIntPower API.
 func TestFastIntPower(t *testing.T) {
 	t.Parallel()
@@ -474,3 +576,82 @@ func TestFastIntPower(t *testing.T) {
 		})
 	}
 }
+
+func TestFastTanh(t *testing.T) {
+	t.Parallel()
+
+	for _, x := range []float64{-30, -3, -0.7, -0.1, 0, 1e-9, 0.3, 0.55, 2, 19.1, 30} {
+		want := math.Tanh(x)
+
+		if got := FastTanh(x); math.Abs(got-want) > 3e-6*math.Abs(want) {
+			t.Errorf("FastTanh(%v) = %v, want %v", x, got, want)
+		}
+
+		if got := FastTanhPrec(x, PrecisionHigh); math.Abs(got-want) > 6e-9*math.Abs(want) {
+			t.Errorf("FastTanhPrec(%v, High) = %v, want %v", x, got, want)
+		}
+
+		if got := FastTanh32(float32(x)); math.Abs(float64(got)-want) > 1e-5*math.Abs(want) {
+			t.Errorf("FastTanh32(%v) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+// TestFastSinPiCosPi tests the public FastSinPi/FastCosPi API.
+func TestFastSinPiCosPi(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   float64
+		wantSin float64
+		wantCos float64
+	}{
+		{"zero", 0, 0, 1},
+		{"1/6", 1.0 / 6, 0.5, math.Sqrt(3) / 2},
+		{"1/2", 0.5, 1, 0},
+		{"-3/4", -0.75, -math.Sqrt2 / 2, -math.Sqrt2 / 2},
+		{"large integer", 1e12, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := FastSinPi(tt.input); math.Abs(got-tt.wantSin) > 1e-7 {
+				t.Errorf("FastSinPi(%v) = %v, want %v", tt.input, got, tt.wantSin)
+			}
+
+			if got := FastCosPi(tt.input); math.Abs(got-tt.wantCos) > 1e-7 {
+				t.Errorf("FastCosPi(%v) = %v, want %v", tt.input, got, tt.wantCos)
+			}
+		})
+	}
+}
+
+// TestFastSinCosTurns tests the public turns-based API.
+func TestFastSinCosTurns(t *testing.T) {
+	t.Parallel()
+
+	for _, x := range []float64{0, 0.125, 0.3, -0.6, 12.05} {
+		if got, want := FastSinTurns(x), math.Sin(2*math.Pi*x); math.Abs(got-want) > 1e-7 {
+			t.Errorf("FastSinTurns(%v) = %v, want %v", x, got, want)
+		}
+
+		if got, want := FastCosTurns(x), math.Cos(2*math.Pi*x); math.Abs(got-want) > 1e-7 {
+			t.Errorf("FastCosTurns(%v) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+// TestFastSinCos tests the public FastSinCos API.
+func TestFastSinCos(t *testing.T) {
+	t.Parallel()
+
+	for _, x := range []float64{0, 0.5, math.Pi / 3, -2.5, 10} {
+		s, c := FastSinCos(x)
+		if math.Abs(s-math.Sin(x)) > 1e-6 || math.Abs(c-math.Cos(x)) > 1e-6 {
+			t.Errorf("FastSinCos(%v) = (%v, %v), want (%v, %v)", x, s, c, math.Sin(x), math.Cos(x))
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package approx
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	iapprox "github.com/meko-christian/algo-approx/internal/approx"
+)
+
+// BatchOptions configures RunBatch and the other cancellation-aware batch
+// drivers. The zero value processes DefaultBatchChunk elements at a time on
+// the calling goroutine.
+type BatchOptions struct {
+	// Chunk is the number of elements processed between cancellation
+	// checks; <= 0 uses DefaultBatchChunk.
+	Chunk int
+
+	// Workers is the number of goroutines processing chunks; 0 and 1 run on
+	// the calling goroutine, < 0 uses GOMAXPROCS.
+	Workers int
+
+	// Progress, if set, is called with the number of elements done so far
+	// whenever another ProgressEvery of them have finished, and once more
+	// when the batch completes. Calls never overlap and done never
+	// decreases; with several workers they come from the worker goroutines.
+	// Leaving it nil costs nothing.
+	Progress func(done, total int)
+
+	// ProgressEvery is the reporting interval in elements; <= 0 reports
+	// after every chunk. Reports happen at chunk boundaries, so an interval
+	// below Chunk reports once per chunk.
+	ProgressEvery int
+
+	// FlushSubnormals flushes subnormal results of RunBatch to zero, chunk
+	// by chunk while the output is still in cache; see FlushSubnormal.
+	FlushSubnormals bool
+}
+
+// DefaultBatchChunk is the chunk size of a zero BatchOptions: large enough
+// that checking the context is noise, small enough that a cancellation is
+// noticed within a fraction of a millisecond.
+const DefaultBatchChunk = 64 << 10
+
+// RunBatch applies kernel to dst and src in chunks, checking ctx before each
+// one, so a service can abort a transformation of hundreds of millions of
+// elements when its request times out. kernel receives matching subslices
+// of dst and src, typically wrapping a batch function:
+//
+//	n, err := approx.RunBatch(ctx, dst, src, func(d, s []float64) {
+//		approx.FastGELUInto(d, s, approx.PrecisionFast)
+//	}, approx.BatchOptions{})
+//
+// It returns the number of leading elements done and ctx.Err() if it stopped
+// early. On cancellation dst[:n] holds results and the chunk after it was not
+// started; with several workers, later chunks may also have been written, so
+// only dst[:n] is meaningful. A context already done when RunBatch is called
+// leaves dst untouched. On success n == len(src) and the error is nil.
+//
+// It panics with ErrLengthMismatch if the slices differ in length.
+func RunBatch[T Float](ctx context.Context, dst, src []T, kernel func(dst, src []T), opt BatchOptions) (int, error) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("RunBatch")
+	}
+
+	plan := newBatchPlan(len(src), opt)
+	ftz := opt.FlushSubnormals
+
+	if plan.workers <= 1 {
+		return batchDone(plan.sequential(ctx, func(lo, hi int) { runKernel(dst[lo:hi], src[lo:hi], kernel, ftz) }))
+	}
+
+	return batchDone(plan.parallel(ctx, func(lo, hi int) { runKernel(dst[lo:hi], src[lo:hi], kernel, ftz) }))
+}
+
+func runKernel[T Float](dst, src []T, kernel func(dst, src []T), ftz bool) {
+	kernel(dst, src)
+
+	if ftz {
+		FlushSubnormals(dst)
+	}
+}
+
+// ScaledSoftmaxRowsContext is ScaledSoftmaxRowsPrec driven by RunBatch's
+// chunking over rows: opt.Chunk counts rows, and the result counts the
+// leading rows done, with the same partial-result semantics; progress is
+// reported in rows as well. The output of
+// every finished row is identical to the sequential call.
+func ScaledSoftmaxRowsContext[T Float](
+	ctx context.Context, dst, src []T, rows, cols int, scale T, prec Precision, opt BatchOptions,
+) (int, error) {
+	checkSoftmaxShape("ScaledSoftmaxRowsContext", dst, src, rows, cols)
+
+	p := iapprox.Precision(normalizePrecision(prec))
+	plan := newBatchPlan(rows, opt)
+
+	if plan.workers <= 1 {
+		return batchDone(plan.sequential(ctx, func(lo, hi int) { scaledSoftmaxRowRange(dst, src, lo, hi, cols, float64(scale), p) }))
+	}
+
+	return batchDone(plan.parallel(ctx, func(lo, hi int) { scaledSoftmaxRowRange(dst, src, lo, hi, cols, float64(scale), p) }))
+}
+
+func scaledSoftmaxRowRange[T Float](dst, src []T, lo, hi, cols int, scale float64, prec iapprox.Precision) {
+	for r := lo; r < hi; r++ {
+		scaledSoftmaxRow(dst[r*cols:(r+1)*cols], src[r*cols:(r+1)*cols], scale, prec)
+	}
+}
+
+// batchPlan is the chunking of n elements under a BatchOptions.
+type batchPlan struct {
+	n, chunk, chunks, workers int
+	progress                  batchProgress
+}
+
+func newBatchPlan(n int, opt BatchOptions) batchPlan {
+	chunk := opt.Chunk
+	if chunk <= 0 {
+		chunk = DefaultBatchChunk
+	}
+
+	chunks := (n + chunk - 1) / chunk
+
+	workers := opt.Workers
+	if workers < 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	every := max(opt.ProgressEvery, 1)
+
+	return batchPlan{
+		n: n, chunk: chunk, chunks: chunks, workers: min(workers, chunks),
+		progress: batchProgress{fn: opt.Progress, every: every, total: n, done: 0, reported: 0},
+	}
+}
+
+// sequential calls do for consecutive ranges [lo, hi) covering [0, n), at
+// most one chunk long, checking ctx before each. It returns the length of the
+// finished prefix and ctx.Err() if any range was skipped. parallel does the
+// same on p.workers goroutines; callers pick one with separate closures so
+// that do stays on the stack when no workers are started, and parallel takes
+// the plan by value so that only its own copy escapes to the workers.
+func (p *batchPlan) sequential(ctx context.Context, do func(lo, hi int)) (int, error) {
+	for lo := 0; lo < p.n; lo += p.chunk {
+		if err := ctx.Err(); err != nil {
+			return lo, err
+		}
+
+		hi := min(lo+p.chunk, p.n)
+		do(lo, hi)
+		p.progress.add(hi - lo)
+	}
+
+	return p.n, nil
+}
+
+func (p batchPlan) parallel(ctx context.Context, do func(lo, hi int)) (int, error) {
+	// Workers claim chunks in order and finish every chunk they claim, so
+	// the finished chunks form a prefix apart from the ones in flight at
+	// cancellation.
+	var (
+		next atomic.Int64
+		wg   sync.WaitGroup
+		mu   sync.Mutex // serializes progress reports
+	)
+
+	finished := make([]bool, p.chunks)
+
+	for range p.workers {
+		wg.Go(func() {
+			for ctx.Err() == nil {
+				c := int(next.Add(1) - 1)
+				if c >= p.chunks {
+					return
+				}
+
+				lo, hi := c*p.chunk, min((c+1)*p.chunk, p.n)
+				do(lo, hi)
+				finished[c] = true
+
+				if p.progress.fn != nil {
+					mu.Lock()
+					p.progress.add(hi - lo)
+					mu.Unlock()
+				}
+			}
+		})
+	}
+
+	wg.Wait()
+
+	for c, ok := range finished {
+		if !ok {
+			return c * p.chunk, ctx.Err()
+		}
+	}
+
+	return p.n, nil
+}
+
+// batchProgress decides when BatchOptions.Progress is due.
+type batchProgress struct {
+	fn             func(done, total int)
+	every, total   int
+	done, reported int // reported is done/every at the last call
+}
+
+// add records k more finished elements and reports if another interval was
+// crossed or the batch is complete.
+func (p *batchProgress) add(k int) {
+	if p.fn == nil {
+		return
+	}
+
+	p.done += k
+	if step := p.done / p.every; step > p.reported || p.done == p.total {
+		p.reported = step
+		p.fn(p.done, p.total)
+	}
+}
@@ -0,0 +1,193 @@
+package approx
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func expKernel(dst, src []float64) {
+	for i, x := range src {
+		dst[i] = FastExpPrec(x, PrecisionFast)
+	}
+}
+
+func TestRunBatch(t *testing.T) {
+	t.Parallel()
+
+	src := make([]float64, 10_000)
+	for i := range src {
+		src[i] = float64(i%200)/10 - 10
+	}
+
+	for _, workers := range []int{0, 1, 4, -1} {
+		dst := make([]float64, len(src))
+
+		n, err := RunBatch(t.Context(), dst, src, expKernel, BatchOptions{Chunk: 999, Workers: workers})
+		if n != len(src) || err != nil {
+			t.Fatalf("workers %d: RunBatch = %d, %v", workers, n, err)
+		}
+
+		for i, x := range src {
+			if dst[i] != FastExpPrec(x, PrecisionFast) {
+				t.Fatalf("workers %d: dst[%d] = %v", workers, i, dst[i])
+			}
+		}
+	}
+}
+
+func TestRunBatch_Canceled(t *testing.T) {
+	t.Parallel()
+
+	src := make([]float64, 1000)
+	dst := make([]float64, len(src))
+
+	for i := range dst {
+		dst[i] = -1
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	n, err := RunBatch(ctx, dst, src, expKernel, BatchOptions{Chunk: 10, Workers: 3})
+	if n != 0 || !errors.Is(err, context.Canceled) {
+		t.Fatalf("pre-canceled RunBatch = %d, %v", n, err)
+	}
+
+	for i, v := range dst {
+		if v != -1 {
+			t.Fatalf("dst[%d] written after cancellation", i)
+		}
+	}
+}
+
+func TestRunBatch_CanceledMidway(t *testing.T) {
+	t.Parallel()
+
+	const chunk = 100
+
+	src := make([]float64, 10*chunk)
+
+	for _, workers := range []int{1, 4} {
+		ctx, cancel := context.WithCancel(t.Context())
+		dst := make([]float64, len(src))
+		calls := 0
+
+		// Each call to the kernel is one chunk; cancel during the third.
+		var kernel func(d, s []float64)
+		if workers == 1 {
+			kernel = func(d, s []float64) {
+				if calls++; calls == 3 {
+					cancel()
+				}
+
+				expKernel(d, s)
+			}
+		} else {
+			kernel = func(d, s []float64) {
+				if &s[0] == &src[2*chunk] {
+					cancel()
+				}
+
+				expKernel(d, s)
+			}
+		}
+
+		n, err := RunBatch(ctx, dst, src, kernel, BatchOptions{Chunk: chunk, Workers: workers})
+		cancel()
+
+		if !errors.Is(err, context.Canceled) || n%chunk != 0 || n < 2*chunk || n == len(src) {
+			t.Fatalf("workers %d: RunBatch = %d, %v", workers, n, err)
+		}
+
+		if workers == 1 && n != 3*chunk {
+			t.Fatalf("sequential run stopped after %d elements, want %d", n, 3*chunk)
+		}
+
+		for i := range n {
+			if dst[i] != 1 {
+				t.Fatalf("workers %d: dst[%d] = %v inside the finished prefix", workers, i, dst[i])
+			}
+		}
+	}
+}
+
+func TestScaledSoftmaxRowsContext_MatchesSequential(t *testing.T) {
+	t.Parallel()
+
+	const rows, cols = 64, 48
+
+	r := Seeded(9)
+	src := make([]float32, rows*cols)
+
+	for i := range src {
+		src[i] = float32(r.NormFloat64())
+	}
+
+	want := make([]float32, len(src))
+	ScaledSoftmaxRowsPrec(want, src, rows, cols, 0.5, PrecisionBalanced)
+
+	got := make([]float32, len(src))
+
+	n, err := ScaledSoftmaxRowsContext(t.Context(), got, src, rows, cols, 0.5, PrecisionBalanced, BatchOptions{Chunk: 5, Workers: 3})
+	if n != rows || err != nil {
+		t.Fatalf("ScaledSoftmaxRowsContext = %d, %v", n, err)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("element %d = %g, want %g", i, got[i], want[i])
+		}
+	}
+}
+
+func TestRunBatch_Progress(t *testing.T) {
+	t.Parallel()
+
+	src := make([]float64, 1000)
+	dst := make([]float64, len(src))
+
+	cases := []struct {
+		every int
+		want  []int
+	}{
+		{250, []int{300, 500, 800, 1000}},
+		{0, []int{100, 200, 300, 400, 500, 600, 700, 800, 900, 1000}},
+		{5000, []int{1000}},
+	}
+
+	for _, tc := range cases {
+		var got []int
+
+		opt := BatchOptions{Chunk: 100, ProgressEvery: tc.every, Progress: func(done, total int) {
+			if total != len(src) {
+				t.Errorf("total = %d", total)
+			}
+
+			got = append(got, done)
+		}}
+
+		if _, err := RunBatch(t.Context(), dst, src, expKernel, opt); err != nil {
+			t.Fatal(err)
+		}
+
+		if !slices.Equal(got, tc.want) {
+			t.Fatalf("every %d: progress %v, want %v", tc.every, got, tc.want)
+		}
+	}
+
+	// Parallel reports are serialized, non-decreasing and end at the total.
+	var got []int
+
+	opt := BatchOptions{Chunk: 10, Workers: 4, ProgressEvery: 95, Progress: func(done, _ int) { got = append(got, done) }}
+	if _, err := RunBatch(t.Context(), dst, src, expKernel, opt); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 11 || !slices.IsSorted(got) || got[len(got)-1] != len(src) {
+		t.Fatalf("parallel progress %v", got)
+	}
+}
+
+func copyKernel(dst, src []float64) { copy(dst, src) }
@@ -0,0 +1,128 @@
+package approx
+
+import (
+	"math"
+	"runtime"
+	"sync"
+)
+
+// Axpy sets y[i] = alpha·x[i] + y[i], the BLAS level-1 axpy, for the
+// arithmetic between transcendental stages of a pipeline. The product and
+// sum are rounded separately in T, also where the compiler would otherwise
+// fuse them into an FMA. The loop is unrolled by four with the
+// bounds checks hoisted, which is what the Go compiler can make of it
+// without assembly.
+//
+// It panics with ErrLengthMismatch if the slices differ in length.
+func Axpy[T Float](alpha T, x, y []T) {
+	if len(x) != len(y) {
+		panicLengthMismatch("Axpy")
+	}
+
+	axpy(alpha, x, y)
+}
+
+func axpy[T Float](alpha T, x, y []T) {
+	x = x[:len(y)]
+
+	i := 0
+	for ; i+4 <= len(y); i += 4 {
+		xs, ys := x[i:i+4:i+4], y[i:i+4:i+4]
+		ys[0] += T(alpha * xs[0])
+		ys[1] += T(alpha * xs[1])
+		ys[2] += T(alpha * xs[2])
+		ys[3] += T(alpha * xs[3])
+	}
+
+	for ; i < len(y); i++ {
+		y[i] += T(alpha * x[i])
+	}
+}
+
+// FMAInto sets dst[i] = a[i]·b[i] + c[i] with a single rounding, using
+// math.FMA in float64. For float32 the exact float64 product is added in
+// float64 and rounded again to float32. dst may alias any of the inputs.
+//
+// It panics with ErrLengthMismatch if the slices differ in length.
+func FMAInto[T Float](dst, a, b, c []T) {
+	if len(dst) != len(a) || len(dst) != len(b) || len(dst) != len(c) {
+		panicLengthMismatch("FMAInto")
+	}
+
+	fmaInto(dst, a, b, c)
+}
+
+func fmaInto[T Float](dst, a, b, c []T) {
+	a, b, c = a[:len(dst)], b[:len(dst)], c[:len(dst)]
+
+	for i := range dst {
+		dst[i] = T(math.FMA(float64(a[i]), float64(b[i]), float64(c[i])))
+	}
+}
+
+// AxpyParallel is Axpy with the slices split into contiguous blocks
+// processed by up to workers goroutines; workers <= 0 uses GOMAXPROCS.
+// Each worker gets at least 64Ki elements, so shorter slices are processed on
+// the calling goroutine. The result is identical to Axpy.
+func AxpyParallel[T Float](alpha T, x, y []T, workers int) {
+	if len(x) != len(y) {
+		panicLengthMismatch("AxpyParallel")
+	}
+
+	if workers = blasWorkers(len(y), workers); workers <= 1 {
+		axpy(alpha, x, y)
+
+		return
+	}
+
+	parallelBlocks(len(y), workers, func(lo, hi int) { axpy(alpha, x[lo:hi], y[lo:hi]) })
+}
+
+// FMAIntoParallel is FMAInto split over up to workers goroutines like
+// AxpyParallel. The result is identical to FMAInto.
+func FMAIntoParallel[T Float](dst, a, b, c []T, workers int) {
+	if len(dst) != len(a) || len(dst) != len(b) || len(dst) != len(c) {
+		panicLengthMismatch("FMAIntoParallel")
+	}
+
+	if workers = blasWorkers(len(dst), workers); workers <= 1 {
+		fmaInto(dst, a, b, c)
+
+		return
+	}
+
+	parallelBlocks(len(dst), workers, func(lo, hi int) { fmaInto(dst[lo:hi], a[lo:hi], b[lo:hi], c[lo:hi]) })
+}
+
+// blasMinParallelElems is the number of elements per worker below which an
+// extra worker does not pay for itself; the kernels are memory-bound, so the
+// threshold is higher than for softmax.
+const blasMinParallelElems = 64 << 10
+
+// blasWorkers returns the number of goroutines for n elements: workers, or
+// GOMAXPROCS for workers <= 0, limited to one per blasMinParallelElems.
+// Callers run the kernel directly for a result <= 1, so the closure for
+// parallelBlocks is only built, and escapes, when it is used.
+func blasWorkers(n, workers int) int {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	return min(workers, n/blasMinParallelElems)
+}
+
+// parallelBlocks calls do for contiguous ranges covering [0, n) on workers
+// goroutines.
+func parallelBlocks(n, workers int, do func(lo, hi int)) {
+	per := (n + workers - 1) / workers
+
+	var wg sync.WaitGroup
+
+	for lo := 0; lo < n; lo += per {
+		hi := min(lo+per, n)
+
+		wg.Go(func() { do(lo, hi) })
+	}
+
+	wg.Wait()
+}
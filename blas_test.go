@@ -0,0 +1,121 @@
+package approx
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestAxpy(t *testing.T) {
+	t.Parallel()
+
+	for n := range 11 {
+		x, y := make([]float64, n), make([]float64, n)
+		for i := range n {
+			x[i], y[i] = float64(i), float64(10*i)
+		}
+
+		Axpy(0.5, x, y)
+
+		for i := range n {
+			if want := 10*float64(i) + 0.5*float64(i); y[i] != want {
+				t.Fatalf("n=%d: y[%d] = %v, want %v", n, i, y[i], want)
+			}
+		}
+	}
+
+	y32 := []float32{1, 2, 3, 4, 5}
+	Axpy(-1, y32, y32)
+
+	if !slices.Equal(y32, make([]float32, 5)) {
+		t.Errorf("Axpy(-1, y, y) = %v, want zeros", y32)
+	}
+}
+
+func TestFMAInto(t *testing.T) {
+	t.Parallel()
+
+	// 1+2^-30 squared is 1 + 2^-29 + 2^-60: only a fused operation keeps the
+	// 2^-60 once the 1 is cancelled.
+	e := 1 + 0x1p-30
+	a := []float64{e, 2, 3}
+	b := []float64{e, 3, -4}
+	c := []float64{-(1 + 0x1p-29), 1, 12}
+	dst := make([]float64, 3)
+
+	FMAInto(dst, a, b, c)
+
+	if want := []float64{0x1p-60, 7, 0}; !slices.Equal(dst, want) {
+		t.Errorf("FMAInto = %v, want %v", dst, want)
+	}
+
+	// dst may alias an input.
+	FMAInto(a, a, b, c)
+
+	if !slices.Equal(a, dst) {
+		t.Errorf("aliased FMAInto = %v, want %v", a, dst)
+	}
+
+	f := []float32{1.5, -2}
+	FMAInto(f, f, f, []float32{0.25, 1})
+
+	if want := []float32{2.5, 5}; !slices.Equal(f, want) {
+		t.Errorf("float32 FMAInto = %v, want %v", f, want)
+	}
+}
+
+func TestAxpyParallelMatchesSequential(t *testing.T) {
+	t.Parallel()
+
+	const n = 4*blasMinParallelElems + 13
+
+	x, y := make([]float64, n), make([]float64, n)
+	for i := range n {
+		x[i], y[i] = math.Sin(float64(i)), math.Cos(float64(i))
+	}
+
+	want := slices.Clone(y)
+	Axpy(1.25, x, want)
+
+	for _, workers := range []int{0, 1, 3, 8} {
+		got := slices.Clone(y)
+		AxpyParallel(1.25, x, got, workers)
+
+		if !slices.Equal(got, want) {
+			t.Fatalf("workers=%d: AxpyParallel differs from Axpy", workers)
+		}
+
+		dst := make([]float64, n)
+		FMAIntoParallel(dst, x, y, got, workers)
+
+		wantFMA := make([]float64, n)
+		FMAInto(wantFMA, x, y, got)
+
+		if !slices.Equal(dst, wantFMA) {
+			t.Fatalf("workers=%d: FMAIntoParallel differs from FMAInto", workers)
+		}
+	}
+}
+
+func TestBLASLengthMismatch(t *testing.T) {
+	t.Parallel()
+
+	a, b := make([]float64, 3), make([]float64, 4)
+
+	for name, f := range map[string]func(){
+		"Axpy":            func() { Axpy(1, a, b) },
+		"AxpyParallel":    func() { AxpyParallel(1, a, b, 2) },
+		"FMAInto":         func() { FMAInto(a, a, a, b) },
+		"FMAIntoParallel": func() { FMAIntoParallel(a, b, a, a, 2) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic", name)
+				}
+			}()
+
+			f()
+		}()
+	}
+}
@@ -0,0 +1,73 @@
+package approx
+
+import "math"
+
+// BudgetSplit picks a precision tier for every operation of a composed
+// computation, such as pow as exp∘log or normalization as a dot product
+// followed by invsqrt, so that the result stays within a relative error
+// budget at a low cost. The choice is a greedy heuristic and is not
+// guaranteed to be the cheapest split that fits.
+//
+// The error of the composition is taken as the sum of the contract bounds of
+// its operations (see PrecisionInfo), the first-order estimate for chains
+// whose intermediate results are of order one or feed a function of
+// relative conditioning one, as the output of log feeds exp. Absolute bounds
+// count as relative ones. Starting from PrecisionFast everywhere, operations
+// are upgraded one tier at a time, always the one removing the most error
+// per unit of added cost, until the sum fits.
+//
+// The result has one tier per operation, in order. If even PrecisionHigh
+// everywhere exceeds the budget, every operation gets PrecisionHigh; compare
+// BudgetError of the result with the budget to detect it. Unknown Functions
+// get PrecisionHigh and contribute no error.
+func BudgetSplit(totalRelErr float64, ops []Function) []Precision {
+	tiers := make([]Precision, len(ops))
+	for i, fn := range ops {
+		tiers[i] = PrecisionFast
+		if !fn.valid() {
+			tiers[i] = PrecisionHigh
+		}
+	}
+
+	for BudgetError(ops, tiers) > totalRelErr {
+		best, bestGain := -1, 0.0
+
+		for i, fn := range ops {
+			if tiers[i] == PrecisionHigh {
+				continue
+			}
+
+			cur, next := PrecisionInfo(fn, tiers[i]), PrecisionInfo(fn, tiers[i]+1)
+			// Tiers sharing a kernel cost nothing to skip over.
+			gain := (cur.MaxError - next.MaxError) / math.Max(next.Cost-cur.Cost, 1e-3)
+
+			if best < 0 || gain > bestGain {
+				best, bestGain = i, gain
+			}
+		}
+
+		if best < 0 {
+			break
+		}
+
+		tiers[best]++
+	}
+
+	return tiers
+}
+
+// BudgetError returns the error estimate BudgetSplit works with: the sum of
+// the contract bounds of ops[i] at tiers[i]. It panics with
+// ErrLengthMismatch if the slices differ in length.
+func BudgetError(ops []Function, tiers []Precision) float64 {
+	if len(ops) != len(tiers) {
+		panicLengthMismatch("BudgetError")
+	}
+
+	var sum float64
+	for i, fn := range ops {
+		sum += PrecisionInfo(fn, tiers[i]).MaxError
+	}
+
+	return sum
+}
@@ -0,0 +1,74 @@
+package approx
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestBudgetSplit(t *testing.T) {
+	t.Parallel()
+
+	pow := []Function{FuncLog, FuncExp}
+
+	for _, tc := range []struct {
+		budget float64
+		want   []Precision
+	}{
+		{1, []Precision{PrecisionFast, PrecisionFast}},
+		{3e-3, []Precision{PrecisionBalanced, PrecisionFast}},
+		{1e-4, []Precision{PrecisionBalanced, PrecisionBalanced}},
+		{1e-6, []Precision{PrecisionHigh, PrecisionHigh}},
+		{1e-20, []Precision{PrecisionHigh, PrecisionHigh}}, // unattainable
+	} {
+		got := BudgetSplit(tc.budget, pow)
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("BudgetSplit(%g, log, exp) = %v, want %v", tc.budget, got, tc.want)
+		}
+	}
+}
+
+// TestBudgetSplit_MeetsBudget checks that every attainable budget is met and
+// that a tighter budget never lowers a tier.
+func TestBudgetSplit_MeetsBudget(t *testing.T) {
+	t.Parallel()
+
+	ops := []Function{FuncSqrt, FuncInvSqrt, FuncSin, FuncArctan, FuncExp2, FuncLog2}
+	high := slices.Repeat([]Precision{PrecisionHigh}, len(ops))
+	prev := BudgetSplit(1, ops)
+
+	for budget := 1.0; budget > BudgetError(ops, high); budget /= 3 {
+		tiers := BudgetSplit(budget, ops)
+		if e := BudgetError(ops, tiers); e > budget {
+			t.Fatalf("budget %g: %v has error %g", budget, tiers, e)
+		}
+
+		for i := range tiers {
+			if tiers[i] < prev[i] {
+				t.Fatalf("budget %g lowered %v from %v to %v", budget, ops[i], prev[i], tiers[i])
+			}
+		}
+
+		prev = tiers
+	}
+}
+
+func TestBudgetSplit_Degenerate(t *testing.T) {
+	t.Parallel()
+
+	if got := BudgetSplit(1e-3, nil); len(got) != 0 {
+		t.Errorf("no operations gave %v", got)
+	}
+
+	if got := BudgetSplit(1e-3, []Function{0, FuncExp}); !slices.Equal(got, []Precision{PrecisionHigh, PrecisionFast}) {
+		t.Errorf("unknown function gave %v", got)
+	}
+
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, ErrLengthMismatch) {
+			t.Errorf("recovered %v, want ErrLengthMismatch", err)
+		}
+	}()
+
+	BudgetError([]Function{FuncExp}, nil)
+}
@@ -0,0 +1,181 @@
+//go:build approxcapi && cgo
+
+// Command capi exports the core approx functions with C linkage, for hosts
+// that embed Go through -buildmode=c-archive or c-shared (audio plugins, game
+// engines). Every function takes and returns double; the precision argument
+// is one of the APPROX_* constants below, and an unknown value selects the
+// default tier.
+//
+// The package is gated behind the approxcapi build tag so the rest of the
+// module never needs cgo. Build the archive and its header with
+//
+//	go build -tags approxcapi -buildmode=c-archive -o libapprox.a ./capi
+//
+// which writes libapprox.h next to the archive (see the capi target of the
+// justfile).
+package main
+
+/*
+#include <stddef.h>
+
+enum {
+	APPROX_AUTO = 0,
+	APPROX_FAST = 1,
+	APPROX_BALANCED = 2,
+	APPROX_HIGH = 3
+};
+
+enum {
+	APPROX_FUNC_SQRT = 1,
+	APPROX_FUNC_INV_SQRT,
+	APPROX_FUNC_LOG,
+	APPROX_FUNC_LOG2,
+	APPROX_FUNC_EXP,
+	APPROX_FUNC_EXP2,
+	APPROX_FUNC_SIN,
+	APPROX_FUNC_COS,
+	APPROX_FUNC_TAN,
+	APPROX_FUNC_COTAN,
+	APPROX_FUNC_SEC,
+	APPROX_FUNC_CSC,
+	APPROX_FUNC_ARCTAN,
+	APPROX_FUNC_ARCCOTAN,
+	APPROX_FUNC_ARCCOS,
+	APPROX_FUNC_ARCSEC,
+	APPROX_FUNC_ARCCSC
+};
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func main() {}
+
+// prec maps a C precision argument to a tier; values outside the APPROX_*
+// constants select PrecisionAuto.
+func prec(p C.int) approx.Precision {
+	if q := approx.Precision(p); q.IsValid() {
+		return q
+	}
+
+	return approx.PrecisionAuto
+}
+
+//export approx_sqrt
+func approx_sqrt(x C.double, p C.int) C.double {
+	return C.double(approx.FastSqrtPrec(float64(x), prec(p)))
+}
+
+//export approx_inv_sqrt
+func approx_inv_sqrt(x C.double, p C.int) C.double {
+	return C.double(approx.FastInvSqrtPrec(float64(x), prec(p)))
+}
+
+//export approx_log
+func approx_log(x C.double, p C.int) C.double {
+	return C.double(approx.FastLogPrec(float64(x), prec(p)))
+}
+
+//export approx_log2
+func approx_log2(x C.double, p C.int) C.double {
+	return C.double(approx.FastLog2Prec(float64(x), prec(p)))
+}
+
+//export approx_exp
+func approx_exp(x C.double, p C.int) C.double {
+	return C.double(approx.FastExpPrec(float64(x), prec(p)))
+}
+
+//export approx_exp2
+func approx_exp2(x C.double, p C.int) C.double {
+	return C.double(approx.FastExp2Prec(float64(x), prec(p)))
+}
+
+//export approx_sin
+func approx_sin(x C.double, p C.int) C.double {
+	return C.double(approx.FastSinPrec(float64(x), prec(p)))
+}
+
+//export approx_cos
+func approx_cos(x C.double, p C.int) C.double {
+	return C.double(approx.FastCosPrec(float64(x), prec(p)))
+}
+
+//export approx_tan
+func approx_tan(x C.double, p C.int) C.double {
+	return C.double(approx.FastTanPrec(float64(x), prec(p)))
+}
+
+//export approx_arctan
+func approx_arctan(x C.double, p C.int) C.double {
+	return C.double(approx.FastArctanPrec(float64(x), prec(p)))
+}
+
+//export approx_arccos
+func approx_arccos(x C.double, p C.int) C.double {
+	return C.double(approx.FastArccosPrec(float64(x), prec(p)))
+}
+
+//export approx_pow
+func approx_pow(base, exponent C.double, p C.int) C.double {
+	return C.double(approx.FastPowerPrec(float64(base), float64(exponent), prec(p)))
+}
+
+//export approx_hypot
+func approx_hypot(a, b C.double, p C.int) C.double {
+	return C.double(approx.FastHypotPrec(float64(a), float64(b), prec(p)))
+}
+
+// kernels maps the APPROX_FUNC_* values, which equal the approx.Function
+// values, to their float64 entry points.
+//
+//nolint:gochecknoglobals // read-only lookup table
+var kernels = map[approx.Function]func(float64, approx.Precision) float64{
+	approx.FuncSqrt:     approx.FastSqrtPrec[float64],
+	approx.FuncInvSqrt:  approx.FastInvSqrtPrec[float64],
+	approx.FuncLog:      approx.FastLogPrec[float64],
+	approx.FuncLog2:     approx.FastLog2Prec[float64],
+	approx.FuncExp:      approx.FastExpPrec[float64],
+	approx.FuncExp2:     approx.FastExp2Prec[float64],
+	approx.FuncSin:      approx.FastSinPrec[float64],
+	approx.FuncCos:      approx.FastCosPrec[float64],
+	approx.FuncTan:      approx.FastTanPrec[float64],
+	approx.FuncCotan:    approx.FastCotanPrec[float64],
+	approx.FuncSec:      approx.FastSecPrec[float64],
+	approx.FuncCsc:      approx.FastCscPrec[float64],
+	approx.FuncArctan:   approx.FastArctanPrec[float64],
+	approx.FuncArccotan: approx.FastArccotanPrec[float64],
+	approx.FuncArccos:   approx.FastArccosPrec[float64],
+	approx.FuncArcsec:   approx.FastArcsecPrec[float64],
+	approx.FuncArccsc:   approx.FastArccscPrec[float64],
+}
+
+// approx_eval_into stores fn(src[i]) in dst[i] for i < n, crossing the cgo
+// boundary once per batch instead of once per value. It returns 0, or -1 for
+// an unknown fn. dst may alias src.
+//
+//export approx_eval_into
+func approx_eval_into(fn C.int, dst *C.double, src *C.double, n C.size_t, p C.int) C.int {
+	kernel, ok := kernels[approx.Function(fn)]
+	if !ok {
+		return -1
+	}
+
+	if n == 0 {
+		return 0
+	}
+
+	out := unsafe.Slice((*float64)(unsafe.Pointer(dst)), int(n))
+	in := unsafe.Slice((*float64)(unsafe.Pointer(src)), int(n))
+
+	pr := prec(p)
+	for i, x := range in {
+		out[i] = kernel(x, pr)
+	}
+
+	return 0
+}
@@ -0,0 +1,68 @@
+//go:build approxcapi && cgo
+
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// TestCSmoke builds the C archive, links testdata/smoke.c against it and
+// checks that values cross the boundary unchanged.
+func TestCSmoke(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a C archive")
+	}
+
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler")
+	}
+
+	dir := t.TempDir()
+	archive := filepath.Join(dir, "libapprox.a")
+	goTool := filepath.Join(runtime.GOROOT(), "bin", "go")
+
+	run := func(name string, args ...string) string {
+		t.Helper()
+
+		out, err := exec.Command(name, args...).CombinedOutput() //nolint:gosec // test-controlled arguments
+		if err != nil {
+			t.Fatalf("%s %s: %v\n%s", name, strings.Join(args, " "), err, out)
+		}
+
+		return string(out)
+	}
+
+	run(goTool, "build", "-tags", "approxcapi", "-buildmode=c-archive", "-o", archive, ".")
+
+	bin := filepath.Join(dir, "smoke")
+	run(cc, "-o", bin, filepath.Join("testdata", "smoke.c"), "-I", dir, archive, "-lpthread", "-lm")
+
+	want := fmt.Sprintf("%.6f\n%.6f\n%.6f\n%.6f %.6f %.6f\n-1\n%.17g\n",
+		approx.FastSqrtPrec(2.0, approx.PrecisionHigh),
+		approx.FastExpPrec(1.0, approx.PrecisionHigh),
+		approx.FastPowerPrec(2.0, 10.0, approx.PrecisionHigh),
+		approx.FastExp2Prec(0.0, approx.PrecisionHigh),
+		approx.FastExp2Prec(1.0, approx.PrecisionHigh),
+		approx.FastExp2Prec(2.0, approx.PrecisionHigh),
+		approx.FastExpPrec(1.0, approx.PrecisionAuto))
+
+	if got := run(bin); got != want {
+		t.Fatalf("smoke output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestKernelsCoverRegistry(t *testing.T) {
+	for _, fn := range approx.Functions() {
+		if _, ok := kernels[fn]; !ok {
+			t.Errorf("approx_eval_into has no kernel for %v", fn)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+// Smoke test of the C API: links against libapprox.a and prints a few
+// results for capi_test.go to compare.
+#include <stdio.h>
+
+#include "libapprox.h"
+
+int main(void) {
+	double xs[3] = {0.0, 1.0, 2.0};
+
+	printf("%.6f\n", approx_sqrt(2.0, APPROX_HIGH));
+	printf("%.6f\n", approx_exp(1.0, APPROX_HIGH));
+	printf("%.6f\n", approx_pow(2.0, 10.0, APPROX_HIGH));
+
+	if (approx_eval_into(APPROX_FUNC_EXP2, xs, xs, 3, APPROX_HIGH) != 0) {
+		return 1;
+	}
+
+	printf("%.6f %.6f %.6f\n", xs[0], xs[1], xs[2]);
+	printf("%d\n", approx_eval_into(0, xs, xs, 3, APPROX_HIGH));
+	printf("%.17g\n", approx_exp(1.0, 99));
+
+	return 0;
+}
@@ -0,0 +1,38 @@
+package approx
+
+import (
+	"fmt"
+	"math"
+)
+
+// Clamp is the output policy of the *IntoClamp batch functions: every result
+// is limited to [Lo, Hi] in the same pass that computes it, so a pipeline
+// that needs, say, activations within [-10, 10] does not read and write the
+// whole slice a second time. Either bound may be infinite. NaN results stay
+// NaN.
+type Clamp[T Float] struct {
+	Lo, Hi T
+}
+
+// Saturate returns the Clamp to the finite range of T: overflowing results
+// become ±MaxFloat instead of ±Inf.
+func Saturate[T Float]() Clamp[T] {
+	var zero T
+	if _, single := any(zero).(float32); single {
+		return Clamp[T]{Lo: -math.MaxFloat32, Hi: math.MaxFloat32}
+	}
+
+	hi := math.MaxFloat64
+
+	return Clamp[T]{Lo: T(-hi), Hi: T(hi)}
+}
+
+// Apply returns v limited to [c.Lo, c.Hi], or NaN if v is NaN.
+func (c Clamp[T]) Apply(v T) T { return min(max(v, c.Lo), c.Hi) }
+
+// check panics with ErrDomainError unless c is a non-empty interval.
+func (c Clamp[T]) check(fn string) {
+	if !(c.Lo <= c.Hi) { //nolint:staticcheck // also rejects NaN
+		panic(fmt.Errorf("approx: %s: clamp [%v, %v]: %w", fn, c.Lo, c.Hi, ErrDomainError))
+	}
+}
@@ -0,0 +1,75 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIntoClamp(t *testing.T) {
+	t.Parallel()
+
+	src := []float64{-20, -3, 0, 0.5, 3, 20, math.NaN()}
+	c := Clamp[float64]{Lo: -0.1, Hi: 2}
+
+	gelu := make([]float64, len(src))
+	FastGELUIntoClamp(gelu, src, PrecisionHigh, c)
+
+	silu := append([]float64(nil), src...)
+	FastSiLUIntoClamp(silu, silu, PrecisionHigh, c)
+
+	for i, x := range src {
+		for _, tc := range []struct {
+			got, raw float64
+		}{
+			{gelu[i], FastGELUPrec(x, PrecisionHigh)},
+			{silu[i], FastSiLUPrec(x, PrecisionHigh)},
+		} {
+			want := math.Min(math.Max(tc.raw, c.Lo), c.Hi)
+			if tc.got != want && !(math.IsNaN(want) && math.IsNaN(tc.got)) {
+				t.Fatalf("x=%g: clamped %v, want %v", x, tc.got, want)
+			}
+		}
+	}
+
+	a := []float32{-1000, 0, 90}
+	b := []float32{-1001, 0, 1}
+	dst := make([]float32, len(a))
+	FastLogAddExpIntoClamp(dst, a, b, PrecisionBalanced, Clamp[float32]{Lo: -50, Hi: 50})
+
+	if dst[0] != -50 || dst[2] != 50 || math.Abs(float64(dst[1])-math.Ln2) > 1e-4 {
+		t.Fatalf("log-add-exp clamp = %v", dst)
+	}
+}
+
+func TestSaturate(t *testing.T) {
+	t.Parallel()
+
+	s32 := Saturate[float32]()
+	if got := s32.Apply(float32(math.Inf(1))); got != math.MaxFloat32 {
+		t.Fatalf("float32 +Inf saturates to %v", got)
+	}
+
+	if got := Saturate[float64]().Apply(math.Inf(-1)); got != -math.MaxFloat64 {
+		t.Fatalf("float64 -Inf saturates to %v", got)
+	}
+
+	if got := s32.Apply(1.5); got != 1.5 {
+		t.Fatalf("finite value changed to %v", got)
+	}
+}
+
+func TestIntoClamp_InvalidPanics(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []Clamp[float64]{{Lo: 1, Hi: 0}, {Lo: math.NaN(), Hi: 1}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("clamp %+v did not panic", c)
+				}
+			}()
+
+			FastGELUIntoClamp(make([]float64, 1), make([]float64, 1), PrecisionAuto, c)
+		}()
+	}
+}
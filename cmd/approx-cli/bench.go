@@ -0,0 +1,99 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/accuracy"
+	"github.com/meko-christian/algo-approx/internal/reference"
+)
+
+// tiers are the precision tiers reported by bench and accuracy.
+//
+//nolint:gochecknoglobals // read-only list
+var tiers = []approx.Precision{approx.PrecisionFast, approx.PrecisionBalanced, approx.PrecisionHigh}
+
+// benchSamples is the number of inputs bench cycles over.
+const benchSamples = 1024
+
+func runBench(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("bench", flag.ContinueOnError)
+	benchTime := flags.Duration("benchtime", 100*time.Millisecond, "measurement time per function and tier")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	fns, err := parseFunctions(flags.Args())
+	if err != nil {
+		return err
+	}
+
+	contract := accuracy.Current()
+
+	fmt.Fprintf(stdout, "%-9s %-9s %8s %8s %8s\n", "function", "tier", "ns/op", "math", "speedup")
+
+	for _, fn := range fns {
+		pair, _ := reference.ForFunction(fn)
+		ref := latency(pair.Ref, fn, contract, *benchTime)
+
+		for _, prec := range tiers {
+			ns := latency(func(x float64) float64 { return pair.Approx(x, prec) }, fn, contract, *benchTime)
+			fmt.Fprintf(stdout, "%-9v %-9v %8.2f %8.2f %7.2fx\n", fn, prec, ns, ref, ref/ns)
+		}
+	}
+
+	return nil
+}
+
+// sink keeps the benchmark loop from being optimized away.
+var sink float64 //nolint:gochecknoglobals
+
+// latency returns the mean time per call of f over the contract domain of
+// fn, measured for at least d.
+func latency(f func(float64) float64, fn approx.Function, c accuracy.Contract, d time.Duration) float64 {
+	b, _ := c.Lookup(fn, approx.PrecisionBalanced)
+	xs := b.Domain.Samples(benchSamples)
+
+	var (
+		calls int
+		acc   float64
+	)
+
+	start := time.Now()
+	for time.Since(start) < d {
+		for _, x := range xs {
+			acc += f(x)
+		}
+
+		calls += len(xs)
+	}
+
+	sink = acc
+
+	return float64(time.Since(start).Nanoseconds()) / float64(calls)
+}
+
+// parseFunctions resolves Function names case-insensitively; no names select
+// every registered Function.
+func parseFunctions(names []string) ([]approx.Function, error) {
+	if len(names) == 0 {
+		return approx.Functions(), nil
+	}
+
+	fns := make([]approx.Function, 0, len(names))
+
+	for _, name := range names {
+		fn, ok := lookupFunction(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown function %q", name)
+		}
+
+		fns = append(fns, fn)
+	}
+
+	return fns, nil
+}
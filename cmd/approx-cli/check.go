@@ -0,0 +1,59 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/meko-christian/algo-approx/accuracy"
+	"github.com/meko-christian/algo-approx/internal/reference"
+)
+
+var errContract = errors.New("accuracy contract violated")
+
+func runAccuracy(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("accuracy", flag.ContinueOnError)
+	n := flags.Int("n", 10001, "samples per domain")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	fns, err := parseFunctions(flags.Args())
+	if err != nil {
+		return err
+	}
+
+	contract := accuracy.Current()
+	failed := false
+
+	fmt.Fprintf(stdout, "contract %s\n\n", contract.Version)
+	fmt.Fprintf(stdout, "%-9s %-9s %-6s %10s %10s %12s\n", "function", "tier", "metric", "bound", "measured", "at")
+
+	for _, fn := range fns {
+		pair, _ := reference.ForFunction(fn)
+
+		for _, prec := range tiers {
+			b, ok := contract.Lookup(fn, prec)
+			if !ok {
+				continue
+			}
+
+			worst, at := b.Measure(func(x float64) float64 { return pair.Approx(x, prec) }, pair.Ref, *n)
+
+			status := ""
+			if !(worst <= b.MaxError) { //nolint:staticcheck // also catches NaN
+				status, failed = "  FAIL", true
+			}
+
+			fmt.Fprintf(stdout, "%-9v %-9v %-6v %10.3g %10.3g %12.6g%s\n", fn, prec, b.Metric, b.MaxError, worst, at, status)
+		}
+	}
+
+	if failed {
+		return errContract
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"time"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/accuracy"
+	"github.com/meko-christian/algo-approx/internal/reference"
+)
+
+// contender is one float32 implementation of some Functions for compare.
+type contender struct {
+	name string
+	fns  map[approx.Function]func(float32) float32
+}
+
+// contenders lists the implementations compare measures: the tiers of this
+// package at float32 and the math package through float64, the usual way to
+// get float32 results from the standard library. Files built with the
+// approxcompare tag append third-party libraries from init.
+//
+//nolint:gochecknoglobals // registry filled once at start-up
+var contenders = []contender{
+	approx32("approx-fast", approx.PrecisionFast),
+	approx32("approx-balanced", approx.PrecisionBalanced),
+	approx32("approx-high", approx.PrecisionHigh),
+	{name: "math-via-f64", fns: map[approx.Function]func(float32) float32{
+		approx.FuncSqrt:    via64(math.Sqrt),
+		approx.FuncInvSqrt: via64(func(x float64) float64 { return 1 / math.Sqrt(x) }),
+		approx.FuncLog:     via64(math.Log),
+		approx.FuncLog2:    via64(math.Log2),
+		approx.FuncExp:     via64(math.Exp),
+		approx.FuncExp2:    via64(math.Exp2),
+		approx.FuncSin:     via64(math.Sin),
+		approx.FuncCos:     via64(math.Cos),
+		approx.FuncTan:     via64(math.Tan),
+		approx.FuncArctan:  via64(math.Atan),
+		approx.FuncArccos:  via64(math.Acos),
+	}},
+}
+
+// math32Contender names the library added under the approxcompare tag.
+const math32Contender = "chewxy/math32"
+
+func via64(f func(float64) float64) func(float32) float32 {
+	return func(x float32) float32 { return float32(f(float64(x))) }
+}
+
+// approx32 returns the float32 instantiations of every Function at prec.
+func approx32(name string, prec approx.Precision) contender {
+	c := contender{name: name, fns: make(map[approx.Function]func(float32) float32)}
+
+	for _, fn := range approx.Functions() {
+		if f, ok := reference.ForFunction32(fn); ok {
+			c.fns[fn] = func(x float32) float32 { return f(x, prec) }
+		}
+	}
+
+	return c
+}
+
+// runCompare measures every contender on the same float32 samples of each
+// function's contract domain, against the float64 reference at those
+// inputs, and reports the error distribution and latency.
+func runCompare(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("compare", flag.ContinueOnError)
+	n := flags.Int("n", 10001, "samples per domain")
+	benchTime := flags.Duration("benchtime", 50*time.Millisecond, "measurement time per function and contender; 0 skips timing")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	fns, err := parseFunctions(flags.Args())
+	if err != nil {
+		return err
+	}
+
+	contract := accuracy.Current()
+
+	fmt.Fprintf(stdout, "%-9s %-16s %-6s %10s %10s %10s %8s\n", "function", "implementation", "metric", "p50", "p99", "max", "ns/op")
+
+	for _, fn := range fns {
+		pair, _ := reference.ForFunction(fn)
+		b, _ := contract.Lookup(fn, approx.PrecisionBalanced)
+		samples := float32Samples(b.Domain.Samples(*n))
+
+		for _, c := range contenders {
+			f, ok := c.fns[fn]
+			if !ok {
+				continue
+			}
+
+			f64 := func(x float64) float64 { return float64(f(float32(x))) }
+			rep := accuracy.MeasureWeighted(f64, pair.Ref, samples, b.Metric)
+
+			ns := math.NaN()
+			if *benchTime > 0 {
+				ns = latency(f64, fn, contract, *benchTime)
+			}
+
+			fmt.Fprintf(stdout, "%-9v %-16s %-6v %10.3g %10.3g %10.3g %8.2f\n", fn, c.name, b.Metric, rep.P50, rep.P99, rep.Max, ns)
+		}
+	}
+
+	if !slices.ContainsFunc(contenders, func(c contender) bool { return c.name == math32Contender }) {
+		fmt.Fprintf(stdout, "\n%s not included; build with -tags approxcompare to add it\n", math32Contender)
+	}
+
+	return nil
+}
+
+// float32Samples rounds xs to float32, so every contender sees the same
+// representable inputs and the reference is exact for them.
+func float32Samples(xs []float64) []accuracy.WeightedSample {
+	out := make([]accuracy.WeightedSample, len(xs))
+	for i, x := range xs {
+		out[i] = accuracy.WeightedSample{X: float64(float32(x)), Weight: 1}
+	}
+
+	return out
+}
@@ -0,0 +1,27 @@
+//go:build approxcompare
+
+package main
+
+import (
+	"github.com/chewxy/math32"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// The approxcompare tag adds github.com/chewxy/math32, the common pure-Go
+// float32 math library, to compare. Its InvSqrt is 1/Sqrt.
+func init() {
+	contenders = append(contenders, contender{name: math32Contender, fns: map[approx.Function]func(float32) float32{
+		approx.FuncSqrt:    math32.Sqrt,
+		approx.FuncInvSqrt: func(x float32) float32 { return 1 / math32.Sqrt(x) },
+		approx.FuncLog:     math32.Log,
+		approx.FuncLog2:    math32.Log2,
+		approx.FuncExp:     math32.Exp,
+		approx.FuncExp2:    math32.Exp2,
+		approx.FuncSin:     math32.Sin,
+		approx.FuncCos:     math32.Cos,
+		approx.FuncTan:     math32.Tan,
+		approx.FuncArctan:  math32.Atan,
+		approx.FuncArccos:  math32.Acos,
+	}})
+}
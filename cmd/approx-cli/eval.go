@@ -0,0 +1,126 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/stream"
+)
+
+func runEval(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("eval", flag.ContinueOnError)
+	precName := flags.String("prec", "balanced", "precision tier: fast, balanced or high")
+	budget := flags.Float64("budget", 0, "relative error budget of the whole expression; picks a tier per function call instead of -prec")
+	csvInput := flags.Bool("csv", false, "read and write comma-separated values instead of whitespace-separated ones")
+	cols := flags.String("cols", "", "comma-separated 1-based columns to transform (default all)")
+	format := flags.String("fmt", "g", "strconv.FormatFloat format of the results")
+	digits := flags.Int("digits", -1, "digits of the results, -1 for the shortest exact form")
+	passText := flags.Bool("text", false, "copy non-numeric fields, such as a header, instead of failing")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() == 0 {
+		return errors.New("eval: missing expression")
+	}
+
+	var prec approx.Precision
+	if err := prec.UnmarshalText([]byte(*precName)); err != nil {
+		return err
+	}
+
+	f, err := compileEval(flags.Arg(0), prec, *budget, flags.Output())
+	if err != nil {
+		return err
+	}
+
+	if len(*format) != 1 {
+		return fmt.Errorf("eval: bad -fmt %q", *format)
+	}
+
+	tr := &stream.Transformer{
+		Chain:    stream.Chain{f},
+		Format:   (*format)[0],
+		Prec:     *digits,
+		PassText: *passText,
+	}
+
+	if *csvInput {
+		tr.Comma = ','
+	}
+
+	if tr.Columns, err = parseColumns(*cols); err != nil {
+		return err
+	}
+
+	if flags.NArg() == 1 {
+		return tr.Transform(stdout, stdin)
+	}
+
+	for _, path := range flags.Args()[1:] {
+		if err := transformFile(tr, stdout, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compileEval compiles the eval expression at prec, or within budget if it
+// is positive, reporting the chosen tiers on w.
+func compileEval(src string, prec approx.Precision, budget float64, w io.Writer) (func(float64) float64, error) {
+	if budget <= 0 {
+		return compile(src, prec)
+	}
+
+	f, tiers, err := compileBudget(src, budget)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(w, "eval: tiers %v\n", tiers)
+
+	return f, nil
+}
+
+func transformFile(tr *stream.Transformer, w io.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := tr.Transform(w, f); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return nil
+}
+
+// parseColumns converts a list of 1-based columns to the 0-based form of
+// stream.Transformer; an empty list selects all columns.
+func parseColumns(list string) ([]int, error) {
+	if list == "" {
+		return nil, nil
+	}
+
+	var cols []int
+
+	for field := range strings.SplitSeq(list, ",") {
+		c, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil || c < 1 {
+			return nil, fmt.Errorf("eval: bad column %q", field)
+		}
+
+		cols = append(cols, c-1)
+	}
+
+	return cols, nil
+}
@@ -0,0 +1,325 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/internal/reference"
+)
+
+// compile turns an expression in x into a function evaluated with the
+// approx kernels at prec. The grammar is
+//
+//	expr    = term {("+" | "-") term}
+//	term    = unary {("*" | "/") unary}
+//	unary   = "-" unary | power
+//	power   = primary ["^" unary]
+//	primary = number | "x" | "pi" | "e" | name "(" expr ")" | "(" expr ")"
+//
+// where name is any registered Function name, compared case-insensitively,
+// and ^ is approx.FastIntPower for an integer literal exponent and
+// approx.FastPower otherwise. A lone name such as "exp" means name(x).
+func compile(src string, prec approx.Precision) (func(float64) float64, error) {
+	f, _, err := compileTiers(src, prec, nil)
+
+	return f, err
+}
+
+// compileBudget compiles src with a tier per function call chosen by
+// approx.BudgetSplit for the relative error budget, and returns the tiers in
+// the order the calls appear.
+func compileBudget(src string, budget float64) (func(float64) float64, []approx.Precision, error) {
+	_, ops, err := compileTiers(src, approx.PrecisionHigh, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tiers := approx.BudgetSplit(budget, ops)
+
+	f, _, err := compileTiers(src, approx.PrecisionHigh, tiers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return f, tiers, nil
+}
+
+// compileTiers compiles src, evaluating the i-th function call at tiers[i],
+// or at prec if tiers is nil, and returns the functions called in order.
+func compileTiers(src string, prec approx.Precision, tiers []approx.Precision) (
+	func(float64) float64, []approx.Function, error,
+) {
+	if fn, ok := lookupFunction(strings.TrimSpace(src)); ok {
+		pair, _ := reference.ForFunction(fn)
+		if tiers != nil {
+			prec = tiers[0]
+		}
+
+		return func(x float64) float64 { return pair.Approx(x, prec) }, []approx.Function{fn}, nil
+	}
+
+	p := &parser{src: src, prec: prec, tiers: tiers}
+
+	f, err := p.expr()
+	if err == nil && p.peek() != 0 {
+		err = p.errorf("unexpected %q", p.peek())
+	}
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("expression %q: %w", src, err)
+	}
+
+	return f, p.calls, nil
+}
+
+func lookupFunction(name string) (approx.Function, bool) {
+	for _, fn := range approx.Functions() {
+		if strings.EqualFold(fn.String(), name) {
+			return fn, true
+		}
+	}
+
+	return 0, false
+}
+
+type parser struct {
+	src   string
+	pos   int
+	prec  approx.Precision
+	tiers []approx.Precision // per call, overriding prec
+	calls []approx.Function
+}
+
+type node = func(float64) float64
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("at offset %d: "+format, append([]any{p.pos}, args...)...)
+}
+
+// peek skips whitespace and returns the next byte, or 0 at the end.
+func (p *parser) peek() byte {
+	for p.pos < len(p.src) && p.src[p.pos] == ' ' {
+		p.pos++
+	}
+
+	if p.pos == len(p.src) {
+		return 0
+	}
+
+	return p.src[p.pos]
+}
+
+func (p *parser) expr() (node, error) {
+	lhs, err := p.term()
+
+	for err == nil {
+		op := p.peek()
+		if op != '+' && op != '-' {
+			break
+		}
+
+		p.pos++
+
+		var rhs node
+		if rhs, err = p.term(); err == nil {
+			lhs = binary(op, lhs, rhs)
+		}
+	}
+
+	return lhs, err
+}
+
+func (p *parser) term() (node, error) {
+	lhs, err := p.unary()
+
+	for err == nil {
+		op := p.peek()
+		if op != '*' && op != '/' {
+			break
+		}
+
+		p.pos++
+
+		var rhs node
+		if rhs, err = p.unary(); err == nil {
+			lhs = binary(op, lhs, rhs)
+		}
+	}
+
+	return lhs, err
+}
+
+func (p *parser) unary() (node, error) {
+	if p.peek() == '-' {
+		p.pos++
+
+		f, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		return func(x float64) float64 { return -f(x) }, nil
+	}
+
+	base, err := p.primary()
+	if err != nil || p.peek() != '^' {
+		return base, err
+	}
+
+	p.pos++
+
+	// An integer literal exponent uses exact binary powering, which also
+	// accepts negative bases.
+	if n, ok := p.intLiteral(); ok {
+		return func(x float64) float64 { return approx.FastIntPower(base(x), n) }, nil
+	}
+
+	exp, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+
+	return binary('^', base, exp), nil
+}
+
+// intLiteral consumes an optionally negative integer literal that is not
+// followed by a fraction or exponent.
+func (p *parser) intLiteral() (int, bool) {
+	start := p.peek()
+	end := p.pos
+
+	if start == '-' {
+		end++
+	}
+
+	digits := end
+	for end < len(p.src) && p.src[end] >= '0' && p.src[end] <= '9' {
+		end++
+	}
+
+	if end == digits || (end < len(p.src) && strings.IndexByte(".eE", p.src[end]) >= 0) {
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(p.src[p.pos:end])
+	if err != nil {
+		return 0, false
+	}
+
+	p.pos = end
+
+	return n, true
+}
+
+func (p *parser) primary() (node, error) {
+	c := p.peek()
+
+	switch {
+	case c == '(':
+		p.pos++
+
+		f, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.peek() != ')' {
+			return nil, p.errorf("missing )")
+		}
+
+		p.pos++
+
+		return f, nil
+	case c == '.' || unicode.IsDigit(rune(c)):
+		start := p.pos
+		for p.pos++; p.pos < len(p.src) && isNumberByte(p.src[p.pos], p.src[p.pos-1]); p.pos++ {
+		}
+
+		v, err := strconv.ParseFloat(p.src[start:p.pos], 64)
+		if err != nil {
+			return nil, p.errorf("bad number %q", p.src[start:p.pos])
+		}
+
+		return func(float64) float64 { return v }, nil
+	case unicode.IsLetter(rune(c)):
+		return p.name()
+	case c == 0:
+		return nil, p.errorf("unexpected end")
+	default:
+		return nil, p.errorf("unexpected %q", c)
+	}
+}
+
+// isNumberByte reports whether c continues a number literal after prev:
+// digits, a decimal point, an exponent marker, or an exponent sign.
+func isNumberByte(c, prev byte) bool {
+	switch {
+	case c >= '0' && c <= '9', c == '.', c == 'e', c == 'E':
+		return true
+	case c == '+', c == '-':
+		return prev == 'e' || prev == 'E'
+	default:
+		return false
+	}
+}
+
+func (p *parser) name() (node, error) {
+	start := p.pos
+	for p.pos < len(p.src) && (unicode.IsLetter(rune(p.src[p.pos])) || unicode.IsDigit(rune(p.src[p.pos]))) {
+		p.pos++
+	}
+
+	name := p.src[start:p.pos]
+
+	switch strings.ToLower(name) {
+	case "x":
+		return func(x float64) float64 { return x }, nil
+	case "pi":
+		return func(float64) float64 { return 3.141592653589793 }, nil
+	case "e":
+		return func(float64) float64 { return 2.718281828459045 }, nil
+	}
+
+	fn, ok := lookupFunction(name)
+	if !ok {
+		return nil, p.errorf("unknown function %q", name)
+	}
+
+	if p.peek() != '(' {
+		return nil, p.errorf("%s needs an argument", name)
+	}
+
+	arg, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+
+	pair, _ := reference.ForFunction(fn)
+
+	prec := p.prec
+	if p.tiers != nil {
+		prec = p.tiers[len(p.calls)]
+	}
+
+	p.calls = append(p.calls, fn)
+
+	return func(x float64) float64 { return pair.Approx(arg(x), prec) }, nil
+}
+
+func binary(op byte, lhs, rhs node) node {
+	switch op {
+	case '+':
+		return func(x float64) float64 { return lhs(x) + rhs(x) }
+	case '-':
+		return func(x float64) float64 { return lhs(x) - rhs(x) }
+	case '*':
+		return func(x float64) float64 { return lhs(x) * rhs(x) }
+	case '/':
+		return func(x float64) float64 { return lhs(x) / rhs(x) }
+	default:
+		return func(x float64) float64 { return approx.FastPower(lhs(x), rhs(x)) }
+	}
+}
@@ -0,0 +1,63 @@
+// Command approx-cli makes the approx kernels available to shell pipelines
+// and quick experiments.
+//
+//	approx-cli eval [-prec P | -budget E] [-csv] [-cols 2,3] [-fmt f -digits 4] EXPR [FILE...]
+//	approx-cli bench [-benchtime 100ms] [FUNC...]
+//	approx-cli accuracy [-n 10001] [FUNC...]
+//	approx-cli compare [-n 10001] [-benchtime 50ms] [FUNC...]
+//
+// eval applies EXPR to every number of the input (standard input, or the
+// named files in order), or to the selected 1-based columns, and writes the
+// results with the same line structure. EXPR is a registered function name
+// such as exp, or an expression in x such as "exp(-x^2/2)/sqrt(2*pi)".
+// With -budget, every function call of EXPR gets a tier chosen by
+// approx.BudgetSplit's greedy search to keep the whole expression within the
+// relative error E; the tiers are reported on standard error.
+//
+// bench prints the latency of every tier of the named functions, or of every
+// registered function, next to the math package. accuracy measures every
+// tier against the published accuracy contract and fails if any bound is
+// exceeded. compare measures the float32 tiers next to the math package
+// (through float64) and, in binaries built with -tags approxcompare,
+// github.com/chewxy/math32, over the same float32 samples of each contract
+// domain, reporting the median, 99th percentile and largest error and the
+// latency of each.
+//
+//	cat data.txt | approx-cli eval exp
+//	approx-cli eval -csv -cols 2 'log(x)' prices.csv
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "approx-cli:", err)
+		os.Exit(1)
+	}
+}
+
+var errUsage = errors.New("usage: approx-cli eval|bench|accuracy|compare [flags] [args]")
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	switch args[0] {
+	case "eval":
+		return runEval(args[1:], stdin, stdout)
+	case "bench":
+		return runBench(args[1:], stdout)
+	case "accuracy":
+		return runAccuracy(args[1:], stdout)
+	case "compare":
+		return runCompare(args[1:], stdout)
+	default:
+		return fmt.Errorf("unknown command %q; %w", args[0], errUsage)
+	}
+}
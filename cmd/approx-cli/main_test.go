@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		src  string
+		want func(float64) float64
+	}{
+		{"exp", math.Exp},
+		{"Sqrt", math.Sqrt},
+		{"2*x+1", func(x float64) float64 { return 2*x + 1 }},
+		{"-x^2", func(x float64) float64 { return -(x * x) }},
+		{"x^-1", func(x float64) float64 { return 1 / x }},
+		{"exp(-x^2/2)/sqrt(2*pi)", func(x float64) float64 { return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi) }},
+		{"log(x) - log2(x)*log(2)", func(float64) float64 { return 0 }},
+		{"sqrt(x) + 1e-1", func(x float64) float64 { return math.Sqrt(x) + 0.1 }},
+		{"(1 - x) * (1 + x)", func(x float64) float64 { return 1 - x*x }},
+	}
+
+	for _, tc := range cases {
+		f, err := compile(tc.src, approx.PrecisionHigh)
+		if err != nil {
+			t.Fatalf("compile(%q): %v", tc.src, err)
+		}
+
+		for _, x := range []float64{0.25, 1, 3.5} {
+			if got, want := f(x), tc.want(x); math.Abs(got-want) > 1e-6*math.Max(1, math.Abs(want)) {
+				t.Errorf("%s at %g = %v, want %v", tc.src, x, got, want)
+			}
+		}
+	}
+
+	for _, bad := range []string{"", "x +", "foo(x)", "exp x", "(x", "x)", "1.2.3", "x $ 2"} {
+		if _, err := compile(bad, approx.PrecisionHigh); err == nil {
+			t.Errorf("compile(%q) must fail", bad)
+		}
+	}
+}
+
+func TestCompileBudget(t *testing.T) {
+	t.Parallel()
+
+	for _, budget := range []float64{1e-2, 1e-4, 1e-6} {
+		f, tiers, err := compileBudget("exp(log(x)*2) + sin(x)", budget)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(tiers) != 3 {
+			t.Fatalf("tiers %v, want one per call", tiers)
+		}
+
+		for _, x := range []float64{0.5, 1.5} {
+			want := x*x + math.Sin(x)
+			if got := f(x); math.Abs(got-want) > 3*budget*math.Abs(want) {
+				t.Errorf("budget %g at %g: got %v, want %v", budget, x, got, want)
+			}
+		}
+	}
+}
+
+func TestRunEval(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	if err := run([]string{"eval", "-prec", "high", "-fmt", "f", "-digits", "3", "exp"}, strings.NewReader("0 1\n2\n"), &out); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := out.String(), "1.000 2.718\n7.389\n"; got != want {
+		t.Fatalf("eval output %q, want %q", got, want)
+	}
+
+	path := filepath.Join(t.TempDir(), "in.csv")
+	if err := os.WriteFile(path, []byte("x,y\n4,9\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	out.Reset()
+
+	if err := run([]string{"eval", "-csv", "-cols", "2", "-text", "sqrt(x)", path}, nil, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := out.String(); !strings.HasPrefix(got, "x,y\n4,3") {
+		t.Fatalf("csv output %q", got)
+	}
+
+	for _, args := range [][]string{{"eval"}, {"eval", "-prec", "ultra", "x"}, {"eval", "-cols", "0", "x"}, {"frobnicate"}, {}} {
+		if err := run(args, strings.NewReader(""), &out); err == nil {
+			t.Errorf("run(%q) must fail", args)
+		}
+	}
+}
+
+func TestRunAccuracyAndBench(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	if err := run([]string{"accuracy", "-n", "2001", "exp", "arccos"}, nil, &out); err != nil {
+		t.Fatalf("accuracy: %v\n%s", err, out.String())
+	}
+
+	if got := out.String(); !strings.Contains(got, "Arccos    high") || strings.Contains(got, "FAIL") {
+		t.Fatalf("accuracy report:\n%s", got)
+	}
+
+	out.Reset()
+
+	if err := run([]string{"bench", "-benchtime", "1ms", "sin"}, nil, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := strings.Count(out.String(), "\n"); got != 4 {
+		t.Fatalf("bench printed %d lines:\n%s", got, out.String())
+	}
+
+	if err := run([]string{"bench", "nosuch"}, nil, &out); err == nil {
+		t.Fatalf("unknown function must fail")
+	}
+}
+
+func TestRunCompare(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	if err := run([]string{"compare", "-n", "501", "-benchtime", "0", "exp", "cotan"}, nil, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	got := out.String()
+
+	// Every contender implements Exp; only this package has Cotan.
+	if n := strings.Count(got, "Exp "); n != len(contenders) {
+		t.Errorf("%d Exp rows for %d contenders:\n%s", n, len(contenders), got)
+	}
+
+	if n := strings.Count(got, "Cotan "); n != 3 {
+		t.Errorf("%d Cotan rows, want one per tier:\n%s", n, got)
+	}
+
+	// Rows are laid out in the fixed-width columns of the header.
+	if !strings.Contains(got, "Exp       approx-high      rel") || !strings.Contains(got, "Exp       math-via-f64") {
+		t.Errorf("compare report:\n%s", got)
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/accuracy"
+	"github.com/meko-christian/algo-approx/internal/reference"
+)
+
+type heatmapConfig struct {
+	name           string
+	xRange, yRange string
+	n              int
+	prec           string
+	relative       bool
+	out            string
+}
+
+// runHeatmap writes the error grid of a two-argument function and reports
+// its worst cell.
+func runHeatmap(cfg heatmapConfig, stdout io.Writer) error {
+	pair, ok := reference.ForFunction2(cfg.name)
+	if !ok {
+		return fmt.Errorf("unknown two-argument function %q (want one of %s)",
+			cfg.name, strings.Join(reference.Functions2(), ", "))
+	}
+
+	var prec approx.Precision
+	if err := prec.UnmarshalText([]byte(cfg.prec)); err != nil {
+		return err
+	}
+
+	dx, err := parseDomain(cfg.xRange)
+	if err != nil {
+		return err
+	}
+
+	dy, err := parseDomain(cfg.yRange)
+	if err != nil {
+		return err
+	}
+
+	metric := accuracy.Absolute
+	if cfg.relative {
+		metric = accuracy.Relative
+	}
+
+	fn := func(x, y float64) float64 { return pair.Approx(x, y, prec) }
+	grid := accuracy.ErrorGrid(fn, pair.Ref, dx, dy, cfg.n, cfg.n, metric)
+
+	worst, x, y := grid.Worst()
+
+	report := stdout
+	if cfg.out == "" {
+		// The CSV goes to stdout; keep it parseable.
+		report = os.Stderr
+	}
+
+	fmt.Fprintf(report, "%s %v: worst %v error %.3g at (%g, %g)\n", cfg.name, prec, metric, worst, x, y)
+
+	if cfg.out == "" {
+		return grid.WriteCSV(stdout)
+	}
+
+	f, err := os.Create(cfg.out)
+	if err != nil {
+		return err
+	}
+
+	write := grid.WriteCSV
+	if strings.EqualFold(filepath.Ext(cfg.out), ".png") {
+		write = grid.WritePNG
+	}
+
+	if err := write(f); err != nil {
+		f.Close()
+
+		return err
+	}
+
+	return f.Close()
+}
+
+// parseDomain reads a heatmap axis spec uniform:LO:HI or log:LO:HI.
+func parseDomain(spec string) (accuracy.Domain, error) {
+	name, params, _ := strings.Cut(spec, ":")
+
+	lo, hi, err := parsePair(params)
+	if err != nil {
+		return accuracy.Domain{}, fmt.Errorf("range %q: %w", spec, err)
+	}
+
+	switch {
+	case name == "uniform" && lo < hi:
+		return accuracy.Domain{Lo: lo, Hi: hi}, nil
+	case name == "log" && lo > 0 && lo < hi:
+		return accuracy.Domain{Lo: lo, Hi: hi, Log: true}, nil
+	default:
+		return accuracy.Domain{}, fmt.Errorf("range %q: want uniform:LO:HI or log:LO:HI with LO < HI", spec)
+	}
+}
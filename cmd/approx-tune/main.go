@@ -0,0 +1,150 @@
+// Command approx-tune picks, per function, the cheapest precision tier that
+// meets an error target on a user-supplied input distribution.
+//
+// It measures the latency of every tier on this host and its worst error
+// against the math package over the given inputs, prints a table, and can
+// write the recommendation as an approx.Profile in JSON:
+//
+//	approx-tune -func Exp,Log -dist log:1e-3:1e3 -target 1e-5 -profile tiers.json
+//	approx-tune -func Sin -samples angles.txt -metric abs -target 1e-3
+//
+// Distributions are uniform:LO:HI, log:LO:HI (log-uniform, LO > 0) and
+// normal:MEAN:STDDEV. A samples file holds whitespace-separated numbers; "-"
+// reads standard input.
+//
+// With -heatmap it instead maps the error of a two-argument function (Power
+// or Hypot) over a grid of both arguments and writes it as CSV or, for a
+// .png output, as an image:
+//
+//	approx-tune -heatmap Power -x log:1e-3:1e3 -y uniform:-4:4 -grid 256 -out power.png
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/internal/reference"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "approx-tune:", err)
+		os.Exit(1)
+	}
+}
+
+var errUsage = errors.New("need exactly one of -dist and -samples")
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("approx-tune", flag.ContinueOnError)
+	funcs := flags.String("func", "", "comma-separated function names (default all)")
+	dist := flags.String("dist", "", "input distribution: uniform:LO:HI, log:LO:HI or normal:MEAN:STDDEV")
+	samplesPath := flags.String("samples", "", "file of input samples, - for stdin")
+	n := flags.Int("n", 10000, "number of samples drawn from -dist")
+	seed := flags.Uint64("seed", 1, "seed for -dist")
+	target := flags.Float64("target", 1e-6, "maximum acceptable error")
+	metric := flags.String("metric", "rel", "error metric: rel or abs")
+	benchTime := flags.Duration("benchtime", 50*time.Millisecond, "latency measurement time per tier")
+	profilePath := flags.String("profile", "", "write the recommended approx.Profile as JSON to this file")
+	heatmap := flags.String("heatmap", "", "map the error of a two-argument function: "+strings.Join(reference.Functions2(), ", "))
+	xRange := flags.String("x", "uniform:0.5:4", "heatmap range of the first argument: uniform:LO:HI or log:LO:HI")
+	yRange := flags.String("y", "uniform:-4:4", "heatmap range of the second argument")
+	gridSize := flags.Int("grid", 128, "heatmap points per axis")
+	prec := flags.String("prec", "balanced", "heatmap precision tier")
+	out := flags.String("out", "", "heatmap output file, .csv or .png (default CSV on stdout)")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *metric != "rel" && *metric != "abs" {
+		return fmt.Errorf("unknown metric %q", *metric)
+	}
+
+	if *heatmap != "" {
+		hm := heatmapConfig{
+			name: *heatmap, xRange: *xRange, yRange: *yRange, n: *gridSize,
+			prec: *prec, relative: *metric == "rel", out: *out,
+		}
+
+		return runHeatmap(hm, stdout)
+	}
+
+	fns, err := parseFunctions(*funcs)
+	if err != nil {
+		return err
+	}
+
+	var samples []float64
+
+	switch {
+	case (*dist == "") == (*samplesPath == ""):
+		return errUsage
+	case *dist != "":
+		samples, err = drawSamples(*dist, *n, approx.Seeded(*seed))
+	default:
+		samples, err = loadSamples(*samplesPath, stdin)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	cfg := config{target: *target, relative: *metric == "rel", benchTime: *benchTime}
+
+	results := tune(fns, samples, cfg)
+	writeReport(stdout, results, cfg)
+
+	if *profilePath == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(recommend(results), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(*profilePath, append(data, '\n'), 0o600)
+}
+
+// parseFunctions resolves a comma-separated list of Function names; an empty
+// list selects every Function.
+func parseFunctions(list string) ([]approx.Function, error) {
+	if list == "" {
+		return approx.Functions(), nil
+	}
+
+	var fns []approx.Function
+
+	for name := range strings.SplitSeq(list, ",") {
+		var fn approx.Function
+		if err := fn.UnmarshalText([]byte(strings.TrimSpace(name))); err != nil {
+			return nil, err
+		}
+
+		fns = append(fns, fn)
+	}
+
+	return fns, nil
+}
+
+func loadSamples(path string, stdin io.Reader) ([]float64, error) {
+	if path == "-" {
+		return readSamples(stdin)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return readSamples(f)
+}
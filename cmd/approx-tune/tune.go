@@ -0,0 +1,253 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/internal/reference"
+)
+
+var errNoSamples = errors.New("no samples")
+
+// tiers are the candidates, cheapest by design first.
+//
+//nolint:gochecknoglobals // read-only candidate list
+var tiers = []approx.Precision{approx.PrecisionFast, approx.PrecisionBalanced, approx.PrecisionHigh}
+
+type config struct {
+	target    float64
+	relative  bool
+	benchTime time.Duration
+}
+
+// tierResult is the measurement of one tier of one function.
+type tierResult struct {
+	prec    approx.Precision
+	nsPerOp float64
+	maxErr  float64
+}
+
+// funcResult collects the tiers of one function and the recommendation.
+type funcResult struct {
+	fn      approx.Function
+	samples int // inputs inside the function's domain
+	tiers   []tierResult
+	choice  approx.Precision
+	met     bool // whether choice meets the target
+}
+
+// sink keeps the benchmark loop from being optimized away.
+var sink float64 //nolint:gochecknoglobals
+
+// tune measures every tier of every function over the samples inside its
+// domain and picks the fastest tier meeting cfg.target, or the most accurate
+// tier when none does.
+func tune(fns []approx.Function, samples []float64, cfg config) []funcResult {
+	results := make([]funcResult, 0, len(fns))
+
+	for _, fn := range fns {
+		pair, ok := reference.ForFunction(fn)
+		if !ok {
+			continue
+		}
+
+		xs := inDomain(samples, approx.Describe(fn), pair.Ref)
+		res := funcResult{fn: fn, samples: len(xs), choice: approx.PrecisionHigh}
+
+		if len(xs) == 0 {
+			results = append(results, res)
+
+			continue
+		}
+
+		best := math.Inf(1)
+		bestErr := math.Inf(1)
+
+		for _, prec := range tiers {
+			eval := func(x float64) float64 { return pair.Approx(x, prec) }
+			m := reference.MeasureAccuracy(xs, pair.Ref, eval)
+
+			tr := tierResult{prec: prec, nsPerOp: latency(xs, eval, cfg.benchTime), maxErr: m.MaxAbsError}
+			if cfg.relative {
+				tr.maxErr = m.MaxRelError
+			}
+
+			res.tiers = append(res.tiers, tr)
+
+			switch {
+			case tr.maxErr <= cfg.target && tr.nsPerOp < best:
+				best, res.choice, res.met = tr.nsPerOp, prec, true
+			case !res.met && tr.maxErr < bestErr:
+				bestErr, res.choice = tr.maxErr, prec
+			}
+		}
+
+		results = append(results, res)
+	}
+
+	return results
+}
+
+// inDomain returns the samples inside the function's registered domain where
+// ref is finite, which drops poles.
+func inDomain(samples []float64, md approx.Metadata, ref func(float64) float64) []float64 {
+	xs := make([]float64, 0, len(samples))
+
+	for _, x := range samples {
+		if y := ref(x); md.InDomain(x) && !math.IsNaN(y) && !math.IsInf(y, 0) {
+			xs = append(xs, x)
+		}
+	}
+
+	return xs
+}
+
+// latency returns the mean time per call of eval cycling over xs, measured
+// for at least d.
+func latency(xs []float64, eval func(float64) float64, d time.Duration) float64 {
+	var (
+		calls int
+		acc   float64
+	)
+
+	start := time.Now()
+	for time.Since(start) < d {
+		for _, x := range xs {
+			acc += eval(x)
+		}
+
+		calls += len(xs)
+	}
+
+	sink = acc
+
+	return float64(time.Since(start).Nanoseconds()) / float64(calls)
+}
+
+// recommend turns the results into a Profile.
+func recommend(results []funcResult) approx.Profile {
+	p := make(approx.Profile, len(results))
+	for _, r := range results {
+		if r.samples > 0 {
+			p[r.fn] = r.choice
+		}
+	}
+
+	return p
+}
+
+func writeReport(w io.Writer, results []funcResult, cfg config) {
+	metric := "abs"
+	if cfg.relative {
+		metric = "rel"
+	}
+
+	fmt.Fprintf(w, "target %s error %.3g\n\n", metric, cfg.target)
+	fmt.Fprintf(w, "%-9s %-9s %10s %12s\n", "function", "tier", "ns/op", "max error")
+
+	for _, r := range results {
+		if r.samples == 0 {
+			fmt.Fprintf(w, "%-9v no samples inside the domain %v\n", r.fn, approx.Describe(r.fn).Domain)
+
+			continue
+		}
+
+		for _, tr := range r.tiers {
+			mark := ""
+			if tr.prec == r.choice {
+				mark = " <- recommended"
+				if !r.met {
+					mark = " <- target not met"
+				}
+			}
+
+			fmt.Fprintf(w, "%-9v %-9v %10.2f %12.3g%s\n", r.fn, tr.prec, tr.nsPerOp, tr.maxErr, mark)
+		}
+	}
+}
+
+// drawSamples draws n inputs from a distribution spec NAME:A:B.
+func drawSamples(spec string, n int, r *rand.Rand) ([]float64, error) {
+	name, params, _ := strings.Cut(spec, ":")
+
+	a, b, err := parsePair(params)
+	if err != nil {
+		return nil, fmt.Errorf("distribution %q: %w", spec, err)
+	}
+
+	var draw func() float64
+
+	switch name {
+	case "uniform":
+		draw = func() float64 { return a + (b-a)*r.Float64() }
+	case "log":
+		if !(a > 0 && b > a) {
+			return nil, fmt.Errorf("distribution %q: need 0 < LO < HI", spec)
+		}
+
+		draw = func() float64 { return a * math.Pow(b/a, r.Float64()) }
+	case "normal":
+		draw = func() float64 { return a + b*r.NormFloat64() }
+	default:
+		return nil, fmt.Errorf("unknown distribution %q", name)
+	}
+
+	xs := make([]float64, max(n, 1))
+	for i := range xs {
+		xs[i] = draw()
+	}
+
+	return xs, nil
+}
+
+func parsePair(s string) (a, b float64, err error) {
+	first, second, ok := strings.Cut(s, ":")
+	if !ok {
+		return 0, 0, fmt.Errorf("want two parameters, got %q", s)
+	}
+
+	if a, err = strconv.ParseFloat(first, 64); err != nil {
+		return 0, 0, err
+	}
+
+	if b, err = strconv.ParseFloat(second, 64); err != nil {
+		return 0, 0, err
+	}
+
+	return a, b, nil
+}
+
+// readSamples parses whitespace-separated numbers.
+func readSamples(r io.Reader) ([]float64, error) {
+	sc := bufio.NewScanner(r)
+	sc.Split(bufio.ScanWords)
+
+	var xs []float64
+
+	for sc.Scan() {
+		x, err := strconv.ParseFloat(sc.Text(), 64)
+		if err != nil {
+			return nil, err
+		}
+
+		xs = append(xs, x)
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(xs) == 0 {
+		return nil, errNoSamples
+	}
+
+	return xs, nil
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func TestDrawSamples(t *testing.T) {
+	t.Parallel()
+
+	xs, err := drawSamples("log:1e-3:1e3", 1000, approx.Seeded(1))
+	if err != nil || len(xs) != 1000 {
+		t.Fatalf("drawSamples: %d samples, %v", len(xs), err)
+	}
+
+	for _, x := range xs {
+		if x < 1e-3 || x > 1e3 {
+			t.Fatalf("sample %g outside the distribution", x)
+		}
+	}
+
+	for _, bad := range []string{"log:0:1", "uniform:1", "cauchy:0:1", "normal:a:1"} {
+		if _, err := drawSamples(bad, 10, approx.Seeded(1)); err == nil {
+			t.Fatalf("drawSamples(%q) must fail", bad)
+		}
+	}
+}
+
+func TestReadSamples(t *testing.T) {
+	t.Parallel()
+
+	xs, err := readSamples(strings.NewReader("1 2.5\n-3e2\n"))
+	if err != nil || len(xs) != 3 || xs[2] != -300 {
+		t.Fatalf("readSamples = %v, %v", xs, err)
+	}
+
+	if _, err := readSamples(strings.NewReader("1 x")); err == nil {
+		t.Fatalf("malformed input must fail")
+	}
+
+	if _, err := readSamples(strings.NewReader("")); err == nil {
+		t.Fatalf("empty input must fail")
+	}
+}
+
+func TestTuneChoosesTier(t *testing.T) {
+	t.Parallel()
+
+	xs, _ := drawSamples("uniform:-5:5", 500, approx.Seeded(2))
+	cfg := config{relative: true, benchTime: time.Millisecond}
+
+	cfg.target = 1e-8
+	res := tune([]approx.Function{approx.FuncExp}, xs, cfg)
+
+	if len(res) != 1 || !res[0].met || res[0].choice != approx.PrecisionHigh {
+		t.Fatalf("only High meets 1e-8: %+v", res)
+	}
+
+	cfg.target = 0
+	if res = tune([]approx.Function{approx.FuncExp}, xs, cfg); res[0].met || res[0].choice != approx.PrecisionHigh {
+		t.Fatalf("unreachable target must fall back to the most accurate tier: %+v", res)
+	}
+
+	// Negative inputs are outside Log's domain.
+	if res = tune([]approx.Function{approx.FuncLog}, []float64{-1, -2}, cfg); res[0].samples != 0 {
+		t.Fatalf("out-of-domain samples must be dropped: %+v", res)
+	}
+
+	if p := recommend(res); len(p) != 0 {
+		t.Fatalf("functions without samples must not be recommended: %v", p)
+	}
+}
+
+func TestRunWritesProfile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "profile.json")
+
+	var out bytes.Buffer
+
+	err := run([]string{
+		"-func", "Exp,Sqrt", "-samples", "-", "-target", "1", "-benchtime", "1ms", "-profile", path,
+	}, strings.NewReader("0.5 1 2 4"), &out)
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+
+	if !strings.Contains(out.String(), "recommended") {
+		t.Fatalf("report lacks a recommendation:\n%s", out.String())
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var p approx.Profile
+	if err := json.Unmarshal(data, &p); err != nil || len(p) != 2 {
+		t.Fatalf("profile = %s, %v", data, err)
+	}
+
+	if err := run([]string{"-func", "Exp"}, strings.NewReader(""), &out); err == nil {
+		t.Fatalf("missing input must fail")
+	}
+
+	if err := run([]string{"-func", "Cosh", "-dist", "uniform:0:1"}, strings.NewReader(""), &out); err == nil {
+		t.Fatalf("unknown function must fail")
+	}
+}
+
+func TestRunHeatmap(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	var out bytes.Buffer
+
+	for _, name := range []string{"power.csv", "power.png"} {
+		path := filepath.Join(dir, name)
+
+		err := run([]string{"-heatmap", "Power", "-x", "log:0.1:10", "-y", "uniform:-2:2", "-grid", "8", "-out", path},
+			strings.NewReader(""), &out)
+		if err != nil {
+			t.Fatalf("run %s: %v", name, err)
+		}
+
+		if info, err := os.Stat(path); err != nil || info.Size() == 0 {
+			t.Fatalf("%s not written: %v", name, err)
+		}
+	}
+
+	if !strings.Contains(out.String(), "Power balanced: worst rel error") {
+		t.Fatalf("report:\n%s", out.String())
+	}
+
+	for _, args := range [][]string{
+		{"-heatmap", "Atan3"},
+		{"-heatmap", "Hypot", "-x", "log:0:1"},
+		{"-heatmap", "Hypot", "-prec", "extreme"},
+	} {
+		if err := run(args, strings.NewReader(""), &out); err == nil {
+			t.Fatalf("run %v must fail", args)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package columnar
+
+import (
+	"fmt"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// Map stores f(src[i]) in dst[i] for every valid slot of src and zero in
+// its null slots; dst's validity bitmap is left to the caller, as Arrow
+// kernels share the input's. dst may alias src.
+//
+// It returns an error wrapping approx.ErrLengthMismatch if the columns differ
+// in length or a buffer is too short, and approx.ErrDomainError for a
+// negative offset or length.
+func Map[T approx.Float](dst, src Column[T], f func(T) T) error {
+	if err := src.check(); err != nil {
+		return fmt.Errorf("source: %w", err)
+	}
+
+	if err := dst.check(); err != nil {
+		return fmt.Errorf("destination: %w", err)
+	}
+
+	if dst.Len != src.Len {
+		return fmt.Errorf("columnar: %d destination slots for %d source slots: %w", dst.Len, src.Len, approx.ErrLengthMismatch)
+	}
+
+	in, okIn := src.View()
+	out, okOut := dst.View()
+
+	for i := range src.Len {
+		var v T
+
+		switch {
+		case !src.IsValid(i):
+		case okIn:
+			v = f(in[i])
+		default:
+			v = f(src.At(i))
+		}
+
+		if okOut {
+			out[i] = v
+		} else {
+			dst.Set(i, v)
+		}
+	}
+
+	return nil
+}
+
+// Exp stores approx.FastExpPrec of every valid slot of src in dst. See Map.
+func Exp[T approx.Float](dst, src Column[T], prec approx.Precision) error {
+	return Map(dst, src, func(x T) T { return approx.FastExpPrec(x, prec) })
+}
+
+// Log stores approx.FastLogPrec of every valid slot of src in dst. See Map.
+func Log[T approx.Float](dst, src Column[T], prec approx.Precision) error {
+	return Map(dst, src, func(x T) T { return approx.FastLogPrec(x, prec) })
+}
@@ -0,0 +1,102 @@
+package columnar
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"unsafe"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// Column is a float32 or float64 Arrow-style column: Len values starting at
+// element Offset of the little-endian buffer Values. Validity is the Arrow
+// validity bitmap, LSB first and indexed like Values from element 0; a nil
+// Validity means every slot holds a value.
+type Column[T approx.Float] struct {
+	Values   []byte
+	Validity []byte
+	Offset   int
+	Len      int
+}
+
+// New returns a Column over the values in buf, with no nulls.
+func New[T approx.Float](buf []byte) Column[T] {
+	return Column[T]{Values: buf, Len: len(buf) / width[T]()} //nolint:exhaustruct
+}
+
+func width[T approx.Float]() int {
+	var zero T
+
+	return int(unsafe.Sizeof(zero))
+}
+
+// check reports a column whose buffers are too short for Offset and Len.
+func (c Column[T]) check() error {
+	switch {
+	case c.Offset < 0 || c.Len < 0:
+		return fmt.Errorf("columnar: offset %d, length %d: %w", c.Offset, c.Len, approx.ErrDomainError)
+	case len(c.Values) < (c.Offset+c.Len)*width[T]():
+		return fmt.Errorf("columnar: values buffer of %d bytes, need %d: %w",
+			len(c.Values), (c.Offset+c.Len)*width[T](), approx.ErrLengthMismatch)
+	case c.Validity != nil && len(c.Validity)*8 < c.Offset+c.Len:
+		return fmt.Errorf("columnar: validity bitmap of %d bytes, need %d bits: %w",
+			len(c.Validity), c.Offset+c.Len, approx.ErrLengthMismatch)
+	}
+
+	return nil
+}
+
+// IsValid reports whether slot i (0 <= i < Len) holds a value.
+func (c Column[T]) IsValid(i int) bool {
+	j := c.Offset + i
+
+	return c.Validity == nil || c.Validity[j>>3]&(1<<(j&7)) != 0
+}
+
+// At decodes slot i.
+func (c Column[T]) At(i int) T {
+	b := c.Values[(c.Offset+i)*width[T]():]
+	if width[T]() == 4 {
+		return T(math.Float32frombits(binary.LittleEndian.Uint32(b)))
+	}
+
+	return T(math.Float64frombits(binary.LittleEndian.Uint64(b)))
+}
+
+// Set encodes v into slot i.
+func (c Column[T]) Set(i int, v T) {
+	b := c.Values[(c.Offset+i)*width[T]():]
+	if width[T]() == 4 {
+		binary.LittleEndian.PutUint32(b, math.Float32bits(float32(v)))
+	} else {
+		binary.LittleEndian.PutUint64(b, math.Float64bits(float64(v)))
+	}
+}
+
+// View returns the column's values as a []T aliasing Values, or false when
+// the host is big-endian or the buffer is not aligned for T. Slots that are
+// null hold unspecified values.
+func (c Column[T]) View() ([]T, bool) {
+	if c.check() != nil || !littleEndian {
+		return nil, false
+	}
+
+	if c.Len == 0 {
+		return []T{}, true
+	}
+
+	p := unsafe.Pointer(&c.Values[c.Offset*width[T]()])
+	if uintptr(p)%uintptr(width[T]()) != 0 {
+		return nil, false
+	}
+
+	return unsafe.Slice((*T)(p), c.Len), true
+}
+
+//nolint:gochecknoglobals // host property, fixed at start-up
+var littleEndian = func() bool {
+	x := uint16(1)
+
+	return *(*byte)(unsafe.Pointer(&x)) == 1
+}()
@@ -0,0 +1,109 @@
+package columnar
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// encode64 returns xs as a little-endian buffer starting skew bytes into a
+// larger allocation, so skew != 0 yields a misaligned buffer.
+func encode64(xs []float64, skew int) []byte {
+	raw := make([]byte, len(xs)*8+skew+8)
+	buf := raw[skew : skew+len(xs)*8]
+
+	for i, x := range xs {
+		binary.LittleEndian.PutUint64(buf[i*8:], math.Float64bits(x))
+	}
+
+	return buf
+}
+
+func TestMapAlignedAndMisaligned(t *testing.T) {
+	t.Parallel()
+
+	xs := []float64{0.5, 1, 2, 10, 100}
+
+	for _, skew := range []int{0, 1} {
+		src := New[float64](encode64(xs, skew))
+		dst := New[float64](make([]byte, len(xs)*8))
+
+		if err := Log(dst, src, approx.PrecisionHigh); err != nil {
+			t.Fatal(err)
+		}
+
+		for i, x := range xs {
+			if got, want := dst.At(i), approx.FastLogPrec(x, approx.PrecisionHigh); got != want {
+				t.Fatalf("skew %d: log slot %d = %v, want %v", skew, i, got, want)
+			}
+		}
+	}
+
+	if _, ok := New[float64](encode64(xs, 0)).View(); !ok && littleEndian {
+		t.Fatalf("an aligned buffer must be viewable")
+	}
+
+	if _, ok := New[float64](encode64(xs, 3)).View(); ok {
+		t.Fatalf("a misaligned buffer must not be viewed")
+	}
+}
+
+func TestMapOffsetValidityInPlace(t *testing.T) {
+	t.Parallel()
+
+	// Slots 1..4 of the buffer; slot 3 (buffer element 3) is null.
+	col := Column[float32]{Values: make([]byte, 6*4), Validity: []byte{0b11110111}, Offset: 1, Len: 4}
+	for i := range col.Len {
+		col.Set(i, float32(i))
+	}
+
+	if err := Exp(col, col, approx.PrecisionHigh); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range col.Len {
+		want := approx.FastExpPrec(float32(i), approx.PrecisionHigh)
+		if !col.IsValid(i) {
+			want = 0
+		}
+
+		if got := col.At(i); got != want {
+			t.Fatalf("slot %d = %v, want %v", i, got, want)
+		}
+	}
+
+	if col.IsValid(2) {
+		t.Fatalf("slot 2 is null in the bitmap")
+	}
+
+	// Elements outside [Offset, Offset+Len) are untouched.
+	if binary.LittleEndian.Uint32(col.Values) != 0 || binary.LittleEndian.Uint32(col.Values[20:]) != 0 {
+		t.Fatalf("wrote outside the column")
+	}
+}
+
+func TestMapErrors(t *testing.T) {
+	t.Parallel()
+
+	ok := New[float64](make([]byte, 32))
+
+	cases := []struct {
+		name     string
+		dst, src Column[float64]
+		want     error
+	}{
+		{"short values", ok, Column[float64]{Values: make([]byte, 31), Len: 4}, approx.ErrLengthMismatch},
+		{"short bitmap", ok, Column[float64]{Values: make([]byte, 32), Validity: []byte{}, Len: 4}, approx.ErrLengthMismatch},
+		{"negative offset", ok, Column[float64]{Values: make([]byte, 32), Offset: -1, Len: 4}, approx.ErrDomainError},
+		{"length", New[float64](make([]byte, 24)), ok, approx.ErrLengthMismatch},
+	}
+
+	for _, tc := range cases {
+		if err := Exp(tc.dst, tc.src, approx.PrecisionAuto); !errors.Is(err, tc.want) {
+			t.Errorf("%s: err = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+// Package columnar runs the approx kernels over Apache Arrow-style columns
+// without copying them into Go slices first.
+//
+// A Column describes a primitive Arrow array by its raw parts: the
+// little-endian values buffer, the element offset and length of the slice
+// being processed, and an optional validity bitmap. These are exactly the
+// buffers an Arrow or Parquet reader hands out, so the package needs no
+// Arrow dependency; with github.com/apache/arrow-go, for example, they are
+// arr.Data().Buffers()[0:2], arr.Data().Offset() and arr.Len().
+//
+// When the values buffer is suitably aligned and the host is little-endian,
+// Column.View returns a []T aliasing the buffer and the kernels run on it
+// directly; otherwise every element is decoded and encoded in place.
+package columnar
@@ -0,0 +1,28 @@
+package columnar_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/columnar"
+)
+
+func ExampleLog() {
+	// A float64 values buffer as read from an Arrow IPC stream or a Parquet
+	// page, with the second slot null.
+	buf := make([]byte, 3*8)
+	for i, x := range []float64{1, -1, math.E} {
+		binary.LittleEndian.PutUint64(buf[i*8:], math.Float64bits(x))
+	}
+
+	col := columnar.Column[float64]{Values: buf, Validity: []byte{0b101}, Len: 3}
+	if err := columnar.Log(col, col, approx.PrecisionHigh); err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%.4f %v %.4f\n", col.At(0), col.IsValid(1), col.At(2))
+	// Output:
+	// 0.0000 false 1.0000
+}
@@ -0,0 +1,53 @@
+package approx
+
+import iapprox "github.com/meko-christian/algo-approx/internal/approx"
+
+// FastLgamma returns an approximate ln Γ(x) for x > 0 using the default
+// precision, via the shifted Stirling series. Positive integers up to 256
+// are exact.
+func FastLgamma[T Float](x T) T { return FastLgammaPrec(x, PrecisionAuto) }
+
+// FastLgammaPrec returns an approximate ln Γ(x) using the requested precision.
+// The error is below about 1.3e-4 (Fast), 3e-8 (Balanced) and 3e-11 (High),
+// absolute where |ln Γ(x)| < 1 and relative elsewhere.
+func FastLgammaPrec[T Float](x T, prec Precision) T {
+	checkPositive("FastLgamma", x, prec)
+
+	return iapprox.Lgamma(x, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastLgamma32(x float32) float32 { return FastLgamma[float32](x) }
+func FastLgamma64(x float64) float64 { return FastLgamma[float64](x) }
+
+// FastLogFactorial returns ln(n!) using the default precision: exact from a
+// table for n < 256 and from the Stirling series beyond, so it never
+// overflows. Negative n returns NaN.
+func FastLogFactorial(n int) float64 { return FastLogFactorialPrec(n, PrecisionAuto) }
+
+// FastLogFactorialPrec returns ln(n!) using the requested precision.
+func FastLogFactorialPrec(n int, prec Precision) float64 {
+	if debugEnabled && n < 0 {
+		reportViolation("FastLogFactorial", float64(n), prec, "negative n")
+	}
+
+	return iapprox.LogFactorial(n, iapprox.Precision(normalizePrecision(prec)))
+}
+
+// FastLogBinomial returns ln C(n, k), the log of the binomial coefficient,
+// using the default precision. It returns -Inf when k < 0 or k > n and NaN
+// when n < 0.
+//
+// When min(k, n-k) <= 32 the coefficient is accumulated as a product, which
+// keeps the result accurate to the log kernel even for n in the billions;
+// otherwise it is a difference of FastLogFactorial values, whose absolute
+// error grows with n.
+func FastLogBinomial(n, k int) float64 { return FastLogBinomialPrec(n, k, PrecisionAuto) }
+
+// FastLogBinomialPrec returns ln C(n, k) using the requested precision.
+func FastLogBinomialPrec(n, k int, prec Precision) float64 {
+	if debugEnabled && n < 0 {
+		reportViolation("FastLogBinomial", float64(n), prec, "negative n")
+	}
+
+	return iapprox.LogBinomial(n, k, iapprox.Precision(normalizePrecision(prec)))
+}
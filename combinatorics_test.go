@@ -0,0 +1,55 @@
+package approx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+func TestFastLgamma(t *testing.T) {
+	t.Parallel()
+
+	for _, x := range []float64{0.5, 1, 3.7, 10, 171.5} {
+		ref, _ := math.Lgamma(x)
+		if got := FastLgammaPrec(x, PrecisionHigh); !testutil.Close(got, ref, 1e-10) && math.Abs(got-ref) > 1e-10 {
+			t.Fatalf("FastLgammaPrec(%g) = %g, want %g", x, got, ref)
+		}
+	}
+
+	if got := FastLgamma32(0.5); math.Abs(float64(got)-0.5*math.Log(math.Pi)) > 1e-4 {
+		t.Fatalf("FastLgamma32(0.5) = %g", got)
+	}
+}
+
+func TestFastLogFactorial(t *testing.T) {
+	t.Parallel()
+
+	if got := FastLogFactorial(5); math.Abs(got-math.Log(120)) > 1e-15 {
+		t.Fatalf("FastLogFactorial(5) = %.17g", got)
+	}
+
+	// 1000! overflows float64 but its log is fine.
+	ref, _ := math.Lgamma(1001)
+	if got := FastLogFactorialPrec(1000, PrecisionHigh); !testutil.Close(got, ref, 1e-12) {
+		t.Fatalf("FastLogFactorialPrec(1000) = %g, want %g", got, ref)
+	}
+}
+
+func TestFastLogBinomial(t *testing.T) {
+	t.Parallel()
+
+	if got := math.Round(math.Exp(FastLogBinomial(10, 3))); got != 120 {
+		t.Fatalf("C(10, 3) = %g", got)
+	}
+
+	// ln C(1e9, 2) = ln(1e9·(1e9-1)/2)
+	want := math.Log(1e9) + math.Log(1e9-1) - math.Ln2
+	if got := FastLogBinomialPrec(1_000_000_000, 2, PrecisionHigh); math.Abs(got-want) > 1e-9 {
+		t.Fatalf("FastLogBinomial(1e9, 2) = %.15g, want %.15g", got, want)
+	}
+
+	if !math.IsInf(FastLogBinomial(3, 4), -1) {
+		t.Fatal("k > n is not -Inf")
+	}
+}
@@ -0,0 +1,94 @@
+package approx
+
+import (
+	"math"
+	"slices"
+	"sync/atomic"
+)
+
+// SetLibmConformance turns libm conformance on or off and returns the
+// previous setting. While it is on, the scalar PrecisionHigh entry points of
+// the Function families (FastSqrtPrec through FastArccscPrec) return exactly
+// what the math package returns for the inputs of LibmConformanceInputs,
+// rounded to T, so snapshot tests downstream keep their golden values when
+// a project swaps math for approx. Every other input, tier and entry point
+// is unaffected.
+//
+// The check is a final comparison of the tier in every call and a load of
+// the setting at PrecisionHigh; the table is only searched while
+// conformance is on. It is off by default and safe to toggle concurrently.
+func SetLibmConformance(on bool) bool { return libmConformance.Swap(on) }
+
+// LibmConformanceInputs returns the curated inputs SetLibmConformance pins to
+// the math package, sorted. The result is a fresh slice.
+func LibmConformanceInputs() []float64 { return slices.Clone(conformanceInputs) }
+
+var libmConformance atomic.Bool //nolint:gochecknoglobals // process-wide switch
+
+// conformanceInputs are the arguments snapshot tests reach for: signed
+// zeros, small integers and halves, and the common constants of the trig,
+// exp and log families. A float32 call matches the float32 rounding.
+//
+//nolint:gochecknoglobals // read-only table
+var conformanceInputs = []float64{
+	-2 * math.Pi, -math.Pi, -2, -math.Pi / 2, -1, -math.Pi / 4, -0.5,
+	math.Copysign(0, -1), 0,
+	0.5, math.Pi / 6, math.Ln2, math.Pi / 4, 1, math.Pi / 3, math.Sqrt2, math.Pi / 2, 2,
+	math.E, math.Pi, 4, 2 * math.Pi, 10,
+}
+
+// libm is the math package counterpart of every Function, indexed by
+// Function: the reference of the accuracy tests and the results
+// SetLibmConformance pins.
+//
+//nolint:gochecknoglobals // read-only table indexed by Function
+var libm = [...]func(float64) float64{
+	FuncSqrt:     math.Sqrt,
+	FuncInvSqrt:  func(x float64) float64 { return 1 / math.Sqrt(x) },
+	FuncLog:      math.Log,
+	FuncLog2:     math.Log2,
+	FuncExp:      math.Exp,
+	FuncExp2:     math.Exp2,
+	FuncSin:      math.Sin,
+	FuncCos:      math.Cos,
+	FuncTan:      math.Tan,
+	FuncCotan:    func(x float64) float64 { return 1 / math.Tan(x) },
+	FuncSec:      func(x float64) float64 { return 1 / math.Cos(x) },
+	FuncCsc:      func(x float64) float64 { return 1 / math.Sin(x) },
+	FuncArctan:   math.Atan,
+	FuncArccotan: func(x float64) float64 { return math.Pi/2 - math.Atan(x) },
+	FuncArccos:   math.Acos,
+	FuncArcsec:   func(x float64) float64 { return math.Acos(1 / x) },
+	FuncArccsc:   func(x float64) float64 { return math.Asin(1 / x) },
+}
+
+// conform returns y, the kernel result of fn at x, unless conformance is on
+// at PrecisionHigh and x is a conformance input.
+func conform[T Float](fn Function, x T, prec Precision, y T) T {
+	if prec != PrecisionHigh || !libmConformance.Load() {
+		return y
+	}
+
+	return conformLookup(fn, x, y)
+}
+
+// conformLookup binary-searches the sorted conformanceInputs, rounded to T
+// as rounding keeps their order. Both zeros are inputs and libm sees x
+// itself, so comparing values suffices.
+func conformLookup[T Float](fn Function, x T, y T) T {
+	lo, hi := 0, len(conformanceInputs)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if T(conformanceInputs[m]) < x {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+
+	if lo < len(conformanceInputs) && T(conformanceInputs[lo]) == x {
+		return T(libm[fn](float64(x)))
+	}
+
+	return y
+}
@@ -0,0 +1,97 @@
+package approx
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestLibmConformanceInputsSorted(t *testing.T) {
+	t.Parallel()
+
+	xs := LibmConformanceInputs()
+	if !slices.IsSortedFunc(xs, func(a, b float64) int { return cmp.Compare(a, b) }) {
+		t.Fatalf("conformance inputs are not sorted: %v", xs)
+	}
+}
+
+func sameBits(a, b float64) bool {
+	return math.Float64bits(a) == math.Float64bits(b) || (a != a && b != b) //nolint:gocritic
+}
+
+// The tests below toggle the process-wide setting and are not parallel.
+
+func TestLibmConformance(t *testing.T) {
+	defer SetLibmConformance(SetLibmConformance(true))
+
+	for _, fn := range Functions() {
+		for _, x := range LibmConformanceInputs() {
+			if got, want := sampleKernels[fn](x, PrecisionHigh), libm[fn](x); !sameBits(got, want) {
+				t.Errorf("%v(%v) = %v, want math's %v", fn, x, got, want)
+			}
+		}
+	}
+
+	x32 := float32(math.Pi / 6)
+	if got, want := FastSinPrec(x32, PrecisionHigh), float32(math.Sin(float64(x32))); got != want {
+		t.Errorf("FastSinPrec(float32(π/6)) = %v, want %v", got, want)
+	}
+
+	if got, want := FastCosPrec(float32(math.Pi/2), PrecisionHigh), float32(math.Cos(float64(float32(math.Pi/2)))); got != want {
+		t.Errorf("FastCosPrec(float32(π/2)) = %v, want %v", got, want)
+	}
+}
+
+func TestLibmConformance_Scope(t *testing.T) {
+	defer SetLibmConformance(SetLibmConformance(false))
+
+	// Off, and at the other tiers, the kernels answer on their own.
+	x := math.Pi / 6
+	off := FastSinPrec(x, PrecisionHigh)
+
+	if off == math.Sin(x) {
+		t.Skip("the kernel already matches math at π/6")
+	}
+
+	SetLibmConformance(true)
+
+	if got := FastSinPrec(x, PrecisionBalanced); got == math.Sin(x) {
+		t.Errorf("Balanced tier was pinned: %v", got)
+	}
+
+	if got := FastSinPrec(x, PrecisionHigh); got != math.Sin(x) {
+		t.Errorf("FastSinPrec(π/6) = %v, want %v", got, math.Sin(x))
+	}
+
+	// -0 and +0 are separate entries.
+	if got := FastSinPrec(math.Copysign(0, -1), PrecisionHigh); !math.Signbit(got) {
+		t.Errorf("FastSinPrec(-0) = %v, want -0", got)
+	}
+
+	if SetLibmConformance(false) != true {
+		t.Error("SetLibmConformance did not report the previous setting")
+	}
+
+	if got := FastSinPrec(x, PrecisionHigh); got != off {
+		t.Errorf("after turning off: %v, want %v", got, off)
+	}
+}
+
+func BenchmarkFastSinPrec_High(b *testing.B) {
+	for _, on := range []bool{false, true} {
+		name := "off"
+		if on {
+			name = "on"
+		}
+
+		b.Run(name, func(b *testing.B) {
+			defer SetLibmConformance(SetLibmConformance(on))
+
+			x := 0.7
+			for b.Loop() {
+				x = FastSinPrec(x+1, PrecisionHigh)
+			}
+		})
+	}
+}
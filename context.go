@@ -0,0 +1,45 @@
+package approx
+
+import "context"
+
+// Config is a request-scoped choice of math provider, carried through call
+// stacks by WithContext and FromContext so that, for example, an API can
+// offer "fast" and "precise" modes per request without threading a
+// parameter through every layer.
+type Config struct {
+	// Precision is the tier requested by the caller. Code that calls the
+	// Fast*Prec functions directly can pass it on.
+	Precision Precision
+
+	// Evaluator provides the functions. WithContext replaces a nil
+	// Evaluator with NewEvaluator(Precision).
+	Evaluator Evaluator
+}
+
+type configKey struct{}
+
+//nolint:gochecknoglobals // immutable default returned by FromContext
+var defaultConfig = Config{
+	Precision: PrecisionAuto,
+	Evaluator: NewEvaluator(PrecisionAuto),
+}
+
+// WithContext returns a copy of ctx that carries cfg.
+func WithContext(ctx context.Context, cfg Config) context.Context {
+	if cfg.Evaluator == nil {
+		cfg.Evaluator = NewEvaluator(cfg.Precision)
+	}
+
+	return context.WithValue(ctx, configKey{}, &cfg)
+}
+
+// FromContext returns the Config carried by ctx, or the package default
+// (PrecisionAuto with this package's kernels) when there is none. The
+// lookup never allocates.
+func FromContext(ctx context.Context) Config {
+	if cfg, ok := ctx.Value(configKey{}).(*Config); ok {
+		return *cfg
+	}
+
+	return defaultConfig
+}
@@ -0,0 +1,52 @@
+package approx
+
+import (
+	"context"
+	"testing"
+)
+
+func TestContextConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	def := FromContext(ctx)
+	if def.Precision != PrecisionAuto || def.Evaluator == nil {
+		t.Fatalf("default config = %+v", def)
+	}
+
+	precise := WithContext(ctx, Config{Precision: PrecisionHigh, Evaluator: Stdlib()})
+	if cfg := FromContext(precise); cfg.Precision != PrecisionHigh || cfg.Evaluator != Stdlib() {
+		t.Fatalf("FromContext = %+v", cfg)
+	}
+
+	// A nil evaluator is filled in from the precision.
+	fast := WithContext(ctx, Config{Precision: PrecisionFast}) //nolint:exhaustruct
+	if got, want := FromContext(fast).Evaluator.Exp(1), FastExpPrec(1.0, PrecisionFast); got != want {
+		t.Fatalf("filled-in evaluator Exp(1) = %g, want %g", got, want)
+	}
+
+	// Derived contexts inherit, and inner configs shadow outer ones.
+	child, cancel := context.WithCancel(precise)
+	defer cancel()
+
+	if FromContext(child).Precision != PrecisionHigh {
+		t.Fatal("derived context lost the config")
+	}
+
+	if FromContext(WithContext(child, Config{Precision: PrecisionFast})).Precision != PrecisionFast { //nolint:exhaustruct
+		t.Fatal("inner config does not shadow the outer one")
+	}
+}
+
+//nolint:paralleltest // testing.AllocsPerRun must not run in parallel tests
+func TestFromContext_NoAllocs(t *testing.T) {
+	plain := context.Background()
+	scoped := WithContext(plain, Config{Precision: PrecisionHigh}) //nolint:exhaustruct
+
+	for name, ctx := range map[string]context.Context{"default": plain, "scoped": scoped} {
+		if allocs := testing.AllocsPerRun(1000, func() { _ = FromContext(ctx).Evaluator.Sqrt(2) }); allocs != 0 {
+			t.Fatalf("%s: FromContext allocated %v times", name, allocs)
+		}
+	}
+}
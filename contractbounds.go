@@ -0,0 +1,28 @@
+// Code generated by go run ./internal/gen/accuracy; DO NOT EDIT.
+
+package approx
+
+// contractBounds mirrors the bounds of accuracy.Current 1.0.0 by Function:
+// the maximum error per tier, Fast, Balanced and High, whether it is
+// relative, and the domain it holds on.
+//
+//nolint:gochecknoglobals // read-only generated table
+var contractBounds = [...]contractBound{
+	FuncSqrt:     {maxError: [3]float64{0.002, 2e-06, 2e-12}, relative: true, lo: 1e-06, hi: 1e+06, log: true},
+	FuncInvSqrt:  {maxError: [3]float64{0.002, 6e-06, 5e-11}, relative: true, lo: 1e-06, hi: 1e+06, log: true},
+	FuncLog:      {maxError: [3]float64{0.0025, 1.5e-05, 1.5e-07}, relative: false, lo: 1e-06, hi: 1e+06, log: true},
+	FuncLog2:     {maxError: [3]float64{0.0001, 6e-08, 4e-11}, relative: false, lo: 1e-06, hi: 1e+06, log: true},
+	FuncExp:      {maxError: [3]float64{0.001, 4e-06, 1e-08}, relative: true, lo: -20, hi: 20, log: false},
+	FuncExp2:     {maxError: [3]float64{0.001, 4e-06, 1e-08}, relative: true, lo: -30, hi: 30, log: false},
+	FuncSin:      {maxError: [3]float64{0.006, 5e-06, 1e-09}, relative: false, lo: -3.141592653589793, hi: 3.141592653589793, log: false},
+	FuncCos:      {maxError: [3]float64{0.03, 3e-05, 1e-08}, relative: false, lo: -1.5707963267948966, hi: 1.5707963267948966, log: false},
+	FuncTan:      {maxError: [3]float64{0.06, 0.015, 0.0003}, relative: false, lo: -0.7853981633974483, hi: 0.7853981633974483, log: false},
+	FuncCotan:    {maxError: [3]float64{0.07, 0.015, 0.0003}, relative: false, lo: 0.7853981633974483, hi: 2.356194490192345, log: false},
+	FuncSec:      {maxError: [3]float64{0.003, 1e-06, 5e-11}, relative: true, lo: -1, hi: 1, log: false},
+	FuncCsc:      {maxError: [3]float64{0.006, 5e-06, 1e-09}, relative: true, lo: 0.5, hi: 2.5, log: false},
+	FuncArctan:   {maxError: [3]float64{1.5e-05, 1.5e-05, 3e-09}, relative: false, lo: -0.26179938779914946, hi: 0.26179938779914946, log: false},
+	FuncArccotan: {maxError: [3]float64{1.5e-05, 1.5e-05, 3e-09}, relative: false, lo: -0.26179938779914946, hi: 0.26179938779914946, log: false},
+	FuncArccos:   {maxError: [3]float64{0.001, 7e-06, 1.5e-08}, relative: false, lo: -1, hi: 1, log: false},
+	FuncArcsec:   {maxError: [3]float64{0.001, 7e-06, 1.5e-08}, relative: false, lo: 1, hi: 1e+06, log: true},
+	FuncArccsc:   {maxError: [3]float64{0.001, 7e-06, 1.5e-08}, relative: false, lo: 1, hi: 1e+06, log: true},
+}
@@ -0,0 +1,8 @@
+// Package control provides helpers for discrete-time control loops whose
+// period jitters: filter coefficients recomputed from the measured dt on
+// every tick with the approx kernels, and the low-pass and filtered
+// derivative stages of a PID controller built on them.
+//
+// The types keep their state in plain fields, do not allocate and are not
+// safe for concurrent use.
+package control
@@ -0,0 +1,37 @@
+package control_test
+
+import (
+	"fmt"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/control"
+)
+
+func ExampleSmoothingAlpha() {
+	// A 20 ms filter in a loop that nominally runs at 1 kHz but jitters.
+	for _, dt := range []float64{0.0009, 0.001, 0.0013} {
+		fmt.Printf("dt %.4f s: alpha %.5f\n", dt, control.SmoothingAlpha(dt, 0.02))
+	}
+	// Output:
+	// dt 0.0009 s: alpha 0.04400
+	// dt 0.0010 s: alpha 0.04877
+	// dt 0.0013 s: alpha 0.06293
+}
+
+func ExampleDerivative() {
+	// The D term of a PID loop: position samples of a target moving at
+	// 2 m/s, taken at irregular intervals.
+	d := control.NewDerivative(0.005, approx.PrecisionBalanced)
+
+	t, pos := 0.0, 0.0
+	for i := range 100 {
+		dt := 0.001 + 0.0005*float64(i%3)
+		t += dt
+		pos = 2 * t
+		d.Update(pos, dt)
+	}
+
+	fmt.Printf("velocity %.3f m/s\n", d.Value())
+	// Output:
+	// velocity 2.000 m/s
+}
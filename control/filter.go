@@ -0,0 +1,136 @@
+package control
+
+import (
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// RCAlpha returns the smoothing factor dt/(dt+rc) of the backward-Euler
+// discretization of an RC low-pass filter with time constant rc, sampled
+// every dt. It needs no transcendental and is the classic choice for
+// y += alpha·(x - y); a non-positive rc yields 1 (no filtering) and a
+// non-positive dt yields 0 (hold).
+func RCAlpha(dt, rc float64) float64 {
+	switch {
+	case dt <= 0:
+		return 0
+	case rc <= 0:
+		return 1
+	}
+
+	return dt / (dt + rc)
+}
+
+// SmoothingAlpha returns the exact smoothing factor 1 - e^(-dt/tau) of a
+// first-order low-pass filter with time constant tau sampled every dt, using
+// the default precision. Unlike RCAlpha it stays exact for dt comparable to
+// tau. A non-positive tau yields 1 and a non-positive dt yields 0.
+func SmoothingAlpha(dt, tau float64) float64 {
+	return SmoothingAlphaPrec(dt, tau, approx.PrecisionAuto)
+}
+
+// SmoothingAlphaPrec is SmoothingAlpha using the requested precision.
+//
+// For dt/tau below 1/8 the factor comes from its Taylor series, with a
+// relative error below 1e-7, since 1 - FastExp would cancel.
+func SmoothingAlphaPrec(dt, tau float64, prec approx.Precision) float64 {
+	switch {
+	case dt <= 0:
+		return 0
+	case tau <= 0:
+		return 1
+	}
+
+	x := dt / tau
+	if x < 0.125 {
+		return x * (1 - x*(1.0/2-x*(1.0/6-x*(1.0/24-x*(1.0/120)))))
+	}
+
+	return 1 - approx.FastExpPrec(-x, prec)
+}
+
+// LowPass is a first-order low-pass filter y' = (x - y)/Tau discretized
+// exactly for each sample's dt, so a jittering loop period does not shift
+// its corner frequency.
+//
+// The zero value has Tau 0 and passes its input through; the first sample
+// after construction or Reset initializes the output to the input.
+type LowPass struct {
+	Tau  float64
+	Prec approx.Precision
+
+	y      float64
+	primed bool
+}
+
+// NewLowPass returns a LowPass with time constant tau seconds.
+func NewLowPass(tau float64, prec approx.Precision) *LowPass {
+	return &LowPass{Tau: tau, Prec: prec} //nolint:exhaustruct
+}
+
+// Update feeds the sample x taken dt seconds after the previous one and
+// returns the filtered value. A non-positive dt holds the output.
+func (f *LowPass) Update(x, dt float64) float64 {
+	if !f.primed {
+		f.y, f.primed = x, true
+
+		return x
+	}
+
+	f.y += SmoothingAlphaPrec(dt, f.Tau, f.Prec) * (x - f.y)
+
+	return f.y
+}
+
+// Value returns the current output.
+func (f *LowPass) Value() float64 { return f.y }
+
+// Reset forgets the filter state.
+func (f *LowPass) Reset() { f.y, f.primed = 0, false }
+
+// Derivative estimates the time derivative of a noisy signal, as the D term
+// of a PID controller: the backward difference (x - x_prev)/dt passed
+// through a first-order low-pass with time constant Tau. A Tau of a few
+// loop periods suppresses the noise amplification of the raw difference;
+// Tau 0 returns the raw difference.
+//
+// The first sample after construction or Reset only records x and returns
+// 0, so the estimator does not kick on start-up.
+type Derivative struct {
+	Tau  float64
+	Prec approx.Precision
+
+	prev, d float64
+	primed  bool
+}
+
+// NewDerivative returns a Derivative with filter time constant tau seconds.
+func NewDerivative(tau float64, prec approx.Precision) *Derivative {
+	return &Derivative{Tau: tau, Prec: prec} //nolint:exhaustruct
+}
+
+// Update feeds the sample x taken dt seconds after the previous one and
+// returns the filtered derivative. A non-positive dt leaves the estimate
+// unchanged.
+func (e *Derivative) Update(x, dt float64) float64 {
+	if !e.primed {
+		e.prev, e.primed = x, true
+
+		return e.d
+	}
+
+	if dt <= 0 {
+		return e.d
+	}
+
+	raw := (x - e.prev) / dt
+	e.prev = x
+	e.d += SmoothingAlphaPrec(dt, e.Tau, e.Prec) * (raw - e.d)
+
+	return e.d
+}
+
+// Value returns the current derivative estimate.
+func (e *Derivative) Value() float64 { return e.d }
+
+// Reset forgets the estimator state.
+func (e *Derivative) Reset() { e.prev, e.d, e.primed = 0, 0, false }
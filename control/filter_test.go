@@ -0,0 +1,117 @@
+package control
+
+import (
+	"math"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func TestSmoothingAlpha(t *testing.T) {
+	t.Parallel()
+
+	for _, x := range []float64{1e-6, 1e-3, 0.05, 0.124, 0.125, 0.5, 2, 10} {
+		want := -math.Expm1(-x)
+
+		if got := SmoothingAlphaPrec(x, 1, approx.PrecisionHigh); math.Abs(got-want) > 1e-7*want {
+			t.Fatalf("SmoothingAlpha(%g, 1) = %.12g, want %.12g", x, got, want)
+		}
+	}
+
+	if SmoothingAlpha(0, 1) != 0 || SmoothingAlpha(-1, 1) != 0 || SmoothingAlpha(0.01, 0) != 1 {
+		t.Fatal("SmoothingAlpha edge values")
+	}
+}
+
+func TestRCAlpha(t *testing.T) {
+	t.Parallel()
+
+	if got := RCAlpha(0.001, 0.009); math.Abs(got-0.1) > 1e-15 {
+		t.Fatalf("RCAlpha(0.001, 0.009) = %g", got)
+	}
+
+	// For dt << rc both discretizations agree to first order.
+	if a, b := RCAlpha(1e-4, 0.1), SmoothingAlpha(1e-4, 0.1); math.Abs(a-b) > 1e-6 {
+		t.Fatalf("RCAlpha %g, SmoothingAlpha %g", a, b)
+	}
+
+	if RCAlpha(0, 1) != 0 || RCAlpha(0.01, 0) != 1 {
+		t.Fatal("RCAlpha edge values")
+	}
+}
+
+func TestLowPassJitter(t *testing.T) {
+	t.Parallel()
+
+	// A step response sampled with alternating periods lands where the
+	// continuous filter does: 1 - e^(-t/τ).
+	f := NewLowPass(0.05, approx.PrecisionHigh)
+	f.Update(0, 0)
+
+	elapsed := 0.0
+	for i := range 100 {
+		dt := 0.001
+		if i%2 == 1 {
+			dt = 0.003
+		}
+
+		f.Update(1, dt)
+		elapsed += dt
+	}
+
+	if want := -math.Expm1(-elapsed / 0.05); math.Abs(f.Value()-want) > 1e-9 {
+		t.Fatalf("LowPass after %g s = %.12g, want %.12g", elapsed, f.Value(), want)
+	}
+
+	f.Reset()
+
+	if got := f.Update(7, 0.001); got != 7 {
+		t.Fatalf("first sample after Reset = %g, want 7", got)
+	}
+}
+
+func TestDerivative(t *testing.T) {
+	t.Parallel()
+
+	// A ramp of slope 3 settles to a derivative of 3; the first sample
+	// returns 0.
+	d := NewDerivative(0.01, approx.PrecisionBalanced)
+	if got := d.Update(5, 0.001); got != 0 {
+		t.Fatalf("first Update = %g, want 0", got)
+	}
+
+	x := 5.0
+	for range 200 {
+		x += 3 * 0.001
+		d.Update(x, 0.001)
+	}
+
+	if math.Abs(d.Value()-3) > 1e-6 {
+		t.Fatalf("derivative of a ramp = %.9g, want 3", d.Value())
+	}
+
+	if got := d.Update(x+1, 0); got != d.Value() {
+		t.Fatalf("dt 0 changed the estimate to %g", got)
+	}
+
+	raw := NewDerivative(0, approx.PrecisionFast)
+	raw.Update(1, 0.5)
+
+	if got := raw.Update(2, 0.5); got != 2 {
+		t.Fatalf("unfiltered derivative = %g, want 2", got)
+	}
+
+	d.Reset()
+
+	if d.Value() != 0 || d.Update(9, 0.001) != 0 {
+		t.Fatal("Reset did not clear the estimator")
+	}
+}
+
+func TestDerivativeAllocs(t *testing.T) {
+	d := NewDerivative(0.01, approx.PrecisionFast)
+
+	if n := testing.AllocsPerRun(100, func() { d.Update(1, 0.001) }); n != 0 {
+		t.Fatalf("Update allocates %v times", n)
+	}
+}
@@ -0,0 +1,198 @@
+package approx
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The edge-case corpus is a set of adversarial inputs embedded in the
+// package: signed zeros, subnormals, neighbours of one, reduction and
+// shortcut boundaries, the float64 neighbours of poles and domain edges,
+// and huge magnitudes. It lives in corpus/*.txt, one value per line, and
+// grows as fuzzing finds inputs worth keeping.
+//
+//go:embed corpus/*.txt
+var corpusFS embed.FS
+
+// corpusFile is the corpus file holding the inputs specific to fn.
+func corpusFile(fn Function) string {
+	switch fn {
+	case FuncSqrt, FuncInvSqrt:
+		return "sqrt"
+	case FuncLog, FuncLog2:
+		return "log"
+	case FuncExp, FuncExp2:
+		return "exp"
+	case FuncSin, FuncCos, FuncTan, FuncCotan, FuncSec, FuncCsc:
+		return "trig"
+	case FuncArctan, FuncArccotan, FuncArccos, FuncArcsec, FuncArccsc:
+		return "arc"
+	default:
+		return ""
+	}
+}
+
+// Corpus returns every input of the edge-case corpus, sorted, without
+// duplicates and with NaN last. The result is a fresh slice.
+func Corpus() []float64 {
+	all := slices.Clone(corpusSet(""))
+	for _, fn := range Functions() {
+		all = append(all, corpusSet(corpusFile(fn))...)
+	}
+
+	return sortCorpus(all)
+}
+
+// CorpusFor returns the inputs of the corpus aimed at fn: the shared set plus
+// fn's own, in the order of Corpus. An unknown fn returns the shared set.
+func CorpusFor(fn Function) []float64 {
+	xs := slices.Clone(corpusSet(""))
+	if name := corpusFile(fn); name != "" {
+		xs = append(xs, corpusSet(name)...)
+	}
+
+	return sortCorpus(xs)
+}
+
+// CorpusTB is the part of testing.TB that ReplayCorpus uses, so the package
+// does not import testing.
+type CorpusTB interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// ReplayCorpus calls check with every input of Corpus and reports each
+// non-nil error, or panic, through t together with the input. It lets
+// downstream wrappers validate their own compositions:
+//
+//	approx.ReplayCorpus(t, func(x float64) error {
+//		if y := mylib.SoftClip(x); y > 1 || y < -1 {
+//			return fmt.Errorf("SoftClip = %v", y)
+//		}
+//		return nil
+//	})
+func ReplayCorpus(t CorpusTB, check func(x float64) error) {
+	t.Helper()
+
+	for _, x := range Corpus() {
+		if err := replayOne(check, x); err != nil {
+			t.Errorf("corpus input %v (%x): %v", x, x, err)
+		}
+	}
+}
+
+// replayOne runs check on x, turning a panic into an error.
+func replayOne(check func(float64) error, x float64) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return check(x)
+}
+
+// corpusSet returns the parsed inputs of corpus/<name>.txt, or of
+// common.txt for the empty name. The files are parsed once.
+func corpusSet(name string) []float64 {
+	corpusOnce.Do(loadCorpus)
+
+	if name == "" {
+		name = "common"
+	}
+
+	return corpusSets[name]
+}
+
+//nolint:gochecknoglobals // parsed once from the embedded files
+var (
+	corpusOnce sync.Once
+	corpusSets map[string][]float64
+)
+
+func loadCorpus() {
+	entries, err := corpusFS.ReadDir("corpus")
+	if err != nil {
+		panic(err)
+	}
+
+	corpusSets = make(map[string][]float64, len(entries))
+
+	for _, e := range entries {
+		name := strings.TrimSuffix(e.Name(), ".txt")
+
+		xs, err := parseCorpus("corpus/" + e.Name())
+		if err != nil {
+			panic(err) // the embedded files are checked by the tests
+		}
+
+		corpusSets[name] = xs
+	}
+}
+
+// parseCorpus reads one value per line, ignoring blank lines and anything
+// after '#'.
+func parseCorpus(path string) ([]float64, error) {
+	f, err := corpusFS.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var xs []float64
+
+	sc := bufio.NewScanner(f)
+	for line := 1; sc.Scan(); line++ {
+		text, _, _ := strings.Cut(sc.Text(), "#")
+		if text = strings.TrimSpace(text); text == "" {
+			continue
+		}
+
+		x, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("approx: %s:%d: %w", path, line, err)
+		}
+
+		xs = append(xs, x)
+	}
+
+	return xs, sc.Err()
+}
+
+// sortCorpus sorts xs with NaN last and removes duplicates, telling the
+// zeros apart.
+func sortCorpus(xs []float64) []float64 {
+	slices.SortFunc(xs, func(a, b float64) int {
+		switch {
+		case a != a || b != b: //nolint:gocritic
+			return boolCmp(a != a, b != b) //nolint:gocritic
+		case a == b:
+			return boolCmp(!math.Signbit(a), !math.Signbit(b))
+		case a < b:
+			return -1
+		default:
+			return 1
+		}
+	})
+
+	return slices.CompactFunc(xs, func(a, b float64) bool {
+		return (a != a && b != b) || (a == b && math.Signbit(a) == math.Signbit(b)) //nolint:gocritic
+	})
+}
+
+func boolCmp(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
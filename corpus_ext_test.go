@@ -0,0 +1,68 @@
+package approx_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/internal/reference"
+)
+
+// TestCorpus_NaNAgreement replays the corpus against every function: a NaN
+// must come out exactly where the reference is NaN, at every tier.
+func TestCorpus_NaNAgreement(t *testing.T) {
+	t.Parallel()
+
+	if approx.DebugEnabled() {
+		t.Skip("the corpus deliberately leaves the documented ranges")
+	}
+
+	for _, fn := range approx.Functions() {
+		impl, _ := reference.ForFunction(fn)
+
+		for _, x := range approx.CorpusFor(fn) {
+			// The arctangent series is only valid for |x| <= π/12 and
+			// overflows to NaN for huge arguments.
+			if (fn == approx.FuncArctan || fn == approx.FuncArccotan) && math.Abs(x) > 1e30 {
+				continue
+			}
+
+			want := impl.Ref(x)
+
+			for _, prec := range []approx.Precision{approx.PrecisionFast, approx.PrecisionBalanced, approx.PrecisionHigh} {
+				if got := impl.Approx(x, prec); math.IsNaN(got) != math.IsNaN(want) {
+					t.Errorf("%v/%v(%v) = %v, reference %v", fn, prec, x, got, want)
+				}
+			}
+		}
+	}
+}
+
+// countingTB stands in for the *testing.T of a real test.
+type countingTB struct{ failures int }
+
+func (*countingTB) Helper()                 {}
+func (c *countingTB) Errorf(string, ...any) { c.failures++ }
+
+func ExampleReplayCorpus() {
+	var t countingTB
+
+	// A composition under test: |x| as sqrt(x·x), which the corpus exposes
+	// at the extremes where x·x overflows or underflows.
+	approx.ReplayCorpus(&t, func(x float64) error {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return nil
+		}
+
+		if got := approx.FastSqrtPrec(x*x, approx.PrecisionHigh); math.Abs(got-math.Abs(x)) > 1e-9*math.Abs(x) {
+			return fmt.Errorf("sqrt(x*x) = %v", got)
+		}
+
+		return nil
+	})
+
+	fmt.Println(t.failures, "corpus inputs break it")
+	// Output:
+	// 10 corpus inputs break it
+}
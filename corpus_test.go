@@ -0,0 +1,88 @@
+package approx
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestCorpusFiles(t *testing.T) {
+	t.Parallel()
+
+	entries, err := corpusFS.ReadDir("corpus")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, e := range entries {
+		if xs, err := parseCorpus("corpus/" + e.Name()); err != nil || len(xs) == 0 {
+			t.Fatalf("%s: %d values, %v", e.Name(), len(xs), err)
+		}
+	}
+
+	for _, fn := range Functions() {
+		if name := corpusFile(fn); len(corpusSet(name)) == 0 {
+			t.Fatalf("%v has no corpus file (%q)", fn, name)
+		}
+	}
+
+	all := Corpus()
+	if !math.IsNaN(all[len(all)-1]) || math.IsNaN(all[len(all)-2]) {
+		t.Fatal("Corpus must hold NaN exactly once, last")
+	}
+
+	zeros := 0
+
+	for i, x := range all[:len(all)-1] {
+		if x == 0 {
+			zeros++
+		}
+
+		if i > 0 && all[i-1] > x {
+			t.Fatalf("Corpus not sorted at %d: %v > %v", i, all[i-1], x)
+		}
+	}
+
+	if zeros != 2 {
+		t.Fatalf("Corpus holds %d zeros, want -0 and +0", zeros)
+	}
+
+	if n := len(CorpusFor(FuncExp)); n <= len(corpusSet("")) || n >= len(all) {
+		t.Fatalf("CorpusFor(FuncExp) has %d of %d inputs", n, len(all))
+	}
+}
+
+// recordingTB collects the errors ReplayCorpus reports.
+type recordingTB struct{ errs []string }
+
+func (*recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errs = append(r.errs, fmt.Sprintf(format, args...))
+}
+
+func TestReplayCorpus(t *testing.T) {
+	t.Parallel()
+
+	var rec recordingTB
+
+	calls := 0
+
+	ReplayCorpus(&rec, func(x float64) error {
+		calls++
+
+		switch {
+		case math.IsInf(x, 1):
+			panic("boom")
+		case x == 0 && math.Signbit(x):
+			return errors.New("negative zero")
+		default:
+			return nil
+		}
+	})
+
+	if calls != len(Corpus()) || len(rec.errs) != 2 {
+		t.Fatalf("%d calls, errors %q", calls, rec.errs)
+	}
+}
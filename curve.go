@@ -0,0 +1,89 @@
+package approx
+
+import "math"
+
+// Point is one sample of a Function: the argument, the approximation, the
+// math package reference and the relative error between them.
+type Point struct {
+	X      float64
+	Approx float64
+	Ref    float64
+
+	// RelErr is |Approx-Ref|/|Ref|, or the absolute error where Ref is 0.
+	// It is NaN where either value is.
+	RelErr float64
+}
+
+// Sample evaluates fn at n evenly spaced points from from to to, both
+// included, and annotates each with its reference value and relative error.
+// It is meant for plots and accuracy explorers; the points can be fed to a
+// plotting library as is. n == 1 samples from alone and n <= 0 or an unknown
+// fn returns nil.
+//
+// Sample evaluates the float64 entry point at the given tier. In debug builds
+// arguments outside the function's valid range trigger the usual hook.
+func Sample(fn Function, prec Precision, from, to float64, n int) []Point {
+	if n <= 0 || !fn.valid() {
+		return nil
+	}
+
+	eval, ref := sampleKernels[fn], libm[fn]
+	pts := make([]Point, n)
+
+	step := 0.0
+	if n > 1 {
+		step = (to - from) / float64(n-1)
+	}
+
+	for i := range pts {
+		x := from + float64(i)*step
+		if i == n-1 && n > 1 {
+			x = to
+		}
+
+		got, want := eval(x, prec), ref(x)
+		pts[i] = Point{X: x, Approx: got, Ref: want, RelErr: relativeError(got, want)}
+	}
+
+	return pts
+}
+
+// relativeError is |got-ref|/|ref|, falling back to the absolute error when
+// ref is 0. Matching infinities have no error.
+func relativeError(got, ref float64) float64 {
+	if got == ref {
+		return 0
+	}
+
+	d := math.Abs(got - ref)
+	if ref == 0 {
+		return d
+	}
+
+	return d / math.Abs(ref)
+}
+
+// sampleKernel is the float64 entry point of a Function; its reference is
+// libm[fn].
+type sampleKernel func(float64, Precision) float64
+
+//nolint:gochecknoglobals // read-only table indexed by Function
+var sampleKernels = [...]sampleKernel{
+	FuncSqrt:     FastSqrtPrec[float64],
+	FuncInvSqrt:  FastInvSqrtPrec[float64],
+	FuncLog:      FastLogPrec[float64],
+	FuncLog2:     FastLog2Prec[float64],
+	FuncExp:      FastExpPrec[float64],
+	FuncExp2:     FastExp2Prec[float64],
+	FuncSin:      FastSinPrec[float64],
+	FuncCos:      FastCosPrec[float64],
+	FuncTan:      FastTanPrec[float64],
+	FuncCotan:    FastCotanPrec[float64],
+	FuncSec:      FastSecPrec[float64],
+	FuncCsc:      FastCscPrec[float64],
+	FuncArctan:   FastArctanPrec[float64],
+	FuncArccotan: FastArccotanPrec[float64],
+	FuncArccos:   FastArccosPrec[float64],
+	FuncArcsec:   FastArcsecPrec[float64],
+	FuncArccsc:   FastArccscPrec[float64],
+}
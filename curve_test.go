@@ -0,0 +1,71 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSample(t *testing.T) {
+	t.Parallel()
+
+	pts := Sample(FuncSin, PrecisionHigh, -1, 1, 5)
+	if len(pts) != 5 {
+		t.Fatalf("len = %d, want 5", len(pts))
+	}
+
+	for i, want := range []float64{-1, -0.5, 0, 0.5, 1} {
+		p := pts[i]
+		if p.X != want {
+			t.Fatalf("pts[%d].X = %v, want %v", i, p.X, want)
+		}
+
+		if p.Ref != math.Sin(want) || p.Approx != FastSinPrec(want, PrecisionHigh) {
+			t.Fatalf("pts[%d] = %+v", i, p)
+		}
+
+		if p.RelErr > 1e-6 || p.RelErr < 0 {
+			t.Fatalf("pts[%d].RelErr = %g", i, p.RelErr)
+		}
+	}
+
+	// The end point is hit exactly even when the step rounds.
+	if pts := Sample(FuncExp, PrecisionFast, 0, 0.3, 7); pts[6].X != 0.3 {
+		t.Fatalf("last X = %.17g, want 0.3", pts[6].X)
+	}
+
+	// Fast is coarser than High on the same grid.
+	worst := func(prec Precision) float64 {
+		m := 0.0
+		for _, p := range Sample(FuncLog, prec, 0.1, 10, 200) {
+			m = math.Max(m, p.RelErr)
+		}
+
+		return m
+	}
+
+	if fast, high := worst(PrecisionFast), worst(PrecisionHigh); !(fast > high) {
+		t.Fatalf("worst Fast error %g not above High %g", fast, high)
+	}
+}
+
+func TestSample_Degenerate(t *testing.T) {
+	t.Parallel()
+
+	if pts := Sample(FuncSqrt, PrecisionAuto, 4, 9, 1); len(pts) != 1 || pts[0].X != 4 {
+		t.Fatalf("n=1 gave %+v", pts)
+	}
+
+	if Sample(FuncSqrt, PrecisionAuto, 0, 1, 0) != nil || Sample(Function(0), PrecisionAuto, 0, 1, 3) != nil {
+		t.Fatal("n <= 0 and unknown functions must return nil")
+	}
+
+	for _, fn := range Functions() {
+		if sampleKernels[fn] == nil || libm[fn] == nil {
+			t.Fatalf("%v has no sample kernel", fn)
+		}
+	}
+
+	if relativeError(0.5, 0) != 0.5 || relativeError(math.Inf(1), math.Inf(1)) != 0 {
+		t.Fatal("relativeError edge cases")
+	}
+}
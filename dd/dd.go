@@ -0,0 +1,133 @@
+// Package dd provides double-double arithmetic: a value is the unevaluated
+// sum Hi + Lo of two float64 with |Lo| at most half an ulp of Hi, about 106
+// bits of significand. The approx kernels use it where a float64 rounding
+// would show in the result, for example in the High tier of FastPowerPrec,
+// and it serves callers building their own compensated reductions or sums.
+//
+// The error-free transformations TwoSum, FastTwoSum and TwoProd return a
+// rounded result together with its exact rounding error. The Float
+// operations below are accurate to a few units of 2^-106 relative, for finite
+// operands whose results neither overflow nor fall into the subnormal range.
+//
+// Everything is a plain value; nothing allocates.
+package dd
+
+import "math"
+
+// Float is the double-double value Hi + Lo. The zero value is 0. Values built
+// with From and the operations of this package are normalized: Hi is Hi + Lo
+// rounded to float64.
+type Float struct {
+	Hi, Lo float64
+}
+
+// From returns x as a Float.
+func From(x float64) Float { return Float{Hi: x, Lo: 0} }
+
+// Float64 returns f rounded to float64.
+func (f Float) Float64() float64 { return f.Hi + f.Lo }
+
+// TwoSum returns s = fl(a+b) and e with a + b = s + e exactly, for any
+// finite a and b (Knuth).
+func TwoSum(a, b float64) (s, e float64) {
+	s = a + b
+	bb := s - a
+	e = (a - (s - bb)) + (b - bb)
+
+	return s, e
+}
+
+// FastTwoSum is TwoSum for |a| >= |b| (or a = 0), in three operations
+// instead of six (Dekker).
+func FastTwoSum(a, b float64) (s, e float64) {
+	s = a + b
+	e = b - (s - a)
+
+	return s, e
+}
+
+// TwoProd returns p = fl(a·b) and e with a·b = p + e exactly, unless the
+// product underflows, using a fused multiply-add. The conversion keeps the
+// compiler from fusing the product into a later addition, which it may do on
+// FMA targets such as arm64 or GOAMD64=v3.
+func TwoProd(a, b float64) (p, e float64) {
+	p = float64(a * b)
+	e = math.FMA(a, b, -p)
+
+	return p, e
+}
+
+// Neg returns -f.
+func (f Float) Neg() Float { return Float{Hi: -f.Hi, Lo: -f.Lo} }
+
+// Add returns f + g. Both parts are summed error-free, so the result is
+// accurate even when f and g nearly cancel.
+func (f Float) Add(g Float) Float {
+	s, e := TwoSum(f.Hi, g.Hi)
+	t, u := TwoSum(f.Lo, g.Lo)
+
+	e += t
+	s, e = FastTwoSum(s, e)
+	e += u
+
+	return norm(s, e)
+}
+
+// Sub returns f - g.
+func (f Float) Sub(g Float) Float { return f.Add(g.Neg()) }
+
+// AddFloat returns f + x.
+func (f Float) AddFloat(x float64) Float {
+	s, e := TwoSum(f.Hi, x)
+
+	return norm(s, e+f.Lo)
+}
+
+// Mul returns f·g.
+func (f Float) Mul(g Float) Float {
+	p, e := TwoProd(f.Hi, g.Hi)
+
+	return norm(p, e+(float64(f.Hi*g.Lo)+float64(f.Lo*g.Hi)))
+}
+
+// MulFloat returns f·x.
+func (f Float) MulFloat(x float64) Float {
+	p, e := TwoProd(f.Hi, x)
+
+	return norm(p, e+float64(f.Lo*x))
+}
+
+// Div returns f/g: a float64 quotient corrected by one step on the exact
+// remainder.
+func (f Float) Div(g Float) Float {
+	q := f.Hi / g.Hi
+	r := f.Sub(g.MulFloat(q))
+
+	return norm(q, r.Hi/g.Hi)
+}
+
+// FMA returns f·g + h with a single renormalization: the product of the high
+// parts and its sum with h.Hi are kept exactly, the cross terms and low parts
+// are added in float64.
+func FMA(f, g, h Float) Float {
+	p, e := TwoProd(f.Hi, g.Hi)
+	s, t := TwoSum(p, h.Hi)
+
+	return norm(s, t+e+(float64(f.Hi*g.Lo)+float64(f.Lo*g.Hi))+h.Lo)
+}
+
+// norm returns hi + lo as a normalized Float; |lo| must not exceed a few
+// ulps of hi.
+func norm(hi, lo float64) Float {
+	s, e := FastTwoSum(hi, lo)
+
+	return Float{Hi: s, Lo: e}
+}
+
+// Constants to double-double precision.
+//
+//nolint:gochecknoglobals // read-only constants that cannot be const
+var (
+	Ln2 = Float{Hi: 6.93147180559945286e-01, Lo: 2.31904681384629956e-17}
+	Pi  = Float{Hi: 3.141592653589793116e+00, Lo: 1.224646799147353207e-16}
+)
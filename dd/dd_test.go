@@ -0,0 +1,245 @@
+package dd_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/dd"
+)
+
+func exact(xs ...float64) *big.Float {
+	sum := new(big.Float).SetPrec(2200)
+	for _, x := range xs {
+		sum.Add(sum, new(big.Float).SetFloat64(x))
+	}
+
+	return sum
+}
+
+func value(f dd.Float) *big.Float { return exact(f.Hi, f.Lo) }
+
+// relErr returns |got - want| / |want| of the double-double got.
+func relErr(got dd.Float, want *big.Float) float64 {
+	d := new(big.Float).SetPrec(2200).Sub(value(got), want)
+	r, _ := d.Quo(d, want).Float64()
+
+	return math.Abs(r)
+}
+
+// randFloat returns a random float64 with a random sign and an exponent in
+// [-60, 60].
+func randFloat(r *rand.Rand) float64 {
+	x := math.Ldexp(r.Float64()+0.5, r.IntN(121)-60)
+	if r.IntN(2) == 0 {
+		return -x
+	}
+
+	return x
+}
+
+func randDD(r *rand.Rand) dd.Float {
+	hi := randFloat(r)
+	lo := hi * 0x1p-54 * (r.Float64() - 0.5)
+	s, e := dd.FastTwoSum(hi, lo)
+
+	return dd.Float{Hi: s, Lo: e}
+}
+
+func TestTwoSumExact(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewPCG(1, 2))
+
+	cases := [][2]float64{{1, 0x1p-60}, {0x1p-60, 1}, {1e300, -1e-300}, {0.1, 0.2}, {-0.1, 0.1}}
+	for range 1000 {
+		cases = append(cases, [2]float64{randFloat(r), randFloat(r)})
+	}
+
+	for _, c := range cases {
+		a, b := c[0], c[1]
+
+		s, e := dd.TwoSum(a, b)
+		if s != a+b || exact(s, e).Cmp(exact(a, b)) != 0 {
+			t.Fatalf("TwoSum(%g, %g) = %g, %g", a, b, s, e)
+		}
+
+		if math.Abs(a) < math.Abs(b) {
+			a, b = b, a
+		}
+
+		if fs, fe := dd.FastTwoSum(a, b); fs != s || fe != e {
+			t.Fatalf("FastTwoSum(%g, %g) = %g, %g, want %g, %g", a, b, fs, fe, s, e)
+		}
+	}
+}
+
+func TestTwoProdExact(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewPCG(3, 4))
+
+	cases := [][2]float64{{0.1, 0.1}, {1 + 0x1p-52, 1 - 0x1p-52}, {3, 1.0 / 3}, {-1e150, 1e150}}
+	for range 1000 {
+		cases = append(cases, [2]float64{randFloat(r), randFloat(r)})
+	}
+
+	for _, c := range cases {
+		a, b := c[0], c[1]
+
+		p, e := dd.TwoProd(a, b)
+
+		want := new(big.Float).SetPrec(2200).Mul(exact(a), exact(b))
+		if p != a*b || exact(p, e).Cmp(want) != 0 {
+			t.Fatalf("TwoProd(%g, %g) = %g, %g", a, b, p, e)
+		}
+	}
+}
+
+func TestOperations(t *testing.T) {
+	t.Parallel()
+
+	const tol = 0x1p-100
+
+	r := rand.New(rand.NewPCG(5, 6))
+
+	for range 2000 {
+		f, g, h := randDD(r), randDD(r), randDD(r)
+		x := randFloat(r)
+		bf, bg, bh := value(f), value(g), value(h)
+
+		checks := []struct {
+			name string
+			got  dd.Float
+			want *big.Float
+		}{
+			{"Add", f.Add(g), new(big.Float).SetPrec(2200).Add(bf, bg)},
+			{"Sub", f.Sub(g), new(big.Float).SetPrec(2200).Sub(bf, bg)},
+			{"AddFloat", f.AddFloat(x), new(big.Float).SetPrec(2200).Add(bf, exact(x))},
+			{"Mul", f.Mul(g), new(big.Float).SetPrec(2200).Mul(bf, bg)},
+			{"MulFloat", f.MulFloat(x), new(big.Float).SetPrec(2200).Mul(bf, exact(x))},
+			{"Div", f.Div(g), new(big.Float).SetPrec(2200).Quo(bf, bg)},
+			{"FMA", dd.FMA(f, g, h), new(big.Float).SetPrec(2200).Add(new(big.Float).SetPrec(2200).Mul(bf, bg), bh)},
+		}
+
+		for _, c := range checks {
+			if c.want.Sign() == 0 {
+				continue
+			}
+
+			// Add and FMA may cancel; bound them by the operands instead.
+			scale := 1.0
+			if c.name == "Add" || c.name == "Sub" || c.name == "FMA" {
+				w, _ := c.want.Float64()
+				scale = math.Max(1, (math.Abs(f.Hi)+math.Abs(g.Hi)+math.Abs(f.Hi*g.Hi)+math.Abs(h.Hi))/math.Abs(w))
+			}
+
+			if e := relErr(c.got, c.want); e > tol*scale {
+				t.Fatalf("%s(%v, %v, %v, %v): relative error %g", c.name, f, g, h, x, e)
+			}
+
+			if c.got.Hi != c.got.Float64() {
+				t.Fatalf("%s(%v, %v): not normalized: %v", c.name, f, g, c.got)
+			}
+		}
+	}
+}
+
+// TestAddCancellation checks the case a plain float64 sum loses entirely.
+func TestAddCancellation(t *testing.T) {
+	t.Parallel()
+
+	a := dd.Float{Hi: 1, Lo: 0x1p-60}
+	b := dd.Float{Hi: -1, Lo: 0x1p-70}
+
+	if got := a.Add(b); got.Hi != 0x1p-60+0x1p-70 || got.Lo != 0 {
+		t.Fatalf("Add = %v", got)
+	}
+}
+
+func TestConstants(t *testing.T) {
+	t.Parallel()
+
+	// ln 2 and π to 40 digits.
+	ln2, _ := new(big.Float).SetPrec(2200).SetString("0.6931471805599453094172321214581765680755")
+	pi, _ := new(big.Float).SetPrec(2200).SetString("3.141592653589793238462643383279502884197")
+
+	for _, c := range []struct {
+		name string
+		got  dd.Float
+		want *big.Float
+	}{{"Ln2", dd.Ln2, ln2}, {"Pi", dd.Pi, pi}} {
+		if e := relErr(c.got, c.want); e > 0x1p-105 {
+			t.Errorf("%s: relative error %g", c.name, e)
+		}
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	t.Parallel()
+
+	var z dd.Float
+	if got := z.Add(dd.From(2)).Mul(dd.From(3)); got != dd.From(6) {
+		t.Fatalf("(0 + 2)·3 = %v", got)
+	}
+}
+
+func BenchmarkTwoSum(b *testing.B) {
+	x, y := 1.0, 0x1p-60
+
+	for b.Loop() {
+		x, y = dd.TwoSum(x, y)
+	}
+
+	_ = x + y
+}
+
+func BenchmarkTwoProd(b *testing.B) {
+	x, y := 1+0x1p-30, 1-0x1p-30
+
+	for b.Loop() {
+		x, y = dd.TwoProd(x, 1+0x1p-30)
+		x += y
+	}
+}
+
+func BenchmarkAdd(b *testing.B) {
+	f, g := dd.Ln2, dd.Pi
+
+	for b.Loop() {
+		f = f.Add(g)
+	}
+
+	_ = f
+}
+
+func BenchmarkMul(b *testing.B) {
+	f, g := dd.Ln2, dd.Float{Hi: 1 + 0x1p-40, Lo: 0}
+
+	for b.Loop() {
+		f = f.Mul(g)
+	}
+
+	_ = f
+}
+
+func BenchmarkDiv(b *testing.B) {
+	f, g := dd.Pi, dd.Float{Hi: 1 + 0x1p-40, Lo: 0}
+
+	for b.Loop() {
+		f = f.Div(g)
+	}
+
+	_ = f
+}
+
+func BenchmarkFMA(b *testing.B) {
+	f, g, h := dd.Ln2, dd.Float{Hi: 1 - 0x1p-40, Lo: 0}, dd.Pi
+
+	for b.Loop() {
+		f = dd.FMA(f, g, h)
+	}
+
+	_ = f
+}
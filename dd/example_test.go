@@ -0,0 +1,29 @@
+package dd_test
+
+import (
+	"fmt"
+
+	"github.com/meko-christian/algo-approx/dd"
+)
+
+func ExampleTwoSum() {
+	s, e := dd.TwoSum(1, 0x1p-60)
+	fmt.Println(s, e)
+	// Output:
+	// 1 8.673617379884035e-19
+}
+
+func ExampleFloat_Add() {
+	// Summing 0.1 ten times: float64 drifts, the double-double does not.
+	var f float64
+
+	var g dd.Float
+	for range 10 {
+		f += 0.1
+		g = g.AddFloat(0.1)
+	}
+
+	fmt.Println(f, g.Float64())
+	// Output:
+	// 0.9999999999999999 1
+}
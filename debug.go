@@ -0,0 +1,148 @@
+package approx
+
+import (
+	"fmt"
+	"math"
+	"sync/atomic"
+)
+
+// Violation describes an input that falls outside the documented valid range
+// of a public function.
+//
+// Violations are only detected in builds using the approxdebug build tag;
+// release builds never construct them.
+type Violation struct {
+	Function  string
+	Input     float64
+	Precision Precision
+	Reason    string
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf("approx: %s(%g) at precision %s: %s", v.Function, v.Input, v.Precision, v.Reason)
+}
+
+// DebugHook receives input-range violations detected under the approxdebug
+// build tag.
+type DebugHook func(v *Violation)
+
+var debugHook atomic.Pointer[DebugHook] //nolint:gochecknoglobals
+
+// SetDebugHook installs h as the receiver for input-range violations and
+// returns the previously installed hook.
+//
+// With a nil hook (the default) violations panic. The hook is only invoked in
+// builds using the approxdebug build tag; in release builds it is stored but
+// never called.
+func SetDebugHook(h DebugHook) DebugHook {
+	var prev *DebugHook
+	if h == nil {
+		prev = debugHook.Swap(nil)
+	} else {
+		prev = debugHook.Swap(&h)
+	}
+
+	if prev == nil {
+		return nil
+	}
+
+	return *prev
+}
+
+// DebugEnabled reports whether the package was built with the approxdebug tag.
+func DebugEnabled() bool { return debugEnabled }
+
+func reportViolation(fn string, x float64, prec Precision, reason string) {
+	v := &Violation{Function: fn, Input: x, Precision: prec, Reason: reason}
+
+	metrics().Count("approx_debug_violations")
+
+	if h := debugHook.Load(); h != nil {
+		(*h)(v)
+		return
+	}
+
+	panic(v)
+}
+
+// The check helpers below compile to nothing unless debugEnabled is true.
+
+func checkNonNegative[T Float](fn string, x T, prec Precision) {
+	if debugEnabled && x < 0 {
+		reportViolation(fn, float64(x), prec, "negative input")
+	}
+}
+
+func checkPositive[T Float](fn string, x T, prec Precision) {
+	if debugEnabled && x <= 0 {
+		reportViolation(fn, float64(x), prec, "non-positive input")
+	}
+}
+
+// checkRoot checks the nth root of x: n must be non-zero, and a negative x
+// needs an odd integer n.
+func checkRoot[T Float](fn string, x T, n float64, prec Precision) {
+	if !debugEnabled {
+		return
+	}
+
+	switch {
+	case n == 0:
+		reportViolation(fn, float64(x), prec, "zeroth root")
+	case x < 0 && math.Abs(math.Mod(n, 2)) != 1:
+		reportViolation(fn, float64(x), prec, "negative input without an odd integer root")
+	}
+}
+
+func checkExpRange[T Float](fn string, x T, prec Precision) {
+	if debugEnabled && (float64(x) > maxLogFloat64 || float64(x) < minLogFloat64) {
+		reportViolation(fn, float64(x), prec, "result overflows or underflows float64")
+	}
+}
+
+func checkFinite[T Float](fn string, x T, prec Precision) {
+	if debugEnabled && (math.IsNaN(float64(x)) || math.IsInf(float64(x), 0)) {
+		reportViolation(fn, float64(x), prec, "non-finite input")
+	}
+}
+
+// checkArctanRange flags x outside the contract domain of the tier prec
+// runs. The Taylor kernels behind FastArctan/FastArccotan meet their
+// contracted error only for |x| <= π/12, the domain every tier documents,
+// and diverge beyond |x| = 1.
+func checkArctanRange[T Float](fn string, x T, prec Precision) {
+	if !debugEnabled {
+		return
+	}
+
+	tier := resolveTier(FuncArctan, prec)
+	if b := &contractBounds[FuncArctan]; float64(x) < b.lo || float64(x) > b.hi {
+		reportViolation(fn, float64(x), prec,
+			fmt.Sprintf("|x| > π/12, outside the domain of the %v tier's %g error bound", tier, b.maxError[tier-1]))
+	}
+}
+
+func checkSignedUnitInterval[T Float](fn string, x T, prec Precision) {
+	if debugEnabled && (x < -1 || x > 1) {
+		reportViolation(fn, float64(x), prec, "input outside [-1, 1]")
+	}
+}
+
+// checkUnitInterval checks a probability argument.
+func checkUnitInterval[T Float](fn string, x T, prec Precision) {
+	if debugEnabled && (x < 0 || x > 1) {
+		reportViolation(fn, float64(x), prec, "input outside [0, 1]")
+	}
+}
+
+func checkOutsideUnitInterval[T Float](fn string, x T, prec Precision) {
+	if debugEnabled && x > -1 && x < 1 {
+		reportViolation(fn, float64(x), prec, "input inside (-1, 1)")
+	}
+}
+
+const (
+	// Natural-log bounds for float64 exp overflow/underflow.
+	maxLogFloat64 = 709.782712893384
+	minLogFloat64 = -745.133219101941
+)
@@ -0,0 +1,15 @@
+//go:build approxdebug
+
+package approx
+
+import (
+	"os"
+	"testing"
+)
+
+// TestMain installs a tolerant hook so that the edge-case tests, which pass
+// out-of-range inputs on purpose, do not panic under the approxdebug tag.
+func TestMain(m *testing.M) {
+	SetDebugHook(func(*Violation) {})
+	os.Exit(m.Run())
+}
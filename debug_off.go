@@ -0,0 +1,7 @@
+//go:build !approxdebug
+
+package approx
+
+// debugEnabled is false in release builds so that the range checks are
+// eliminated by the compiler.
+const debugEnabled = false
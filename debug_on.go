@@ -0,0 +1,6 @@
+//go:build approxdebug
+
+package approx
+
+// debugEnabled turns on input-range validation in every public function.
+const debugEnabled = true
@@ -0,0 +1,98 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+//nolint:paralleltest // mutates the package-wide debug hook
+func TestDebugHook_ReportsViolations(t *testing.T) {
+	var got []*Violation
+
+	prev := SetDebugHook(func(v *Violation) { got = append(got, v) })
+	defer SetDebugHook(prev)
+
+	_ = FastArctanPrec(0.9, PrecisionHigh)
+	_ = FastSqrt(-1.0)
+	_ = FastArccos(float32(1.5))
+	_ = FastLog(0.0)
+	_ = FastSin(math.NaN())
+	_ = FastArcsec(0.5)
+	_ = FastRootF(-8.0, 2.5)
+	_ = FastInvRoot(-8.0, 2)
+	_ = FastBinomialCDF(1, 3, -0.5)
+	_ = FastPhiInv(-0.5)
+
+	// Valid inputs must never be reported.
+	_ = FastArctan(0.1)
+	_ = FastSqrt(2.0)
+	_ = FastArccos(1.0)
+	_ = FastArccsc(-1.0)
+	_ = FastRootF(-8.0, -3)
+	_ = FastInvRoot(-8.0, 3)
+	_ = FastBetaInc(2.0, 3.0, 1.5) // clamped, documented as 1
+
+	if !DebugEnabled() {
+		if len(got) != 0 {
+			t.Fatalf("release build reported %d violations", len(got))
+		}
+
+		return
+	}
+
+	wantFns := []string{"FastArctan", "FastSqrt", "FastArccos", "FastLog", "FastSin", "FastArcsec", "FastRootF", "FastInvRoot", "FastBinomialCDF", "FastPhiInv"}
+	if len(got) != len(wantFns) {
+		t.Fatalf("got %d violations, want %d: %v", len(got), len(wantFns), got)
+	}
+
+	for i, fn := range wantFns {
+		if got[i].Function != fn {
+			t.Errorf("violation %d: function %q, want %q", i, got[i].Function, fn)
+		}
+	}
+
+	if got[0].Precision != PrecisionHigh || got[0].Input != 0.9 {
+		t.Errorf("unexpected violation details: %+v", got[0])
+	}
+}
+
+//nolint:paralleltest // mutates the package-wide debug hook
+func TestDebugHook_NilHookPanics(t *testing.T) {
+	prev := SetDebugHook(nil)
+	defer SetDebugHook(prev)
+
+	defer func() {
+		r := recover()
+		if DebugEnabled() != (r != nil) {
+			t.Fatalf("DebugEnabled=%v but recover()=%v", DebugEnabled(), r)
+		}
+
+		if r != nil {
+			if _, ok := r.(*Violation); !ok {
+				t.Fatalf("panic value %T, want *Violation", r)
+			}
+		}
+	}()
+
+	_ = FastArccos(2.0)
+}
+
+//nolint:paralleltest // mutates the package-wide debug hook
+func TestSetDebugHook_ReturnsPrevious(t *testing.T) {
+	calls := 0
+	first := DebugHook(func(*Violation) { calls++ })
+
+	orig := SetDebugHook(first)
+	defer SetDebugHook(orig)
+
+	prev := SetDebugHook(nil)
+	if prev == nil {
+		t.Fatalf("expected previous hook to be returned")
+	}
+
+	prev(nil)
+
+	if calls != 1 {
+		t.Fatalf("returned hook is not the installed one")
+	}
+}
@@ -0,0 +1,83 @@
+package approx
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	iapprox "github.com/meko-christian/algo-approx/internal/approx"
+)
+
+// Degradation describes a call that returned a result less accurate than
+// its precision tier promises, for a valid input. Unlike a Violation it is
+// detected in every build, so production code can log or count such events.
+type Degradation struct {
+	Function  string
+	Input     float64
+	Precision Precision
+	Reason    string
+}
+
+func (d Degradation) String() string {
+	return fmt.Sprintf("approx: %s(%g) at precision %s: %s", d.Function, d.Input, d.Precision, d.Reason)
+}
+
+// DegradationHook receives degraded-accuracy events. It is called on the
+// goroutine that made the call and must be safe for concurrent use.
+type DegradationHook func(d Degradation)
+
+var degradationHook atomic.Pointer[DegradationHook] //nolint:gochecknoglobals
+
+// SetDegradationHook installs h as the receiver for degraded-accuracy events
+// and returns the previously installed hook. A nil hook (the default)
+// disables reporting.
+//
+// The kernels report:
+//   - Sin, Cos, SinCos, Sec, Csc, Tan and Cotan for |x| beyond the limit at
+//     which range reduction error exceeds the tier: 2^40 (Fast), 2^26
+//     (Balanced) and 2^12 (High);
+//   - Log and Log2 for subnormal inputs;
+//   - Arctan and Arccotan for |x| > π/12, outside their series' range.
+//
+// The regime test is a comparison in the common path; the hook is only
+// loaded once a call is found to be degraded.
+func SetDegradationHook(h DegradationHook) DegradationHook {
+	var prev *DegradationHook
+	if h == nil {
+		prev = degradationHook.Swap(nil)
+	} else {
+		prev = degradationHook.Swap(&h)
+	}
+
+	if prev == nil {
+		return nil
+	}
+
+	return *prev
+}
+
+func reportDegraded(fn string, x float64, prec Precision, reason string) {
+	if h := degradationHook.Load(); h != nil {
+		(*h)(Degradation{Function: fn, Input: x, Precision: prec, Reason: reason})
+	}
+}
+
+// The note helpers below run in every build and only call into the hook
+// once the input is known to be in a degraded regime.
+
+func noteReduction[T Float](fn string, x T, prec Precision) {
+	if iapprox.ReductionDegraded(float64(x), iapprox.Precision(normalizePrecision(prec))) {
+		reportDegraded(fn, float64(x), prec, "argument too large for accurate range reduction")
+	}
+}
+
+func noteSubnormalLog[T Float](fn string, x T, prec Precision) {
+	if iapprox.LogDegraded(float64(x)) {
+		reportDegraded(fn, float64(x), prec, "subnormal input")
+	}
+}
+
+func noteArctanRange[T Float](fn string, x T, prec Precision) {
+	if iapprox.ArctanDegraded(float64(x)) {
+		reportDegraded(fn, float64(x), prec, "|x| > π/12, series is inaccurate")
+	}
+}
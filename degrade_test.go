@@ -0,0 +1,76 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+//nolint:paralleltest // installs the process-wide degradation hook
+func TestDegradationHook(t *testing.T) {
+	var got []Degradation
+
+	prev := SetDegradationHook(func(d Degradation) { got = append(got, d) })
+	defer SetDegradationHook(prev)
+
+	// In-range calls stay silent.
+	_ = FastSinPrec(1e3, PrecisionHigh)
+	_ = FastCosPrec(1e7, PrecisionBalanced)
+	_ = FastLog(1e-300)
+	_ = FastArctan(0.2)
+
+	if len(got) != 0 {
+		t.Fatalf("unexpected degradations: %v", got)
+	}
+
+	_ = FastSinPrec(1e5, PrecisionHigh)
+	_, _ = FastSinCosPrec(-1e9, PrecisionBalanced)
+	_ = FastTanPrec(float32(1e13), PrecisionFast)
+	_ = FastLog(5e-324)
+	_ = FastArccotan(0.9)
+
+	want := []struct {
+		fn   string
+		prec Precision
+	}{
+		{"FastSin", PrecisionHigh},
+		{"FastSinCos", PrecisionBalanced},
+		{"FastTan", PrecisionFast},
+		{"FastLog", PrecisionAuto},
+		{"FastArccotan", PrecisionAuto},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d degradations, want %d: %v", len(got), len(want), got)
+	}
+
+	for i, w := range want {
+		if got[i].Function != w.fn || got[i].Precision != w.prec || got[i].Reason == "" {
+			t.Fatalf("degradation %d = %v, want %s at %v", i, got[i], w.fn, w.prec)
+		}
+	}
+
+	// Non-finite input is a domain error, not a degradation.
+	got = got[:0]
+	_ = FastSin(math.Inf(1))
+
+	if len(got) != 0 {
+		t.Fatalf("infinite input reported as degraded: %v", got)
+	}
+}
+
+//nolint:paralleltest // installs the process-wide degradation hook
+func TestDegradationHook_ReportsRealLoss(t *testing.T) {
+	// Just past the High limit the reduction error is already visible
+	// against the ~1e-12 truncation error of the tier.
+	prev := SetDegradationHook(nil)
+	defer SetDegradationHook(prev)
+
+	x := 0x1p30 + 0.5
+	if err := math.Abs(FastSinPrec(x, PrecisionHigh) - math.Sin(x)); err < 1e-10 {
+		t.Fatalf("sin(%g) error %g; the High limit is too conservative", x, err)
+	}
+
+	if d := (Degradation{Function: "FastSin", Input: 2, Precision: PrecisionHigh, Reason: "r"}).String(); d != "approx: FastSin(2) at precision high: r" {
+		t.Fatalf("String() = %q", d)
+	}
+}
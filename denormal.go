@@ -0,0 +1,73 @@
+package approx
+
+import "math"
+
+// Subnormal (denormal) numbers make arithmetic on many CPUs an order of
+// magnitude slower, which breaks the deadline of an audio callback when a
+// decaying envelope or filter state drifts into that range. The helpers
+// below flush subnormals to zero of the same sign, branchlessly, so the
+// cost does not depend on the data.
+
+// FlushSubnormal returns x, or a zero of the same sign if x is subnormal.
+func FlushSubnormal[T Float](x T) T {
+	switch v := any(x).(type) {
+	case float32:
+		return T(flushSubnormal32(v))
+	default:
+		return T(flushSubnormal64(float64(x)))
+	}
+}
+
+// FlushSubnormals flushes every subnormal element of x to zero in place.
+func FlushSubnormals[T Float](x []T) {
+	switch v := any(x).(type) {
+	case []float32:
+		for i, e := range v {
+			v[i] = flushSubnormal32(e)
+		}
+	case []float64:
+		for i, e := range v {
+			v[i] = flushSubnormal64(e)
+		}
+	}
+}
+
+// flushSubnormal64 clears the fraction bits when the exponent field is zero:
+// (e + 0x7ff) >> 11 is 0 for e == 0 and 1 otherwise.
+func flushSubnormal64(x float64) float64 {
+	b := math.Float64bits(x)
+	keep := -((b>>52&0x7ff + 0x7ff) >> 11)
+
+	return math.Float64frombits(b & (f64SignExpMask | keep))
+}
+
+func flushSubnormal32(x float32) float32 {
+	b := math.Float32bits(x)
+	keep := -((b>>23&0xff + 0xff) >> 8)
+
+	return math.Float32frombits(b & (f32SignExpMask | keep))
+}
+
+const (
+	f64SignExpMask = 0xfff0000000000000
+	f32SignExpMask = 0xff800000
+)
+
+// FlushToZero returns an Evaluator that calls e and flushes subnormal
+// results to zero, for audio and other real-time loops that take an
+// Evaluator. It is safe for concurrent use if e is.
+func FlushToZero(e Evaluator) Evaluator { return ftzEvaluator{e} }
+
+type ftzEvaluator struct{ e Evaluator }
+
+func (f ftzEvaluator) Sin(x float64) float64     { return flushSubnormal64(f.e.Sin(x)) }
+func (f ftzEvaluator) Cos(x float64) float64     { return flushSubnormal64(f.e.Cos(x)) }
+func (f ftzEvaluator) Tan(x float64) float64     { return flushSubnormal64(f.e.Tan(x)) }
+func (f ftzEvaluator) Exp(x float64) float64     { return flushSubnormal64(f.e.Exp(x)) }
+func (f ftzEvaluator) Log(x float64) float64     { return flushSubnormal64(f.e.Log(x)) }
+func (f ftzEvaluator) Sqrt(x float64) float64    { return flushSubnormal64(f.e.Sqrt(x)) }
+func (f ftzEvaluator) InvSqrt(x float64) float64 { return flushSubnormal64(f.e.InvSqrt(x)) }
+func (f ftzEvaluator) Pow(b, p float64) float64  { return flushSubnormal64(f.e.Pow(b, p)) }
+func (f ftzEvaluator) Hypot(a, b float64) float64 {
+	return flushSubnormal64(f.e.Hypot(a, b))
+}
@@ -0,0 +1,87 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFlushSubnormal(t *testing.T) {
+	t.Parallel()
+
+	sub64 := math.Float64frombits(1)
+	sub32 := math.Float32frombits(0x007fffff)
+
+	cases64 := []struct{ in, want float64 }{
+		{sub64, 0},
+		{-sub64, math.Copysign(0, -1)},
+		{0x1p-1022, 0x1p-1022}, // smallest normal
+		{1.5, 1.5},
+		{math.Inf(-1), math.Inf(-1)},
+	}
+
+	for _, tc := range cases64 {
+		if got := FlushSubnormal(tc.in); math.Float64bits(got) != math.Float64bits(tc.want) {
+			t.Fatalf("FlushSubnormal(%g) = %g, want %g", tc.in, got, tc.want)
+		}
+	}
+
+	if got := FlushSubnormal(-sub32); math.Float32bits(got) != 0x80000000 {
+		t.Fatalf("float32 -subnormal flushed to %#x", math.Float32bits(got))
+	}
+
+	if got := FlushSubnormal(float32(0x1p-126)); got != 0x1p-126 {
+		t.Fatalf("smallest float32 normal changed to %g", got)
+	}
+
+	if got := FlushSubnormal(math.NaN()); !math.IsNaN(got) {
+		t.Fatalf("NaN flushed to %g", got)
+	}
+}
+
+func TestFlushSubnormals_Exp(t *testing.T) {
+	t.Parallel()
+
+	// e^x is subnormal in float64 below about -708 and in float32 below -87.
+	src := []float64{-720, -740, -1, -745}
+	dst := make([]float64, len(src))
+
+	run := func(ftz bool) {
+		_, _ = RunBatch(t.Context(), dst, src, func(d, s []float64) {
+			for i, x := range s {
+				d[i] = FastExpPrec(x, PrecisionHigh)
+			}
+		}, BatchOptions{FlushSubnormals: ftz})
+	}
+
+	run(false)
+
+	if dst[0] == 0 || dst[0] >= 0x1p-1022 {
+		t.Fatalf("exp(-720) = %g, expected a subnormal to test with", dst[0])
+	}
+
+	run(true)
+
+	if dst[0] != 0 || dst[1] != 0 || dst[2] != FastExpPrec(-1.0, PrecisionHigh) {
+		t.Fatalf("flushed batch = %v", dst)
+	}
+
+	x32 := make([]float32, 4)
+	for i, x := range []float32{-90, -100, -10, 0} {
+		x32[i] = FastExpPrec(x, PrecisionHigh)
+	}
+
+	if x32[0] == 0 {
+		t.Fatal("float32 exp(-90) is not subnormal")
+	}
+
+	FlushSubnormals(x32)
+
+	if x32[0] != 0 || x32[1] != 0 || x32[2] == 0 || x32[3] != 1 {
+		t.Fatalf("flushed float32 = %v", x32)
+	}
+
+	ftz := FlushToZero(NewEvaluator(PrecisionHigh))
+	if ftz.Exp(-720) != 0 || ftz.Exp(1) != NewEvaluator(PrecisionHigh).Exp(1) {
+		t.Fatalf("FlushToZero evaluator: exp(-720) = %g", ftz.Exp(-720))
+	}
+}
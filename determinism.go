@@ -0,0 +1,25 @@
+package approx
+
+import iapprox "github.com/meko-christian/algo-approx/internal/approx"
+
+// SetDeterministic turns deterministic mode on or off and returns the
+// previous setting. Deterministic mode is on by default.
+//
+// With it off, FastSqrt and FastInvSqrt start from the hardware
+// reciprocal-square-root estimate where the platform has one (RSQRTSS on
+// amd64) instead of the bit-trick seed. The estimate is accurate to
+// 1.5·2⁻¹², which saves one refinement step in every tier while keeping the
+// accuracy contract, but its exact value differs between CPU vendors, so
+// results are no longer bit-identical across machines. Elsewhere, and for
+// inputs outside the float32 normal range, the bit-trick seed is used either
+// way. Seeds installed with SetSeeds take precedence over both.
+//
+// The setting is safe to change concurrently with evaluation.
+func SetDeterministic(on bool) bool { return iapprox.SetDeterministic(on) }
+
+// Deterministic reports whether deterministic mode is on.
+func Deterministic() bool { return iapprox.Deterministic() }
+
+// HardwareSeed reports whether FastSqrt and FastInvSqrt currently use a
+// hardware seed: deterministic mode is off and the platform provides one.
+func HardwareSeed() bool { return iapprox.HardwareSeed() }
@@ -2,3 +2,6 @@
 //
 // The API is generic over float32 and float64 using the Float constraint.
 package approx
+
+//go:generate go run ./internal/gen/flavors
+//go:generate go run ./internal/gen/accuracy
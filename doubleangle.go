@@ -0,0 +1,69 @@
+package approx
+
+// SinDoubleOf returns sin 2x = 2·sin x·cos x from the sine and cosine of x.
+func SinDoubleOf[T Float](sinX, cosX T) T { return 2 * sinX * cosX }
+
+// CosDoubleOf returns cos 2x from the sine and cosine of x, picking the form
+// that does not cancel: 1 - 2sin²x while |sin x| <= ½, as cos x approaches 1,
+// 2cos²x - 1 while |cos x| <= ½, and (cos x - sin x)(cos x + sin x) in
+// between, where the result crosses zero and the first factor is exact.
+func CosDoubleOf[T Float](sinX, cosX T) T {
+	switch {
+	case sinX >= -0.5 && sinX <= 0.5:
+		return 1 - 2*sinX*sinX
+	case cosX >= -0.5 && cosX <= 0.5:
+		return 2*cosX*cosX - 1
+	default:
+		return (cosX - sinX) * (cosX + sinX)
+	}
+}
+
+// TanHalfOf returns tan(x/2) from the sine and cosine of x. It is
+// sin x / (1 + cos x) for cos x >= 0 and (1 - cos x) / sin x otherwise, so
+// neither 1 ± cos x cancels; the pole at x = π yields ±Inf.
+func TanHalfOf[T Float](sinX, cosX T) T {
+	if cosX >= 0 {
+		return sinX / (1 + cosX)
+	}
+
+	return (1 - cosX) / sinX
+}
+
+// FastSinDouble returns an approximate sin(2x) using the default precision.
+func FastSinDouble[T Float](x T) T { return FastSinDoublePrec(x, PrecisionAuto) }
+
+// FastSinDoublePrec returns an approximate sin(2x) using the requested
+// precision, as SinDoubleOf of one FastSinCosPrec call.
+func FastSinDoublePrec[T Float](x T, prec Precision) T {
+	return SinDoubleOf(FastSinCosPrec(x, prec))
+}
+
+func FastSinDouble32(x float32) float32 { return FastSinDouble[float32](x) }
+func FastSinDouble64(x float64) float64 { return FastSinDouble[float64](x) }
+
+// FastCosDouble returns an approximate cos(2x) using the default precision.
+func FastCosDouble[T Float](x T) T { return FastCosDoublePrec(x, PrecisionAuto) }
+
+// FastCosDoublePrec returns an approximate cos(2x) using the requested
+// precision, as CosDoubleOf of one FastSinCosPrec call.
+func FastCosDoublePrec[T Float](x T, prec Precision) T {
+	return CosDoubleOf(FastSinCosPrec(x, prec))
+}
+
+func FastCosDouble32(x float32) float32 { return FastCosDouble[float32](x) }
+func FastCosDouble64(x float64) float64 { return FastCosDouble[float64](x) }
+
+// FastTanHalf returns an approximate tan(x/2) using the default precision.
+func FastTanHalf[T Float](x T) T { return FastTanHalfPrec(x, PrecisionAuto) }
+
+// FastTanHalfPrec returns an approximate tan(x/2) using the requested
+// precision, as TanHalfOf of one FastSinCosPrec call. The half-angle tangent
+// is the stereographic parameter of rational rotations and quaternion
+// interpolation; unlike FastTanPrec(x/2, prec) it runs no tangent series and
+// is accurate wherever the sine and cosine are.
+func FastTanHalfPrec[T Float](x T, prec Precision) T {
+	return TanHalfOf(FastSinCosPrec(x, prec))
+}
+
+func FastTanHalf32(x float32) float32 { return FastTanHalf[float32](x) }
+func FastTanHalf64(x float64) float64 { return FastTanHalf[float64](x) }
@@ -0,0 +1,102 @@
+package approx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+func TestDoubleOfExactInputs(t *testing.T) {
+	t.Parallel()
+
+	for x := -4.0; x <= 4; x += 0.01 {
+		s, c := math.Sincos(x)
+
+		if got, want := SinDoubleOf(s, c), math.Sin(2*x); math.Abs(got-want) > 1e-15 {
+			t.Fatalf("SinDoubleOf(%g) = %.17g, want %.17g", x, got, want)
+		}
+
+		if got, want := CosDoubleOf(s, c), math.Cos(2*x); math.Abs(got-want) > 1e-15 {
+			t.Fatalf("CosDoubleOf(%g) = %.17g, want %.17g", x, got, want)
+		}
+
+		if x > -3 && x < 3 {
+			if got, want := TanHalfOf(s, c), math.Tan(x/2); !testutil.Close(got, want, 1e-14) {
+				t.Fatalf("TanHalfOf(%g) = %.17g, want %.17g", x, got, want)
+			}
+		}
+	}
+}
+
+// TestCosDoubleOfNearOne checks that, as cos x approaches 1, an error in
+// cos x does not reach the result, which 2cos²x - 1 would quadruple.
+func TestCosDoubleOfNearOne(t *testing.T) {
+	t.Parallel()
+
+	x := 0.1
+	s, c := math.Sincos(x)
+	cOff := c * (1 + 0x1p-40) // an approximate cosine
+
+	if got, want := CosDoubleOf(s, cOff), math.Cos(2*x); math.Abs(got-want) > 1e-15 {
+		t.Errorf("CosDoubleOf(0.1) = %.17g, want %.17g", got, want)
+	}
+
+	if naive := 2*cOff*cOff - 1; math.Abs(naive-math.Cos(2*x)) < 1e-12 {
+		t.Fatalf("2cos²x - 1 = %.17g; the test no longer shows the amplification", naive)
+	}
+}
+
+func TestTanHalfOfPole(t *testing.T) {
+	t.Parallel()
+
+	if got := TanHalfOf(0.0, -1.0); !math.IsInf(got, 1) {
+		t.Errorf("TanHalfOf at π = %g, want +Inf", got)
+	}
+
+	if got := TanHalfOf(math.Copysign(0, -1), -1.0); !math.IsInf(got, -1) {
+		t.Errorf("TanHalfOf at -π = %g, want -Inf", got)
+	}
+
+	// Near the pole the quotient form keeps its relative accuracy.
+	x := math.Pi - 1e-6
+	if got, want := TanHalfOf(math.Sincos(x)), math.Tan(x/2); !testutil.Close(got, want, 1e-9) {
+		t.Errorf("TanHalfOf(π - 1e-6) = %g, want %g", got, want)
+	}
+}
+
+func TestFastDoubleAngle(t *testing.T) {
+	t.Parallel()
+
+	tols := map[Precision]float64{PrecisionFast: 1e-3, PrecisionBalanced: 1e-7, PrecisionHigh: 1e-11}
+
+	for prec, tol := range tols {
+		for x := -7.0; x <= 7; x += 0.013 {
+			if got, want := FastSinDoublePrec(x, prec), math.Sin(2*x); math.Abs(got-want) > tol {
+				t.Fatalf("%v: FastSinDouble(%g) = %g, want %g", prec, x, got, want)
+			}
+
+			if got, want := FastCosDoublePrec(x, prec), math.Cos(2*x); math.Abs(got-want) > tol {
+				t.Fatalf("%v: FastCosDouble(%g) = %g, want %g", prec, x, got, want)
+			}
+
+			if x > -3 && x < 3 {
+				if got, want := FastTanHalfPrec(x, prec), math.Tan(x/2); !testutil.Close(got, want, 10*tol) {
+					t.Fatalf("%v: FastTanHalf(%g) = %g, want %g", prec, x, got, want)
+				}
+			}
+		}
+	}
+
+	if got := FastSinDouble32(0.25); math.Abs(float64(got)-math.Sin(0.5)) > 1e-6 {
+		t.Errorf("FastSinDouble32(0.25) = %v", got)
+	}
+
+	if got := FastCosDouble64(0.25); math.Abs(got-math.Cos(0.5)) > 1e-6 {
+		t.Errorf("FastCosDouble64(0.25) = %v", got)
+	}
+
+	if got := FastTanHalf32(1); math.Abs(float64(got)-math.Tan(0.5)) > 1e-6 {
+		t.Errorf("FastTanHalf32(1) = %v", got)
+	}
+}
@@ -0,0 +1,129 @@
+package dsp
+
+import (
+	"fmt"
+	"math"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// FilterKind selects a biquad response from the RBJ Audio EQ Cookbook.
+type FilterKind int
+
+const (
+	// LowPass is a second-order low-pass filter.
+	LowPass FilterKind = iota
+	// HighPass is a second-order high-pass filter.
+	HighPass
+	// BandPass is a band-pass filter with 0 dB peak gain.
+	BandPass
+	// Notch rejects a narrow band around the center frequency.
+	Notch
+	// AllPass passes all frequencies with a frequency-dependent phase shift.
+	AllPass
+	// Peaking boosts or cuts around the center frequency by gainDb.
+	Peaking
+	// LowShelf boosts or cuts below the corner frequency by gainDb.
+	LowShelf
+	// HighShelf boosts or cuts above the corner frequency by gainDb.
+	HighShelf
+)
+
+func (k FilterKind) String() string {
+	switch k {
+	case LowPass:
+		return "lowpass"
+	case HighPass:
+		return "highpass"
+	case BandPass:
+		return "bandpass"
+	case Notch:
+		return "notch"
+	case AllPass:
+		return "allpass"
+	case Peaking:
+		return "peaking"
+	case LowShelf:
+		return "lowshelf"
+	case HighShelf:
+		return "highshelf"
+	default:
+		return "unknown"
+	}
+}
+
+// Biquad holds normalized biquad coefficients (a0 = 1) for
+//
+//	y[n] = B0·x[n] + B1·x[n-1] + B2·x[n-2] - A1·y[n-1] - A2·y[n-2]
+type Biquad struct {
+	B0, B1, B2 float64
+	A1, A2     float64
+}
+
+// BiquadCoeffs designs a biquad using the RBJ cookbook formulas.
+//
+// freq is the center/corner frequency in Hz and must lie in (0, sampleRate/2);
+// q must be positive. gainDb is only used by Peaking, LowShelf and HighShelf.
+// The angle is evaluated with FastSinCos and the shelf/peak amplitude
+// 10^(gainDb/40) with FastPower, which makes per-block coefficient
+// modulation cheap.
+//
+//nolint:cyclop,funlen
+func BiquadCoeffs(kind FilterKind, freq, q, sampleRate, gainDb float64) (Biquad, error) {
+	if sampleRate <= 0 || freq <= 0 || freq >= 0.5*sampleRate || q <= 0 {
+		return Biquad{}, fmt.Errorf("dsp: biquad freq=%g q=%g fs=%g: %w", freq, q, sampleRate, approx.ErrDomainError)
+	}
+
+	sinW, cosW := approx.FastSinCos(2 * math.Pi * freq / sampleRate)
+	alpha := sinW / (2 * q)
+
+	var b0, b1, b2, a0, a1, a2 float64
+
+	switch kind {
+	case LowPass:
+		b1 = 1 - cosW
+		b0, b2 = 0.5*b1, 0.5*b1
+		a0, a1, a2 = 1+alpha, -2*cosW, 1-alpha
+	case HighPass:
+		b1 = -(1 + cosW)
+		b0, b2 = -0.5*b1, -0.5*b1
+		a0, a1, a2 = 1+alpha, -2*cosW, 1-alpha
+	case BandPass:
+		b0, b1, b2 = alpha, 0, -alpha
+		a0, a1, a2 = 1+alpha, -2*cosW, 1-alpha
+	case Notch:
+		b0, b1, b2 = 1, -2*cosW, 1
+		a0, a1, a2 = 1+alpha, -2*cosW, 1-alpha
+	case AllPass:
+		b0, b1, b2 = 1-alpha, -2*cosW, 1+alpha
+		a0, a1, a2 = 1+alpha, -2*cosW, 1-alpha
+	case Peaking:
+		amp := approx.FastPower(10, gainDb/40)
+		b0, b1, b2 = 1+alpha*amp, -2*cosW, 1-alpha*amp
+		a0, a1, a2 = 1+alpha/amp, -2*cosW, 1-alpha/amp
+	case LowShelf:
+		amp := approx.FastPower(10, gainDb/40)
+		k := 2 * approx.FastSqrt(amp) * alpha
+		b0 = amp * ((amp + 1) - (amp-1)*cosW + k)
+		b1 = 2 * amp * ((amp - 1) - (amp+1)*cosW)
+		b2 = amp * ((amp + 1) - (amp-1)*cosW - k)
+		a0 = (amp + 1) + (amp-1)*cosW + k
+		a1 = -2 * ((amp - 1) + (amp+1)*cosW)
+		a2 = (amp + 1) + (amp-1)*cosW - k
+	case HighShelf:
+		amp := approx.FastPower(10, gainDb/40)
+		k := 2 * approx.FastSqrt(amp) * alpha
+		b0 = amp * ((amp + 1) + (amp-1)*cosW + k)
+		b1 = -2 * amp * ((amp - 1) + (amp+1)*cosW)
+		b2 = amp * ((amp + 1) + (amp-1)*cosW - k)
+		a0 = (amp + 1) - (amp-1)*cosW + k
+		a1 = 2 * ((amp - 1) - (amp+1)*cosW)
+		a2 = (amp + 1) - (amp-1)*cosW - k
+	default:
+		return Biquad{}, fmt.Errorf("dsp: unknown filter kind %d: %w", kind, approx.ErrDomainError)
+	}
+
+	inv := 1 / a0
+
+	return Biquad{B0: b0 * inv, B1: b1 * inv, B2: b2 * inv, A1: a1 * inv, A2: a2 * inv}, nil
+}
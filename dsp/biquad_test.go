@@ -0,0 +1,89 @@
+package dsp
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// exactBiquad evaluates the cookbook formulas with math.* for reference.
+func exactBiquad(kind FilterKind, freq, q, fs, gainDb float64) Biquad {
+	w := 2 * math.Pi * freq / fs
+	s, c := math.Sin(w), math.Cos(w)
+	alpha := s / (2 * q)
+	amp := math.Pow(10, gainDb/40)
+	k := 2 * math.Sqrt(amp) * alpha
+
+	var b [6]float64
+
+	switch kind {
+	case LowPass:
+		b = [6]float64{(1 - c) / 2, 1 - c, (1 - c) / 2, 1 + alpha, -2 * c, 1 - alpha}
+	case HighPass:
+		b = [6]float64{(1 + c) / 2, -(1 + c), (1 + c) / 2, 1 + alpha, -2 * c, 1 - alpha}
+	case BandPass:
+		b = [6]float64{alpha, 0, -alpha, 1 + alpha, -2 * c, 1 - alpha}
+	case Notch:
+		b = [6]float64{1, -2 * c, 1, 1 + alpha, -2 * c, 1 - alpha}
+	case AllPass:
+		b = [6]float64{1 - alpha, -2 * c, 1 + alpha, 1 + alpha, -2 * c, 1 - alpha}
+	case Peaking:
+		b = [6]float64{1 + alpha*amp, -2 * c, 1 - alpha*amp, 1 + alpha/amp, -2 * c, 1 - alpha/amp}
+	case LowShelf:
+		b = [6]float64{
+			amp * ((amp + 1) - (amp-1)*c + k), 2 * amp * ((amp - 1) - (amp+1)*c), amp * ((amp + 1) - (amp-1)*c - k),
+			(amp + 1) + (amp-1)*c + k, -2 * ((amp - 1) + (amp+1)*c), (amp + 1) + (amp-1)*c - k,
+		}
+	case HighShelf:
+		b = [6]float64{
+			amp * ((amp + 1) + (amp-1)*c + k), -2 * amp * ((amp - 1) + (amp+1)*c), amp * ((amp + 1) + (amp-1)*c - k),
+			(amp + 1) - (amp-1)*c + k, 2 * ((amp - 1) - (amp+1)*c), (amp + 1) - (amp-1)*c - k,
+		}
+	}
+
+	return Biquad{B0: b[0] / b[3], B1: b[1] / b[3], B2: b[2] / b[3], A1: b[4] / b[3], A2: b[5] / b[3]}
+}
+
+func TestBiquadCoeffs_MatchExact(t *testing.T) {
+	t.Parallel()
+
+	kinds := []FilterKind{LowPass, HighPass, BandPass, Notch, AllPass, Peaking, LowShelf, HighShelf}
+	for _, kind := range kinds {
+		for _, freq := range []float64{40, 440, 5000, 18000} {
+			for _, gain := range []float64{-12, 0, 6} {
+				got, err := BiquadCoeffs(kind, freq, 0.707, 48000, gain)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				want := exactBiquad(kind, freq, 0.707, 48000, gain)
+				gotC := [5]float64{got.B0, got.B1, got.B2, got.A1, got.A2}
+				wantC := [5]float64{want.B0, want.B1, want.B2, want.A1, want.A2}
+
+				// Balanced-tier tolerance: the shelf/peak gain goes through
+				// the exp/log composition of FastPower.
+				for i := range gotC {
+					if math.Abs(gotC[i]-wantC[i]) > 1e-4*math.Max(1, math.Abs(wantC[i])) {
+						t.Fatalf("%v f=%g gain=%g coeff %d = %g, want %g", kind, freq, gain, i, gotC[i], wantC[i])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestBiquadCoeffs_InvalidArguments(t *testing.T) {
+	t.Parallel()
+
+	for _, args := range [][3]float64{{0, 1, 48000}, {24000, 1, 48000}, {1000, 0, 48000}, {1000, 1, 0}} {
+		if _, err := BiquadCoeffs(LowPass, args[0], args[1], args[2], 0); !errors.Is(err, approx.ErrDomainError) {
+			t.Fatalf("BiquadCoeffs(%v) error = %v", args, err)
+		}
+	}
+
+	if _, err := BiquadCoeffs(FilterKind(99), 1000, 1, 48000, 0); !errors.Is(err, approx.ErrDomainError) {
+		t.Fatalf("unknown kind error = %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package dsp
+
+import (
+	"fmt"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// Chirp fills dst with a linear sine sweep from f0 to f1 Hz (amplitude 1).
+//
+// The instantaneous frequency rises linearly from f0 at the first sample to
+// f1 at the end of the buffer (len(dst)/sampleRate seconds). The phase is
+// tracked in turns so every sample is reduced exactly by FastSinTurns.
+func Chirp(dst []float32, f0, f1, sampleRate float64) error {
+	if sampleRate <= 0 {
+		return fmt.Errorf("dsp: chirp sample rate %g: %w", sampleRate, approx.ErrDomainError)
+	}
+
+	if len(dst) == 0 {
+		return nil
+	}
+
+	dt := 1 / sampleRate
+	rate := (f1 - f0) / (float64(len(dst)) * dt) // Hz per second
+
+	for n := range dst {
+		t := float64(n) * dt
+		phase := t * (f0 + 0.5*rate*t)
+		dst[n] = float32(approx.FastSinTurns(phase))
+	}
+
+	return nil
+}
+
+// ExpChirp fills dst with an exponential (logarithmic) sine sweep from f0 to
+// f1 Hz (amplitude 1), as used for impulse-response measurement.
+//
+// The instantaneous frequency is f0·(f1/f0)^(t/T) with T = len(dst)/sampleRate,
+// so each octave takes the same time. Both frequencies must be positive.
+func ExpChirp(dst []float32, f0, f1, sampleRate float64) error {
+	if sampleRate <= 0 {
+		return fmt.Errorf("dsp: chirp sample rate %g: %w", sampleRate, approx.ErrDomainError)
+	}
+
+	if f0 <= 0 || f1 <= 0 {
+		return fmt.Errorf("dsp: exponential chirp from %g to %g Hz: %w", f0, f1, approx.ErrDomainError)
+	}
+
+	if len(dst) == 0 {
+		return nil
+	}
+
+	if f0 == f1 {
+		return Chirp(dst, f0, f1, sampleRate)
+	}
+
+	duration := float64(len(dst)) / sampleRate
+	k := approx.FastLog(f1 / f0) // growth over the whole sweep
+	scale := f0 * duration / k   // phase in turns = scale·(e^(k·t/T) - 1)
+	step := k / float64(len(dst))
+
+	for n := range dst {
+		phase := scale * (approx.FastExp(float64(n)*step) - 1)
+		dst[n] = float32(approx.FastSinTurns(phase))
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package dsp
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func TestChirp_MatchesAnalyticPhase(t *testing.T) {
+	t.Parallel()
+
+	const (
+		fs     = 48000.0
+		f0, f1 = 100.0, 2000.0
+	)
+
+	dst := make([]float32, 4800)
+	if err := Chirp(dst, f0, f1, fs); err != nil {
+		t.Fatal(err)
+	}
+
+	dur := float64(len(dst)) / fs
+	for n, got := range dst {
+		tt := float64(n) / fs
+		want := math.Sin(2 * math.Pi * (f0*tt + 0.5*(f1-f0)/dur*tt*tt))
+
+		if math.Abs(float64(got)-want) > 1e-5 {
+			t.Fatalf("sample %d = %v, want %v", n, got, want)
+		}
+	}
+}
+
+func TestExpChirp_MatchesAnalyticPhase(t *testing.T) {
+	t.Parallel()
+
+	const (
+		fs     = 44100.0
+		f0, f1 = 20.0, 20000.0
+	)
+
+	dst := make([]float32, 22050)
+	if err := ExpChirp(dst, f0, f1, fs); err != nil {
+		t.Fatal(err)
+	}
+
+	dur := float64(len(dst)) / fs
+	k := math.Log(f1 / f0)
+
+	for n, got := range dst {
+		tt := float64(n) / fs
+		want := math.Sin(2 * math.Pi * f0 * dur / k * (math.Exp(k*tt/dur) - 1))
+
+		// The phase reaches ~1500 turns, so exp's relative error shows up as
+		// a small absolute phase error towards the end of the sweep.
+		if math.Abs(float64(got)-want) > 5e-2 {
+			t.Fatalf("sample %d = %v, want %v", n, got, want)
+		}
+	}
+}
+
+func TestChirp_InvalidArguments(t *testing.T) {
+	t.Parallel()
+
+	dst := make([]float32, 8)
+
+	if err := Chirp(dst, 1, 2, 0); !errors.Is(err, approx.ErrDomainError) {
+		t.Fatalf("Chirp with zero sample rate: %v", err)
+	}
+
+	if err := ExpChirp(dst, 0, 100, 48000); !errors.Is(err, approx.ErrDomainError) {
+		t.Fatalf("ExpChirp with f0=0: %v", err)
+	}
+}
@@ -0,0 +1,5 @@
+// Package dsp provides audio and signal-processing building blocks on top of
+// the approx kernels: test-signal generators, envelopes and filter design.
+//
+// Generators write into caller-provided slices and do not allocate.
+package dsp
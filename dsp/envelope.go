@@ -0,0 +1,204 @@
+package dsp
+
+import (
+	"fmt"
+	"math"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// OnePoleCoeff returns the feedback coefficient e^(-1/(τ·fs)) of a one-pole
+// smoother with time constant tau seconds at the given sample rate. A
+// non-positive time constant yields 0 (instant response).
+func OnePoleCoeff(tau, sampleRate float64) float64 {
+	if tau <= 0 || sampleRate <= 0 {
+		return 0
+	}
+
+	return approx.FastExp(-1 / (tau * sampleRate))
+}
+
+// EnvelopeFollower tracks the amplitude envelope of a signal with separate
+// attack and release time constants.
+//
+// The zero value follows the input instantly; call SetTimes to configure it.
+// SetTimes is cheap enough to call per block when the times are modulated.
+type EnvelopeFollower struct {
+	attack  float64
+	release float64
+	env     float64
+}
+
+// NewEnvelopeFollower returns a follower with the given attack and release
+// time constants in seconds.
+func NewEnvelopeFollower(attack, release, sampleRate float64) *EnvelopeFollower {
+	e := &EnvelopeFollower{} //nolint:exhaustruct
+	e.SetTimes(attack, release, sampleRate)
+
+	return e
+}
+
+// SetTimes recomputes the attack and release coefficients.
+func (e *EnvelopeFollower) SetTimes(attack, release, sampleRate float64) {
+	e.attack = OnePoleCoeff(attack, sampleRate)
+	e.release = OnePoleCoeff(release, sampleRate)
+}
+
+// Reset clears the tracked envelope.
+func (e *EnvelopeFollower) Reset() { e.env = 0 }
+
+// Value returns the current envelope level.
+func (e *EnvelopeFollower) Value() float64 { return e.env }
+
+// Next feeds one sample and returns the updated envelope level.
+func (e *EnvelopeFollower) Next(x float64) float64 {
+	level := math.Abs(x)
+
+	coeff := e.release
+	if level > e.env {
+		coeff = e.attack
+	}
+
+	e.env = level + coeff*(e.env-level)
+
+	return e.env
+}
+
+// Process writes the envelope of src into dst; dst and src may alias.
+// It panics if len(dst) != len(src).
+func (e *EnvelopeFollower) Process(dst, src []float32) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("EnvelopeFollower.Process")
+	}
+
+	for i := range src {
+		dst[i] = float32(e.Next(float64(src[i])))
+	}
+}
+
+// ADSRStage identifies the current segment of an ADSR envelope.
+type ADSRStage int
+
+const (
+	// StageIdle means the envelope is silent and waiting for a gate.
+	StageIdle ADSRStage = iota
+	// StageAttack rises towards full level.
+	StageAttack
+	// StageDecay falls from full level towards the sustain level.
+	StageDecay
+	// StageSustain holds the sustain level while the gate is open.
+	StageSustain
+	// StageRelease falls towards silence after the gate closes.
+	StageRelease
+)
+
+func (s ADSRStage) String() string {
+	switch s {
+	case StageIdle:
+		return "idle"
+	case StageAttack:
+		return "attack"
+	case StageDecay:
+		return "decay"
+	case StageSustain:
+		return "sustain"
+	case StageRelease:
+		return "release"
+	default:
+		return "unknown"
+	}
+}
+
+// ADSR is an exponential-segment attack/decay/sustain/release envelope
+// generator of the kind used per voice in synthesizers.
+//
+// Each segment is a one-pole approach towards a target. The attack targets a
+// level slightly above 1 so it reaches full scale in finite time; decay and
+// release approach their targets asymptotically and switch stage once within
+// adsrEpsilon.
+type ADSR struct {
+	attackCoeff  float64
+	decayCoeff   float64
+	releaseCoeff float64
+	sustain      float64
+	level        float64
+	stage        ADSRStage
+}
+
+// NewADSR returns an idle envelope. Times are time constants in seconds and
+// sustain is a level in [0, 1].
+func NewADSR(attack, decay, sustain, release, sampleRate float64) *ADSR {
+	a := &ADSR{} //nolint:exhaustruct
+	a.Set(attack, decay, sustain, release, sampleRate)
+
+	return a
+}
+
+// Set recomputes all segment coefficients; it may be called while running.
+func (a *ADSR) Set(attack, decay, sustain, release, sampleRate float64) {
+	a.attackCoeff = OnePoleCoeff(attack, sampleRate)
+	a.decayCoeff = OnePoleCoeff(decay, sampleRate)
+	a.releaseCoeff = OnePoleCoeff(release, sampleRate)
+	a.sustain = min(max(sustain, 0), 1)
+}
+
+// Gate opens (true) or closes (false) the envelope.
+func (a *ADSR) Gate(on bool) {
+	if on {
+		a.stage = StageAttack
+	} else if a.stage != StageIdle {
+		a.stage = StageRelease
+	}
+}
+
+// Stage returns the current segment.
+func (a *ADSR) Stage() ADSRStage { return a.stage }
+
+// Level returns the current output level.
+func (a *ADSR) Level() float64 { return a.level }
+
+// Next advances the envelope by one sample and returns the new level.
+func (a *ADSR) Next() float64 {
+	switch a.stage {
+	case StageAttack:
+		a.level = adsrAttackTarget + a.attackCoeff*(a.level-adsrAttackTarget)
+		if a.level >= 1 {
+			a.level = 1
+			a.stage = StageDecay
+		}
+	case StageDecay:
+		a.level = a.sustain + a.decayCoeff*(a.level-a.sustain)
+		if a.level-a.sustain <= adsrEpsilon {
+			a.level = a.sustain
+			a.stage = StageSustain
+		}
+	case StageSustain:
+		a.level = a.sustain
+	case StageRelease:
+		a.level *= a.releaseCoeff
+		if a.level <= adsrEpsilon {
+			a.level = 0
+			a.stage = StageIdle
+		}
+	case StageIdle:
+		a.level = 0
+	}
+
+	return a.level
+}
+
+// Process writes successive envelope levels into dst.
+func (a *ADSR) Process(dst []float32) {
+	for i := range dst {
+		dst[i] = float32(a.Next())
+	}
+}
+
+const (
+	adsrAttackTarget = 1.2
+	adsrEpsilon      = 1e-5
+)
+
+func panicLengthMismatch(fn string) {
+	panic(fmt.Errorf("dsp: %s: %w", fn, approx.ErrLengthMismatch))
+}
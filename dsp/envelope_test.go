@@ -0,0 +1,120 @@
+package dsp
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func TestOnePoleCoeff(t *testing.T) {
+	t.Parallel()
+
+	for _, tau := range []float64{1e-4, 1e-3, 0.01, 0.5, 2} {
+		got := OnePoleCoeff(tau, 48000)
+
+		want := math.Exp(-1 / (tau * 48000))
+		if math.Abs(got-want) > 1e-6 {
+			t.Fatalf("OnePoleCoeff(%g) = %g, want %g", tau, got, want)
+		}
+	}
+
+	if OnePoleCoeff(0, 48000) != 0 {
+		t.Fatalf("zero time constant must give an instant response")
+	}
+}
+
+func TestEnvelopeFollower(t *testing.T) {
+	t.Parallel()
+
+	const fs = 1000.0
+
+	e := NewEnvelopeFollower(0.001, 0.1, fs)
+
+	// One time constant of attack reaches 1-1/e of the step.
+	var level float64
+	for range 1 {
+		level = e.Next(-1)
+	}
+
+	if math.Abs(level-(1-math.Exp(-1))) > 1e-4 {
+		t.Fatalf("after one attack time constant level = %g", level)
+	}
+
+	for range 50 {
+		e.Next(1)
+	}
+
+	// Release decays much more slowly than the attack rose.
+	for range 100 {
+		level = e.Next(0)
+	}
+
+	if math.Abs(level-math.Exp(-1)) > 1e-3 {
+		t.Fatalf("after one release time constant level = %g, want ~%g", level, math.Exp(-1))
+	}
+
+	e.Reset()
+
+	if e.Value() != 0 {
+		t.Fatalf("Reset did not clear the envelope")
+	}
+}
+
+func TestEnvelopeFollowerProcessLengthMismatch(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, approx.ErrLengthMismatch) {
+			t.Fatalf("recover = %v, want ErrLengthMismatch", err)
+		}
+	}()
+
+	NewEnvelopeFollower(0.001, 0.1, 1000).Process(make([]float32, 2), make([]float32, 3))
+}
+
+func TestADSR_Stages(t *testing.T) {
+	t.Parallel()
+
+	a := NewADSR(0.01, 0.02, 0.5, 0.05, 1000)
+	if a.Stage() != StageIdle || a.Next() != 0 {
+		t.Fatalf("new envelope must be idle and silent")
+	}
+
+	a.Gate(true)
+
+	seen := map[ADSRStage]bool{}
+	prev, prevStage := 0.0, a.Stage()
+
+	for range 400 {
+		lvl := a.Next()
+		seen[a.Stage()] = true
+
+		if prevStage == StageDecay && lvl > prev {
+			t.Fatalf("level rose during decay: %g -> %g", prev, lvl)
+		}
+
+		prev, prevStage = lvl, a.Stage()
+	}
+
+	if !seen[StageDecay] || a.Stage() != StageSustain || a.Level() != 0.5 {
+		t.Fatalf("expected to settle in sustain at 0.5, got %v at %g", a.Stage(), a.Level())
+	}
+
+	a.Gate(false)
+
+	buf := make([]float32, 2000)
+	a.Process(buf)
+
+	if a.Stage() != StageIdle || buf[len(buf)-1] != 0 {
+		t.Fatalf("expected release to finish, got %v at %g", a.Stage(), a.Level())
+	}
+
+	for i := 1; i < len(buf); i++ {
+		if buf[i] > buf[i-1] {
+			t.Fatalf("level rose during release at %d", i)
+		}
+	}
+}
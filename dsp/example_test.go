@@ -0,0 +1,138 @@
+package dsp_test
+
+import (
+	"fmt"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/dsp"
+)
+
+func ExampleChirp() {
+	buf := make([]float32, 8)
+	if err := dsp.Chirp(buf, 1000, 2000, 8000); err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%.3f\n", buf)
+	// Output:
+	// [0.000 0.741 0.981 0.337 -0.707 -0.904 0.195 0.999]
+}
+
+func ExampleExpChirp() {
+	buf := make([]float32, 4)
+	if err := dsp.ExpChirp(buf, 100, 1000, 1000); err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%.3f\n", buf)
+	// Output:
+	// [0.000 0.751 0.704 -0.945]
+}
+
+func ExampleOnePoleCoeff() {
+	// Smoothing coefficient for a 10 ms time constant at 48 kHz.
+	fmt.Printf("%.6f\n", dsp.OnePoleCoeff(0.010, 48000))
+	// Output:
+	// 0.997919
+}
+
+func ExampleBiquadCoeffs() {
+	bq, err := dsp.BiquadCoeffs(dsp.LowPass, 1000, 0.7071, 48000, 0)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("b0=%.6f a1=%.6f a2=%.6f\n", bq.B0, bq.A1, bq.A2)
+	// Output:
+	// b0=0.003916 a1=-1.815340 a2=0.831004
+}
+
+func ExampleADSR() {
+	env := dsp.NewADSR(0.001, 0.002, 0.5, 0.001, 8000)
+	env.Gate(true)
+
+	for range 40 {
+		env.Next()
+	}
+
+	fmt.Println(env.Stage())
+	fmt.Printf("%.2f\n", env.Level())
+
+	env.Gate(false)
+	fmt.Println(env.Stage())
+	// Output:
+	// decay
+	// 0.60
+	// release
+}
+
+func ExampleEnvelopeFollower() {
+	f := dsp.NewEnvelopeFollower(0.001, 0.050, 8000)
+	for range 100 {
+		f.Next(1)
+	}
+
+	fmt.Printf("%.3f\n", f.Value())
+	// Output:
+	// 1.000
+}
+
+// Block-based synthesis: a sweep shaped by an ADSR envelope and measured by
+// an envelope follower, processing 64-sample blocks without allocating.
+func Example_audioBlockProcessing() {
+	const (
+		sampleRate = 8000
+		blockSize  = 64
+		blocks     = 16
+	)
+
+	sweep := make([]float32, blockSize*blocks)
+	if err := dsp.Chirp(sweep, 200, 800, sampleRate); err != nil {
+		panic(err)
+	}
+
+	env := dsp.NewADSR(0.01, 0.02, 0.6, 0.02, sampleRate)
+	follower := dsp.NewEnvelopeFollower(0.001, 0.02, sampleRate)
+	gain := make([]float32, blockSize)
+	level := make([]float32, blockSize)
+
+	env.Gate(true)
+
+	for b := range blocks {
+		if b == blocks/2 {
+			env.Gate(false)
+		}
+
+		block := sweep[b*blockSize : (b+1)*blockSize]
+		env.Process(gain)
+
+		for i := range block {
+			block[i] *= gain[i]
+		}
+
+		follower.Process(level, block)
+
+		if b%4 == 3 {
+			fmt.Printf("block %2d: %-7s level %.2f\n", b, env.Stage(), level[blockSize-1])
+		}
+	}
+	// Output:
+	// block  3: decay   level 0.77
+	// block  7: decay   level 0.60
+	// block 11: release level 0.25
+	// block 15: release level 0.08
+}
+
+func ExampleOscillator() {
+	osc, err := dsp.NewOscillator(1000, 8000, approx.PrecisionBalanced)
+	if err != nil {
+		panic(err)
+	}
+
+	buf := make([]float32, 8)
+	osc.Fill(buf)
+
+	fmt.Printf("%.3f\n", buf)
+	// Output:
+	// [0.000 0.707 1.000 0.707 -0.000 -0.707 -1.000 -0.707]
+}
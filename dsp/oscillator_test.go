@@ -0,0 +1,106 @@
+package dsp
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func TestOscillatorAccuracy(t *testing.T) {
+	t.Parallel()
+
+	tols := map[approx.Precision]float64{
+		approx.PrecisionFast:     5e-4, // dominated by FastSinCos at the block centres
+		approx.PrecisionBalanced: 2e-7,
+		approx.PrecisionHigh:     1e-7, // float32 output
+	}
+
+	for prec, tol := range tols {
+		for _, freq := range []float64{0, 1, 440, -997, 3000, 20000, 47000} {
+			o, err := NewOscillator(freq, 48000, prec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sin := make([]float32, 10007)
+			cos := make([]float32, len(sin))
+			o.FillQuadrature(sin[:5000], cos[:5000])
+			o.FillQuadrature(sin[5000:], cos[5000:]) // continues the phase
+
+			for n := range sin {
+				phase := 2 * math.Pi * float64(n) * freq / 48000
+				if d := math.Abs(float64(sin[n]) - math.Sin(phase)); d > tol {
+					t.Fatalf("%v %g Hz: sin[%d] = %v, want %v", prec, freq, n, sin[n], math.Sin(phase))
+				}
+
+				if d := math.Abs(float64(cos[n]) - math.Cos(phase)); d > tol {
+					t.Fatalf("%v %g Hz: cos[%d] = %v, want %v", prec, freq, n, cos[n], math.Cos(phase))
+				}
+			}
+		}
+	}
+}
+
+func TestOscillatorPhase(t *testing.T) {
+	t.Parallel()
+
+	o, err := NewOscillator(1000, 8000, approx.PrecisionHigh)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o.SetPhase(1.25) // a quarter turn
+	buf := make([]float32, 3)
+	o.Fill(buf)
+
+	if math.Abs(float64(buf[0])-1) > 1e-7 || math.Abs(o.Phase()-0.625+1) > 1e-15 {
+		t.Errorf("after SetPhase(1.25): %v, phase %v", buf, o.Phase())
+	}
+
+	// A frequency change keeps the phase continuous.
+	if err := o.SetFrequency(2000, 8000); err != nil {
+		t.Fatal(err)
+	}
+
+	o.Fill(buf[:1])
+
+	if want := math.Sin(2 * math.Pi * 0.625); math.Abs(float64(buf[0])-want) > 1e-7 {
+		t.Errorf("first sample after SetFrequency = %v, want %v", buf[0], want)
+	}
+}
+
+func TestOscillatorInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range [][2]float64{{440, 0}, {440, -1}, {math.NaN(), 48000}, {math.Inf(1), 48000}, {440, math.Inf(1)}} {
+		if _, err := NewOscillator(c[0], c[1], approx.PrecisionAuto); !errors.Is(err, approx.ErrDomainError) {
+			t.Errorf("NewOscillator(%g, %g) error = %v, want ErrDomainError", c[0], c[1], err)
+		}
+	}
+}
+
+func BenchmarkOscillator(b *testing.B) {
+	o, _ := NewOscillator(440, 48000, approx.PrecisionBalanced)
+	buf := make([]float32, 4096)
+
+	b.SetBytes(int64(len(buf) * 4))
+
+	for b.Loop() {
+		o.Fill(buf)
+	}
+}
+
+func BenchmarkOscillator_PerSampleSinTurns(b *testing.B) {
+	buf := make([]float32, 4096)
+	step := 440.0 / 48000
+
+	b.SetBytes(int64(len(buf) * 4))
+
+	for b.Loop() {
+		for n := range buf {
+			buf[n] = float32(approx.FastSinTurns(float64(n) * step))
+		}
+	}
+}
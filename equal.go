@@ -0,0 +1,170 @@
+package approx
+
+import "math"
+
+// EqualOption configures the tolerances used by Equal and its variants.
+type EqualOption func(*equalConfig)
+
+type equalConfig struct {
+	abs      float64
+	rel      float64
+	ulps     uint64
+	nanEqual bool
+}
+
+// Default tolerances of Equal: a relative error in line with
+// PrecisionBalanced and no absolute or ULP slack.
+const (
+	DefaultEqualRelTol = 1e-6
+	DefaultEqualAbsTol = 0
+)
+
+// WithAbsTol accepts values whose absolute difference is at most tol. This
+// is what makes comparisons against zero meaningful.
+func WithAbsTol(tol float64) EqualOption { return func(c *equalConfig) { c.abs = tol } }
+
+// WithRelTol accepts values whose difference is at most tol times the larger
+// magnitude. A tol of 0 disables the relative check.
+func WithRelTol(tol float64) EqualOption { return func(c *equalConfig) { c.rel = tol } }
+
+// WithULP accepts values at most n units in the last place apart in the
+// precision of the compared type (float32 or float64).
+func WithULP(n uint64) EqualOption { return func(c *equalConfig) { c.ulps = n } }
+
+// WithNaNEqual makes NaN compare equal to NaN.
+func WithNaNEqual() EqualOption { return func(c *equalConfig) { c.nanEqual = true } }
+
+// Equal reports whether a and b agree within the configured tolerances.
+//
+// The values are equal if any enabled criterion holds: absolute difference,
+// relative difference, or ULP distance. Infinities are only equal to
+// themselves and NaN is unequal to everything unless WithNaNEqual is given.
+// Without options the relative tolerance DefaultEqualRelTol applies.
+func Equal[T Float](a, b T, opts ...EqualOption) bool {
+	cfg := newEqualConfig(opts)
+	return equalWith(&cfg, a, b)
+}
+
+// EqualSlice compares a and b element-wise with Equal. It returns -1 and true
+// when all elements agree, otherwise the index of the first mismatch and
+// false. Slices of different length mismatch at the shorter length.
+func EqualSlice[T Float](a, b []T, opts ...EqualOption) (int, bool) {
+	cfg := newEqualConfig(opts)
+
+	n := min(len(a), len(b))
+	for i := range n {
+		if !equalWith(&cfg, a[i], b[i]) {
+			return i, false
+		}
+	}
+
+	if len(a) != len(b) {
+		return n, false
+	}
+
+	return -1, true
+}
+
+// EqualMatrix compares two row-major matrices element-wise with Equal. It
+// returns (-1, -1, true) when they agree, otherwise the row and column of the
+// first mismatch in row-major order. A row-count or row-length difference is
+// reported at the first missing position.
+func EqualMatrix[T Float](a, b [][]T, opts ...EqualOption) (row, col int, ok bool) {
+	cfg := newEqualConfig(opts)
+
+	rows := min(len(a), len(b))
+	for i := range rows {
+		cols := min(len(a[i]), len(b[i]))
+		for j := range cols {
+			if !equalWith(&cfg, a[i][j], b[i][j]) {
+				return i, j, false
+			}
+		}
+
+		if len(a[i]) != len(b[i]) {
+			return i, cols, false
+		}
+	}
+
+	if len(a) != len(b) {
+		return rows, 0, false
+	}
+
+	return -1, -1, true
+}
+
+func newEqualConfig(opts []EqualOption) equalConfig {
+	cfg := equalConfig{abs: DefaultEqualAbsTol, rel: DefaultEqualRelTol} //nolint:exhaustruct
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return cfg
+}
+
+func equalWith[T Float](c *equalConfig, a, b T) bool {
+	af, bf := float64(a), float64(b)
+
+	if af != af || bf != bf { //nolint:gocritic
+		return c.nanEqual && af != af && bf != bf //nolint:gocritic
+	}
+
+	if af == bf {
+		return true
+	}
+
+	if math.IsInf(af, 0) || math.IsInf(bf, 0) {
+		return false
+	}
+
+	diff := math.Abs(af - bf)
+	if diff <= c.abs {
+		return true
+	}
+
+	if c.rel > 0 && diff <= c.rel*math.Max(math.Abs(af), math.Abs(bf)) {
+		return true
+	}
+
+	return c.ulps > 0 && ulpDistance(a, b) <= c.ulps
+}
+
+// ulpDistance returns the number of representable values of T between a and
+// b (both finite), counting across zero.
+func ulpDistance[T Float](a, b T) uint64 {
+	if floatBits[T]() == 32 {
+		ia, ib := orderedBits32(float32(a)), orderedBits32(float32(b))
+		if ia > ib {
+			return uint64(ia - ib) //nolint:gosec
+		}
+
+		return uint64(ib - ia) //nolint:gosec
+	}
+
+	ia, ib := orderedBits64(float64(a)), orderedBits64(float64(b))
+	if ia > ib {
+		return uint64(ia) - uint64(ib) //nolint:gosec
+	}
+
+	return uint64(ib) - uint64(ia) //nolint:gosec
+}
+
+// orderedBits maps a float onto an integer line that is monotone in the
+// float value, with -0 and +0 adjacent.
+func orderedBits32(x float32) int64 {
+	b := int64(math.Float32bits(x))
+	if b&(1<<31) != 0 {
+		return -(b &^ (1 << 31))
+	}
+
+	return b
+}
+
+func orderedBits64(x float64) int64 {
+	b := math.Float64bits(x)
+	if b&(1<<63) != 0 {
+		return -int64(b &^ (1 << 63)) //nolint:gosec
+	}
+
+	return int64(b) //nolint:gosec
+}
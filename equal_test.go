@@ -0,0 +1,105 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+
+	nan := math.NaN()
+	inf := math.Inf(1)
+
+	cases := []struct {
+		name string
+		a, b float64
+		opts []EqualOption
+		want bool
+	}{
+		{"identical", 1.5, 1.5, nil, true},
+		{"default relative", 1, 1 + 5e-7, nil, true},
+		{"default relative fails", 1, 1 + 5e-6, nil, false},
+		{"near zero needs abs", 0, 1e-12, nil, false},
+		{"abs tolerance", 0, 1e-12, []EqualOption{WithAbsTol(1e-9)}, true},
+		{"relative disabled", 1, 1 + 1e-12, []EqualOption{WithRelTol(0)}, false},
+		{"ulp", 1, math.Nextafter(math.Nextafter(1, 2), 2), []EqualOption{WithRelTol(0), WithULP(2)}, true},
+		{"ulp too far", 1, 1 + 1e-15, []EqualOption{WithRelTol(0), WithULP(2)}, false},
+		{"ulp across zero", -5e-324, 5e-324, []EqualOption{WithRelTol(0), WithULP(2)}, true},
+		{"signed zeros", 0, math.Copysign(0, -1), nil, true},
+		{"inf equal", inf, inf, nil, true},
+		{"inf vs max", inf, math.MaxFloat64, []EqualOption{WithAbsTol(inf)}, false},
+		{"nan default", nan, nan, nil, false},
+		{"nan equal", nan, nan, []EqualOption{WithNaNEqual()}, true},
+		{"nan vs number", nan, 1, []EqualOption{WithNaNEqual()}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Equal(tc.a, tc.b, tc.opts...); got != tc.want {
+				t.Errorf("Equal(%v, %v) = %v, want %v", tc.a, tc.b, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEqual_Float32ULP(t *testing.T) {
+	t.Parallel()
+
+	a := float32(1)
+	b := math.Nextafter32(a, 2)
+
+	// One float32 ULP is ~1.2e-7, far more than a float64 ULP.
+	if !Equal(a, b, WithRelTol(0), WithULP(1)) {
+		t.Fatalf("adjacent float32 values should be 1 ULP apart")
+	}
+
+	// A named float32 type counts float32 ULPs too.
+	type sample float32
+
+	if !Equal(sample(a), sample(b), WithRelTol(0), WithULP(1)) {
+		t.Fatalf("adjacent values of a named float32 type should be 1 ULP apart")
+	}
+}
+
+func TestEqualSlice(t *testing.T) {
+	t.Parallel()
+
+	a := []float64{1, 2, 3}
+
+	if idx, ok := EqualSlice(a, []float64{1, 2, 3 + 1e-9}); !ok || idx != -1 {
+		t.Fatalf("EqualSlice = (%d, %v), want (-1, true)", idx, ok)
+	}
+
+	if idx, ok := EqualSlice(a, []float64{1, 2.1, 3.1}); ok || idx != 1 {
+		t.Fatalf("EqualSlice = (%d, %v), want (1, false)", idx, ok)
+	}
+
+	if idx, ok := EqualSlice(a, a[:2]); ok || idx != 2 {
+		t.Fatalf("EqualSlice length mismatch = (%d, %v), want (2, false)", idx, ok)
+	}
+}
+
+func TestEqualMatrix(t *testing.T) {
+	t.Parallel()
+
+	a := [][]float32{{1, 2}, {3, 4}}
+
+	if r, c, ok := EqualMatrix(a, [][]float32{{1, 2}, {3, 4}}); !ok || r != -1 || c != -1 {
+		t.Fatalf("EqualMatrix = (%d, %d, %v)", r, c, ok)
+	}
+
+	if r, c, ok := EqualMatrix(a, [][]float32{{1, 2}, {3, 5}}); ok || r != 1 || c != 1 {
+		t.Fatalf("EqualMatrix mismatch = (%d, %d, %v), want (1, 1, false)", r, c, ok)
+	}
+
+	if r, c, ok := EqualMatrix(a, [][]float32{{1, 2}, {3}}); ok || r != 1 || c != 1 {
+		t.Fatalf("EqualMatrix ragged = (%d, %d, %v), want (1, 1, false)", r, c, ok)
+	}
+
+	if r, c, ok := EqualMatrix(a, a[:1]); ok || r != 1 || c != 0 {
+		t.Fatalf("EqualMatrix rows = (%d, %d, %v), want (1, 0, false)", r, c, ok)
+	}
+}
@@ -1,6 +1,9 @@
 package approx
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// ErrDomainError indicates the input is outside the valid domain.
@@ -9,4 +12,17 @@ var (
 	ErrNaN = errors.New("result is not a number")
 	// ErrInfinity indicates the result is infinite.
 	ErrInfinity = errors.New("result is infinite")
+	// ErrLengthMismatch indicates that slice arguments have different lengths.
+	ErrLengthMismatch = errors.New("slice length mismatch")
+	// ErrEvaluatorExists indicates that an evaluator name is already registered.
+	ErrEvaluatorExists = errors.New("evaluator already registered")
+	// ErrSelfTest indicates that SelfTest found a result outside the
+	// accuracy contract.
+	ErrSelfTest = errors.New("self-test failed")
 )
+
+// panicLengthMismatch reports a batch call whose slice arguments disagree in
+// length. Batch functions panic rather than silently truncating.
+func panicLengthMismatch(fn string) {
+	panic(fmt.Errorf("approx: %s: %w", fn, ErrLengthMismatch))
+}
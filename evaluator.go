@@ -0,0 +1,121 @@
+package approx
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+)
+
+// Evaluator is a provider of elementary functions. Libraries that want to
+// accept "some math provider" can take an Evaluator instead of calling the
+// Fast* functions directly, so callers choose between this package's
+// kernels at a given tier, the standard library, or a third-party backend
+// (SIMD, CORDIC, ...) without changes to the library.
+//
+// Implementations must be safe for concurrent use.
+type Evaluator interface {
+	Sin(x float64) float64
+	Cos(x float64) float64
+	Tan(x float64) float64
+	Exp(x float64) float64
+	Log(x float64) float64
+	Sqrt(x float64) float64
+	InvSqrt(x float64) float64
+	Pow(base, exponent float64) float64
+	Hypot(a, b float64) float64
+}
+
+// NewEvaluator returns the Evaluator backed by this package's kernels at the
+// given precision.
+func NewEvaluator(prec Precision) Evaluator { return fastEvaluator{prec: normalizePrecision(prec)} }
+
+// Stdlib returns the Evaluator backed by the math package, useful as a
+// reference or as the "precise" choice next to a fast one.
+func Stdlib() Evaluator { return stdlibEvaluator{} }
+
+type fastEvaluator struct{ prec Precision }
+
+func (e fastEvaluator) Sin(x float64) float64     { return FastSinPrec(x, e.prec) }
+func (e fastEvaluator) Cos(x float64) float64     { return FastCosPrec(x, e.prec) }
+func (e fastEvaluator) Tan(x float64) float64     { return FastTanPrec(x, e.prec) }
+func (e fastEvaluator) Exp(x float64) float64     { return FastExpPrec(x, e.prec) }
+func (e fastEvaluator) Log(x float64) float64     { return FastLogPrec(x, e.prec) }
+func (e fastEvaluator) Sqrt(x float64) float64    { return FastSqrtPrec(x, e.prec) }
+func (e fastEvaluator) InvSqrt(x float64) float64 { return FastInvSqrtPrec(x, e.prec) }
+func (e fastEvaluator) Pow(b, p float64) float64  { return FastPowerPrec(b, p, e.prec) }
+func (e fastEvaluator) Hypot(a, b float64) float64 {
+	return FastHypotPrec(a, b, e.prec)
+}
+
+type stdlibEvaluator struct{}
+
+func (stdlibEvaluator) Sin(x float64) float64      { return math.Sin(x) }
+func (stdlibEvaluator) Cos(x float64) float64      { return math.Cos(x) }
+func (stdlibEvaluator) Tan(x float64) float64      { return math.Tan(x) }
+func (stdlibEvaluator) Exp(x float64) float64      { return math.Exp(x) }
+func (stdlibEvaluator) Log(x float64) float64      { return math.Log(x) }
+func (stdlibEvaluator) Sqrt(x float64) float64     { return math.Sqrt(x) }
+func (stdlibEvaluator) InvSqrt(x float64) float64  { return 1 / math.Sqrt(x) }
+func (stdlibEvaluator) Pow(b, p float64) float64   { return math.Pow(b, p) }
+func (stdlibEvaluator) Hypot(a, b float64) float64 { return math.Hypot(a, b) }
+
+//nolint:gochecknoglobals // process-wide registry, guarded by evaluatorsMu
+var (
+	evaluatorsMu sync.RWMutex
+	evaluators   = map[string]Evaluator{
+		"fast":     NewEvaluator(PrecisionFast),
+		"balanced": NewEvaluator(PrecisionBalanced),
+		"high":     NewEvaluator(PrecisionHigh),
+		"stdlib":   Stdlib(),
+	}
+)
+
+// RegisterEvaluator makes e available under name for LookupEvaluator, so a
+// backend package can register itself from an init function and be selected
+// by configuration. The names "fast", "balanced", "high" and "stdlib" are
+// pre-registered.
+//
+// It returns an error wrapping ErrDomainError for an empty name or nil e,
+// and ErrEvaluatorExists if name is already taken.
+func RegisterEvaluator(name string, e Evaluator) error {
+	if name == "" || e == nil {
+		return fmt.Errorf("approx: registering evaluator %q: %w", name, ErrDomainError)
+	}
+
+	evaluatorsMu.Lock()
+	defer evaluatorsMu.Unlock()
+
+	if _, ok := evaluators[name]; ok {
+		return fmt.Errorf("approx: registering evaluator %q: %w", name, ErrEvaluatorExists)
+	}
+
+	evaluators[name] = e
+
+	return nil
+}
+
+// LookupEvaluator returns the Evaluator registered under name.
+func LookupEvaluator(name string) (Evaluator, bool) {
+	evaluatorsMu.RLock()
+	defer evaluatorsMu.RUnlock()
+
+	e, ok := evaluators[name]
+
+	return e, ok
+}
+
+// EvaluatorNames returns the registered evaluator names in sorted order.
+func EvaluatorNames() []string {
+	evaluatorsMu.RLock()
+	defer evaluatorsMu.RUnlock()
+
+	names := make([]string, 0, len(evaluators))
+	for name := range evaluators {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
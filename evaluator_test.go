@@ -0,0 +1,114 @@
+package approx
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+// evaluatorDistance returns the largest relative difference between two
+// evaluators over a small argument set. Trigonometric arguments stay
+// within |x| <= π/2, the range the series tiers are specified for.
+func evaluatorDistance(a, b Evaluator) float64 {
+	worst := 0.0
+
+	rel := func(x, y float64) {
+		worst = max(worst, math.Abs(x-y)/math.Max(1, math.Abs(y)))
+	}
+
+	for _, x := range []float64{-1.5, -0.3, 0.1, 0.7, 1.2} {
+		rel(a.Sin(x), b.Sin(x))
+		rel(a.Cos(x), b.Cos(x))
+	}
+
+	for _, x := range []float64{0.1, 0.5, 0.7, 1, 2.5, 10} {
+		rel(a.Exp(x), b.Exp(x))
+		rel(a.Log(x), b.Log(x))
+		rel(a.Sqrt(x), b.Sqrt(x))
+		rel(a.InvSqrt(x), b.InvSqrt(x))
+		rel(a.Hypot(x, 3), b.Hypot(x, 3))
+	}
+
+	// Tan is compared away from its poles, where the series is accurate.
+	rel(a.Tan(0.3), b.Tan(0.3))
+
+	return worst
+}
+
+func TestEvaluator_DefaultAndStdlib(t *testing.T) {
+	t.Parallel()
+
+	std := Stdlib()
+	if d := evaluatorDistance(std, stdlibEvaluator{}); d != 0 {
+		t.Fatalf("Stdlib differs from math: %g", d)
+	}
+
+	cases := []struct {
+		prec Precision
+		tol  float64
+	}{
+		{PrecisionFast, 2e-2},
+		{PrecisionBalanced, 1e-4},
+		{PrecisionHigh, 1e-6},
+	}
+
+	for _, tc := range cases {
+		if d := evaluatorDistance(NewEvaluator(tc.prec), std); d > tc.tol {
+			t.Fatalf("NewEvaluator(%v) is %g away from the standard library", tc.prec, d)
+		}
+	}
+
+	// Pow follows the tier of FastPowerPrec.
+	for prec, tol := range map[Precision]float64{PrecisionFast: 1e-3, PrecisionBalanced: 1e-4, PrecisionHigh: 1e-14} {
+		if got, want := NewEvaluator(prec).Pow(2.5, 1.5), math.Pow(2.5, 1.5); math.Abs(got-want) > tol*want {
+			t.Fatalf("NewEvaluator(%v).Pow(2.5, 1.5) = %g, want %g", prec, got, want)
+		}
+	}
+
+	if got := NewEvaluator(PrecisionHigh).Exp(1); got != FastExpPrec(1.0, PrecisionHigh) {
+		t.Fatalf("NewEvaluator(High).Exp(1) = %g, want FastExpPrec", got)
+	}
+}
+
+type constEvaluator struct{ stdlibEvaluator }
+
+func (constEvaluator) Sin(float64) float64 { return 42 }
+
+//nolint:paralleltest // mutates the process-wide registry
+func TestRegisterEvaluator(t *testing.T) {
+	for _, name := range []string{"fast", "balanced", "high", "stdlib"} {
+		if _, ok := LookupEvaluator(name); !ok {
+			t.Fatalf("built-in evaluator %q is not registered", name)
+		}
+	}
+
+	if err := RegisterEvaluator("test-const", constEvaluator{}); err != nil {
+		t.Fatal(err)
+	}
+
+	e, ok := LookupEvaluator("test-const")
+	if !ok || e.Sin(1) != 42 {
+		t.Fatal("registered evaluator is not returned by LookupEvaluator")
+	}
+
+	if !slices.Contains(EvaluatorNames(), "test-const") || !slices.IsSorted(EvaluatorNames()) {
+		t.Fatalf("EvaluatorNames() = %v", EvaluatorNames())
+	}
+
+	if err := RegisterEvaluator("stdlib", constEvaluator{}); !errors.Is(err, ErrEvaluatorExists) {
+		t.Fatalf("duplicate registration: %v", err)
+	}
+
+	if err := RegisterEvaluator("", constEvaluator{}); !errors.Is(err, ErrDomainError) {
+		t.Fatalf("empty name: %v", err)
+	}
+
+	if err := RegisterEvaluator("nil", nil); !errors.Is(err, ErrDomainError) {
+		t.Fatalf("nil evaluator: %v", err)
+	}
+
+	if _, ok := LookupEvaluator("missing"); ok {
+		t.Fatal("LookupEvaluator found an unregistered name")
+	}
+}
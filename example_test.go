@@ -0,0 +1,961 @@
+package approx_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func ExampleFastSqrt() {
+	fmt.Printf("%.4f\n", approx.FastSqrt(2.0))
+	fmt.Printf("%.4f\n", approx.FastSqrt32(9))
+	// Output:
+	// 1.4142
+	// 3.0000
+}
+
+func ExampleFastSqrtPrec() {
+	for _, prec := range []approx.Precision{approx.PrecisionFast, approx.PrecisionBalanced, approx.PrecisionHigh} {
+		fmt.Printf("%-8s %.10f\n", prec, approx.FastSqrtPrec(2.0, prec))
+	}
+	// Output:
+	// fast     1.4166666667
+	// balanced 1.4142156863
+	// high     1.4142135624
+}
+
+func ExampleFastInvSqrt() {
+	fmt.Printf("%.4f\n", approx.FastInvSqrt(4.0))
+	fmt.Printf("%.4f\n", approx.FastInvSqrtPrec(float32(0.25), approx.PrecisionHigh))
+	// Output:
+	// 0.5000
+	// 2.0000
+}
+
+func ExampleFastInvSqrt4() {
+	fmt.Printf("%.4f\n", approx.FastInvSqrt4([4]float32{1, 4, 16, 64}))
+	// Output:
+	// [1.0000 0.5000 0.2500 0.1250]
+}
+
+func ExampleFastInvSqrt2() {
+	fmt.Printf("%.6f\n", approx.FastInvSqrt2Prec([2]float64{2, 3}, approx.PrecisionHigh))
+	// Output:
+	// [0.707107 0.577350]
+}
+
+func ExampleFastLog() {
+	fmt.Printf("%.4f\n", approx.FastLog(math.E))
+	fmt.Printf("%.4f\n", approx.FastLogPrec(10.0, approx.PrecisionHigh))
+	// Output:
+	// 1.0000
+	// 2.3026
+}
+
+func ExampleFastExp() {
+	fmt.Printf("%.4f\n", approx.FastExp(1.0))
+	fmt.Printf("%.4f\n", approx.FastExpPrec(-2.0, approx.PrecisionHigh))
+	// Output:
+	// 2.7183
+	// 0.1353
+}
+
+func ExampleFastLog2() {
+	fmt.Println(approx.FastLog2(1024.0))
+	fmt.Printf("%.4f\n", approx.FastLog2Prec(3.0, approx.PrecisionHigh))
+	// Output:
+	// 10
+	// 1.5850
+}
+
+func ExampleFastExp2() {
+	fmt.Println(approx.FastExp2(10.0))
+	fmt.Printf("%.4f\n", approx.FastExp2Prec(0.5, approx.PrecisionHigh))
+	// Output:
+	// 1024
+	// 1.4142
+}
+
+func ExampleFastTanh() {
+	fmt.Printf("%.4f\n", approx.FastTanh(0.5))
+	fmt.Printf("%.6f\n", approx.FastTanhPrec(-1.5, approx.PrecisionHigh))
+	fmt.Println(approx.FastTanh(25.0))
+	// Output:
+	// 0.4621
+	// -0.905148
+	// 1
+}
+
+func ExampleFastSin() {
+	fmt.Printf("%.4f\n", approx.FastSin(math.Pi/6))
+	fmt.Printf("%.4f\n", approx.FastSinPrec(1.0, approx.PrecisionHigh))
+	// Output:
+	// 0.5000
+	// 0.8415
+}
+
+func ExampleFastCos() {
+	fmt.Printf("%.4f\n", approx.FastCos(math.Pi/3))
+	fmt.Printf("%.4f\n", approx.FastCosPrec(1.0, approx.PrecisionHigh))
+	// Output:
+	// 0.5000
+	// 0.5403
+}
+
+func ExampleFastSinPi() {
+	// sin(π·x) with exact argument reduction: integers give exact zeros.
+	fmt.Println(approx.FastSinPi(1e6))
+	fmt.Printf("%.4f\n", approx.FastSinPi(0.25))
+	// Output:
+	// 0
+	// 0.7071
+}
+
+func ExampleFastCosPi() {
+	fmt.Println(approx.FastCosPi(0.5))
+	fmt.Println(approx.FastCosPi(3.0))
+	// Output:
+	// 0
+	// -1
+}
+
+func ExampleFastSinTurns() {
+	// A quarter turn is 90°.
+	fmt.Println(approx.FastSinTurns(0.25))
+	fmt.Printf("%.4f\n", approx.FastSinTurnsPrec(1.0/12, approx.PrecisionHigh))
+	// Output:
+	// 1
+	// 0.5000
+}
+
+func ExampleFastCosTurns() {
+	fmt.Println(approx.FastCosTurns(0.5))
+	// Output:
+	// -1
+}
+
+func ExampleFastSinCos() {
+	s, c := approx.FastSinCos(math.Pi / 4)
+	fmt.Printf("%.4f %.4f\n", s, c)
+	// Output:
+	// 0.7071 0.7071
+}
+
+func ExampleFastSec() {
+	fmt.Printf("%.4f\n", approx.FastSec(math.Pi/3))
+	// Output:
+	// 2.0000
+}
+
+func ExampleFastCsc() {
+	fmt.Printf("%.4f\n", approx.FastCsc(math.Pi/6))
+	// Output:
+	// 2.0000
+}
+
+func ExampleFastTan() {
+	fmt.Printf("%.4f\n", approx.FastTanPrec(0.5, approx.PrecisionHigh))
+	// Output:
+	// 0.5463
+}
+
+func ExampleFastCotan() {
+	fmt.Printf("%.4f\n", approx.FastCotanPrec(0.5, approx.PrecisionHigh))
+	// Output:
+	// 1.8305
+}
+
+func ExampleFastArctan() {
+	fmt.Printf("%.4f\n", approx.FastArctan(0.2))
+	// Output:
+	// 0.1974
+}
+
+func ExampleFastArccotan() {
+	// Like FastArctan, the series kernels are meant for small |x|.
+	fmt.Printf("%.4f\n", approx.FastArccotan(0.2))
+	// Output:
+	// 1.3734
+}
+
+func ExampleFastArccos() {
+	fmt.Printf("%.4f\n", approx.FastArccos(0.5))
+	// Output:
+	// 1.0472
+}
+
+func ExampleFastArcsec() {
+	fmt.Printf("%.4f %.4f\n", approx.FastArcsec(2.0), approx.FastArccsc(2.0))
+	// Output:
+	// 1.0472 0.5236
+}
+
+func ExampleFastPower() {
+	fmt.Printf("%.4f\n", approx.FastPower(2.0, 0.5))
+	// Output:
+	// 1.4142
+}
+
+func ExampleFastPowerPrec() {
+	// A compound-interest factor multiplies the log error by the exponent.
+	fmt.Printf("%.10f\n", approx.FastPowerPrec(1.0001, 1e4, approx.PrecisionBalanced))
+	fmt.Printf("%.10f\n", approx.FastPowerPrec(1.0001, 1e4, approx.PrecisionHigh))
+	// Output:
+	// 2.7181435046
+	// 2.7181459268
+}
+
+func ExampleFastRoot() {
+	fmt.Printf("%.4f\n", approx.FastRoot(27.0, 3))
+	// Output:
+	// 3.0000
+}
+
+func ExampleFastInvRoot() {
+	// 1/∛27, and the 2.5th root of 32 at the default tier.
+	fmt.Printf("%.6f %.6f\n", approx.FastInvRoot(27.0, 3), approx.FastRootF(32.0, 2.5))
+	// Output:
+	// 0.333333 4.000020
+}
+
+func ExampleFastIntPower() {
+	fmt.Println(approx.FastIntPower(2.0, 10))
+	fmt.Println(approx.FastIntPower(2.0, -2))
+	// Output:
+	// 1024
+	// 0.25
+}
+
+func ExampleILogBase() {
+	fmt.Println(approx.ILogBase(999, 10), approx.ILogBase(1000, 10))
+	// Output:
+	// 2 3
+}
+
+func ExampleIsPerfectPower() {
+	fmt.Println(approx.IsPerfectPower(1024, 2))
+	fmt.Println(approx.IsPerfectPower(1000, 2))
+	// Output:
+	// 10 true
+	// 9 false
+}
+
+func ExampleFastLgamma() {
+	// ln Γ(0.5) = ln √π
+	fmt.Printf("%.6f\n", approx.FastLgammaPrec(0.5, approx.PrecisionHigh))
+	// Output:
+	// 0.572365
+}
+
+func ExampleFastLogFactorial() {
+	// 200! overflows float64; its logarithm does not.
+	fmt.Printf("%.4f\n", approx.FastLogFactorial(200))
+	// Output:
+	// 863.2320
+}
+
+func ExampleFastLogBinomial() {
+	// Number of 5-card poker hands.
+	fmt.Printf("%.0f\n", math.Exp(approx.FastLogBinomial(52, 5)))
+	// Output:
+	// 2598960
+}
+
+func ExampleFastLogBeta() {
+	// B(2, 3) = 1/12
+	fmt.Printf("%.6f\n", math.Exp(approx.FastLogBetaPrec(2.0, 3.0, approx.PrecisionHigh)))
+	// Output:
+	// 0.083333
+}
+
+func ExampleFastBetaInc() {
+	// Probability that a Beta(2, 5) variate is below 0.3.
+	fmt.Printf("%.4f\n", approx.FastBetaInc(2.0, 5.0, 0.3))
+	// Output:
+	// 0.5798
+}
+
+func ExampleFastPoissonCDF() {
+	// A service normally sees 4 errors per minute; is 12 in one minute unusual?
+	tail := 1 - approx.FastPoissonCDFPrec(11, 4, approx.PrecisionHigh)
+	fmt.Printf("P(X >= 12) = %.5f\n", tail)
+	// Output:
+	// P(X >= 12) = 0.00092
+}
+
+func ExampleFastBinomialCDF() {
+	// Probability of at most 3 failures in 100 requests with a 1% failure rate.
+	fmt.Printf("%.4f\n", approx.FastBinomialCDFPrec(3, 100, 0.01, approx.PrecisionHigh))
+	// Output:
+	// 0.9816
+}
+
+func ExampleFastPhiInv() {
+	// The 99% one-sided z-score, and a normal draw from a uniform one.
+	fmt.Printf("%.6f\n", approx.FastPhiInvPrec(0.99, approx.PrecisionHigh))
+	fmt.Printf("%.6f\n", approx.FastPhiInv(0.3))
+	// Output:
+	// 2.326348
+	// -0.524401
+}
+
+func ExampleNormCDFInto() {
+	// Tail probabilities of a batch of risk z-scores.
+	z := []float64{-3, -1.5, 0.5, 2.33}
+	p := make([]float64, len(z))
+	approx.NormCDFIntoPrec(p, z, approx.PrecisionHigh)
+	fmt.Printf("%.5f\n", p)
+	// Output:
+	// [0.00135 0.06681 0.69146 0.99010]
+}
+
+func ExampleSelfTest() {
+	// At service start-up, before serving traffic.
+	if err := approx.SelfTest(); err != nil {
+		fmt.Println("refusing to start:", err)
+		return
+	}
+
+	fmt.Println("kernels ok")
+	// Output:
+	// kernels ok
+}
+
+func ExampleFastHypot() {
+	fmt.Printf("%.4f\n", approx.FastHypot(3.0, 4.0))
+	// Scaling avoids overflow of the intermediate squares.
+	fmt.Printf("%.4e\n", approx.FastHypot(3e200, 4e200))
+	// Output:
+	// 5.0000
+	// 5.0000e+200
+}
+
+func ExampleFastLogAddExp() {
+	// log(e^1000 + e^1000) without overflow.
+	fmt.Printf("%.4f\n", approx.FastLogAddExp(1000.0, 1000.0))
+	// Output:
+	// 1000.6931
+}
+
+func ExampleFastGELUIntoClamp() {
+	x := []float64{-4, 0.5, 3, 40}
+	approx.FastGELUIntoClamp(x, x, approx.PrecisionHigh, approx.Clamp[float64]{Lo: -1, Hi: 10})
+	fmt.Printf("%.4f\n", x)
+	// Output:
+	// [-0.0001 0.3457 2.9964 10.0000]
+}
+
+func ExampleFastLogAddExpInto() {
+	dst := make([]float64, 2)
+	approx.FastLogAddExpInto(dst, []float64{0, -1}, []float64{0, 2}, approx.PrecisionHigh)
+	fmt.Printf("%.4f\n", dst)
+	// Output:
+	// [0.6931 2.0486]
+}
+
+func ExampleSample() {
+	for _, p := range approx.Sample(approx.FuncExp, approx.PrecisionFast, 0, 2, 3) {
+		fmt.Printf("x=%.1f approx=%.5f ref=%.5f err=%.1e\n", p.X, p.Approx, p.Ref, p.RelErr)
+	}
+	// Output:
+	// x=0.0 approx=1.00000 ref=1.00000 err=0.0e+00
+	// x=1.0 approx=2.71750 ref=2.71828 err=2.9e-04
+	// x=2.0 approx=7.38904 ref=7.38906 err=1.8e-06
+}
+
+func ExampleFastLogRatio() {
+	// Log-likelihood ratio of two close probabilities.
+	fmt.Printf("%.6e\n", approx.FastLogRatio(0.500001, 0.5))
+	// Output:
+	// 1.999998e-06
+}
+
+func ExampleFastRatioToCents() {
+	// A perfect fifth is about 702 cents.
+	fmt.Printf("%.2f\n", approx.FastRatioToCents(1.5))
+	// Output:
+	// 701.96
+}
+
+func ExampleFastCentsToRatio() {
+	fmt.Println(approx.FastCentsToRatio(1200.0))
+	fmt.Printf("%.4f\n", approx.FastCentsToRatio(100.0))
+	// Output:
+	// 2
+	// 1.0595
+}
+
+func ExampleFastRatioToCentsInto() {
+	cents := make([]float64, 3)
+	approx.FastRatioToCentsInto(cents, []float64{1, 2, 4}, approx.PrecisionBalanced)
+	fmt.Println(cents)
+	// Output:
+	// [0 1200 2400]
+}
+
+func ExampleFastCentsToRatioInto() {
+	ratios := make([]float64, 3)
+	approx.FastCentsToRatioInto(ratios, []float64{-1200, 0, 2400}, approx.PrecisionBalanced)
+	fmt.Println(ratios)
+	// Output:
+	// [0.5 1 4]
+}
+
+func ExampleFastRoundToDigits() {
+	fmt.Println(approx.FastRoundToDigits(123456.789, 3))
+	fmt.Println(approx.FastRoundToDigits(0.000123456, 2))
+	// Output:
+	// 123000
+	// 0.00012
+}
+
+func ExampleFastQuantize() {
+	fmt.Println(approx.FastQuantize(0.37, 0.25))
+	// Output:
+	// 0.25
+}
+
+func ExampleFastRoundToDigitsInto() {
+	dst := make([]float64, 2)
+	approx.FastRoundToDigitsInto(dst, []float64{math.Pi, math.E}, 3)
+	fmt.Println(dst)
+	// Output:
+	// [3.14 2.72]
+}
+
+func ExampleFastRoundDecimal() {
+	// Prices to cents. The double nearest 2.675 lies just below it, which
+	// only High resolves exactly.
+	fmt.Println(approx.FastRoundDecimal(19.999, 2), approx.FastRoundDecimal(2.675, 2))
+	fmt.Println(approx.FastRoundDecimalPrec(2.675, 2, approx.PrecisionHigh))
+	fmt.Println(approx.FastTruncDecimal(-1.239, 2), approx.FastRoundDecimal(1234.5, -2))
+	// Output:
+	// 20 2.68
+	// 2.67
+	// -1.23 1200
+}
+
+func ExampleFastFtoa() {
+	// Byte for byte what strconv.FormatFloat(x, 'g', digits, 64) prints,
+	// ties to even included.
+	fmt.Println(approx.FastFtoa(21.498751, 6), approx.FastFtoa(0.000731234, 3), approx.FastFtoa(1234567.0, 6))
+
+	line := approx.AppendFastFtoa([]byte("latency_ms="), 2.5, 1)
+	fmt.Println(string(line))
+	// Output:
+	// 21.4988 0.000731 1.23457e+06
+	// latency_ms=2
+}
+
+func ExampleFastQuantizeInto() {
+	dst := make([]float64, 3)
+	approx.FastQuantizeInto(dst, []float64{0.1, 0.6, 1.4}, 0.5)
+	fmt.Println(dst)
+	// Output:
+	// [0 0.5 1.5]
+}
+
+func ExampleAngleBetween2() {
+	fmt.Printf("%.4f\n", approx.AngleBetween2([2]float64{1, 0}, [2]float64{1, 1}))
+	// Output:
+	// 0.7854
+}
+
+func ExampleAngleBetween3() {
+	fmt.Printf("%.4f\n", approx.AngleBetween3([3]float64{1, 0, 0}, [3]float64{0, 0, 2}))
+	// Output:
+	// 1.5708
+}
+
+func ExampleSlerp3() {
+	v := approx.Slerp3Prec([3]float64{1, 0, 0}, [3]float64{0, 1, 0}, 0.5, approx.PrecisionHigh)
+	fmt.Printf("%.4f\n", v)
+	// Output:
+	// [0.7071 0.7071 0.0000]
+}
+
+func ExampleNlerp3() {
+	v := approx.Nlerp3Prec([3]float64{1, 0, 0}, [3]float64{0, 1, 0}, 0.5, approx.PrecisionHigh)
+	fmt.Printf("%.4f\n", v)
+	// Output:
+	// [0.7071 0.7071 0.0000]
+}
+
+func ExampleGivensRotation() {
+	c, s, r := approx.GivensRotationPrec(3.0, 4.0, approx.PrecisionHigh)
+	fmt.Printf("%.4f %.4f %.4f\n", c, s, r)
+	// Output:
+	// 0.6000 0.8000 5.0000
+}
+
+func ExampleJacobiEigen2() {
+	values, _ := approx.JacobiEigen2Prec([2][2]float64{{2, 1}, {1, 2}}, approx.PrecisionHigh)
+	fmt.Printf("%.4f\n", values)
+	// Output:
+	// [1.0000 3.0000]
+}
+
+func ExampleExpm2x2() {
+	// State-transition matrix of a damped oscillator, x'' = -4x - 0.4x',
+	// over one 10 ms control period.
+	const dt = 0.01
+	phi := approx.Expm2x2Prec([2][2]float64{{0, dt}, {-4 * dt, -0.4 * dt}}, approx.PrecisionHigh)
+	fmt.Printf("%.6f\n", phi)
+	// Output:
+	// [[0.999800 0.009979] [-0.039917 0.995809]]
+}
+
+func ExampleRK4Step() {
+	// Newtonian cooling towards a room whose temperature swings daily:
+	// T' = -k (T - 20 - 3 sin(2πt/24)), t in hours.
+	const k = 0.3
+
+	cooling := func(t, temp float64) float64 {
+		return -k * (temp - 20 - 3*approx.FastSinTurns(t/24))
+	}
+
+	t, temp := 0.0, 90.0
+	for range 48 * 60 {
+		temp = approx.RK4Step(cooling, t, temp, 1.0/60)
+		t += 1.0 / 60
+	}
+
+	fmt.Printf("%.2f °C after two days\n", temp)
+	// Output:
+	// 18.51 °C after two days
+}
+
+func ExampleRK4Step2() {
+	// A damped pendulum, θ'' = -(g/L) sin θ - c θ', released at 60°.
+	pendulum := func(_ float64, y approx.Vec2[float64]) approx.Vec2[float64] {
+		return approx.Vec2[float64]{y[1], -9.81*approx.FastSin(y[0]) - 0.5*y[1]}
+	}
+
+	y := approx.Vec2[float64]{math.Pi / 3, 0}
+	for i := range 1000 {
+		y = approx.RK4Step2(pendulum, float64(i)*0.001, y, 0.001)
+	}
+
+	fmt.Printf("after 1 s: θ = %.3f rad, ω = %.3f rad/s\n", y[0], y[1])
+	// Output:
+	// after 1 s: θ = -0.778 rad, ω = -0.459 rad/s
+}
+
+func ExampleJacobiEigen3() {
+	m := [3][3]float64{{2, 0, 0}, {0, 3, 4}, {0, 4, 9}}
+	values, _ := approx.JacobiEigen3Prec(m, 6, approx.PrecisionHigh)
+	fmt.Printf("%.4f\n", values)
+	// Output:
+	// [1.0000 2.0000 11.0000]
+}
+
+func ExampleSymEigen2() {
+	values, _ := approx.SymEigen2Prec([2][2]float64{{4, 0}, {0, 1}}, approx.PrecisionHigh)
+	fmt.Printf("%.4f\n", values)
+	// Output:
+	// [1.0000 4.0000]
+}
+
+func ExampleSymEigen3() {
+	values, _ := approx.SymEigen3Prec([3][3]float64{{2, 0, 0}, {0, 3, 4}, {0, 4, 9}}, approx.PrecisionHigh)
+	fmt.Printf("%.4f\n", values)
+	// Output:
+	// [1.0000 2.0000 11.0000]
+}
+
+func ExampleSymSqrt2() {
+	fmt.Printf("%.4f\n", approx.SymSqrt2Prec([2][2]float64{{4, 0}, {0, 9}}, approx.PrecisionHigh))
+	// Output:
+	// [[2.0000 0.0000] [0.0000 3.0000]]
+}
+
+func ExampleSymInvSqrt2() {
+	fmt.Printf("%.4f\n", approx.SymInvSqrt2Prec([2][2]float64{{4, 0}, {0, 16}}, approx.PrecisionHigh))
+	// Output:
+	// [[0.5000 0.0000] [0.0000 0.2500]]
+}
+
+func ExampleSymSqrt3() {
+	fmt.Printf("%.4f\n", approx.SymSqrt3Prec([3][3]float64{{1, 0, 0}, {0, 4, 0}, {0, 0, 9}}, approx.PrecisionHigh))
+	// Output:
+	// [[1.0000 0.0000 0.0000] [0.0000 2.0000 0.0000] [0.0000 0.0000 3.0000]]
+}
+
+func ExampleSymInvSqrt3() {
+	fmt.Printf("%.4f\n", approx.SymInvSqrt3Prec([3][3]float64{{1, 0, 0}, {0, 4, 0}, {0, 0, 16}}, approx.PrecisionHigh))
+	// Output:
+	// [[1.0000 0.0000 0.0000] [0.0000 0.5000 0.0000] [0.0000 0.0000 0.2500]]
+}
+
+func ExampleVec3_Normalize() {
+	v := approx.Vec3[float64]{3, 0, 4}
+	fmt.Printf("%.4f\n", v.NormalizePrec(approx.PrecisionHigh))
+	// Output:
+	// [0.6000 0.0000 0.8000]
+}
+
+func ExampleVec3_Cross() {
+	x := approx.Vec3[float64]{1, 0, 0}
+	y := approx.Vec3[float64]{0, 1, 0}
+	fmt.Println(x.Cross(y))
+	// Output:
+	// [0 0 1]
+}
+
+func ExampleVec2_Length() {
+	fmt.Printf("%.4f\n", approx.Vec2[float64]{3, 4}.Length())
+	// Output:
+	// 5.0000
+}
+
+func ExampleVec4_Dot() {
+	a := approx.Vec4[float32]{1, 2, 3, 4}
+	fmt.Println(a.Dot(a))
+	// Output:
+	// 30
+}
+
+func ExampleEqual() {
+	fmt.Println(approx.Equal(1.0, 1.0+1e-9))
+	fmt.Println(approx.Equal(0.0, 1e-12))
+	fmt.Println(approx.Equal(0.0, 1e-12, approx.WithAbsTol(1e-9)))
+	fmt.Println(approx.Equal(float32(1), math.Nextafter32(1, 2), approx.WithULP(1)))
+	fmt.Println(approx.Equal(math.NaN(), math.NaN(), approx.WithNaNEqual()))
+	// Output:
+	// true
+	// false
+	// true
+	// true
+	// true
+}
+
+func ExampleEqualSlice() {
+	fmt.Println(approx.EqualSlice([]float64{1, 2, 3}, []float64{1, 2, 3.1}))
+	// Output:
+	// 2 false
+}
+
+func ExampleEqualMatrix() {
+	a := [][]float64{{1, 2}, {3, 4}}
+	b := [][]float64{{1, 2}, {3, 4 + 1e-12}}
+	fmt.Println(approx.EqualMatrix(a, b, approx.WithRelTol(1e-9)))
+	// Output:
+	// -1 -1 true
+}
+
+func ExampleLogBucketIndex() {
+	fmt.Println(approx.LogBucketIndex(1000, 2))
+	fmt.Println(approx.LogBucketIndex(1000, 10))
+	// Output:
+	// 9
+	// 2
+}
+
+func ExampleLogHistogram() {
+	h, err := approx.NewLogHistogram(1.1)
+	if err != nil {
+		panic(err)
+	}
+
+	for i := 1; i <= 1000; i++ {
+		h.Add(float64(i))
+	}
+
+	fmt.Println(h.Count())
+	fmt.Printf("p50 ≈ %.0f\n", h.Quantile(0.5))
+	fmt.Printf("p99 ≈ %.0f\n", h.Quantile(0.99))
+	// Output:
+	// 1000
+	// p50 ≈ 514
+	// p99 ≈ 1002
+}
+
+func ExampleRollingQuantile() {
+	// p99 latency over a sliding horizon of roughly the last few thousand
+	// requests.
+	r, err := approx.NewRollingQuantile(0.99, 1000)
+	if err != nil {
+		panic(err)
+	}
+
+	for i := range 20000 {
+		r.Add(float64(i%100) + 1)
+	}
+
+	fmt.Printf("p99 ≈ %.0f\n", r.Quantile())
+	// Output:
+	// p99 ≈ 99
+}
+
+func ExampleSeeded() {
+	r := approx.Seeded(7)
+	fmt.Println(r.Float64())
+	// Output:
+	// 0.9153469951474317
+}
+
+func ExampleSeededStream() {
+	a := approx.SeededStream(42, 0).Uint64()
+	b := approx.SeededStream(42, 1).Uint64()
+	fmt.Println(a != b, a == approx.SeededStream(42, 0).Uint64())
+	// Output:
+	// true true
+}
+
+func ExampleNewSinTable() {
+	tab, err := approx.NewSinTable(4096)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%.5f %.5f\n", tab.Sin(1), tab.Cos(1))
+	// Output:
+	// 0.84147 0.54030
+}
+
+func ExamplePreload() {
+	// Build the tables at startup instead of on the first request.
+	if err := approx.Preload(1024, 4096); err != nil {
+		panic(err)
+	}
+
+	_, err := approx.NewSinTable(1000)
+	fmt.Println(errors.Is(err, approx.ErrDomainError))
+	// Output:
+	// true
+}
+
+func ExampleDescribe() {
+	md := approx.Describe(approx.FuncArcsec)
+	fmt.Println(md.Entry, md.Domain, md.Codomain, md.Tiers)
+	// Output:
+	// FastArcsec [(-Inf, -1] [1, +Inf)] [[0, π]] [fast balanced high]
+}
+
+func ExamplePrecision_String() {
+	fmt.Println(approx.PrecisionAuto, approx.PrecisionFast, approx.PrecisionHigh)
+	fmt.Println(approx.Precision(42).IsValid())
+	// Output:
+	// auto fast high
+	// false
+}
+
+// Normal variates by the Box–Muller transform, built from FastLog, FastSqrt
+// and FastSinCos and seeded for reproducibility.
+func Example_normalSampling() {
+	r := approx.Seeded(1)
+
+	const n = 100000
+
+	var sum, sumSq float64
+
+	for range n / 2 {
+		u1, u2 := 1-r.Float64(), r.Float64() // u1 in (0, 1]
+		radius := approx.FastSqrt(-2 * approx.FastLog(u1))
+		s, c := approx.FastSinCos(2 * math.Pi * u2)
+
+		for _, z := range [2]float64{radius * c, radius * s} {
+			sum += z
+			sumSq += z * z
+		}
+	}
+
+	mean := sum / n
+	fmt.Printf("mean %.2f, stddev %.2f\n", math.Abs(mean), math.Sqrt(sumSq/n-mean*mean))
+	// Output:
+	// mean 0.00, stddev 1.00
+}
+
+// Normalizing a batch of direction vectors, e.g. mesh normals.
+func Example_vectorNormalization() {
+	normals := []approx.Vec3[float32]{{0, 3, 4}, {1, 1, 1}, {0, 0, 0}}
+	for i, n := range normals {
+		normals[i] = n.Normalize()
+	}
+
+	for _, n := range normals {
+		fmt.Printf("%.3f  |n| = %.3f\n", n, n.Length())
+	}
+	// Output:
+	// [0.000 0.600 0.800]  |n| = 1.000
+	// [0.577 0.577 0.577]  |n| = 1.000
+	// [0.000 0.000 0.000]  |n| = 0.000
+}
+
+func ExampleSampleCategorical() {
+	logits := []float64{2.0, 1.0, 0.1, -1.0}
+	r := approx.Seeded(42)
+
+	counts := make([]int, len(logits))
+	for range 10000 {
+		counts[approx.SampleCategorical(logits, 0.7, r)]++
+	}
+
+	fmt.Println(counts)
+	// Temperature 0 is greedy decoding.
+	fmt.Println(approx.SampleCategorical(logits, 0, r))
+	// Output:
+	// [7639 1747 501 113]
+	// 0
+}
+
+func ExampleLayerNorm() {
+	x := []float64{1, 2, 3, 4}
+	approx.LayerNorm(x, x, nil, nil, 1e-5)
+
+	fmt.Printf("%.3f\n", x)
+	// Output:
+	// [-1.342 -0.447 0.447 1.342]
+}
+
+func ExampleFastGELUInto() {
+	x := []float32{-2, -1, 0, 1, 2}
+	approx.FastGELUInto(x, x, approx.PrecisionBalanced)
+
+	fmt.Printf("%.4f\n", x)
+	// Output:
+	// [-0.0454 -0.1588 0.0000 0.8412 1.9546]
+}
+
+func ExampleQuantizeInt8() {
+	weights := []float32{-0.8, -0.1, 0, 0.3, 1.2}
+	scale, zp := approx.CalibrateInt8MinMax(weights)
+
+	q := make([]int8, len(weights))
+	approx.QuantizeInt8(q, weights, scale, zp)
+
+	back := make([]float32, len(q))
+	approx.DequantizeInt8(back, q, scale, zp)
+
+	fmt.Println(q, zp)
+	fmt.Printf("%.3f\n", back)
+	// Output:
+	// [-128 -39 -26 12 127] -26
+	// [-0.800 -0.102 0.000 0.298 1.200]
+}
+
+func ExampleEvaluator() {
+	// A library function that accepts any math provider.
+	rms := func(e approx.Evaluator, xs []float64) float64 {
+		sum := 0.0
+		for _, x := range xs {
+			sum += x * x
+		}
+
+		return e.Sqrt(sum / float64(len(xs)))
+	}
+
+	xs := []float64{3, 4, 12}
+	fast, _ := approx.LookupEvaluator("fast")
+
+	fmt.Printf("%.4f %.4f\n", rms(fast, xs), rms(approx.Stdlib(), xs))
+	// Output:
+	// 7.5056 7.5056
+}
+
+func ExampleWithContext() {
+	// Deep inside request handling, code asks the context for its provider.
+	score := func(ctx context.Context, x float64) float64 {
+		return approx.FromContext(ctx).Evaluator.Exp(-x * x)
+	}
+
+	fastCtx := approx.WithContext(context.Background(), approx.Config{Precision: approx.PrecisionFast})
+	preciseCtx := approx.WithContext(context.Background(), approx.Config{Evaluator: approx.Stdlib()})
+
+	fmt.Printf("%.6f\n%.6f\n", score(fastCtx, 0.5), score(preciseCtx, 0.5))
+	// Output:
+	// 0.778646
+	// 0.778801
+}
+
+func ExamplePrecisionInfo() {
+	// Pick the cheapest tier giving at least five digits.
+	for _, prec := range []approx.Precision{approx.PrecisionFast, approx.PrecisionBalanced, approx.PrecisionHigh} {
+		info := approx.PrecisionInfo(approx.FuncSin, prec)
+		if info.Digits >= 5 {
+			fmt.Printf("%v: %d terms, cost %.1fx, %.1f digits\n", info.Precision, info.Terms, info.Cost, info.Digits)
+
+			break
+		}
+	}
+	// Output:
+	// balanced: 5 terms, cost 1.7x, 5.3 digits
+}
+
+func ExampleFastHav() {
+	// Haversine distance between two points about 6 cm apart on a sphere of
+	// Earth's radius, where 1 - cos of the latitude difference is already 0.
+	const r = 6371e3
+
+	lat1, lat2 := 0.8, 0.8+1e-8
+	dLon := 0.0
+
+	h := approx.FastHav(lat2-lat1) + approx.FastCos(lat1)*approx.FastCos(lat2)*approx.FastHav(dLon)
+	d := 2 * r * math.Asin(approx.FastSqrtPrec(h, approx.PrecisionHigh))
+
+	fmt.Printf("%.4f m, naive versine %g\n", d, 1-approx.FastCos(lat2-lat1))
+	// Output:
+	// 0.0637 m, naive versine 0
+}
+
+func ExampleSinSumOf() {
+	// An object caches the sine and cosine of its heading; turning it by a
+	// small delta needs only the delta's pair.
+	sinH, cosH := approx.FastSinCos(1.2)
+	sinD, cosD := approx.FastSinCos(0.01)
+
+	sinH, cosH = approx.SinSumOf(sinH, cosH, sinD, cosD), approx.CosSumOf(sinH, cosH, sinD, cosD)
+	fmt.Printf("%.6f %.6f\n", sinH, cosH)
+	// Output:
+	// 0.935616 0.353019
+}
+
+func ExampleBudgetSplit() {
+	// pow(x, y) as exp(y·log(x)) within a relative error of 1e-4.
+	ops := []approx.Function{approx.FuncLog, approx.FuncExp}
+	tiers := approx.BudgetSplit(1e-4, ops)
+
+	fmt.Println(tiers, approx.BudgetError(ops, tiers))
+	// Output:
+	// [balanced balanced] 1.9e-05
+}
+
+func ExampleGeometricMean() {
+	// Growth factors of four periods; their product would be the total.
+	growth := []float64{1.10, 0.95, 1.20, 1.05}
+	fmt.Printf("%.4f\n", approx.GeometricMean(growth))
+	fmt.Printf("%.4f\n", approx.LogMeanExp([]float64{-1000, -1000.5}))
+	// Output:
+	// 1.0712
+	// -1000.2191
+}
+
+func ExampleRescale() {
+	// Map raw sensor counts onto [-1, 1] and squash them in the same chunk
+	// of a RunBatch kernel, while the data is still in cache.
+	raw := []float64{0, 256, 512, 768, 1024}
+	lo, hi := approx.MinMax(raw)
+	out := make([]float64, len(raw))
+
+	_, _ = approx.RunBatch(context.Background(), out, raw, func(d, s []float64) {
+		approx.Rescale(d, s, lo, hi, -1, 1)
+		approx.FastSiLUInto(d, d, approx.PrecisionHigh)
+	}, approx.BatchOptions{})
+
+	fmt.Println(lo, hi)
+	fmt.Printf("%.4f\n", out)
+	// Output:
+	// 0 1024
+	// [-0.2689 -0.1888 0.0000 0.3112 0.7311]
+}
+
+func ExamplePow10() {
+	fmt.Println(approx.Pow10(-286), math.Pow10(-286))
+	fmt.Println(approx.Pow10(22), approx.Pow2(-3), approx.Pow2(-1074))
+	// Output:
+	// 1e-286 9.999999999999999e-287
+	// 1e+22 0.125 5e-324
+}
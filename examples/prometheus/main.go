@@ -0,0 +1,56 @@
+// Command prometheus shows how to export the approx usage statistics to
+// Prometheus: it installs a Sink with approx.SetMetricsSink, runs a small
+// batch workload in the background and serves the statistics for scraping
+// on /metrics:
+//
+//	prometheus -addr :9100
+//	curl localhost:9100/metrics
+//
+// Sink writes the Prometheus text exposition format itself, so the example
+// needs nothing beyond the standard library. A service that already uses
+// github.com/prometheus/client_golang would instead implement
+// approx.MetricsSink with a CounterVec and a SummaryVec, registered once per
+// metric name.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func main() {
+	addr := flag.String("addr", ":9100", "listen address")
+	flag.Parse()
+
+	sink := NewSink()
+	approx.SetMetricsSink(sink)
+
+	go workload(approx.Instrument(approx.NewEvaluator(approx.PrecisionBalanced), nil))
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", sink)
+
+	srv := &http.Server{Addr: *addr, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+
+	log.Printf("serving metrics on %s/metrics", *addr)
+	log.Fatal(srv.ListenAndServe())
+}
+
+// workload stands in for a real service: every second it transforms a batch
+// and makes a few Evaluator calls, both of which show up in the metrics.
+func workload(e approx.Evaluator) {
+	buf := make([]float64, 4096)
+
+	for range time.Tick(time.Second) {
+		_, _ = approx.RunBatch(context.Background(), buf, buf, func(d, s []float64) {
+			approx.FastGELUInto(d, s, approx.PrecisionFast)
+		}, approx.BatchOptions{})
+
+		_ = e.Exp(1) + e.Log(2)
+	}
+}
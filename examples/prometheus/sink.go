@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// Sink is an approx.MetricsSink that keeps every Count as a Prometheus
+// counter and every Observe as a summary without quantiles (a _sum and a
+// _count series), and serves them as an http.Handler in the text exposition
+// format.
+type Sink struct {
+	mu        sync.Mutex
+	counters  map[string]uint64
+	summaries map[string]summary
+}
+
+type summary struct {
+	sum   float64
+	count uint64
+}
+
+var _ approx.MetricsSink = (*Sink)(nil)
+
+// NewSink returns an empty Sink.
+func NewSink() *Sink {
+	return &Sink{counters: map[string]uint64{}, summaries: map[string]summary{}}
+}
+
+// Count adds one to the counter name.
+func (s *Sink) Count(name string) {
+	s.mu.Lock()
+	s.counters[name]++
+	s.mu.Unlock()
+}
+
+// Observe adds v to the summary name.
+func (s *Sink) Observe(name string, v float64) {
+	s.mu.Lock()
+	sm := s.summaries[name]
+	sm.sum += v
+	sm.count++
+	s.summaries[name] = sm
+	s.mu.Unlock()
+}
+
+// ServeHTTP writes the metrics in name order. Counters get the _total
+// suffix Prometheus expects.
+func (s *Sink) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	counters, summaries := maps.Clone(s.counters), maps.Clone(s.summaries)
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+
+	for _, name := range slices.Sorted(maps.Keys(counters)) {
+		fmt.Fprintf(w, "# TYPE %s_total counter\n%s_total %d\n", name, name, counters[name])
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(summaries)) {
+		sm := summaries[name]
+		fmt.Fprintf(w, "# TYPE %s summary\n%s_sum %s\n%s_count %d\n",
+			name, name, strconv.FormatFloat(sm.sum, 'g', -1, 64), name, sm.count)
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func TestSinkServesTextFormat(t *testing.T) {
+	t.Parallel()
+
+	s := NewSink()
+	s.Count("approx_batch_canceled")
+	s.Count("approx_batch_canceled")
+	s.Observe("approx_batch_elements", 10)
+	s.Observe("approx_batch_elements", 0.5)
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	want := `# TYPE approx_batch_canceled_total counter
+approx_batch_canceled_total 2
+# TYPE approx_batch_elements summary
+approx_batch_elements_sum 10.5
+approx_batch_elements_count 2
+`
+	if got := rec.Body.String(); got != want {
+		t.Errorf("body:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestSinkReceivesPackageMetrics(t *testing.T) {
+	s := NewSink()
+	approx.SetMetricsSink(s)
+
+	defer approx.SetMetricsSink(nil)
+
+	buf := make([]float64, 8)
+	_, _ = approx.RunBatch(context.Background(), buf, buf, func(d, s []float64) { copy(d, s) }, approx.BatchOptions{})
+	_ = approx.Instrument(approx.Stdlib(), nil).Exp(0)
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	for _, line := range []string{"approx_evaluator_calls_exp_total 1", "approx_batch_elements_sum 8"} {
+		if !strings.Contains(rec.Body.String(), line) {
+			t.Errorf("metrics lack %q:\n%s", line, rec.Body.String())
+		}
+	}
+}
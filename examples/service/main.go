@@ -0,0 +1,72 @@
+// Command service is an example HTTP service that evaluates batches of
+// values with the approx slice APIs, for server-side feature transformation.
+//
+// Every endpoint takes a JSON array under "values" and returns the results in
+// the same shape:
+//
+//	curl -d '{"values":[0,1,2]}' localhost:8080/exp
+//	curl -d '{"values":[1,2,3]}' 'localhost:8080/log?precision=high'
+//
+// The endpoints are /exp, /log, /sqrt, /sin, /cos, /gelu and /softmax. The
+// tier of each function comes from an approx.Profile, for example one written
+// by cmd/approx-tune; a precision query parameter overrides it per request.
+// Large batches are split across -workers goroutines, although JSON
+// encoding dominates the cost of a request (see BenchmarkExpEndpoint); a
+// binary body format is the next step for a production service.
+//
+//	service -addr :8080 -profile tiers.json -workers 8
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"runtime"
+	"time"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "listen address")
+	profilePath := flag.String("profile", "", "approx.Profile JSON file selecting the tier per function")
+	workers := flag.Int("workers", runtime.GOMAXPROCS(0), "goroutines per large batch")
+	maxValues := flag.Int("max-values", 1<<20, "largest accepted batch")
+
+	flag.Parse()
+
+	profile, err := loadProfile(*profilePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           newServer(profile, *workers, *maxValues),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	log.Printf("listening on %s", *addr)
+	log.Fatal(srv.ListenAndServe())
+}
+
+func loadProfile(path string) (approx.Profile, error) {
+	if path == "" {
+		return approx.Profile{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var p approx.Profile
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("profile %s: %w", path, err)
+	}
+
+	return p, nil
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"sync"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// batch is the request and response body of every endpoint.
+type batch struct {
+	Values []float64 `json:"values"`
+}
+
+// endpoint evaluates one function over a batch. fn is 0 for functions
+// outside the approx.Function registry, which then use the default tier and
+// accept any input.
+type endpoint struct {
+	fn   approx.Function
+	eval func(dst, src []float64, prec approx.Precision)
+
+	// split reports whether the batch may be divided among workers; a
+	// softmax must see the whole batch.
+	split bool
+}
+
+// scalar lifts a scalar entry point to a batch evaluator.
+func scalar(f func(float64, approx.Precision) float64) func(dst, src []float64, prec approx.Precision) {
+	return func(dst, src []float64, prec approx.Precision) {
+		for i, x := range src {
+			dst[i] = f(x, prec)
+		}
+	}
+}
+
+//nolint:gochecknoglobals // read-only routing table
+var endpoints = map[string]endpoint{
+	"exp":  {fn: approx.FuncExp, eval: scalar(approx.FastExpPrec[float64]), split: true},
+	"log":  {fn: approx.FuncLog, eval: scalar(approx.FastLogPrec[float64]), split: true},
+	"sqrt": {fn: approx.FuncSqrt, eval: scalar(approx.FastSqrtPrec[float64]), split: true},
+	"sin":  {fn: approx.FuncSin, eval: scalar(approx.FastSinPrec[float64]), split: true},
+	"cos":  {fn: approx.FuncCos, eval: scalar(approx.FastCosPrec[float64]), split: true},
+	"gelu": {eval: approx.FastGELUInto[float64], split: true},
+	"softmax": {eval: func(dst, src []float64, prec approx.Precision) {
+		approx.ScaledSoftmaxRowsPrec(dst, src, 1, len(src), 1, prec)
+	}},
+}
+
+// minChunk is the smallest share of a batch worth a goroutine of its own.
+const minChunk = 8 << 10
+
+type server struct {
+	profile   approx.Profile
+	workers   int
+	maxValues int
+}
+
+func newServer(profile approx.Profile, workers, maxValues int) http.Handler {
+	s := &server{profile: profile, workers: max(workers, 1), maxValues: maxValues}
+	mux := http.NewServeMux()
+
+	for name, ep := range endpoints {
+		mux.HandleFunc("POST /"+name, func(w http.ResponseWriter, r *http.Request) { s.serve(w, r, ep) })
+	}
+
+	return mux
+}
+
+func (s *server) serve(w http.ResponseWriter, r *http.Request, ep endpoint) {
+	prec := s.profile.Precision(ep.fn)
+
+	if q := r.URL.Query().Get("precision"); q != "" {
+		if err := prec.UnmarshalText([]byte(q)); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	var in batch
+
+	// 32 bytes per value is generous for JSON numbers.
+	body := http.MaxBytesReader(w, r.Body, int64(s.maxValues)*32+64)
+	if err := json.NewDecoder(body).Decode(&in); err != nil {
+		http.Error(w, "decoding request: "+err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	if len(in.Values) > s.maxValues {
+		http.Error(w, fmt.Sprintf("batch of %d values exceeds %d", len(in.Values), s.maxValues), http.StatusRequestEntityTooLarge)
+
+		return
+	}
+
+	if ep.fn != 0 {
+		md := approx.Describe(ep.fn)
+		for i, x := range in.Values {
+			if !md.InDomain(x) {
+				http.Error(w, fmt.Sprintf("values[%d] = %g is outside the domain %v of %v", i, x, md.Domain, ep.fn), http.StatusUnprocessableEntity)
+
+				return
+			}
+		}
+	}
+
+	out := batch{Values: make([]float64, len(in.Values))}
+	s.evaluate(ep, out.Values, in.Values, prec)
+
+	for i, y := range out.Values {
+		if math.IsNaN(y) || math.IsInf(y, 0) {
+			http.Error(w, fmt.Sprintf("values[%d]: result %g is not representable in JSON", i, y), http.StatusUnprocessableEntity)
+
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(out); err != nil {
+		log.Printf("writing %s response: %v", r.URL.Path, err)
+	}
+}
+
+// evaluate runs ep over src, splitting large batches into contiguous chunks
+// evaluated by up to s.workers goroutines.
+func (s *server) evaluate(ep endpoint, dst, src []float64, prec approx.Precision) {
+	workers := min(s.workers, len(src)/minChunk)
+	if !ep.split || workers <= 1 {
+		ep.eval(dst, src, prec)
+
+		return
+	}
+
+	per := (len(src) + workers - 1) / workers
+
+	var wg sync.WaitGroup
+
+	for lo := 0; lo < len(src); lo += per {
+		hi := min(lo+per, len(src))
+
+		wg.Go(func() { ep.eval(dst[lo:hi], src[lo:hi], prec) })
+	}
+
+	wg.Wait()
+}
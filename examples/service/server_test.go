@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func post(t testing.TB, h http.Handler, target string, values []float64) (*httptest.ResponseRecorder, []float64) {
+	t.Helper()
+
+	body, err := json.Marshal(batch{Values: values})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, target, bytes.NewReader(body)))
+
+	var out batch
+	if rec.Code == http.StatusOK {
+		if err := json.Unmarshal(rec.Body.Bytes(), &out); err != nil {
+			t.Fatalf("decoding %q: %v", rec.Body.String(), err)
+		}
+	}
+
+	return rec, out.Values
+}
+
+func TestEndpointsUseProfileAndOverride(t *testing.T) {
+	t.Parallel()
+
+	h := newServer(approx.Profile{approx.FuncExp: approx.PrecisionFast}, 1, 1000)
+	xs := []float64{-1, 0.5, 3}
+
+	_, got := post(t, h, "/exp", xs)
+	for i, x := range xs {
+		if want := approx.FastExpPrec(x, approx.PrecisionFast); got[i] != want {
+			t.Fatalf("/exp[%d] = %v, want the profile's fast tier %v", i, got[i], want)
+		}
+	}
+
+	_, got = post(t, h, "/exp?precision=high", xs)
+	for i, x := range xs {
+		if want := approx.FastExpPrec(x, approx.PrecisionHigh); got[i] != want {
+			t.Fatalf("/exp?precision=high [%d] = %v, want %v", i, got[i], want)
+		}
+	}
+
+	_, got = post(t, h, "/softmax", xs)
+	if sum := got[0] + got[1] + got[2]; math.Abs(sum-1) > 1e-12 {
+		t.Fatalf("/softmax sums to %v", sum)
+	}
+}
+
+func TestEndpointErrors(t *testing.T) {
+	t.Parallel()
+
+	h := newServer(nil, 1, 4)
+
+	cases := []struct {
+		target string
+		values []float64
+		want   int
+	}{
+		{"/log", []float64{1, -1}, http.StatusUnprocessableEntity},
+		{"/exp?precision=ultra", []float64{1}, http.StatusBadRequest},
+		{"/exp", []float64{1, 2, 3, 4, 5}, http.StatusRequestEntityTooLarge},
+		{"/tanh", []float64{1}, http.StatusNotFound},
+	}
+
+	for _, tc := range cases {
+		if rec, _ := post(t, h, tc.target, tc.values); rec.Code != tc.want {
+			t.Errorf("%s %v: status %d (%s), want %d", tc.target, tc.values, rec.Code, strings.TrimSpace(rec.Body.String()), tc.want)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/exp", strings.NewReader("{")))
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("malformed JSON: status %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/exp", nil))
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET: status %d", rec.Code)
+	}
+}
+
+func TestParallelBatchMatchesSequential(t *testing.T) {
+	t.Parallel()
+
+	xs := make([]float64, 5*minChunk+3)
+	for i := range xs {
+		xs[i] = float64(i) * 1e-4
+	}
+
+	_, seq := post(t, newServer(nil, 1, len(xs)), "/sin", xs)
+	_, par := post(t, newServer(nil, 4, len(xs)), "/sin", xs)
+
+	for i := range xs {
+		if seq[i] != par[i] {
+			t.Fatalf("[%d]: parallel %v, sequential %v", i, par[i], seq[i])
+		}
+	}
+}
+
+func BenchmarkExpEndpoint(b *testing.B) {
+	for _, n := range []int{1 << 10, 1 << 16} {
+		for _, workers := range []int{1, 4} {
+			xs := make([]float64, n)
+			for i := range xs {
+				xs[i] = float64(i%200) * 0.01
+			}
+
+			body, err := json.Marshal(batch{Values: xs})
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			h := newServer(nil, workers, n)
+
+			b.Run(fmt.Sprintf("n=%d/workers=%d", n, workers), func(b *testing.B) {
+				b.SetBytes(int64(8 * n))
+
+				for range b.N {
+					rec := httptest.NewRecorder()
+					h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/exp", bytes.NewReader(body)))
+
+					if rec.Code != http.StatusOK {
+						b.Fatalf("status %d", rec.Code)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package approx
+
+import (
+	"math"
+
+	iapprox "github.com/meko-christian/algo-approx/internal/approx"
+)
+
+// Expm2x2 returns the matrix exponential e^m of the 2x2 matrix m using the
+// default precision, for example the state-transition matrix e^(A·dt) of a
+// linear system ẋ = Ax.
+//
+// It uses the closed form: with μ = tr(m)/2 and N = m - μI, N² = δI, so
+// e^m = e^μ (cosh √δ · I + sinh √δ / √δ · N), which turns into cos and sin
+// for δ < 0. Its relative error is that of FastExp at the tier.
+func Expm2x2[T Float](m [2][2]T) [2][2]T { return Expm2x2Prec(m, PrecisionAuto) }
+
+// Expm2x2Prec is Expm2x2 using the requested precision.
+func Expm2x2Prec[T Float](m [2][2]T, prec Precision) [2][2]T {
+	p := iapprox.Precision(normalizePrecision(prec))
+	a, b := float64(m[0][0]), float64(m[0][1])
+	c, d := float64(m[1][0]), float64(m[1][1])
+
+	mu := 0.5 * (a + d)
+	h := 0.5 * (a - d) // N = [[h, b], [c, -h]]
+	delta := h*h + b*c
+
+	var diag, off float64 // e^m = diag·I + off·N
+
+	switch {
+	case math.Abs(delta) < 0.25:
+		ch, sc := coshSinhc(delta)
+		e := iapprox.Exp(mu, p)
+		diag, off = e*ch, e*sc
+	case delta > 0:
+		// Splitting the exponent keeps e^μ·cosh finite whenever the result is.
+		s := math.Sqrt(delta)
+		ep, em := iapprox.Exp(mu+s, p), iapprox.Exp(mu-s, p)
+		diag, off = 0.5*(ep+em), 0.5*(ep-em)/s
+	default:
+		t := math.Sqrt(-delta)
+		sin, cos := iapprox.SinCos(t, p)
+		e := iapprox.Exp(mu, p)
+		diag, off = e*cos, e*sin/t
+	}
+
+	return [2][2]T{
+		{T(diag + off*h), T(off * b)},
+		{T(off * c), T(diag - off*h)},
+	}
+}
+
+// coshSinhc returns cosh √δ and sinh √δ / √δ for |δ| < 0.25 from their
+// series in δ, which hold for either sign of δ and do not cancel near 0.
+func coshSinhc(delta float64) (ch, sc float64) {
+	ch, sc = 1, 1
+	term := 1.0
+
+	for k := 1; k <= 7; k++ {
+		term *= delta / float64(2*k-1) / float64(2*k)
+		ch += term
+		sc += term / float64(2*k+1)
+	}
+
+	return ch, sc
+}
+
+// Expm3x3 returns the matrix exponential e^m of the 3x3 matrix m using the
+// default precision.
+//
+// The trace is split off as a scalar FastExp; the rest is scaled by 2^-s
+// until its infinity norm is at most ½, expanded in a Taylor polynomial of 6
+// (Fast), 8 (Balanced) or 12 (High) terms and squared s times. The
+// polynomial is more accurate than FastExp at each tier, so the relative
+// error, measured against the largest entry, is about that of FastExp.
+func Expm3x3[T Float](m [3][3]T) [3][3]T { return Expm3x3Prec(m, PrecisionAuto) }
+
+// Expm3x3Prec is Expm3x3 using the requested precision.
+func Expm3x3Prec[T Float](m [3][3]T, prec Precision) [3][3]T {
+	p := iapprox.Precision(normalizePrecision(prec))
+
+	var b [3][3]float64
+
+	mu := (float64(m[0][0]) + float64(m[1][1]) + float64(m[2][2])) / 3
+
+	norm := 0.0
+	for i := range 3 {
+		row := 0.0
+
+		for j := range 3 {
+			b[i][j] = float64(m[i][j])
+			if i == j {
+				b[i][j] -= mu
+			}
+
+			row += math.Abs(b[i][j])
+		}
+
+		norm = max(norm, row)
+	}
+
+	if norm != norm || math.IsInf(norm, 0) { //nolint:gocritic
+		nan := T(math.NaN())
+
+		return [3][3]T{{nan, nan, nan}, {nan, nan, nan}, {nan, nan, nan}}
+	}
+
+	s := 0
+	if _, e := math.Frexp(norm); e > -1 {
+		s = e + 1 // norm·2^-s < ½
+	}
+
+	for i := range 3 {
+		for j := range 3 {
+			b[i][j] = math.Ldexp(b[i][j], -s)
+		}
+	}
+
+	// Horner form of the Taylor polynomial: I + B(I + B/2(I + ... B/K)).
+	e := identity3()
+	for k := expmTerms[p]; k >= 1; k-- {
+		e = mul3(&b, &e)
+		for i := range 3 {
+			for j := range 3 {
+				e[i][j] /= float64(k)
+			}
+
+			e[i][i]++
+		}
+	}
+
+	for range s {
+		e = mul3(&e, &e)
+	}
+
+	scale := iapprox.Exp(mu, p)
+
+	var out [3][3]T
+	for i := range 3 {
+		for j := range 3 {
+			out[i][j] = T(scale * e[i][j])
+		}
+	}
+
+	return out
+}
+
+// expmTerms is the Taylor degree of Expm3x3 per precision tier.
+//
+//nolint:gochecknoglobals // read-only table indexed by precision
+var expmTerms = [...]int{
+	iapprox.PrecisionAuto:     8,
+	iapprox.PrecisionFast:     6,
+	iapprox.PrecisionBalanced: 8,
+	iapprox.PrecisionHigh:     12,
+}
+
+func identity3() [3][3]float64 { return [3][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}} }
+
+func mul3(a, b *[3][3]float64) [3][3]float64 {
+	var c [3][3]float64
+
+	for i := range 3 {
+		for j := range 3 {
+			c[i][j] = a[i][0]*b[0][j] + a[i][1]*b[1][j] + a[i][2]*b[2][j]
+		}
+	}
+
+	return c
+}
+
+// Expm2x2Into sets dst[i] to Expm2x2Prec(src[i], prec), for propagating many
+// small systems at once.
+//
+// It panics with ErrLengthMismatch if the slices differ in length.
+func Expm2x2Into[T Float](dst, src [][2][2]T, prec Precision) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("Expm2x2Into")
+	}
+
+	for i := range src {
+		dst[i] = Expm2x2Prec(src[i], prec)
+	}
+}
+
+// Expm3x3Into sets dst[i] to Expm3x3Prec(src[i], prec).
+//
+// It panics with ErrLengthMismatch if the slices differ in length.
+func Expm3x3Into[T Float](dst, src [][3][3]T, prec Precision) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("Expm3x3Into")
+	}
+
+	for i := range src {
+		dst[i] = Expm3x3Prec(src[i], prec)
+	}
+}
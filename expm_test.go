@@ -0,0 +1,183 @@
+package approx
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// expmRef is e^m from a 30-term Taylor series after scaling by 2^-10,
+// squared back; it is exact to rounding for the small matrices here.
+func expmRef(m [3][3]float64) [3][3]float64 {
+	var b [3][3]float64
+	for i := range 3 {
+		for j := range 3 {
+			b[i][j] = m[i][j] / 1024
+		}
+	}
+
+	e := identity3()
+	for k := 30; k >= 1; k-- {
+		e = mul3(&b, &e)
+		for i := range 3 {
+			for j := range 3 {
+				e[i][j] /= float64(k)
+			}
+
+			e[i][i]++
+		}
+	}
+
+	for range 10 {
+		e = mul3(&e, &e)
+	}
+
+	return e
+}
+
+// expmError is the largest entry of |got - want| relative to the largest
+// entry of want.
+func expmError(got, want [3][3]float64) float64 {
+	var diff, scale float64
+	for i := range 3 {
+		for j := range 3 {
+			diff = max(diff, math.Abs(got[i][j]-want[i][j]))
+			scale = max(scale, math.Abs(want[i][j]))
+		}
+	}
+
+	return diff / scale
+}
+
+func embed2(m [2][2]float64) [3][3]float64 {
+	return [3][3]float64{{m[0][0], m[0][1], 0}, {m[1][0], m[1][1], 0}, {0, 0, 0}}
+}
+
+func TestExpmAgainstSeries(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		prec Precision
+		tol  float64
+	}{
+		{PrecisionFast, 1e-3},
+		{PrecisionBalanced, 5e-6},
+		{PrecisionHigh, 1e-8},
+	}
+
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	for _, tc := range cases {
+		for range 2000 {
+			var m [3][3]float64
+			for i := range 3 {
+				for j := range 3 {
+					m[i][j] = 4*rng.Float64() - 2
+				}
+			}
+
+			if err := expmError(Expm3x3Prec(m, tc.prec), expmRef(m)); err > tc.tol {
+				t.Fatalf("prec %d: Expm3x3(%v) error %.2g", tc.prec, m, err)
+			}
+
+			m2 := [2][2]float64{{m[0][0], m[0][1]}, {m[1][0], m[1][1]}}
+			want := expmRef(embed2(m2))
+			got := embed2(Expm2x2Prec(m2, tc.prec))
+			got[2][2] = 1
+
+			if err := expmError(got, want); err > tc.tol {
+				t.Fatalf("prec %d: Expm2x2(%v) error %.2g", tc.prec, m2, err)
+			}
+		}
+	}
+}
+
+func TestExpm2x2ClosedForms(t *testing.T) {
+	t.Parallel()
+
+	// The generator of rotations by θ, a nilpotent shear and a diagonal
+	// matrix, one for each branch of the closed form and the series.
+	const theta = 2.5
+
+	cases := []struct {
+		m, want [2][2]float64
+	}{
+		{[2][2]float64{{0, -theta}, {theta, 0}}, [2][2]float64{{math.Cos(theta), -math.Sin(theta)}, {math.Sin(theta), math.Cos(theta)}}},
+		{[2][2]float64{{0, 1}, {0, 0}}, [2][2]float64{{1, 1}, {0, 1}}},
+		{[2][2]float64{{1, 0}, {0, -3}}, [2][2]float64{{math.E, 0}, {0, math.Exp(-3)}}},
+	}
+
+	for _, tc := range cases {
+		got := Expm2x2Prec(tc.m, PrecisionHigh)
+		for i := range 2 {
+			for j := range 2 {
+				if math.Abs(got[i][j]-tc.want[i][j]) > 1e-8*max(1, math.Abs(tc.want[i][j])) {
+					t.Fatalf("Expm2x2(%v) = %v, want %v", tc.m, got, tc.want)
+				}
+			}
+		}
+	}
+}
+
+func TestExpm3x3Inverse(t *testing.T) {
+	t.Parallel()
+
+	m := [3][3]float64{{0.3, -7, 2}, {5, -0.1, 1}, {-2, 4, 0.5}}
+
+	var neg [3][3]float64
+	for i := range 3 {
+		for j := range 3 {
+			neg[i][j] = -m[i][j]
+		}
+	}
+
+	e, inv := Expm3x3Prec(m, PrecisionHigh), Expm3x3Prec(neg, PrecisionHigh)
+	id := identity3()
+
+	if prod := mul3(&e, &inv); expmError(prod, id) > 1e-7 {
+		t.Fatalf("e^m e^-m = %v", prod)
+	}
+
+	if e := Expm3x3([3][3]float64{{math.NaN()}}); !math.IsNaN(e[1][1]) {
+		t.Fatalf("Expm3x3(NaN) = %v", e)
+	}
+}
+
+func TestExpmInto(t *testing.T) {
+	t.Parallel()
+
+	src2 := [][2][2]float32{{{0, 1}, {-1, 0}}, {{0.5, 0}, {0, 0.5}}}
+	dst2 := make([][2][2]float32, len(src2))
+	Expm2x2Into(dst2, src2, PrecisionBalanced)
+
+	src3 := [][3][3]float64{embed2([2][2]float64{{0, 1}, {-1, 0}})}
+	dst3 := make([][3][3]float64, len(src3))
+	Expm3x3Into(dst3, src3, PrecisionBalanced)
+
+	for i := range src2 {
+		if dst2[i] != Expm2x2Prec(src2[i], PrecisionBalanced) {
+			t.Fatalf("Expm2x2Into[%d] = %v", i, dst2[i])
+		}
+	}
+
+	if dst3[0] != Expm3x3Prec(src3[0], PrecisionBalanced) {
+		t.Fatalf("Expm3x3Into = %v", dst3[0])
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Expm2x2Into did not panic on a length mismatch")
+		}
+	}()
+
+	Expm2x2Into(dst2[:1], src2, PrecisionFast)
+}
+
+func BenchmarkExpm3x3(b *testing.B) {
+	m := [3][3]float64{{0.3, -0.7, 0.2}, {0.5, -0.1, 0.1}, {-0.2, 0.4, 0.5}}
+
+	for range b.N {
+		m = Expm3x3Prec(m, PrecisionBalanced)
+		m[0][0] = 0.3
+	}
+}
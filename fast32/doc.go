@@ -0,0 +1,9 @@
+// Package fast32 exposes the approx functions for float32 only, as plain
+// non-generic functions: fast32.Exp(x) instead of approx.FastExp32(x) or
+// approx.FastExp[float32](x). Hot loops get the shortest call path and call
+// sites need no type arguments.
+//
+// The wrappers are generated from the approx API by internal/gen/flavors and
+// stay in sync with it: every FastX32 has an X here and every generic
+// FastXPrec an XPrec.
+package fast32
@@ -0,0 +1,325 @@
+// Code generated by go run ./internal/gen/flavors; DO NOT EDIT.
+
+package fast32
+
+import approx "github.com/meko-christian/algo-approx"
+
+// Arccos calls approx.FastArccos32.
+func Arccos(x float32) float32 { return approx.FastArccos32(x) }
+
+// ArccosPrec calls approx.FastArccosPrec[float32].
+func ArccosPrec(x float32, prec approx.Precision) float32 { return approx.FastArccosPrec(x, prec) }
+
+// Arccotan calls approx.FastArccotan32.
+func Arccotan(x float32) float32 { return approx.FastArccotan32(x) }
+
+// ArccotanPrec calls approx.FastArccotanPrec[float32].
+func ArccotanPrec(x float32, prec approx.Precision) float32 { return approx.FastArccotanPrec(x, prec) }
+
+// Arccsc calls approx.FastArccsc32.
+func Arccsc(x float32) float32 { return approx.FastArccsc32(x) }
+
+// ArccscPrec calls approx.FastArccscPrec[float32].
+func ArccscPrec(x float32, prec approx.Precision) float32 { return approx.FastArccscPrec(x, prec) }
+
+// Arcsec calls approx.FastArcsec32.
+func Arcsec(x float32) float32 { return approx.FastArcsec32(x) }
+
+// ArcsecPrec calls approx.FastArcsecPrec[float32].
+func ArcsecPrec(x float32, prec approx.Precision) float32 { return approx.FastArcsecPrec(x, prec) }
+
+// Arctan calls approx.FastArctan32.
+func Arctan(x float32) float32 { return approx.FastArctan32(x) }
+
+// ArctanPrec calls approx.FastArctanPrec[float32].
+func ArctanPrec(x float32, prec approx.Precision) float32 { return approx.FastArctanPrec(x, prec) }
+
+// BetaInc calls approx.FastBetaInc32.
+func BetaInc(a, b, x float32) float32 { return approx.FastBetaInc32(a, b, x) }
+
+// BetaIncPrec calls approx.FastBetaIncPrec[float32].
+func BetaIncPrec(a, b, x float32, prec approx.Precision) float32 {
+	return approx.FastBetaIncPrec(a, b, x, prec)
+}
+
+// CentsToRatio calls approx.FastCentsToRatio32.
+func CentsToRatio(c float32) float32 { return approx.FastCentsToRatio32(c) }
+
+// CentsToRatioPrec calls approx.FastCentsToRatioPrec[float32].
+func CentsToRatioPrec(c float32, prec approx.Precision) float32 {
+	return approx.FastCentsToRatioPrec(c, prec)
+}
+
+// Cos calls approx.FastCos32.
+func Cos(x float32) float32 { return approx.FastCos32(x) }
+
+// CosPrec calls approx.FastCosPrec[float32].
+func CosPrec(x float32, prec approx.Precision) float32 { return approx.FastCosPrec(x, prec) }
+
+// CosDouble calls approx.FastCosDouble32.
+func CosDouble(x float32) float32 { return approx.FastCosDouble32(x) }
+
+// CosDoublePrec calls approx.FastCosDoublePrec[float32].
+func CosDoublePrec(x float32, prec approx.Precision) float32 {
+	return approx.FastCosDoublePrec(x, prec)
+}
+
+// CosPi calls approx.FastCosPi32.
+func CosPi(x float32) float32 { return approx.FastCosPi32(x) }
+
+// CosPiPrec calls approx.FastCosPiPrec[float32].
+func CosPiPrec(x float32, prec approx.Precision) float32 { return approx.FastCosPiPrec(x, prec) }
+
+// CosSum calls approx.FastCosSum32.
+func CosSum(a, b float32) float32 { return approx.FastCosSum32(a, b) }
+
+// CosSumPrec calls approx.FastCosSumPrec[float32].
+func CosSumPrec(a, b float32, prec approx.Precision) float32 {
+	return approx.FastCosSumPrec(a, b, prec)
+}
+
+// CosTurns calls approx.FastCosTurns32.
+func CosTurns(x float32) float32 { return approx.FastCosTurns32(x) }
+
+// CosTurnsPrec calls approx.FastCosTurnsPrec[float32].
+func CosTurnsPrec(x float32, prec approx.Precision) float32 { return approx.FastCosTurnsPrec(x, prec) }
+
+// Cotan calls approx.FastCotan32.
+func Cotan(x float32) float32 { return approx.FastCotan32(x) }
+
+// CotanPrec calls approx.FastCotanPrec[float32].
+func CotanPrec(x float32, prec approx.Precision) float32 { return approx.FastCotanPrec(x, prec) }
+
+// Csc calls approx.FastCsc32.
+func Csc(x float32) float32 { return approx.FastCsc32(x) }
+
+// CscPrec calls approx.FastCscPrec[float32].
+func CscPrec(x float32, prec approx.Precision) float32 { return approx.FastCscPrec(x, prec) }
+
+// Exp2 calls approx.FastExp232.
+func Exp2(x float32) float32 { return approx.FastExp232(x) }
+
+// Exp2Prec calls approx.FastExp2Prec[float32].
+func Exp2Prec(x float32, prec approx.Precision) float32 { return approx.FastExp2Prec(x, prec) }
+
+// Exp calls approx.FastExp32.
+func Exp(x float32) float32 { return approx.FastExp32(x) }
+
+// ExpPrec calls approx.FastExpPrec[float32].
+func ExpPrec(x float32, prec approx.Precision) float32 { return approx.FastExpPrec(x, prec) }
+
+// GELU calls approx.FastGELU32.
+func GELU(x float32) float32 { return approx.FastGELU32(x) }
+
+// GELUPrec calls approx.FastGELUPrec[float32].
+func GELUPrec(x float32, prec approx.Precision) float32 { return approx.FastGELUPrec(x, prec) }
+
+// Hav calls approx.FastHav32.
+func Hav(x float32) float32 { return approx.FastHav32(x) }
+
+// HavPrec calls approx.FastHavPrec[float32].
+func HavPrec(x float32, prec approx.Precision) float32 { return approx.FastHavPrec(x, prec) }
+
+// Hypot calls approx.FastHypot32.
+func Hypot(a, b float32) float32 { return approx.FastHypot32(a, b) }
+
+// HypotPrec calls approx.FastHypotPrec[float32].
+func HypotPrec(a, b float32, prec approx.Precision) float32 { return approx.FastHypotPrec(a, b, prec) }
+
+// IntPower calls approx.FastIntPower32.
+func IntPower(base float32, exponent int) float32 { return approx.FastIntPower32(base, exponent) }
+
+// InvRoot calls approx.FastInvRoot32.
+func InvRoot(value float32, n int) float32 { return approx.FastInvRoot32(value, n) }
+
+// InvRootPrec calls approx.FastInvRootPrec[float32].
+func InvRootPrec(value float32, n int, prec approx.Precision) float32 {
+	return approx.FastInvRootPrec(value, n, prec)
+}
+
+// InvSqrt calls approx.FastInvSqrt32.
+func InvSqrt(x float32) float32 { return approx.FastInvSqrt32(x) }
+
+// InvSqrtPrec calls approx.FastInvSqrtPrec[float32].
+func InvSqrtPrec(x float32, prec approx.Precision) float32 { return approx.FastInvSqrtPrec(x, prec) }
+
+// Lgamma calls approx.FastLgamma32.
+func Lgamma(x float32) float32 { return approx.FastLgamma32(x) }
+
+// LgammaPrec calls approx.FastLgammaPrec[float32].
+func LgammaPrec(x float32, prec approx.Precision) float32 { return approx.FastLgammaPrec(x, prec) }
+
+// Log2 calls approx.FastLog232.
+func Log2(x float32) float32 { return approx.FastLog232(x) }
+
+// Log2Prec calls approx.FastLog2Prec[float32].
+func Log2Prec(x float32, prec approx.Precision) float32 { return approx.FastLog2Prec(x, prec) }
+
+// Log calls approx.FastLog32.
+func Log(x float32) float32 { return approx.FastLog32(x) }
+
+// LogPrec calls approx.FastLogPrec[float32].
+func LogPrec(x float32, prec approx.Precision) float32 { return approx.FastLogPrec(x, prec) }
+
+// LogAddExp calls approx.FastLogAddExp32.
+func LogAddExp(a, b float32) float32 { return approx.FastLogAddExp32(a, b) }
+
+// LogAddExpPrec calls approx.FastLogAddExpPrec[float32].
+func LogAddExpPrec(a, b float32, prec approx.Precision) float32 {
+	return approx.FastLogAddExpPrec(a, b, prec)
+}
+
+// LogBeta calls approx.FastLogBeta32.
+func LogBeta(a, b float32) float32 { return approx.FastLogBeta32(a, b) }
+
+// LogBetaPrec calls approx.FastLogBetaPrec[float32].
+func LogBetaPrec(a, b float32, prec approx.Precision) float32 {
+	return approx.FastLogBetaPrec(a, b, prec)
+}
+
+// LogRatio calls approx.FastLogRatio32.
+func LogRatio(a, b float32) float32 { return approx.FastLogRatio32(a, b) }
+
+// LogRatioPrec calls approx.FastLogRatioPrec[float32].
+func LogRatioPrec(a, b float32, prec approx.Precision) float32 {
+	return approx.FastLogRatioPrec(a, b, prec)
+}
+
+// NormCDF calls approx.FastNormCDF32.
+func NormCDF(z float32) float32 { return approx.FastNormCDF32(z) }
+
+// NormCDFPrec calls approx.FastNormCDFPrec[float32].
+func NormCDFPrec(z float32, prec approx.Precision) float32 { return approx.FastNormCDFPrec(z, prec) }
+
+// PhiInv calls approx.FastPhiInv32.
+func PhiInv(p float32) float32 { return approx.FastPhiInv32(p) }
+
+// PhiInvPrec calls approx.FastPhiInvPrec[float32].
+func PhiInvPrec(p float32, prec approx.Precision) float32 { return approx.FastPhiInvPrec(p, prec) }
+
+// Power calls approx.FastPower32.
+func Power(base, exponent float32) float32 { return approx.FastPower32(base, exponent) }
+
+// PowerPrec calls approx.FastPowerPrec[float32].
+func PowerPrec(base, exponent float32, prec approx.Precision) float32 {
+	return approx.FastPowerPrec(base, exponent, prec)
+}
+
+// RatioToCents calls approx.FastRatioToCents32.
+func RatioToCents(r float32) float32 { return approx.FastRatioToCents32(r) }
+
+// RatioToCentsPrec calls approx.FastRatioToCentsPrec[float32].
+func RatioToCentsPrec(r float32, prec approx.Precision) float32 {
+	return approx.FastRatioToCentsPrec(r, prec)
+}
+
+// Root calls approx.FastRoot32.
+func Root(value float32, n int) float32 { return approx.FastRoot32(value, n) }
+
+// RootF calls approx.FastRootF32.
+func RootF(value float32, n float64) float32 { return approx.FastRootF32(value, n) }
+
+// RootFPrec calls approx.FastRootFPrec[float32].
+func RootFPrec(value float32, n float64, prec approx.Precision) float32 {
+	return approx.FastRootFPrec(value, n, prec)
+}
+
+// RoundDecimal calls approx.FastRoundDecimal32.
+func RoundDecimal(x float32, places int) float32 { return approx.FastRoundDecimal32(x, places) }
+
+// RoundDecimalPrec calls approx.FastRoundDecimalPrec[float32].
+func RoundDecimalPrec(x float32, places int, prec approx.Precision) float32 {
+	return approx.FastRoundDecimalPrec(x, places, prec)
+}
+
+// Sec calls approx.FastSec32.
+func Sec(x float32) float32 { return approx.FastSec32(x) }
+
+// SecPrec calls approx.FastSecPrec[float32].
+func SecPrec(x float32, prec approx.Precision) float32 { return approx.FastSecPrec(x, prec) }
+
+// SiLU calls approx.FastSiLU32.
+func SiLU(x float32) float32 { return approx.FastSiLU32(x) }
+
+// SiLUPrec calls approx.FastSiLUPrec[float32].
+func SiLUPrec(x float32, prec approx.Precision) float32 { return approx.FastSiLUPrec(x, prec) }
+
+// Sin calls approx.FastSin32.
+func Sin(x float32) float32 { return approx.FastSin32(x) }
+
+// SinPrec calls approx.FastSinPrec[float32].
+func SinPrec(x float32, prec approx.Precision) float32 { return approx.FastSinPrec(x, prec) }
+
+// SinCos calls approx.FastSinCos32.
+func SinCos(x float32) (sin, cos float32) { return approx.FastSinCos32(x) }
+
+// SinCosPrec calls approx.FastSinCosPrec[float32].
+func SinCosPrec(x float32, prec approx.Precision) (sin, cos float32) {
+	return approx.FastSinCosPrec(x, prec)
+}
+
+// SinDouble calls approx.FastSinDouble32.
+func SinDouble(x float32) float32 { return approx.FastSinDouble32(x) }
+
+// SinDoublePrec calls approx.FastSinDoublePrec[float32].
+func SinDoublePrec(x float32, prec approx.Precision) float32 {
+	return approx.FastSinDoublePrec(x, prec)
+}
+
+// SinPi calls approx.FastSinPi32.
+func SinPi(x float32) float32 { return approx.FastSinPi32(x) }
+
+// SinPiPrec calls approx.FastSinPiPrec[float32].
+func SinPiPrec(x float32, prec approx.Precision) float32 { return approx.FastSinPiPrec(x, prec) }
+
+// SinSum calls approx.FastSinSum32.
+func SinSum(a, b float32) float32 { return approx.FastSinSum32(a, b) }
+
+// SinSumPrec calls approx.FastSinSumPrec[float32].
+func SinSumPrec(a, b float32, prec approx.Precision) float32 {
+	return approx.FastSinSumPrec(a, b, prec)
+}
+
+// SinTurns calls approx.FastSinTurns32.
+func SinTurns(x float32) float32 { return approx.FastSinTurns32(x) }
+
+// SinTurnsPrec calls approx.FastSinTurnsPrec[float32].
+func SinTurnsPrec(x float32, prec approx.Precision) float32 { return approx.FastSinTurnsPrec(x, prec) }
+
+// Sqrt calls approx.FastSqrt32.
+func Sqrt(x float32) float32 { return approx.FastSqrt32(x) }
+
+// SqrtPrec calls approx.FastSqrtPrec[float32].
+func SqrtPrec(x float32, prec approx.Precision) float32 { return approx.FastSqrtPrec(x, prec) }
+
+// Tan calls approx.FastTan32.
+func Tan(x float32) float32 { return approx.FastTan32(x) }
+
+// TanPrec calls approx.FastTanPrec[float32].
+func TanPrec(x float32, prec approx.Precision) float32 { return approx.FastTanPrec(x, prec) }
+
+// TanHalf calls approx.FastTanHalf32.
+func TanHalf(x float32) float32 { return approx.FastTanHalf32(x) }
+
+// TanHalfPrec calls approx.FastTanHalfPrec[float32].
+func TanHalfPrec(x float32, prec approx.Precision) float32 { return approx.FastTanHalfPrec(x, prec) }
+
+// Tanh calls approx.FastTanh32.
+func Tanh(x float32) float32 { return approx.FastTanh32(x) }
+
+// TanhPrec calls approx.FastTanhPrec[float32].
+func TanhPrec(x float32, prec approx.Precision) float32 { return approx.FastTanhPrec(x, prec) }
+
+// TruncDecimal calls approx.FastTruncDecimal32.
+func TruncDecimal(x float32, places int) float32 { return approx.FastTruncDecimal32(x, places) }
+
+// TruncDecimalPrec calls approx.FastTruncDecimalPrec[float32].
+func TruncDecimalPrec(x float32, places int, prec approx.Precision) float32 {
+	return approx.FastTruncDecimalPrec(x, places, prec)
+}
+
+// Versin calls approx.FastVersin32.
+func Versin(x float32) float32 { return approx.FastVersin32(x) }
+
+// VersinPrec calls approx.FastVersinPrec[float32].
+func VersinPrec(x float32, prec approx.Precision) float32 { return approx.FastVersinPrec(x, prec) }
@@ -0,0 +1,18 @@
+package fast32_test
+
+import (
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/fast32"
+)
+
+func TestWrappersMatchGenericAPI(t *testing.T) {
+	t.Parallel()
+
+	for _, x := range []float32{0.25, 1, 2.5} {
+		if fast32.Log(x) != approx.FastLog(x) || fast32.InvSqrtPrec(x, approx.PrecisionFast) != approx.FastInvSqrtPrec(x, approx.PrecisionFast) {
+			t.Fatalf("wrapper diverges from the generic API at %g", x)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+// Package fast64 exposes the approx functions for float64 only, as plain
+// non-generic functions: fast64.Exp(x) instead of approx.FastExp64(x) or
+// approx.FastExp[float64](x). Hot loops get the shortest call path and call
+// sites need no type arguments.
+//
+// The wrappers are generated from the approx API by internal/gen/flavors and
+// stay in sync with it: every FastX64 has an X here and every generic
+// FastXPrec an XPrec.
+package fast64
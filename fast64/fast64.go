@@ -0,0 +1,325 @@
+// Code generated by go run ./internal/gen/flavors; DO NOT EDIT.
+
+package fast64
+
+import approx "github.com/meko-christian/algo-approx"
+
+// Arccos calls approx.FastArccos64.
+func Arccos(x float64) float64 { return approx.FastArccos64(x) }
+
+// ArccosPrec calls approx.FastArccosPrec[float64].
+func ArccosPrec(x float64, prec approx.Precision) float64 { return approx.FastArccosPrec(x, prec) }
+
+// Arccotan calls approx.FastArccotan64.
+func Arccotan(x float64) float64 { return approx.FastArccotan64(x) }
+
+// ArccotanPrec calls approx.FastArccotanPrec[float64].
+func ArccotanPrec(x float64, prec approx.Precision) float64 { return approx.FastArccotanPrec(x, prec) }
+
+// Arccsc calls approx.FastArccsc64.
+func Arccsc(x float64) float64 { return approx.FastArccsc64(x) }
+
+// ArccscPrec calls approx.FastArccscPrec[float64].
+func ArccscPrec(x float64, prec approx.Precision) float64 { return approx.FastArccscPrec(x, prec) }
+
+// Arcsec calls approx.FastArcsec64.
+func Arcsec(x float64) float64 { return approx.FastArcsec64(x) }
+
+// ArcsecPrec calls approx.FastArcsecPrec[float64].
+func ArcsecPrec(x float64, prec approx.Precision) float64 { return approx.FastArcsecPrec(x, prec) }
+
+// Arctan calls approx.FastArctan64.
+func Arctan(x float64) float64 { return approx.FastArctan64(x) }
+
+// ArctanPrec calls approx.FastArctanPrec[float64].
+func ArctanPrec(x float64, prec approx.Precision) float64 { return approx.FastArctanPrec(x, prec) }
+
+// BetaInc calls approx.FastBetaInc64.
+func BetaInc(a, b, x float64) float64 { return approx.FastBetaInc64(a, b, x) }
+
+// BetaIncPrec calls approx.FastBetaIncPrec[float64].
+func BetaIncPrec(a, b, x float64, prec approx.Precision) float64 {
+	return approx.FastBetaIncPrec(a, b, x, prec)
+}
+
+// CentsToRatio calls approx.FastCentsToRatio64.
+func CentsToRatio(c float64) float64 { return approx.FastCentsToRatio64(c) }
+
+// CentsToRatioPrec calls approx.FastCentsToRatioPrec[float64].
+func CentsToRatioPrec(c float64, prec approx.Precision) float64 {
+	return approx.FastCentsToRatioPrec(c, prec)
+}
+
+// Cos calls approx.FastCos64.
+func Cos(x float64) float64 { return approx.FastCos64(x) }
+
+// CosPrec calls approx.FastCosPrec[float64].
+func CosPrec(x float64, prec approx.Precision) float64 { return approx.FastCosPrec(x, prec) }
+
+// CosDouble calls approx.FastCosDouble64.
+func CosDouble(x float64) float64 { return approx.FastCosDouble64(x) }
+
+// CosDoublePrec calls approx.FastCosDoublePrec[float64].
+func CosDoublePrec(x float64, prec approx.Precision) float64 {
+	return approx.FastCosDoublePrec(x, prec)
+}
+
+// CosPi calls approx.FastCosPi64.
+func CosPi(x float64) float64 { return approx.FastCosPi64(x) }
+
+// CosPiPrec calls approx.FastCosPiPrec[float64].
+func CosPiPrec(x float64, prec approx.Precision) float64 { return approx.FastCosPiPrec(x, prec) }
+
+// CosSum calls approx.FastCosSum64.
+func CosSum(a, b float64) float64 { return approx.FastCosSum64(a, b) }
+
+// CosSumPrec calls approx.FastCosSumPrec[float64].
+func CosSumPrec(a, b float64, prec approx.Precision) float64 {
+	return approx.FastCosSumPrec(a, b, prec)
+}
+
+// CosTurns calls approx.FastCosTurns64.
+func CosTurns(x float64) float64 { return approx.FastCosTurns64(x) }
+
+// CosTurnsPrec calls approx.FastCosTurnsPrec[float64].
+func CosTurnsPrec(x float64, prec approx.Precision) float64 { return approx.FastCosTurnsPrec(x, prec) }
+
+// Cotan calls approx.FastCotan64.
+func Cotan(x float64) float64 { return approx.FastCotan64(x) }
+
+// CotanPrec calls approx.FastCotanPrec[float64].
+func CotanPrec(x float64, prec approx.Precision) float64 { return approx.FastCotanPrec(x, prec) }
+
+// Csc calls approx.FastCsc64.
+func Csc(x float64) float64 { return approx.FastCsc64(x) }
+
+// CscPrec calls approx.FastCscPrec[float64].
+func CscPrec(x float64, prec approx.Precision) float64 { return approx.FastCscPrec(x, prec) }
+
+// Exp2 calls approx.FastExp264.
+func Exp2(x float64) float64 { return approx.FastExp264(x) }
+
+// Exp2Prec calls approx.FastExp2Prec[float64].
+func Exp2Prec(x float64, prec approx.Precision) float64 { return approx.FastExp2Prec(x, prec) }
+
+// Exp calls approx.FastExp64.
+func Exp(x float64) float64 { return approx.FastExp64(x) }
+
+// ExpPrec calls approx.FastExpPrec[float64].
+func ExpPrec(x float64, prec approx.Precision) float64 { return approx.FastExpPrec(x, prec) }
+
+// GELU calls approx.FastGELU64.
+func GELU(x float64) float64 { return approx.FastGELU64(x) }
+
+// GELUPrec calls approx.FastGELUPrec[float64].
+func GELUPrec(x float64, prec approx.Precision) float64 { return approx.FastGELUPrec(x, prec) }
+
+// Hav calls approx.FastHav64.
+func Hav(x float64) float64 { return approx.FastHav64(x) }
+
+// HavPrec calls approx.FastHavPrec[float64].
+func HavPrec(x float64, prec approx.Precision) float64 { return approx.FastHavPrec(x, prec) }
+
+// Hypot calls approx.FastHypot64.
+func Hypot(a, b float64) float64 { return approx.FastHypot64(a, b) }
+
+// HypotPrec calls approx.FastHypotPrec[float64].
+func HypotPrec(a, b float64, prec approx.Precision) float64 { return approx.FastHypotPrec(a, b, prec) }
+
+// IntPower calls approx.FastIntPower64.
+func IntPower(base float64, exponent int) float64 { return approx.FastIntPower64(base, exponent) }
+
+// InvRoot calls approx.FastInvRoot64.
+func InvRoot(value float64, n int) float64 { return approx.FastInvRoot64(value, n) }
+
+// InvRootPrec calls approx.FastInvRootPrec[float64].
+func InvRootPrec(value float64, n int, prec approx.Precision) float64 {
+	return approx.FastInvRootPrec(value, n, prec)
+}
+
+// InvSqrt calls approx.FastInvSqrt64.
+func InvSqrt(x float64) float64 { return approx.FastInvSqrt64(x) }
+
+// InvSqrtPrec calls approx.FastInvSqrtPrec[float64].
+func InvSqrtPrec(x float64, prec approx.Precision) float64 { return approx.FastInvSqrtPrec(x, prec) }
+
+// Lgamma calls approx.FastLgamma64.
+func Lgamma(x float64) float64 { return approx.FastLgamma64(x) }
+
+// LgammaPrec calls approx.FastLgammaPrec[float64].
+func LgammaPrec(x float64, prec approx.Precision) float64 { return approx.FastLgammaPrec(x, prec) }
+
+// Log2 calls approx.FastLog264.
+func Log2(x float64) float64 { return approx.FastLog264(x) }
+
+// Log2Prec calls approx.FastLog2Prec[float64].
+func Log2Prec(x float64, prec approx.Precision) float64 { return approx.FastLog2Prec(x, prec) }
+
+// Log calls approx.FastLog64.
+func Log(x float64) float64 { return approx.FastLog64(x) }
+
+// LogPrec calls approx.FastLogPrec[float64].
+func LogPrec(x float64, prec approx.Precision) float64 { return approx.FastLogPrec(x, prec) }
+
+// LogAddExp calls approx.FastLogAddExp64.
+func LogAddExp(a, b float64) float64 { return approx.FastLogAddExp64(a, b) }
+
+// LogAddExpPrec calls approx.FastLogAddExpPrec[float64].
+func LogAddExpPrec(a, b float64, prec approx.Precision) float64 {
+	return approx.FastLogAddExpPrec(a, b, prec)
+}
+
+// LogBeta calls approx.FastLogBeta64.
+func LogBeta(a, b float64) float64 { return approx.FastLogBeta64(a, b) }
+
+// LogBetaPrec calls approx.FastLogBetaPrec[float64].
+func LogBetaPrec(a, b float64, prec approx.Precision) float64 {
+	return approx.FastLogBetaPrec(a, b, prec)
+}
+
+// LogRatio calls approx.FastLogRatio64.
+func LogRatio(a, b float64) float64 { return approx.FastLogRatio64(a, b) }
+
+// LogRatioPrec calls approx.FastLogRatioPrec[float64].
+func LogRatioPrec(a, b float64, prec approx.Precision) float64 {
+	return approx.FastLogRatioPrec(a, b, prec)
+}
+
+// NormCDF calls approx.FastNormCDF64.
+func NormCDF(z float64) float64 { return approx.FastNormCDF64(z) }
+
+// NormCDFPrec calls approx.FastNormCDFPrec[float64].
+func NormCDFPrec(z float64, prec approx.Precision) float64 { return approx.FastNormCDFPrec(z, prec) }
+
+// PhiInv calls approx.FastPhiInv64.
+func PhiInv(p float64) float64 { return approx.FastPhiInv64(p) }
+
+// PhiInvPrec calls approx.FastPhiInvPrec[float64].
+func PhiInvPrec(p float64, prec approx.Precision) float64 { return approx.FastPhiInvPrec(p, prec) }
+
+// Power calls approx.FastPower64.
+func Power(base, exponent float64) float64 { return approx.FastPower64(base, exponent) }
+
+// PowerPrec calls approx.FastPowerPrec[float64].
+func PowerPrec(base, exponent float64, prec approx.Precision) float64 {
+	return approx.FastPowerPrec(base, exponent, prec)
+}
+
+// RatioToCents calls approx.FastRatioToCents64.
+func RatioToCents(r float64) float64 { return approx.FastRatioToCents64(r) }
+
+// RatioToCentsPrec calls approx.FastRatioToCentsPrec[float64].
+func RatioToCentsPrec(r float64, prec approx.Precision) float64 {
+	return approx.FastRatioToCentsPrec(r, prec)
+}
+
+// Root calls approx.FastRoot64.
+func Root(value float64, n int) float64 { return approx.FastRoot64(value, n) }
+
+// RootF calls approx.FastRootF64.
+func RootF(value, n float64) float64 { return approx.FastRootF64(value, n) }
+
+// RootFPrec calls approx.FastRootFPrec[float64].
+func RootFPrec(value float64, n float64, prec approx.Precision) float64 {
+	return approx.FastRootFPrec(value, n, prec)
+}
+
+// RoundDecimal calls approx.FastRoundDecimal64.
+func RoundDecimal(x float64, places int) float64 { return approx.FastRoundDecimal64(x, places) }
+
+// RoundDecimalPrec calls approx.FastRoundDecimalPrec[float64].
+func RoundDecimalPrec(x float64, places int, prec approx.Precision) float64 {
+	return approx.FastRoundDecimalPrec(x, places, prec)
+}
+
+// Sec calls approx.FastSec64.
+func Sec(x float64) float64 { return approx.FastSec64(x) }
+
+// SecPrec calls approx.FastSecPrec[float64].
+func SecPrec(x float64, prec approx.Precision) float64 { return approx.FastSecPrec(x, prec) }
+
+// SiLU calls approx.FastSiLU64.
+func SiLU(x float64) float64 { return approx.FastSiLU64(x) }
+
+// SiLUPrec calls approx.FastSiLUPrec[float64].
+func SiLUPrec(x float64, prec approx.Precision) float64 { return approx.FastSiLUPrec(x, prec) }
+
+// Sin calls approx.FastSin64.
+func Sin(x float64) float64 { return approx.FastSin64(x) }
+
+// SinPrec calls approx.FastSinPrec[float64].
+func SinPrec(x float64, prec approx.Precision) float64 { return approx.FastSinPrec(x, prec) }
+
+// SinCos calls approx.FastSinCos64.
+func SinCos(x float64) (sin, cos float64) { return approx.FastSinCos64(x) }
+
+// SinCosPrec calls approx.FastSinCosPrec[float64].
+func SinCosPrec(x float64, prec approx.Precision) (sin, cos float64) {
+	return approx.FastSinCosPrec(x, prec)
+}
+
+// SinDouble calls approx.FastSinDouble64.
+func SinDouble(x float64) float64 { return approx.FastSinDouble64(x) }
+
+// SinDoublePrec calls approx.FastSinDoublePrec[float64].
+func SinDoublePrec(x float64, prec approx.Precision) float64 {
+	return approx.FastSinDoublePrec(x, prec)
+}
+
+// SinPi calls approx.FastSinPi64.
+func SinPi(x float64) float64 { return approx.FastSinPi64(x) }
+
+// SinPiPrec calls approx.FastSinPiPrec[float64].
+func SinPiPrec(x float64, prec approx.Precision) float64 { return approx.FastSinPiPrec(x, prec) }
+
+// SinSum calls approx.FastSinSum64.
+func SinSum(a, b float64) float64 { return approx.FastSinSum64(a, b) }
+
+// SinSumPrec calls approx.FastSinSumPrec[float64].
+func SinSumPrec(a, b float64, prec approx.Precision) float64 {
+	return approx.FastSinSumPrec(a, b, prec)
+}
+
+// SinTurns calls approx.FastSinTurns64.
+func SinTurns(x float64) float64 { return approx.FastSinTurns64(x) }
+
+// SinTurnsPrec calls approx.FastSinTurnsPrec[float64].
+func SinTurnsPrec(x float64, prec approx.Precision) float64 { return approx.FastSinTurnsPrec(x, prec) }
+
+// Sqrt calls approx.FastSqrt64.
+func Sqrt(x float64) float64 { return approx.FastSqrt64(x) }
+
+// SqrtPrec calls approx.FastSqrtPrec[float64].
+func SqrtPrec(x float64, prec approx.Precision) float64 { return approx.FastSqrtPrec(x, prec) }
+
+// Tan calls approx.FastTan64.
+func Tan(x float64) float64 { return approx.FastTan64(x) }
+
+// TanPrec calls approx.FastTanPrec[float64].
+func TanPrec(x float64, prec approx.Precision) float64 { return approx.FastTanPrec(x, prec) }
+
+// TanHalf calls approx.FastTanHalf64.
+func TanHalf(x float64) float64 { return approx.FastTanHalf64(x) }
+
+// TanHalfPrec calls approx.FastTanHalfPrec[float64].
+func TanHalfPrec(x float64, prec approx.Precision) float64 { return approx.FastTanHalfPrec(x, prec) }
+
+// Tanh calls approx.FastTanh64.
+func Tanh(x float64) float64 { return approx.FastTanh64(x) }
+
+// TanhPrec calls approx.FastTanhPrec[float64].
+func TanhPrec(x float64, prec approx.Precision) float64 { return approx.FastTanhPrec(x, prec) }
+
+// TruncDecimal calls approx.FastTruncDecimal64.
+func TruncDecimal(x float64, places int) float64 { return approx.FastTruncDecimal64(x, places) }
+
+// TruncDecimalPrec calls approx.FastTruncDecimalPrec[float64].
+func TruncDecimalPrec(x float64, places int, prec approx.Precision) float64 {
+	return approx.FastTruncDecimalPrec(x, places, prec)
+}
+
+// Versin calls approx.FastVersin64.
+func Versin(x float64) float64 { return approx.FastVersin64(x) }
+
+// VersinPrec calls approx.FastVersinPrec[float64].
+func VersinPrec(x float64, prec approx.Precision) float64 { return approx.FastVersinPrec(x, prec) }
@@ -0,0 +1,23 @@
+package fast64_test
+
+import (
+	"math"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/fast64"
+)
+
+func TestWrappersMatchGenericAPI(t *testing.T) {
+	t.Parallel()
+
+	for _, x := range []float64{0.25, 1, 2.5} {
+		if fast64.Exp(x) != approx.FastExp(x) || fast64.SqrtPrec(x, approx.PrecisionHigh) != approx.FastSqrtPrec(x, approx.PrecisionHigh) {
+			t.Fatalf("wrapper diverges from the generic API at %g", x)
+		}
+	}
+
+	if s, c := fast64.SinCos(0); s != 0 || math.Abs(c-1) > 1e-6 {
+		t.Fatalf("SinCos(0) = %g, %g", s, c)
+	}
+}
@@ -0,0 +1,160 @@
+package approx
+
+import (
+	"slices"
+	"strconv"
+
+	iapprox "github.com/meko-christian/algo-approx/internal/approx"
+)
+
+// FastFtoa formats x with digits significant digits, the result of
+// strconv.FormatFloat(x, 'g', digits, bitSize) for T's bit size, for
+// serializers that print telemetry at a fixed small precision and would
+// otherwise spend much of their time in strconv. The rounding is correct,
+// ties to even, for the exact value of x.
+func FastFtoa[T Float](x T, digits int) string {
+	var buf [32]byte
+
+	return string(AppendFastFtoa(buf[:0], x, digits))
+}
+
+// AppendFastFtoa appends FastFtoa(x, digits) to dst and returns the
+// extended buffer; it does not allocate when dst has room.
+//
+// For 1 <= digits <= 15 the digits come from one scaling by an exact power
+// of ten, 10^k with |k| <= 22, and its rounding error, instead of
+// strconv's general algorithm: for digits = 6 that covers roughly
+// 1e-17 <= |x| < 1e27. Other values and digit counts, NaN, ±Inf and zero
+// are passed to strconv.AppendFloat, so the output is the same everywhere.
+func AppendFastFtoa[T Float](dst []byte, x T, digits int) []byte {
+	n, e, ok := iapprox.SignificantDigits(float64(x), digits)
+	if !ok {
+		return strconv.AppendFloat(dst, float64(x), 'g', digits, floatBits[T]())
+	}
+
+	// The output is written in place: at most a sign, 15 digits and either
+	// a point and four leading zeros or a point and an exponent.
+	dst = slices.Grow(dst, 24)
+	b := dst[len(dst) : len(dst)+24]
+
+	w := 0
+	if x < 0 {
+		b[0] = '-'
+		w = 1
+	}
+
+	// strconv drops trailing zeros of the significant digits.
+	for n%10 == 0 {
+		n /= 10
+	}
+
+	// The digits, right-aligned in d, two at a time.
+	var d [16]byte
+
+	i := len(d)
+	for ; n >= 100; n /= 100 {
+		i -= 2
+		q := 2 * (n % 100)
+		d[i], d[i+1] = digitPairs[q], digitPairs[q+1]
+	}
+
+	if n >= 10 {
+		i -= 2
+		d[i], d[i+1] = digitPairs[2*n], digitPairs[2*n+1]
+	} else {
+		i--
+		d[i] = byte('0' + n)
+	}
+
+	digs := d[i:]
+	nd := len(digs)
+
+	// The digits go to b[w:], with the point before digit split when split <
+	// nd.
+	exp, dp, split := e < -4 || e >= digits, e+1, nd
+
+	switch {
+	case exp:
+		split = 1
+	case dp <= 0:
+		b[w], b[w+1] = '0', '.'
+		w += 2
+
+		for range -dp {
+			b[w] = '0'
+			w++
+		}
+	case dp < nd:
+		split = dp
+	}
+
+	for j, c := range digs {
+		pos := w + j
+		if j >= split {
+			pos++
+		}
+
+		b[pos] = c
+	}
+
+	if split < nd {
+		b[w+split] = '.'
+		w++
+	}
+
+	w += nd
+
+	switch {
+	case exp:
+		w = putExponent(b, w, e)
+	case dp > nd:
+		for range dp - nd {
+			b[w] = '0'
+			w++
+		}
+	}
+
+	return dst[:len(dst)+w]
+}
+
+// putExponent writes e±xx to b at w and returns the new length.
+func putExponent(b []byte, w, e int) int {
+	b[w], b[w+1] = 'e', '+'
+	if e < 0 {
+		b[w+1] = '-'
+		e = -e
+	}
+
+	w += 2
+
+	if e >= 100 {
+		b[w] = byte('0' + e/100)
+		w++
+		e %= 100
+	}
+
+	b[w], b[w+1] = digitPairs[2*e], digitPairs[2*e+1]
+
+	return w + 2
+}
+
+// digitPairs holds "00" to "99".
+const digitPairs = "00010203040506070809" +
+	"10111213141516171819" +
+	"20212223242526272829" +
+	"30313233343536373839" +
+	"40414243444546474849" +
+	"50515253545556575859" +
+	"60616263646566676869" +
+	"70717273747576777879" +
+	"80818283848586878889" +
+	"90919293949596979899"
+
+// floatBits returns the bit size of T for strconv.
+func floatBits[T Float]() int {
+	if T(1+0x1p-30) == 1 {
+		return 32
+	}
+
+	return 64
+}
@@ -0,0 +1,98 @@
+package approx
+
+import (
+	"math"
+	"math/rand/v2"
+	"strconv"
+	"testing"
+)
+
+func TestFastFtoa(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		x      float64
+		digits int
+		want   string
+	}{
+		{1.5, 6, "1.5"},
+		{123456, 6, "123456"},
+		{1234567, 6, "1.23457e+06"},
+		{1200, 6, "1200"},
+		{0.0001, 3, "0.0001"},
+		{0.0000123, 3, "1.23e-05"},
+		{-0.000731234, 3, "-0.000731"},
+		{2.5, 1, "2"},
+		{9.9996, 4, "10"},
+		{1e21, 3, "1e+21"},
+		{1e-300, 3, "1e-300"},
+		{math.Copysign(0, -1), 6, "-0"},
+		{math.Inf(-1), 6, "-Inf"},
+		{math.NaN(), 6, "NaN"},
+		{math.Pi, 0, "3"},
+		{math.Pi, -1, "3.141592653589793"},
+	}
+
+	for _, tc := range cases {
+		if got := FastFtoa(tc.x, tc.digits); got != tc.want {
+			t.Errorf("FastFtoa(%v, %d) = %q, want %q", tc.x, tc.digits, got, tc.want)
+		}
+	}
+
+	// Shortest formatting follows the bit size of T.
+	if got := FastFtoa(float32(0.1), -1); got != "0.1" {
+		t.Errorf("FastFtoa(float32(0.1), -1) = %q, want 0.1", got)
+	}
+}
+
+// TestFastFtoaMatchesStrconv checks the documented equivalence on random bit
+// patterns, log-uniform magnitudes and short decimals, where exact ties are
+// common.
+func TestFastFtoaMatchesStrconv(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewPCG(7, 8))
+
+	for i := range 300000 {
+		var x float64
+
+		switch i % 3 {
+		case 0:
+			x = math.Float64frombits(rng.Uint64())
+		case 1:
+			x = math.Pow(10, 60*rng.Float64()-30) * (rng.Float64() - 0.3)
+		default:
+			x = float64(rng.IntN(100000)) / float64([]int{1, 8, 10, 16, 100, 1000}[rng.IntN(6)])
+		}
+
+		digits := rng.IntN(19) - 1
+
+		if got, want := FastFtoa(x, digits), strconv.FormatFloat(x, 'g', digits, 64); got != want {
+			t.Fatalf("FastFtoa(%v, %d) = %q, want %q", x, digits, got, want)
+		}
+
+		f := float32(x)
+		if got, want := FastFtoa(f, digits), strconv.FormatFloat(float64(f), 'g', digits, 32); got != want {
+			t.Fatalf("FastFtoa(float32 %v, %d) = %q, want %q", f, digits, got, want)
+		}
+	}
+}
+
+func TestAppendFastFtoa(t *testing.T) {
+	t.Parallel()
+
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "t="...)
+	buf = AppendFastFtoa(buf, 21.49875, 4)
+	buf = append(buf, ' ')
+	buf = AppendFastFtoa(buf, math.Inf(1), 4)
+
+	if got := string(buf); got != "t=21.5 +Inf" {
+		t.Fatalf("AppendFastFtoa = %q", got)
+	}
+
+	// A full buffer grows.
+	if got := string(AppendFastFtoa(buf[:len(buf):len(buf)], -1.25e-7, 3)); got != "t=21.5 +Inf-1.25e-07" {
+		t.Fatalf("AppendFastFtoa = %q", got)
+	}
+}
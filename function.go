@@ -0,0 +1,164 @@
+package approx
+
+import (
+	"fmt"
+	"math"
+)
+
+// Function identifies one of the package's scalar functions in metadata
+// queries such as NearestSingularity.
+type Function int
+
+// Function values, one per scalar function family; the zero value is invalid.
+const (
+	FuncSqrt Function = iota + 1
+	FuncInvSqrt
+	FuncLog
+	FuncLog2
+	FuncExp
+	FuncExp2
+	FuncSin
+	FuncCos
+	FuncTan
+	FuncCotan
+	FuncSec
+	FuncCsc
+	FuncArctan
+	FuncArccotan
+	FuncArccos
+	FuncArcsec
+	FuncArccsc
+)
+
+// functionMeta is the registry entry of a Function.
+type functionMeta struct {
+	name string
+
+	// Poles at poleOffset + k·polePeriod for every integer k, when
+	// polePeriod > 0.
+	poleOffset, polePeriod float64
+
+	// Isolated singular points and domain edges.
+	edges []float64
+
+	// Tooling metadata returned by Describe.
+	domain, codomain []Interval
+	tiers            []Precision // nil means all three tiers
+	batch            []string
+}
+
+// Common interval sets of the registry.
+//
+//nolint:gochecknoglobals // read-only registry data
+var (
+	reals        = []Interval{{Lo: math.Inf(-1), Hi: math.Inf(1), LoOpen: true, HiOpen: true}}
+	positives    = []Interval{{Lo: 0, Hi: math.Inf(1), LoOpen: true, HiOpen: true}}
+	nonNegatives = []Interval{{Lo: 0, Hi: math.Inf(1), HiOpen: true}}
+	unit         = []Interval{{Lo: -1, Hi: 1}}
+	outsideUnit  = []Interval{{Lo: math.Inf(-1), Hi: -1, LoOpen: true}, {Lo: 1, Hi: math.Inf(1), HiOpen: true}}
+	halfTurn     = []Interval{{Lo: 0, Hi: math.Pi}}
+	quarterTurns = []Interval{{Lo: -math.Pi / 2, Hi: math.Pi / 2}}
+)
+
+//nolint:gochecknoglobals // read-only registry indexed by Function
+var functions = [...]functionMeta{
+	FuncSqrt:    {name: "Sqrt", edges: []float64{0}, domain: nonNegatives, codomain: nonNegatives},
+	FuncInvSqrt: {name: "InvSqrt", edges: []float64{0}, domain: positives, codomain: positives, batch: []string{"FastInvSqrt4", "FastInvSqrt2"}},
+	FuncLog:     {name: "Log", edges: []float64{0}, domain: positives, codomain: reals},
+	FuncLog2:    {name: "Log2", edges: []float64{0}, domain: positives, codomain: reals},
+	FuncExp:     {name: "Exp", domain: reals, codomain: positives},
+	FuncExp2:    {name: "Exp2", domain: reals, codomain: positives},
+	FuncSin:     {name: "Sin", domain: reals, codomain: unit},
+	FuncCos:     {name: "Cos", domain: reals, codomain: unit},
+	FuncTan:     {name: "Tan", poleOffset: math.Pi / 2, polePeriod: math.Pi, domain: reals, codomain: reals},
+	FuncCotan:   {name: "Cotan", polePeriod: math.Pi, domain: reals, codomain: reals},
+	FuncSec:     {name: "Sec", poleOffset: math.Pi / 2, polePeriod: math.Pi, domain: reals, codomain: outsideUnit},
+	FuncCsc:     {name: "Csc", polePeriod: math.Pi, domain: reals, codomain: outsideUnit},
+	FuncArctan: {
+		name: "Arctan", domain: reals, codomain: quarterTurns,
+		tiers: []Precision{PrecisionBalanced, PrecisionHigh},
+	},
+	FuncArccotan: {
+		name: "Arccotan", domain: reals, codomain: halfTurn,
+		tiers: []Precision{PrecisionBalanced, PrecisionHigh},
+	},
+	FuncArccos: {name: "Arccos", edges: []float64{-1, 1}, domain: unit, codomain: halfTurn},
+	FuncArcsec: {name: "Arcsec", edges: []float64{-1, 1}, domain: outsideUnit, codomain: halfTurn},
+	FuncArccsc: {name: "Arccsc", edges: []float64{-1, 1}, domain: outsideUnit, codomain: quarterTurns},
+}
+
+// Functions returns every Function known to the registry, in declaration
+// order.
+func Functions() []Function {
+	fns := make([]Function, 0, len(functions)-1)
+	for f := FuncSqrt; int(f) < len(functions); f++ {
+		fns = append(fns, f)
+	}
+
+	return fns
+}
+
+func (f Function) valid() bool { return f > 0 && int(f) < len(functions) }
+
+func (f Function) String() string {
+	if !f.valid() {
+		return "Function(invalid)"
+	}
+
+	return functions[f].name
+}
+
+// MarshalText encodes f as its String form.
+func (f Function) MarshalText() ([]byte, error) {
+	if !f.valid() {
+		return nil, fmt.Errorf("approx: marshal Function(%d): %w", int(f), ErrDomainError)
+	}
+
+	return []byte(functions[f].name), nil
+}
+
+// UnmarshalText decodes a name produced by MarshalText.
+func (f *Function) UnmarshalText(text []byte) error {
+	for _, g := range Functions() {
+		if functions[g].name == string(text) {
+			*f = g
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("approx: unknown function %q: %w", text, ErrDomainError)
+}
+
+// NearestSingularity returns the pole or domain edge of fn closest to x and
+// the distance to it. Poles are those of Tan, Cotan, Sec and Csc; domain
+// edges are 0 for Sqrt, InvSqrt, Log and Log2 and ±1 for Arccos, Arcsec and
+// Arccsc. Near these points the functions blow up or stop being defined, and
+// the approximations lose accuracy first, so a plotting tool or a controller
+// avoiding gimbal regions can keep a margin.
+//
+// Functions without singularities return (NaN, +Inf). An unknown fn or NaN x
+// returns (NaN, NaN).
+func NearestSingularity[T Float](fn Function, x T) (location, distance T) {
+	xf := float64(x)
+	if !fn.valid() || xf != xf { //nolint:gocritic
+		return T(math.NaN()), T(math.NaN())
+	}
+
+	meta := &functions[fn]
+	best, bestDist := math.NaN(), math.Inf(1)
+
+	if meta.polePeriod > 0 && !math.IsInf(xf, 0) {
+		k := math.Round((xf - meta.poleOffset) / meta.polePeriod)
+		best = meta.poleOffset + k*meta.polePeriod
+		bestDist = math.Abs(xf - best)
+	}
+
+	for _, e := range meta.edges {
+		if d := math.Abs(xf - e); d < bestDist {
+			best, bestDist = e, d
+		}
+	}
+
+	return T(best), T(bestDist)
+}
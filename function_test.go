@@ -0,0 +1,135 @@
+package approx
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNearestSingularity(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		fn       Function
+		x        float64
+		loc, dst float64
+	}{
+		{FuncTan, 1.5, math.Pi / 2, math.Pi/2 - 1.5},
+		{FuncTan, -1.7, -math.Pi / 2, 1.7 - math.Pi/2},
+		{FuncSec, 4.5, 3 * math.Pi / 2, 3*math.Pi/2 - 4.5},
+		{FuncCotan, 0.2, 0, 0.2},
+		{FuncCsc, 3, math.Pi, math.Pi - 3},
+		{FuncLog, 1e-3, 0, 1e-3},
+		{FuncSqrt, -2, 0, 2},
+		{FuncArccos, 0.9, 1, 0.1},
+		{FuncArccos, -0.6, -1, 0.4},
+	}
+
+	for _, tc := range cases {
+		loc, dst := NearestSingularity(tc.fn, tc.x)
+		if math.Abs(loc-tc.loc) > 1e-12 || math.Abs(dst-tc.dst) > 1e-12 {
+			t.Fatalf("NearestSingularity(%v, %g) = (%g, %g), want (%g, %g)", tc.fn, tc.x, loc, dst, tc.loc, tc.dst)
+		}
+	}
+
+	if loc, dst := NearestSingularity(FuncSin, float32(2)); !math.IsNaN(float64(loc)) || !math.IsInf(float64(dst), 1) {
+		t.Fatalf("FuncSin: (%g, %g), want (NaN, +Inf)", loc, dst)
+	}
+
+	if loc, dst := NearestSingularity(Function(0), 1.0); !math.IsNaN(loc) || !math.IsNaN(dst) {
+		t.Fatalf("invalid function: (%g, %g)", loc, dst)
+	}
+
+	if _, dst := NearestSingularity(FuncTan, math.NaN()); !math.IsNaN(dst) {
+		t.Fatalf("NaN input: distance %g", dst)
+	}
+}
+
+func TestFunctionsRegistry(t *testing.T) {
+	t.Parallel()
+
+	fns := Functions()
+	if len(fns) != len(functions)-1 || fns[0] != FuncSqrt || fns[len(fns)-1] != FuncArccsc {
+		t.Fatalf("Functions() = %v", fns)
+	}
+
+	for _, f := range fns {
+		if f.String() == "" || f.String() == Function(0).String() {
+			t.Fatalf("function %d has no name", int(f))
+		}
+	}
+}
+
+func TestFunctionAndPrecisionText(t *testing.T) {
+	t.Parallel()
+
+	for _, f := range Functions() {
+		text, err := f.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText(%v): %v", f, err)
+		}
+
+		var back Function
+		if err := back.UnmarshalText(text); err != nil || back != f {
+			t.Fatalf("round trip %v: got %v, %v", f, back, err)
+		}
+	}
+
+	for p := PrecisionAuto; p <= PrecisionHigh; p++ {
+		text, err := p.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText(%v): %v", p, err)
+		}
+
+		var back Precision
+		if err := back.UnmarshalText(text); err != nil || back != p {
+			t.Fatalf("round trip %v: got %v, %v", p, back, err)
+		}
+	}
+
+	if _, err := Function(0).MarshalText(); !errors.Is(err, ErrDomainError) {
+		t.Fatalf("invalid Function must not marshal, got %v", err)
+	}
+
+	var f Function
+	if err := f.UnmarshalText([]byte("Cosh")); !errors.Is(err, ErrDomainError) {
+		t.Fatalf("unknown name must fail, got %v", err)
+	}
+
+	var p Precision
+	if err := p.UnmarshalText([]byte("extreme")); !errors.Is(err, ErrDomainError) {
+		t.Fatalf("unknown precision must fail, got %v", err)
+	}
+}
+
+// TestPrecisionJSON checks that configs written before Precision had
+// MarshalText, which stored the tier as a number, still decode.
+func TestPrecisionJSON(t *testing.T) {
+	t.Parallel()
+
+	var cfg struct{ Prec Precision }
+
+	for in, want := range map[string]Precision{
+		`{"Prec":"high"}`: PrecisionHigh,
+		`{"Prec":1}`:      PrecisionFast,
+		`{"Prec":"2"}`:    PrecisionBalanced,
+		`{"Prec":null}`:   PrecisionBalanced,
+	} {
+		cfg.Prec = PrecisionBalanced
+		if err := json.Unmarshal([]byte(in), &cfg); err != nil || cfg.Prec != want {
+			t.Fatalf("Unmarshal(%s) = %v, %v; want %v", in, cfg.Prec, err, want)
+		}
+	}
+
+	for _, in := range []string{`{"Prec":7}`, `{"Prec":-1}`, `{"Prec":1.5}`, `{"Prec":"extreme"}`} {
+		if err := json.Unmarshal([]byte(in), &cfg); !errors.Is(err, ErrDomainError) {
+			t.Fatalf("Unmarshal(%s) must fail with a domain error, got %v", in, err)
+		}
+	}
+
+	data, err := json.Marshal(struct{ Prec Precision }{PrecisionFast})
+	if err != nil || string(data) != `{"Prec":"fast"}` {
+		t.Fatalf("Marshal = %s, %v", data, err)
+	}
+}
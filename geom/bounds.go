@@ -0,0 +1,124 @@
+package geom
+
+import (
+	"fmt"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// Sphere is a bounding sphere.
+type Sphere[T approx.Float] struct {
+	Center approx.Vec3[T]
+	Radius T
+}
+
+// AABB is an axis-aligned bounding box with Min <= Max componentwise.
+type AABB[T approx.Float] struct {
+	Min, Max approx.Vec3[T]
+}
+
+// SphereOverlap reports whether a and b intersect (touching counts). It
+// compares squared distances and takes no square root.
+func SphereOverlap[T approx.Float](a, b Sphere[T]) bool {
+	d := a.Center.Sub(b.Center)
+	r := a.Radius + b.Radius
+
+	return d.Dot(d) <= r*r
+}
+
+// AABBDistance2 returns the squared distance from p to the closest point of
+// box, or 0 when p is inside. No square root is taken.
+func AABBDistance2[T approx.Float](box AABB[T], p approx.Vec3[T]) T {
+	var d2 T
+
+	for i := range 3 {
+		switch {
+		case p[i] < box.Min[i]:
+			e := box.Min[i] - p[i]
+			d2 += e * e
+		case p[i] > box.Max[i]:
+			e := p[i] - box.Max[i]
+			d2 += e * e
+		}
+	}
+
+	return d2
+}
+
+// SphereAABBOverlap reports whether s and box intersect (touching counts).
+func SphereAABBOverlap[T approx.Float](s Sphere[T], box AABB[T]) bool {
+	return AABBDistance2(box, s.Center) <= s.Radius*s.Radius
+}
+
+// SphereContact returns the contact normal (unit length, pointing from a
+// towards b) and the penetration depth of two overlapping spheres using the
+// default precision. ok is false when they do not overlap.
+//
+// This is the only routine here that needs a square root; it uses a single
+// FastInvSqrt for both the normal and the depth. Concentric spheres get the
+// normal +X.
+func SphereContact[T approx.Float](a, b Sphere[T]) (normal approx.Vec3[T], depth T, ok bool) {
+	return SphereContactPrec(a, b, approx.PrecisionAuto)
+}
+
+// SphereContactPrec is SphereContact using the requested precision.
+func SphereContactPrec[T approx.Float](
+	a, b Sphere[T], prec approx.Precision,
+) (normal approx.Vec3[T], depth T, ok bool) {
+	d := b.Center.Sub(a.Center)
+	d2 := d.Dot(d)
+	r := a.Radius + b.Radius
+
+	if d2 > r*r {
+		return normal, 0, false
+	}
+
+	if d2 == 0 {
+		return approx.Vec3[T]{1, 0, 0}, r, true
+	}
+
+	inv := approx.FastInvSqrtPrec(d2, prec)
+
+	return d.Scale(inv), r - d2*inv, true
+}
+
+// SphereOverlapInto sets dst[i] to SphereOverlap(q, spheres[i]), the
+// broad-phase test of one query volume against many.
+// It panics if len(dst) != len(spheres).
+func SphereOverlapInto[T approx.Float](dst []bool, q Sphere[T], spheres []Sphere[T]) {
+	if len(dst) != len(spheres) {
+		panicLengthMismatch("SphereOverlapInto")
+	}
+
+	for i := range spheres {
+		dst[i] = SphereOverlap(q, spheres[i])
+	}
+}
+
+// SphereAABBOverlapInto sets dst[i] to SphereAABBOverlap(q, boxes[i]).
+// It panics if len(dst) != len(boxes).
+func SphereAABBOverlapInto[T approx.Float](dst []bool, q Sphere[T], boxes []AABB[T]) {
+	if len(dst) != len(boxes) {
+		panicLengthMismatch("SphereAABBOverlapInto")
+	}
+
+	for i := range boxes {
+		dst[i] = SphereAABBOverlap(q, boxes[i])
+	}
+}
+
+// AABBDistance2Into sets dst[i] to AABBDistance2(boxes[i], p).
+// It panics if len(dst) != len(boxes).
+func AABBDistance2Into[T approx.Float](dst []T, p approx.Vec3[T], boxes []AABB[T]) {
+	if len(dst) != len(boxes) {
+		panicLengthMismatch("AABBDistance2Into")
+	}
+
+	for i := range boxes {
+		dst[i] = AABBDistance2(boxes[i], p)
+	}
+}
+
+func panicLengthMismatch(fn string) {
+	panic(fmt.Errorf("geom: %s: %w", fn, approx.ErrLengthMismatch))
+}
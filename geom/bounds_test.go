@@ -0,0 +1,127 @@
+package geom
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+type v3 = approx.Vec3[float64]
+
+func TestSphereOverlap(t *testing.T) {
+	t.Parallel()
+
+	a := Sphere[float64]{Center: v3{0, 0, 0}, Radius: 1}
+
+	cases := []struct {
+		b    Sphere[float64]
+		want bool
+	}{
+		{Sphere[float64]{Center: v3{1.5, 0, 0}, Radius: 1}, true},
+		{Sphere[float64]{Center: v3{2, 0, 0}, Radius: 1}, true}, // touching
+		{Sphere[float64]{Center: v3{2, 0.1, 0}, Radius: 1}, false},
+		{Sphere[float64]{Center: v3{0, 0, 0}, Radius: 0.1}, true},
+	}
+
+	for _, tc := range cases {
+		if got := SphereOverlap(a, tc.b); got != tc.want {
+			t.Errorf("SphereOverlap(%v) = %v, want %v", tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestAABBDistance2(t *testing.T) {
+	t.Parallel()
+
+	box := AABB[float64]{Min: v3{0, 0, 0}, Max: v3{1, 1, 1}}
+
+	cases := []struct {
+		p    v3
+		want float64
+	}{
+		{v3{0.5, 0.5, 0.5}, 0},
+		{v3{2, 0.5, 0.5}, 1},
+		{v3{-1, -1, 0.5}, 2},
+		{v3{2, 3, -2}, 1 + 4 + 4},
+	}
+
+	for _, tc := range cases {
+		if got := AABBDistance2(box, tc.p); got != tc.want {
+			t.Errorf("AABBDistance2(%v) = %g, want %g", tc.p, got, tc.want)
+		}
+	}
+
+	if !SphereAABBOverlap(Sphere[float64]{Center: v3{2, 0.5, 0.5}, Radius: 1}, box) {
+		t.Error("touching sphere and box not overlapping")
+	}
+
+	if SphereAABBOverlap(Sphere[float64]{Center: v3{2, 2, 2}, Radius: 1.7}, box) {
+		t.Error("corner miss reported as overlap")
+	}
+}
+
+func TestSphereContact(t *testing.T) {
+	t.Parallel()
+
+	a := Sphere[float64]{Center: v3{0, 0, 0}, Radius: 1}
+	b := Sphere[float64]{Center: v3{0, 1.5, 0}, Radius: 1}
+
+	n, depth, ok := SphereContactPrec(a, b, approx.PrecisionHigh)
+	if !ok || math.Abs(n[1]-1) > 1e-9 || math.Abs(n[0]) > 0 || math.Abs(depth-0.5) > 1e-9 {
+		t.Fatalf("SphereContact = (%v, %g, %v)", n, depth, ok)
+	}
+
+	if _, _, ok := SphereContact(a, Sphere[float64]{Center: v3{3, 0, 0}, Radius: 1}); ok {
+		t.Fatal("separated spheres reported contact")
+	}
+
+	if n, depth, ok := SphereContact(a, a); !ok || n != (v3{1, 0, 0}) || depth != 2 {
+		t.Fatalf("concentric: (%v, %g, %v)", n, depth, ok)
+	}
+}
+
+func TestBatchForms(t *testing.T) {
+	t.Parallel()
+
+	q := Sphere[float32]{Center: approx.Vec3[float32]{0, 0, 0}, Radius: 1}
+	spheres := []Sphere[float32]{
+		{Center: approx.Vec3[float32]{1, 0, 0}, Radius: 0.5},
+		{Center: approx.Vec3[float32]{5, 0, 0}, Radius: 0.5},
+	}
+	boxes := []AABB[float32]{
+		{Min: approx.Vec3[float32]{0.5, 0, 0}, Max: approx.Vec3[float32]{2, 1, 1}},
+		{Min: approx.Vec3[float32]{3, 3, 3}, Max: approx.Vec3[float32]{4, 4, 4}},
+	}
+
+	hits := make([]bool, 2)
+
+	SphereOverlapInto(hits, q, spheres)
+
+	if !hits[0] || hits[1] {
+		t.Fatalf("SphereOverlapInto = %v", hits)
+	}
+
+	SphereAABBOverlapInto(hits, q, boxes)
+
+	if !hits[0] || hits[1] {
+		t.Fatalf("SphereAABBOverlapInto = %v", hits)
+	}
+
+	d2 := make([]float32, 2)
+	AABBDistance2Into(d2, q.Center, boxes)
+
+	if d2[0] != 0.25 || d2[1] != 27 {
+		t.Fatalf("AABBDistance2Into = %v", d2)
+	}
+
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, approx.ErrLengthMismatch) {
+			t.Fatalf("recover = %v, want ErrLengthMismatch", err)
+		}
+	}()
+
+	SphereOverlapInto(hits[:1], q, spheres)
+}
@@ -0,0 +1,9 @@
+// Package geom provides collision and geometry helpers for games and
+// simulations on top of the approx kernels and vector types.
+//
+// Square roots go through FastSqrt/FastInvSqrt, and the tolerances used for
+// near-degenerate configurations (tangency, parallel segments) scale with the
+// requested precision tier; see Epsilon.
+//
+// Functions take and return approx.Vec2/Vec3 values and do not allocate.
+package geom
@@ -0,0 +1,20 @@
+package geom
+
+import approx "github.com/meko-christian/algo-approx"
+
+// Epsilon returns the relative tolerance used by this package for the given
+// precision tier. It sits a little above the worst-case relative error of
+// the tier's sqrt kernels, so decisions such as "tangent" or "parallel" are
+// not flipped by approximation noise.
+func Epsilon(prec approx.Precision) float64 {
+	switch prec {
+	case approx.PrecisionFast:
+		return 1e-3
+	case approx.PrecisionHigh:
+		return 1e-10
+	case approx.PrecisionAuto, approx.PrecisionBalanced:
+		return 1e-5
+	default:
+		return 1e-5
+	}
+}
@@ -0,0 +1,134 @@
+package geom_test
+
+import (
+	"fmt"
+	"math"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/geom"
+)
+
+type vec2 = approx.Vec2[float64]
+
+type vec3 = approx.Vec3[float64]
+
+func ExampleRayCircle() {
+	t0, t1, ok := geom.RayCirclePrec(vec2{-5, 0}, vec2{1, 0}, vec2{0, 0}, 2, approx.PrecisionHigh)
+	fmt.Printf("%.3f %.3f %v\n", t0, t1, ok)
+	// Output:
+	// 3.000 7.000 true
+}
+
+func ExampleSegmentSegment() {
+	p, t, u, ok := geom.SegmentSegment(vec2{0, 0}, vec2{4, 4}, vec2{0, 4}, vec2{4, 0})
+	fmt.Println(p, t, u, ok)
+	// Output:
+	// [2 2] 0.5 0.5 true
+}
+
+func ExampleEpsilon() {
+	fmt.Println(geom.Epsilon(approx.PrecisionFast), geom.Epsilon(approx.PrecisionHigh))
+	// Output:
+	// 0.001 1e-10
+}
+
+func ExampleSphereOverlap() {
+	a := geom.Sphere[float64]{Center: vec3{0, 0, 0}, Radius: 1}
+	b := geom.Sphere[float64]{Center: vec3{1.5, 0, 0}, Radius: 1}
+	fmt.Println(geom.SphereOverlap(a, b))
+	// Output:
+	// true
+}
+
+func ExampleAABBDistance2() {
+	box := geom.AABB[float64]{Min: vec3{0, 0, 0}, Max: vec3{1, 1, 1}}
+	fmt.Println(geom.AABBDistance2(box, vec3{3, 0.5, 0.5}))
+	// Output:
+	// 4
+}
+
+func ExampleSphereAABBOverlap() {
+	box := geom.AABB[float64]{Min: vec3{0, 0, 0}, Max: vec3{1, 1, 1}}
+	fmt.Println(geom.SphereAABBOverlap(geom.Sphere[float64]{Center: vec3{2, 0.5, 0.5}, Radius: 1.5}, box))
+	// Output:
+	// true
+}
+
+func ExampleSphereContact() {
+	a := geom.Sphere[float64]{Center: vec3{0, 0, 0}, Radius: 1}
+	b := geom.Sphere[float64]{Center: vec3{0, 1.5, 0}, Radius: 1}
+	n, depth, ok := geom.SphereContactPrec(a, b, approx.PrecisionHigh)
+	fmt.Printf("%.3f %.3f %v\n", n, depth, ok)
+	// Output:
+	// [0.000 1.000 0.000] 0.500 true
+}
+
+func ExampleSphereOverlapInto() {
+	q := geom.Sphere[float64]{Center: vec3{0, 0, 0}, Radius: 1}
+	others := []geom.Sphere[float64]{
+		{Center: vec3{1, 0, 0}, Radius: 0.5},
+		{Center: vec3{5, 0, 0}, Radius: 0.5},
+		{Center: vec3{0, 0, -1.2}, Radius: 0.5},
+	}
+
+	hits := make([]bool, len(others))
+	geom.SphereOverlapInto(hits, q, others)
+	fmt.Println(hits)
+	// Output:
+	// [true false true]
+}
+
+func ExampleSphereAABBOverlapInto() {
+	q := geom.Sphere[float64]{Center: vec3{0, 0, 0}, Radius: 1}
+	boxes := []geom.AABB[float64]{
+		{Min: vec3{0.5, 0, 0}, Max: vec3{2, 1, 1}},
+		{Min: vec3{3, 3, 3}, Max: vec3{4, 4, 4}},
+	}
+
+	hits := make([]bool, len(boxes))
+	geom.SphereAABBOverlapInto(hits, q, boxes)
+	fmt.Println(hits)
+	// Output:
+	// [true false]
+}
+
+func ExampleAABBDistance2Into() {
+	boxes := []geom.AABB[float64]{
+		{Min: vec3{1, 0, 0}, Max: vec3{2, 1, 1}},
+		{Min: vec3{3, 3, 3}, Max: vec3{4, 4, 4}},
+	}
+
+	d2 := make([]float64, len(boxes))
+	geom.AABBDistance2Into(d2, vec3{0, 0, 0}, boxes)
+	fmt.Println(d2)
+	// Output:
+	// [1 27]
+}
+
+func ExamplePerspectiveMatrix() {
+	m, err := geom.PerspectiveMatrixPrec(math.Pi/3, 1.0, 1, 101, approx.PrecisionHigh)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, row := range m {
+		fmt.Printf("%7.3f\n", row)
+	}
+	// Output:
+	// [  1.732   0.000   0.000   0.000]
+	// [  0.000   1.732   0.000   0.000]
+	// [  0.000   0.000  -1.020  -2.020]
+	// [  0.000   0.000  -1.000   0.000]
+}
+
+func ExampleFovToFocal() {
+	// Horizontal focal length in pixels for a 1920-px-wide, 60° camera.
+	f, err := geom.FovToFocalPrec(math.Pi/3, 1920.0, approx.PrecisionHigh)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%.1f px\n", f)
+	// Output:
+	// 1662.8 px
+}
@@ -0,0 +1,111 @@
+package geom
+
+import (
+	"math"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// RayCircle intersects the ray origin + t·dir (t >= 0) with the circle of the
+// given center and radius using the default precision.
+//
+// It returns the line parameters t0 <= t1 of the entry and exit points and
+// ok = true when the ray reaches the circle (t1 >= 0). t0 is negative when the
+// origin lies inside the circle. dir need not be normalized; a zero dir or a
+// negative radius yields ok = false. A ray that misses the circle by less than
+// Epsilon (relative) is reported as tangent, with t0 == t1.
+func RayCircle[T approx.Float](origin, dir, center approx.Vec2[T], radius T) (t0, t1 T, ok bool) {
+	return RayCirclePrec(origin, dir, center, radius, approx.PrecisionAuto)
+}
+
+// RayCirclePrec is RayCircle using the requested precision.
+func RayCirclePrec[T approx.Float](
+	origin, dir, center approx.Vec2[T], radius T, prec approx.Precision,
+) (t0, t1 T, ok bool) {
+	dx, dy := float64(dir[0]), float64(dir[1])
+	ox, oy := float64(origin[0]-center[0]), float64(origin[1]-center[1])
+	r := float64(radius)
+
+	a := dx*dx + dy*dy
+	if !(a > 0) || r < 0 { //nolint:staticcheck // also rejects NaN
+		return 0, 0, false
+	}
+
+	// |o + t·d|² = r²  ⇔  a·t² + 2b·t + c = 0
+	b := dx*ox + dy*oy
+	c := ox*ox + oy*oy - r*r
+
+	disc := b*b - a*c
+	if disc < 0 {
+		if -disc > Epsilon(prec)*(b*b+math.Abs(a*c)) {
+			return 0, 0, false
+		}
+
+		disc = 0
+	}
+
+	s := approx.FastSqrtPrec(disc, prec)
+
+	// Stable root pair: q has the sign of -b, so no cancellation occurs.
+	q := -(b + math.Copysign(s, b))
+
+	lo, hi := q/a, q/a
+	if q != 0 {
+		hi = c / q
+	}
+
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+
+	if hi < 0 {
+		return 0, 0, false
+	}
+
+	return T(lo), T(hi), true
+}
+
+// SegmentSegment intersects the segments p0–p1 and q0–q1 using the default
+// precision.
+//
+// On a hit it returns the intersection point and the parameters t, u in
+// [0, 1] with point = p0 + t·(p1-p0) = q0 + u·(q1-q0). Endpoint hits within
+// Epsilon (relative to segment length) count as hits, with t and u clamped to
+// [0, 1]. Parallel and collinear segments, as well as degenerate zero-length
+// ones, report ok = false.
+func SegmentSegment[T approx.Float](p0, p1, q0, q1 approx.Vec2[T]) (point approx.Vec2[T], t, u T, ok bool) {
+	return SegmentSegmentPrec(p0, p1, q0, q1, approx.PrecisionAuto)
+}
+
+// SegmentSegmentPrec is SegmentSegment using the requested precision.
+func SegmentSegmentPrec[T approx.Float](
+	p0, p1, q0, q1 approx.Vec2[T], prec approx.Precision,
+) (point approx.Vec2[T], t, u T, ok bool) {
+	rx, ry := float64(p1[0]-p0[0]), float64(p1[1]-p0[1])
+	sx, sy := float64(q1[0]-q0[0]), float64(q1[1]-q0[1])
+	wx, wy := float64(q0[0]-p0[0]), float64(q0[1]-p0[1])
+
+	eps := Epsilon(prec)
+
+	denom := rx*sy - ry*sx
+	lenProduct := approx.FastSqrtPrec((rx*rx+ry*ry)*(sx*sx+sy*sy), prec)
+
+	// |r×s| = |r||s|·sin θ, so this rejects angles below about eps radians.
+	if !(math.Abs(denom) > eps*lenProduct) { //nolint:staticcheck // also rejects NaN
+		return point, 0, 0, false
+	}
+
+	tf := (wx*sy - wy*sx) / denom
+	uf := (wx*ry - wy*rx) / denom
+
+	if tf < -eps || tf > 1+eps || uf < -eps || uf > 1+eps {
+		return point, 0, 0, false
+	}
+
+	tf = min(max(tf, 0), 1)
+	uf = min(max(uf, 0), 1)
+
+	point = approx.Vec2[T]{T(float64(p0[0]) + tf*rx), T(float64(p0[1]) + tf*ry)}
+
+	return point, T(tf), T(uf), true
+}
@@ -0,0 +1,185 @@
+package geom
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+type v2 = approx.Vec2[float64]
+
+func TestRayCircle_Basic(t *testing.T) {
+	t.Parallel()
+
+	t0, t1, ok := RayCirclePrec(v2{-5, 0}, v2{1, 0}, v2{0, 0}, 2, approx.PrecisionHigh)
+	if !ok || math.Abs(t0-3) > 1e-9 || math.Abs(t1-7) > 1e-9 {
+		t.Fatalf("through centre: (%g, %g, %v)", t0, t1, ok)
+	}
+
+	t0, t1, ok = RayCircle(v2{0, 0}, v2{0, 2}, v2{0, 0}, 2)
+	if !ok || math.Abs(t0+1) > 1e-4 || math.Abs(t1-1) > 1e-4 {
+		t.Fatalf("from inside: (%g, %g, %v)", t0, t1, ok)
+	}
+
+	if _, _, ok := RayCircle(v2{5, 0}, v2{1, 0}, v2{0, 0}, 2); ok {
+		t.Fatal("circle behind the ray reported as hit")
+	}
+
+	if _, _, ok := RayCircle(v2{-5, 3}, v2{1, 0}, v2{0, 0}, 2); ok {
+		t.Fatal("miss reported as hit")
+	}
+
+	t0, t1, ok = RayCircle(v2{-5, 2}, v2{1, 0}, v2{0, 0}, 2)
+	if !ok || t0 != t1 || math.Abs(t0-5) > 1e-4 {
+		t.Fatalf("tangent: (%g, %g, %v)", t0, t1, ok)
+	}
+
+	if _, _, ok := RayCircle(v2{0, 0}, v2{0, 0}, v2{1, 1}, 1); ok {
+		t.Fatal("zero direction reported as hit")
+	}
+}
+
+func TestSegmentSegment_Basic(t *testing.T) {
+	t.Parallel()
+
+	p, tt, u, ok := SegmentSegmentPrec(v2{0, 0}, v2{2, 2}, v2{0, 2}, v2{2, 0}, approx.PrecisionHigh)
+	if !ok || p != (v2{1, 1}) || tt != 0.5 || u != 0.5 {
+		t.Fatalf("cross: %v %g %g %v", p, tt, u, ok)
+	}
+
+	if _, _, _, ok := SegmentSegment(v2{0, 0}, v2{1, 0}, v2{0, 1}, v2{1, 1}); ok {
+		t.Fatal("parallel segments reported as hit")
+	}
+
+	if _, _, _, ok := SegmentSegment(v2{0, 0}, v2{1, 1}, v2{3, 0}, v2{2, 1}); ok {
+		t.Fatal("disjoint segments reported as hit")
+	}
+
+	if _, tt, _, ok := SegmentSegment(v2{0, 0}, v2{1, 0}, v2{1, -1}, v2{1, 1}); !ok || tt != 1 {
+		t.Fatalf("endpoint touch: t=%g ok=%v", tt, ok)
+	}
+
+	if _, _, _, ok := SegmentSegment(v2{0, 0}, v2{0, 0}, v2{-1, 0}, v2{1, 0}); ok {
+		t.Fatal("degenerate segment reported as hit")
+	}
+}
+
+func randomPoint(r interface{ IntN(n int) int }) v2 {
+	return v2{float64(r.IntN(201) - 100), float64(r.IntN(201) - 100)}
+}
+
+// TestSegmentSegment_AgainstRational compares against exact rational
+// arithmetic on integer coordinates, away from the tolerance band.
+func TestSegmentSegment_AgainstRational(t *testing.T) {
+	t.Parallel()
+
+	r := approx.Seeded(1)
+
+	for range 5000 {
+		p0, p1, q0, q1 := randomPoint(r), randomPoint(r), randomPoint(r), randomPoint(r)
+
+		rx, ry := int64(p1[0]-p0[0]), int64(p1[1]-p0[1])
+		sx, sy := int64(q1[0]-q0[0]), int64(q1[1]-q0[1])
+		wx, wy := int64(q0[0]-p0[0]), int64(q0[1]-p0[1])
+
+		denom := rx*sy - ry*sx
+		if denom == 0 {
+			if _, _, _, ok := SegmentSegment(p0, p1, q0, q1); ok {
+				t.Fatalf("parallel %v-%v %v-%v reported as hit", p0, p1, q0, q1)
+			}
+
+			continue
+		}
+
+		tr := big.NewRat(wx*sy-wy*sx, denom)
+		ur := big.NewRat(wx*ry-wy*rx, denom)
+		tf, _ := tr.Float64()
+		uf, _ := ur.Float64()
+
+		const margin = 1e-3
+		inside := tf > margin && tf < 1-margin && uf > margin && uf < 1-margin
+		outside := tf < -margin || tf > 1+margin || uf < -margin || uf > 1+margin
+
+		_, gt, gu, ok := SegmentSegment(p0, p1, q0, q1)
+
+		switch {
+		case inside && (!ok || math.Abs(gt-tf) > 1e-9 || math.Abs(gu-uf) > 1e-9):
+			t.Fatalf("%v-%v %v-%v: got (%g, %g, %v), exact (%g, %g)", p0, p1, q0, q1, gt, gu, ok, tf, uf)
+		case outside && ok:
+			t.Fatalf("%v-%v %v-%v: miss reported as hit (exact t=%g u=%g)", p0, p1, q0, q1, tf, uf)
+		}
+	}
+}
+
+// TestRayCircle_AgainstRational decides hit/miss from the exact rational
+// discriminant and checks the roots against a 256-bit evaluation.
+func TestRayCircle_AgainstRational(t *testing.T) {
+	t.Parallel()
+
+	r := approx.Seeded(2)
+
+	for range 5000 {
+		o, d, c := randomPoint(r), randomPoint(r), randomPoint(r)
+		rad := float64(r.IntN(80) + 1)
+
+		if d == (v2{}) {
+			continue
+		}
+
+		ox, oy := int64(o[0]-c[0]), int64(o[1]-c[1])
+		dx, dy := int64(d[0]), int64(d[1])
+		a := dx*dx + dy*dy
+		b := dx*ox + dy*oy
+		cc := ox*ox + oy*oy - int64(rad)*int64(rad)
+		disc := b*b - a*cc // exact in int64 for these ranges
+
+		bigT := func(sign int64) float64 {
+			s := new(big.Float).SetPrec(256).SetInt64(disc)
+			s.Sqrt(s)
+			s.Mul(s, big.NewFloat(float64(sign)))
+			s.Sub(s, new(big.Float).SetInt64(b))
+			s.Quo(s, new(big.Float).SetInt64(a))
+			f, _ := s.Float64()
+
+			return f
+		}
+
+		gt0, gt1, ok := RayCirclePrec(o, d, c, rad, approx.PrecisionHigh)
+
+		if disc < 0 {
+			if ok && float64(-disc) > 1e-9*float64(b*b) {
+				t.Fatalf("o=%v d=%v c=%v r=%g: miss reported as hit", o, d, c, rad)
+			}
+
+			continue
+		}
+
+		e0, e1 := bigT(-1), bigT(1)
+		if e1 < -1e-9 {
+			if ok {
+				t.Fatalf("o=%v d=%v c=%v r=%g: circle behind ray reported as hit", o, d, c, rad)
+			}
+
+			continue
+		}
+
+		if e1 > 1e-9 && (!ok || math.Abs(gt0-e0) > 1e-6*(1+math.Abs(e0)) || math.Abs(gt1-e1) > 1e-6*(1+math.Abs(e1))) {
+			t.Fatalf("o=%v d=%v c=%v r=%g: got (%g, %g, %v), exact (%g, %g)", o, d, c, rad, gt0, gt1, ok, e0, e1)
+		}
+	}
+}
+
+func TestEpsilon_Ordering(t *testing.T) {
+	t.Parallel()
+
+	if !(Epsilon(approx.PrecisionFast) > Epsilon(approx.PrecisionBalanced) &&
+		Epsilon(approx.PrecisionBalanced) > Epsilon(approx.PrecisionHigh)) {
+		t.Fatal("Epsilon is not decreasing with precision")
+	}
+
+	if Epsilon(approx.PrecisionAuto) != Epsilon(approx.PrecisionBalanced) {
+		t.Fatal("Auto does not match Balanced")
+	}
+}
@@ -0,0 +1,78 @@
+package geom
+
+import (
+	"fmt"
+	"math"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// Mat4 is a 4×4 matrix indexed m[row][col]. It multiplies column vectors,
+// so the translation of an affine transform lives in the last column.
+// Transpose before uploading to APIs that expect column-major storage.
+type Mat4[T approx.Float] [4][4]T
+
+// PerspectiveMatrix returns the right-handed OpenGL-style projection for a
+// vertical field of view fovY (radians), width/height aspect ratio and near
+// and far clip distances, mapping view-space depth -near..-far to clip-space
+// z in [-1, 1]. It uses the default precision.
+//
+// The focal scale cot(fovY/2) comes from FastCotan, so recomputing the
+// matrix every frame on zoom is cheap. The kernel error peaks at a 90° field
+// of view (fovY/2 = π/4), at about 2e-4 relative for PrecisionHigh; the
+// depth terms are exact. It returns ErrDomainError unless 0 < fovY < π,
+// aspect > 0 and 0 < near < far.
+func PerspectiveMatrix[T approx.Float](fovY, aspect, near, far T) (Mat4[T], error) {
+	return PerspectiveMatrixPrec(fovY, aspect, near, far, approx.PrecisionAuto)
+}
+
+// PerspectiveMatrixPrec is PerspectiveMatrix using the requested precision.
+func PerspectiveMatrixPrec[T approx.Float](fovY, aspect, near, far T, prec approx.Precision) (Mat4[T], error) {
+	var m Mat4[T]
+
+	if err := checkFov(float64(fovY)); err != nil {
+		return m, err
+	}
+
+	if !(aspect > 0) || !(near > 0) || !(far > near) || math.IsInf(float64(far), 0) { //nolint:staticcheck // also rejects NaN
+		return m, fmt.Errorf("geom: perspective aspect %g, near %g, far %g: %w",
+			float64(aspect), float64(near), float64(far), approx.ErrDomainError)
+	}
+
+	f := approx.FastCotanPrec(fovY/2, prec)
+	depth := near - far
+
+	m[0][0] = f / aspect
+	m[1][1] = f
+	m[2][2] = (far + near) / depth
+	m[2][3] = 2 * far * near / depth
+	m[3][2] = -1
+
+	return m, nil
+}
+
+// FovToFocal converts a field of view (radians) spanning sensorSize into a
+// focal length in the same unit as sensorSize: (sensorSize/2)·cot(fov/2).
+// With sensorSize in pixels this is the pinhole intrinsic fx or fy; with
+// sensorSize 2 it is the projection-matrix scale. It uses the default
+// precision and returns ErrDomainError unless 0 < fov < π.
+func FovToFocal[T approx.Float](fov, sensorSize T) (T, error) {
+	return FovToFocalPrec(fov, sensorSize, approx.PrecisionAuto)
+}
+
+// FovToFocalPrec is FovToFocal using the requested precision.
+func FovToFocalPrec[T approx.Float](fov, sensorSize T, prec approx.Precision) (T, error) {
+	if err := checkFov(float64(fov)); err != nil {
+		return 0, err
+	}
+
+	return sensorSize / 2 * approx.FastCotanPrec(fov/2, prec), nil
+}
+
+func checkFov(fov float64) error {
+	if !(fov > 0 && fov < math.Pi) {
+		return fmt.Errorf("geom: field of view %g outside (0, π): %w", fov, approx.ErrDomainError)
+	}
+
+	return nil
+}
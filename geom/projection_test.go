@@ -0,0 +1,103 @@
+package geom
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func TestPerspectiveMatrix(t *testing.T) {
+	t.Parallel()
+
+	fov, aspect, near, far := 1.2, 16.0/9, 0.1, 100.0
+
+	m, err := PerspectiveMatrixPrec(fov, aspect, near, far, approx.PrecisionHigh)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := 1 / math.Tan(fov/2)
+	want := Mat4[float64]{
+		{f / aspect, 0, 0, 0},
+		{0, f, 0, 0},
+		{0, 0, (far + near) / (near - far), 2 * far * near / (near - far)},
+		{0, 0, -1, 0},
+	}
+
+	for i := range 4 {
+		for j := range 4 {
+			if math.Abs(m[i][j]-want[i][j]) > 3e-4*math.Abs(want[i][j]) {
+				t.Fatalf("m[%d][%d] = %g, want %g", i, j, m[i][j], want[i][j])
+			}
+		}
+	}
+
+	// The near and far planes map to clip z = -1 and +1.
+	for _, tc := range []struct{ z, ndc float64 }{{-near, -1}, {-far, 1}} {
+		clipZ := m[2][2]*tc.z + m[2][3]
+		clipW := m[3][2] * tc.z
+
+		if got := clipZ / clipW; math.Abs(got-tc.ndc) > 1e-9 {
+			t.Fatalf("z=%g maps to %g, want %g", tc.z, got, tc.ndc)
+		}
+	}
+}
+
+func TestPerspectiveMatrix_Tiers(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		prec approx.Precision
+		tol  float64
+	}{
+		{approx.PrecisionFast, 6e-2},
+		{approx.PrecisionBalanced, 2e-2},
+		{approx.PrecisionHigh, 3e-4},
+	} {
+		for fov := 0.2; fov < 3; fov += 0.1 {
+			m, err := PerspectiveMatrixPrec(float32(fov), 1, 0.1, 10, tc.prec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := 1 / math.Tan(fov/2)
+			if d := math.Abs(float64(m[1][1])-want) / want; d > tc.tol {
+				t.Fatalf("prec %v fov %g: focal scale %g, want %g", tc.prec, fov, m[1][1], want)
+			}
+		}
+	}
+}
+
+func TestPerspectiveMatrix_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, args := range [][4]float64{
+		{0, 1, 0.1, 10},
+		{math.Pi, 1, 0.1, 10},
+		{1, 0, 0.1, 10},
+		{1, 1, 0, 10},
+		{1, 1, 10, 10},
+		{1, 1, 0.1, math.Inf(1)},
+		{math.NaN(), 1, 0.1, 10},
+	} {
+		if _, err := PerspectiveMatrix(args[0], args[1], args[2], args[3]); !errors.Is(err, approx.ErrDomainError) {
+			t.Errorf("PerspectiveMatrix%v error = %v, want ErrDomainError", args, err)
+		}
+	}
+}
+
+func TestFovToFocal(t *testing.T) {
+	t.Parallel()
+
+	// A 90° field of view over 1920 px has focal length 960 px.
+	got, err := FovToFocalPrec(math.Pi/2, 1920.0, approx.PrecisionHigh)
+	if err != nil || math.Abs(got-960) > 960*3e-4 {
+		t.Fatalf("FovToFocal = %g, %v", got, err)
+	}
+
+	if _, err := FovToFocal(-1.0, 10.0); !errors.Is(err, approx.ErrDomainError) {
+		t.Fatalf("negative fov error = %v", err)
+	}
+}
@@ -2,4 +2,6 @@ module github.com/meko-christian/algo-approx
 
 go 1.25.0
 
-require golang.org/x/sys v0.39.0 // indirect
+require golang.org/x/sys v0.39.0
+
+require github.com/chewxy/math32 v1.11.2
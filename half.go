@@ -0,0 +1,140 @@
+package approx
+
+import "math"
+
+// Float16Bits returns the IEEE 754 binary16 encoding of x, rounded to
+// nearest with ties to even.
+//
+// Values too large for binary16 become ±Inf, values below half the smallest
+// subnormal (2^-25) become ±0, and subnormal results are rounded exactly
+// like normal ones. NaN stays NaN with the top payload bits kept and the
+// quiet bit set, so a signalling NaN never turns into Inf.
+func Float16Bits(x float32) uint16 {
+	bits := math.Float32bits(x)
+	sign := uint16(bits>>16) & 0x8000 //nolint:gosec
+	abs := bits &^ 0x80000000
+
+	switch {
+	case abs > f32ExpMask: // NaN
+		return sign | f16ExpMask | f16QuietBit | uint16(abs>>13)&0x3ff //nolint:gosec
+	case abs >= f16OverflowBits: // rounds to ±Inf
+		return sign | f16ExpMask
+	case abs < f16MinNormalBits:
+		// Adding 0.5 moves the value into a binade whose ulp is 2^-24, the
+		// binary16 subnormal step, so the FPU performs the rounding; the
+		// mantissa bits are then the subnormal encoding, carrying into the
+		// smallest normal when it rounds up.
+		f := math.Float32frombits(abs) + 0.5
+
+		return sign | uint16(math.Float32bits(f)-math.Float32bits(0.5)) //nolint:gosec
+	default:
+		// Rebias the exponent and round the 13 dropped mantissa bits to
+		// nearest even; a carry correctly propagates into the exponent.
+		odd := (abs >> 13) & 1
+		abs += f16RebiasBits + 0xfff + odd
+
+		return sign | uint16(abs>>13) //nolint:gosec
+	}
+}
+
+// Float16Frombits returns the float32 value of the binary16 encoding h.
+// The conversion is exact; NaN payloads are preserved.
+func Float16Frombits(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0x1f:
+		return math.Float32frombits(sign | f32ExpMask | mant<<13)
+	case 0:
+		// Zero or subnormal: mant · 2^-24, exact in float32.
+		v := float32(mant) * 0x1p-24
+		if sign != 0 {
+			v = -v
+		}
+
+		return v
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+	}
+}
+
+// BFloat16Bits returns the bfloat16 encoding of x (the upper half of its
+// float32 bits), rounded to nearest with ties to even. bfloat16 keeps the
+// float32 exponent range, so only values above the largest finite bfloat16
+// overflow to ±Inf and subnormals round like normal values. NaN stays a
+// quiet NaN.
+func BFloat16Bits(x float32) uint16 {
+	bits := math.Float32bits(x)
+	if bits&^0x80000000 > f32ExpMask {
+		return uint16(bits>>16) | bf16QuietBit //nolint:gosec
+	}
+
+	bits += 0x7fff + (bits>>16)&1
+
+	return uint16(bits >> 16) //nolint:gosec
+}
+
+// BFloat16Frombits returns the float32 value of the bfloat16 encoding h.
+// The conversion is exact.
+func BFloat16Frombits(h uint16) float32 { return math.Float32frombits(uint32(h) << 16) }
+
+// F32ToF16 stores Float16Bits(src[i]) in dst[i].
+// It panics with ErrLengthMismatch if the slices differ in length.
+func F32ToF16(dst []uint16, src []float32) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("F32ToF16")
+	}
+
+	for i, x := range src {
+		dst[i] = Float16Bits(x)
+	}
+}
+
+// F16ToF32 stores Float16Frombits(src[i]) in dst[i].
+// It panics with ErrLengthMismatch if the slices differ in length.
+func F16ToF32(dst []float32, src []uint16) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("F16ToF32")
+	}
+
+	for i, h := range src {
+		dst[i] = Float16Frombits(h)
+	}
+}
+
+// F32ToBF16 stores BFloat16Bits(src[i]) in dst[i].
+// It panics with ErrLengthMismatch if the slices differ in length.
+func F32ToBF16(dst []uint16, src []float32) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("F32ToBF16")
+	}
+
+	for i, x := range src {
+		dst[i] = BFloat16Bits(x)
+	}
+}
+
+// BF16ToF32 stores BFloat16Frombits(src[i]) in dst[i].
+// It panics with ErrLengthMismatch if the slices differ in length.
+func BF16ToF32(dst []float32, src []uint16) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("BF16ToF32")
+	}
+
+	for i, h := range src {
+		dst[i] = BFloat16Frombits(h)
+	}
+}
+
+const (
+	f32ExpMask       = 0x7f800000
+	f16ExpMask       = 0x7c00
+	f16QuietBit      = 0x0200
+	f16MinNormalBits = 0x38800000 // 2^-14 as float32 bits
+	f16OverflowBits  = 0x477ff000 // 65520, halfway between 65504 and 2^16
+	f16RebiasBits    = 0xc8000000 // -(127-15) << 23, modulo 2^32
+	f16InfBits       = 0x47800000 // 2^16 as float32 bits
+	bf16QuietBit     = 0x0040
+)
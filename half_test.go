@@ -0,0 +1,214 @@
+package approx
+
+import (
+	"math"
+	"sort"
+	"testing"
+)
+
+func TestFloat16_ExhaustiveRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for i := range 1 << 16 {
+		h := uint16(i)
+		f := Float16Frombits(h)
+
+		got := Float16Bits(f)
+		if f != f { //nolint:gocritic
+			if got&0x7c00 != 0x7c00 || got&0x3ff == 0 || got&0x200 == 0 {
+				t.Fatalf("NaN %#04x converted back to %#04x", h, got)
+			}
+
+			continue
+		}
+
+		if got != h {
+			t.Fatalf("%#04x -> %g -> %#04x", h, f, got)
+		}
+	}
+}
+
+// TestFloat16_ExhaustiveRoundingBoundaries checks every pair of adjacent
+// binary16 values at the midpoint and one float32 ulp on either side of it,
+// which covers every rounding decision the conversion can make.
+func TestFloat16_ExhaustiveRoundingBoundaries(t *testing.T) {
+	t.Parallel()
+
+	for h := uint16(0); h < 0x7c00; h++ {
+		lo := float64(Float16Frombits(h))
+		hi := 65536.0
+
+		if h+1 < 0x7c00 {
+			hi = float64(Float16Frombits(h + 1))
+		}
+
+		mid := float32((lo + hi) / 2)
+		even := h
+		if h&1 == 1 {
+			even = h + 1
+		}
+
+		below := math.Nextafter32(mid, 0)
+		above := math.Nextafter32(mid, float32(math.Inf(1)))
+
+		for _, s := range []uint16{0, 0x8000} {
+			sign := float32(1)
+			if s != 0 {
+				sign = -1
+			}
+
+			for _, tc := range []struct {
+				x    float32
+				want uint16
+			}{{below, h}, {mid, even}, {above, h + 1}} {
+				if got := Float16Bits(sign * tc.x); got != s|tc.want {
+					t.Fatalf("Float16Bits(%g) = %#04x, want %#04x", sign*tc.x, got, s|tc.want)
+				}
+			}
+		}
+	}
+}
+
+func TestFloat16_RandomAgainstNearest(t *testing.T) {
+	t.Parallel()
+
+	values := make([]float64, 0x7c00)
+	for h := range values {
+		values[h] = float64(Float16Frombits(uint16(h)))
+	}
+
+	r := Seeded(16)
+
+	for range 200000 {
+		x := math.Float32frombits(r.Uint32() & 0x47ffffff) // |x| < 2^17
+		ax := math.Abs(float64(x))
+
+		want := uint16(0x7c00)
+		if ax < 65520 {
+			i := sort.SearchFloat64s(values, ax)
+
+			switch {
+			case i == len(values):
+				i--
+			case i > 0 && ax-values[i-1] <= values[i]-ax:
+				if ax-values[i-1] < values[i]-ax || (i-1)&1 == 0 {
+					i--
+				}
+			}
+
+			want = uint16(i)
+		}
+
+		if x < 0 || (x == 0 && math.Signbit(float64(x))) {
+			want |= 0x8000
+		}
+
+		if got := Float16Bits(x); got != want {
+			t.Fatalf("Float16Bits(%g) = %#04x, want %#04x", x, got, want)
+		}
+	}
+}
+
+func TestFloat16_Specials(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		x    float32
+		want uint16
+	}{
+		{0, 0},
+		{float32(math.Copysign(0, -1)), 0x8000},
+		{1, 0x3c00},
+		{-2, 0xc000},
+		{65504, 0x7bff},
+		{1e10, 0x7c00},
+		{float32(math.Inf(-1)), 0xfc00},
+		{0x1p-24, 0x0001},
+		{0x1p-25, 0x0000},                      // tie to even zero
+		{math.Nextafter32(0x1p-25, 1), 0x0001}, // just above the tie
+		{0x1p-14, 0x0400},                      // smallest normal
+		{math.Nextafter32(0x1p-14, 0), 0x0400}, // rounds up into the normals
+		{float32(math.SmallestNonzeroFloat32), 0},
+	}
+
+	for _, tc := range cases {
+		if got := Float16Bits(tc.x); got != tc.want {
+			t.Fatalf("Float16Bits(%g) = %#04x, want %#04x", tc.x, got, tc.want)
+		}
+	}
+
+	// A signalling NaN whose payload lives only in the low bits stays NaN.
+	if got := Float16Bits(math.Float32frombits(0x7f800001)); got&0x7c00 != 0x7c00 || got&0x3ff == 0 {
+		t.Fatalf("signalling NaN converted to %#04x", got)
+	}
+}
+
+func TestF32ToF16_Slices(t *testing.T) {
+	t.Parallel()
+
+	src := []float32{0.1, -3.5, 1000, 1e-3}
+	h := make([]uint16, len(src))
+	back := make([]float32, len(src))
+
+	F32ToF16(h, src)
+	F16ToF32(back, h)
+
+	for i, x := range src {
+		if h[i] != Float16Bits(x) || back[i] != Float16Frombits(h[i]) {
+			t.Fatalf("element %d: %#04x / %g", i, h[i], back[i])
+		}
+
+		if math.Abs(float64(back[i]-x)) > math.Abs(float64(x))*0x1p-11 {
+			t.Fatalf("round trip of %g = %g", x, back[i])
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("F16ToF32 did not panic on length mismatch")
+		}
+	}()
+
+	F16ToF32(make([]float32, 1), make([]uint16, 2))
+}
+
+func TestBFloat16_ExhaustiveRoundTripAndBoundaries(t *testing.T) {
+	t.Parallel()
+
+	for i := range 1 << 16 {
+		h := uint16(i)
+		f := BFloat16Frombits(h)
+
+		if f != f { //nolint:gocritic
+			if got := BFloat16Bits(f); got&0x7f80 != 0x7f80 || got&0x7f == 0 {
+				t.Fatalf("NaN %#04x converted back to %#04x", h, got)
+			}
+
+			continue
+		}
+
+		if got := BFloat16Bits(f); got != h {
+			t.Fatalf("%#04x -> %g -> %#04x", h, f, got)
+		}
+
+		if h&0x7fff >= 0x7f80 {
+			continue
+		}
+
+		// One float32 ulp below the midpoint, the midpoint, and one above.
+		mid := math.Float32bits(f) + 0x8000
+		want := h
+		if h&1 == 1 {
+			want = h + 1
+		}
+
+		for _, tc := range []struct {
+			bits uint32
+			want uint16
+		}{{mid - 1, h}, {mid, want}, {mid + 1, h + 1}} {
+			if got := BFloat16Bits(math.Float32frombits(tc.bits)); got != tc.want {
+				t.Fatalf("BFloat16Bits(%#08x) = %#04x, want %#04x", tc.bits, got, tc.want)
+			}
+		}
+	}
+}
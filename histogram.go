@@ -0,0 +1,202 @@
+package approx
+
+import (
+	"fmt"
+	"math"
+
+	iapprox "github.com/meko-christian/algo-approx/internal/approx"
+)
+
+// LogBucketIndex returns the index i of the log-spaced bucket
+// [growth^i, growth^(i+1)) that contains x, using FastLog.
+//
+// When growth is an exact power of two the index is read from the binary
+// exponent of x and is exact. Otherwise values within about 1e-6 (relative)
+// of a bucket boundary may be assigned to the neighbouring bucket.
+//
+// growth must be greater than 1. Non-positive and NaN inputs return math.MinInt.
+func LogBucketIndex(x, growth float64) int {
+	if !(x > 0) { //nolint:staticcheck // also rejects NaN
+		return math.MinInt
+	}
+
+	shift, _ := pow2Exponent(growth)
+
+	return logBucketIndex(x, math.Log(growth), shift)
+}
+
+// logBucketIndex maps positive x to its bucket; +Inf shares the bucket of
+// math.MaxFloat64. shift > 0 selects the exact power-of-two path.
+func logBucketIndex(x, logGrowth float64, shift int) int {
+	if math.IsInf(x, 1) {
+		x = math.MaxFloat64
+	}
+
+	if shift > 0 {
+		return floorDiv(binaryExponent(x), shift)
+	}
+
+	return int(math.Floor(iapprox.Log(x, iapprox.PrecisionBalanced) / logGrowth))
+}
+
+// LogHistogram counts positive values in log-spaced buckets with a constant
+// ratio between bucket bounds, giving bounded relative error for quantiles
+// over many orders of magnitude.
+//
+// Non-positive values are counted in a dedicated zero bucket; NaN is ignored.
+// A LogHistogram is not safe for concurrent use.
+type LogHistogram struct {
+	growth    float64
+	logGrowth float64
+	pow2Shift int // >0 when growth == 2^pow2Shift
+
+	offset int // bucket index of counts[0]
+	counts []uint64
+	zeros  uint64
+	total  uint64
+}
+
+// NewLogHistogram returns an empty histogram whose bucket bounds grow by the
+// factor growth (> 1). A growth of 1.02, for example, bounds the relative
+// error of Quantile by about 1%.
+func NewLogHistogram(growth float64) (*LogHistogram, error) {
+	if !(growth > 1) || math.IsInf(growth, 1) { //nolint:staticcheck // also rejects NaN
+		return nil, fmt.Errorf("approx: log histogram growth %g: %w", growth, ErrDomainError)
+	}
+
+	h := &LogHistogram{growth: growth, logGrowth: math.Log(growth)} //nolint:exhaustruct
+	if shift, ok := pow2Exponent(growth); ok {
+		h.pow2Shift = shift
+	}
+
+	return h, nil
+}
+
+// Growth returns the bucket growth factor.
+func (h *LogHistogram) Growth() float64 { return h.growth }
+
+// Count returns the number of recorded values.
+func (h *LogHistogram) Count() uint64 { return h.total }
+
+// Add records one value.
+func (h *LogHistogram) Add(x float64) { h.AddN(x, 1) }
+
+// AddN records n copies of value x.
+func (h *LogHistogram) AddN(x float64, n uint64) {
+	if x != x || n == 0 { //nolint:gocritic
+		return
+	}
+
+	h.total += n
+
+	if x <= 0 {
+		h.zeros += n
+		return
+	}
+
+	idx := logBucketIndex(x, h.logGrowth, h.pow2Shift)
+	h.counts = h.grow(idx)
+	h.counts[idx-h.offset] += n
+}
+
+// Merge adds all counts of other into h. Both histograms must use the same
+// growth factor.
+func (h *LogHistogram) Merge(other *LogHistogram) error {
+	if other.growth != h.growth {
+		return fmt.Errorf("approx: merging log histograms with growth %g and %g: %w",
+			h.growth, other.growth, ErrDomainError)
+	}
+
+	if len(other.counts) > 0 {
+		h.counts = h.grow(other.offset)
+		h.counts = h.grow(other.offset + len(other.counts) - 1)
+
+		for i, c := range other.counts {
+			h.counts[other.offset+i-h.offset] += c
+		}
+	}
+
+	h.zeros += other.zeros
+	h.total += other.total
+
+	return nil
+}
+
+// Quantile returns an estimate of the q-quantile (q in [0, 1]) of the
+// recorded values: the geometric midpoint of the bucket holding the rank
+// q·(Count-1). It returns NaN for an empty histogram or q outside [0, 1], and
+// 0 when the rank falls into the zero bucket.
+func (h *LogHistogram) Quantile(q float64) float64 {
+	if h.total == 0 || !(q >= 0 && q <= 1) {
+		return math.NaN()
+	}
+
+	rank := uint64(math.Round(q * float64(h.total-1)))
+	if rank < h.zeros {
+		return 0
+	}
+
+	seen := h.zeros
+	for i, c := range h.counts {
+		seen += c
+		if rank < seen {
+			mid := (float64(h.offset+i) + 0.5) * h.logGrowth
+			return FastExp(mid)
+		}
+	}
+
+	return math.NaN()
+}
+
+// Reset removes all recorded values but keeps the allocated buckets.
+func (h *LogHistogram) Reset() {
+	clear(h.counts)
+	h.zeros, h.total = 0, 0
+}
+
+// grow extends counts so that bucket idx is addressable.
+func (h *LogHistogram) grow(idx int) []uint64 {
+	if len(h.counts) == 0 {
+		h.offset = idx
+		return make([]uint64, 1)
+	}
+
+	if idx < h.offset {
+		grown := make([]uint64, len(h.counts)+h.offset-idx)
+		copy(grown[h.offset-idx:], h.counts)
+		h.offset = idx
+
+		return grown
+	}
+
+	if need := idx - h.offset + 1; need > len(h.counts) {
+		return append(h.counts, make([]uint64, need-len(h.counts))...)
+	}
+
+	return h.counts
+}
+
+// pow2Exponent reports whether g == 2^k for an integer k >= 1.
+func pow2Exponent(g float64) (int, bool) {
+	frac, exp := math.Frexp(g)
+	if frac != 0.5 || exp < 2 {
+		return 0, false
+	}
+
+	return exp - 1, true
+}
+
+// binaryExponent returns floor(log2(x)) for positive finite x.
+func binaryExponent(x float64) int {
+	_, e := math.Frexp(x)
+	return e - 1
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && (a < 0) {
+		q--
+	}
+
+	return q
+}
@@ -0,0 +1,120 @@
+package approx
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"testing"
+)
+
+func TestLogBucketIndex(t *testing.T) {
+	t.Parallel()
+
+	// Away from boundaries the index matches floor(log_g(x)).
+	for _, g := range []float64{1.01, 1.1, 1.5, 3, 10} {
+		for i := -200; i <= 200; i++ {
+			x := math.Pow(g, float64(i)+0.5)
+			if got := LogBucketIndex(x, g); got != i {
+				t.Fatalf("LogBucketIndex(%g, %g) = %d, want %d", x, g, got, i)
+			}
+		}
+	}
+
+	// Power-of-two growth is exact even on the boundaries.
+	for k := -50; k <= 50; k++ {
+		if got := LogBucketIndex(math.Ldexp(1, k), 2); got != k {
+			t.Fatalf("LogBucketIndex(2^%d, 2) = %d", k, got)
+		}
+
+		if got, want := LogBucketIndex(math.Ldexp(1, k), 4), int(math.Floor(float64(k)/2)); got != want {
+			t.Fatalf("LogBucketIndex(2^%d, 4) = %d, want %d", k, got, want)
+		}
+	}
+
+	if LogBucketIndex(0, 2) != math.MinInt || LogBucketIndex(math.NaN(), 1.1) != math.MinInt {
+		t.Fatalf("non-positive inputs must map to math.MinInt")
+	}
+}
+
+func TestLogHistogram_Quantile(t *testing.T) {
+	t.Parallel()
+
+	h, err := NewLogHistogram(1.02)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := make([]float64, 0, 10000)
+	for i := 1; i <= 10000; i++ {
+		v := math.Exp(float64(i%997) * 0.013)
+		values = append(values, v)
+		h.Add(v)
+	}
+
+	sort.Float64s(values)
+
+	for _, q := range []float64{0, 0.1, 0.5, 0.9, 0.99, 1} {
+		want := values[int(math.Round(q*float64(len(values)-1)))]
+		if got := h.Quantile(q); math.Abs(got-want)/want > 0.011 {
+			t.Errorf("Quantile(%v) = %v, want %v (±1.1%%)", q, got, want)
+		}
+	}
+
+	if h.Count() != 10000 {
+		t.Fatalf("Count = %d", h.Count())
+	}
+}
+
+func TestLogHistogram_ZerosAndMerge(t *testing.T) {
+	t.Parallel()
+
+	a, _ := NewLogHistogram(2)
+	b, _ := NewLogHistogram(2)
+
+	a.AddN(0, 5)
+	a.Add(math.NaN())
+	a.Add(1e6)
+	b.Add(1e-6)
+	b.AddN(3, 4)
+
+	if err := a.Merge(b); err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Count() != 11 {
+		t.Fatalf("merged Count = %d, want 11", a.Count())
+	}
+
+	if got := a.Quantile(0); got != 0 {
+		t.Fatalf("Quantile(0) = %v, want 0 (zero bucket)", got)
+	}
+
+	if got := a.Quantile(0.6); got < 2 || got >= 4 {
+		t.Fatalf("Quantile(0.6) = %v, want within [2, 4)", got)
+	}
+
+	if got := a.Quantile(1); got < 1<<19 || got >= 1<<20 {
+		t.Fatalf("Quantile(1) = %v, want within the bucket of 1e6", got)
+	}
+
+	c, _ := NewLogHistogram(1.5)
+	if err := a.Merge(c); !errors.Is(err, ErrDomainError) {
+		t.Fatalf("merging different growth: %v", err)
+	}
+
+	a.Reset()
+
+	if a.Count() != 0 || !math.IsNaN(a.Quantile(0.5)) {
+		t.Fatalf("Reset did not clear the histogram")
+	}
+}
+
+func TestNewLogHistogram_InvalidGrowth(t *testing.T) {
+	t.Parallel()
+
+	for _, g := range []float64{1, 0.5, -2, math.NaN(), math.Inf(1)} {
+		if _, err := NewLogHistogram(g); !errors.Is(err, ErrDomainError) {
+			t.Fatalf("NewLogHistogram(%v) error = %v", g, err)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package approx
+
+import (
+	"math"
+
+	"github.com/meko-christian/algo-approx/dd"
+	iapprox "github.com/meko-christian/algo-approx/internal/approx"
+)
+
+// ILogBase returns the largest integer k with base^k <= x, the inverse of
+// FastIntPower; for example ILogBase(999, 10) = 2 and ILogBase(1000, 10) = 3.
+//
+// An estimate from FastLog is corrected by comparing x against base^k
+// evaluated in double-double arithmetic, so the result is exact, including at
+// exact powers, even where the approximate log is off by several units.
+//
+// base must be finite and greater than 1. Non-positive and NaN x, and invalid
+// bases, return math.MinInt; +Inf returns math.MaxInt.
+func ILogBase(x, base float64) int {
+	checkPositive("ILogBase", x, PrecisionAuto)
+
+	if !(x > 0) || !(base > 1) || math.IsInf(base, 1) { //nolint:staticcheck // also rejects NaN
+		return math.MinInt
+	}
+
+	if math.IsInf(x, 1) {
+		return math.MaxInt
+	}
+
+	// Split off the binary exponent so subnormal x estimate well too.
+	frac, exp := math.Frexp(x)
+	lnx := iapprox.Log(frac, iapprox.PrecisionHigh) + float64(exp)*math.Ln2
+	k := int(math.Floor(lnx / iapprox.Log(base, iapprox.PrecisionHigh)))
+
+	for !powAtMost(base, k, x) {
+		k--
+	}
+
+	for powAtMost(base, k+1, x) {
+		k++
+	}
+
+	return k
+}
+
+// IsPerfectPower reports whether x is exactly base^k for an integer k, and
+// returns k = ILogBase(x, base) either way. No rounding is tolerated:
+// IsPerfectPower(1e22, 10) is true, while IsPerfectPower(1e23, 10) is false
+// because 1e23 is not representable in float64.
+func IsPerfectPower(x, base float64) (k int, ok bool) {
+	k = ILogBase(x, base)
+	if k == math.MinInt || k == math.MaxInt {
+		return k, false
+	}
+
+	return k, powCompare(base, k, x) == 0
+}
+
+// powAtMost reports whether base^k <= x.
+func powAtMost(base float64, k int, x float64) bool { return powCompare(base, k, x) <= 0 }
+
+// powCompare returns the sign of base^k - x for positive finite x, decided in
+// double-double arithmetic with a separate binary exponent, so neither
+// overflow nor underflow of intermediate powers affects the outcome.
+func powCompare(base float64, k int, x float64) int {
+	xf, xe := math.Frexp(x)
+
+	if k >= 0 {
+		return extCompare(ddMulPow(1, base, k), extended{hi: xf, exp: xe}) //nolint:exhaustruct
+	}
+
+	// base^k - x has the sign of 1 - x·base^-k.
+	return extCompare(extended{hi: 0.5, exp: 1}, ddMulPow(x, base, -k)) //nolint:exhaustruct
+}
+
+// extended is the double-double value (hi + lo)·2^exp with hi in [0.5, 1).
+type extended struct {
+	hi, lo float64
+	exp    int
+}
+
+// ddMulPow returns x·base^n (n >= 0) by binary exponentiation with
+// error-free products. Powers whose exact value fits in 106 bits are exact.
+func ddMulPow(x, base float64, n int) extended {
+	r := newExtended(x)
+	b := newExtended(base)
+
+	for n > 0 {
+		if n&1 == 1 {
+			r = extMul(r, b)
+		}
+
+		n >>= 1
+		if n > 0 {
+			b = extMul(b, b)
+		}
+	}
+
+	return r
+}
+
+func newExtended(x float64) extended {
+	f, e := math.Frexp(x)
+
+	return extended{hi: f, lo: 0, exp: e}
+}
+
+// extMul multiplies two extended values and renormalizes hi into [0.5, 1).
+func extMul(a, b extended) extended {
+	p, e := dd.TwoProd(a.hi, b.hi)
+	hi, lo := dd.FastTwoSum(p, e+(a.hi*b.lo+a.lo*b.hi))
+
+	f, shift := math.Frexp(hi)
+
+	return extended{hi: f, lo: math.Ldexp(lo, -shift), exp: a.exp + b.exp + shift}
+}
+
+// extCompare returns the sign of a - b. With hi in [0.5, 1) and |lo| at most
+// half an ulp of hi, a larger exponent always means a larger value.
+func extCompare(a, b extended) int {
+	switch {
+	case a.exp != b.exp:
+		return cmpInt(a.exp, b.exp)
+	case a.hi != b.hi:
+		return cmpFloat(a.hi, b.hi)
+	default:
+		return cmpFloat(a.lo, b.lo)
+	}
+}
+
+func cmpInt(a, b int) int {
+	if a < b {
+		return -1
+	}
+
+	return 1
+}
+
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,118 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestILogBase_Exact(t *testing.T) {
+	t.Parallel()
+
+	for _, base := range []float64{2, 3, 10, 16, 1.5} {
+		for k := -30; k <= 30; k++ {
+			p := math.Pow(base, float64(k))
+
+			exact, isExact := IsPerfectPower(p, base)
+			if got := ILogBase(p, base); got != exact {
+				t.Fatalf("ILogBase and IsPerfectPower disagree at %g: %d vs %d", p, got, exact)
+			}
+
+			if !isExact {
+				continue // p is a rounded power; the neighbours below check it
+			}
+
+			if exact != k {
+				t.Fatalf("ILogBase(%g, %g) = %d, want %d", p, base, exact, k)
+			}
+
+			if got := ILogBase(math.Nextafter(p, 0), base); got != k-1 {
+				t.Fatalf("ILogBase(just below %g, %g) = %d, want %d", p, base, got, k-1)
+			}
+
+			if got := ILogBase(math.Nextafter(p, math.Inf(1)), base); got != k {
+				t.Fatalf("ILogBase(just above %g, %g) = %d, want %d", p, base, got, k)
+			}
+		}
+	}
+}
+
+func TestILogBase_PowersOfTen(t *testing.T) {
+	t.Parallel()
+
+	// Every power of ten up to 1e22 is exact in float64.
+	p := 1.0
+	for k := 0; k <= 22; k++ {
+		if got, ok := IsPerfectPower(p, 10); !ok || got != k {
+			t.Fatalf("IsPerfectPower(1e%d, 10) = (%d, %v)", k, got, ok)
+		}
+
+		p *= 10
+	}
+
+	if _, ok := IsPerfectPower(1e23, 10); ok {
+		t.Fatal("1e23 reported as an exact power of ten")
+	}
+
+	if got := ILogBase(1e23, 10); got != 22 {
+		t.Fatalf("ILogBase(1e23, 10) = %d, want 22 (1e23 rounds below 10^23)", got)
+	}
+
+	if got := ILogBase(999, 10); got != 2 {
+		t.Fatalf("ILogBase(999, 10) = %d", got)
+	}
+
+	if k, ok := IsPerfectPower(0.001, 10); ok || k != -3 {
+		t.Fatalf("IsPerfectPower(0.001, 10) = (%d, %v), want (-3, false)", k, ok)
+	}
+
+	if k, ok := IsPerfectPower(math.SmallestNonzeroFloat64, 2); !ok || k != -1074 {
+		t.Fatalf("IsPerfectPower(2^-1074, 2) = (%d, %v)", k, ok)
+	}
+
+	if k, ok := IsPerfectPower(0.0625, 2); !ok || k != -4 {
+		t.Fatalf("IsPerfectPower(0.0625, 2) = (%d, %v)", k, ok)
+	}
+}
+
+func TestILogBase_Extremes(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		x, base float64
+		want    int
+	}{
+		{math.MaxFloat64, 2, 1023},
+		{math.SmallestNonzeroFloat64, 2, -1074},
+		{1, 1.0001, 0},
+		{math.MaxFloat64, 1.0001, 7098182},
+		{0, 10, math.MinInt},
+		{-1, 10, math.MinInt},
+		{math.NaN(), 10, math.MinInt},
+		{10, 1, math.MinInt},
+		{math.Inf(1), 10, math.MaxInt},
+	}
+
+	for _, tc := range cases {
+		if got := ILogBase(tc.x, tc.base); got != tc.want {
+			t.Errorf("ILogBase(%g, %g) = %d, want %d", tc.x, tc.base, got, tc.want)
+		}
+	}
+}
+
+func TestILogBase_MatchesBruteForce(t *testing.T) {
+	t.Parallel()
+
+	for n := uint64(1); n < 5000; n += 7 {
+		for _, base := range []uint64{2, 3, 7, 10} {
+			want, p := 0, base
+			for p <= n {
+				want++
+				p *= base
+			}
+
+			if got := ILogBase(float64(n), float64(base)); got != want {
+				t.Fatalf("ILogBase(%d, %d) = %d, want %d", n, base, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package approx
+
+import (
+	"math"
+
+	iapprox "github.com/meko-christian/algo-approx/internal/approx"
+)
+
+// QuantizeInt8 stores the affine int8 quantization of src in dst:
+//
+//	dst[i] = clamp(roundHalfEven(src[i] / scale) + zp, -128, 127)
+//
+// The quotient uses one reciprocal of scale for the whole slice and is
+// formed in float64, so ties are only resolved exactly when src[i] is an
+// exact multiple of scale/2. Out-of-range values saturate and NaN maps to
+// zp, the code for 0. scale must be positive.
+//
+// It panics with ErrLengthMismatch if the slices differ in length.
+func QuantizeInt8(dst []int8, src []float32, scale float32, zp int8) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("QuantizeInt8")
+	}
+
+	checkPositive("QuantizeInt8", scale, PrecisionAuto)
+
+	inv := 1 / float64(scale)
+	z := float64(zp)
+
+	for i, x := range src {
+		q := iapprox.RoundHalfEven(float64(x)*inv) + z
+
+		switch {
+		case q != q: //nolint:gocritic
+			dst[i] = zp
+		case q <= math.MinInt8:
+			dst[i] = math.MinInt8
+		case q >= math.MaxInt8:
+			dst[i] = math.MaxInt8
+		default:
+			dst[i] = int8(q)
+		}
+	}
+}
+
+// DequantizeInt8 stores (src[i] - zp) · scale in dst[i], the inverse of
+// QuantizeInt8 up to rounding.
+//
+// It panics with ErrLengthMismatch if the slices differ in length.
+func DequantizeInt8(dst []float32, src []int8, scale float32, zp int8) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("DequantizeInt8")
+	}
+
+	for i, q := range src {
+		dst[i] = float32(int(q)-int(zp)) * scale
+	}
+}
+
+// CalibrateInt8MinMax returns the asymmetric scale and zero point that map
+// the range [min(src), max(src)], widened to include 0, onto [-128, 127].
+// Zero is always exactly representable, so zero padding survives the round
+// trip. NaN entries are ignored; if no spread remains the result is
+// (1, 0).
+func CalibrateInt8MinMax(src []float32) (scale float32, zp int8) {
+	lo, hi := 0.0, 0.0
+
+	for _, x := range src {
+		if x == x { //nolint:gocritic
+			lo = min(lo, float64(x))
+			hi = max(hi, float64(x))
+		}
+	}
+
+	if hi == lo || math.IsInf(hi-lo, 0) {
+		return 1, 0
+	}
+
+	s := (hi - lo) / 255
+	z := iapprox.RoundHalfEven(math.MinInt8 - lo/s)
+
+	return float32(s), int8(min(max(z, math.MinInt8), math.MaxInt8))
+}
+
+// CalibrateInt8Percentile returns the symmetric scale (with zero point 0)
+// that maps the q-quantile of |src| to 127 and clips larger magnitudes,
+// which keeps a few outliers from wasting the code space. q = 1 clips at
+// the maximum magnitude; 0.999 or 0.9999 are common choices for
+// activations.
+//
+// The quantile comes from a LogHistogram with growth factor 1.01, so the clip
+// point is within about 0.5% of the exact quantile and the pass over src
+// needs no sort. NaN entries are ignored. q outside (0, 1], or a zero
+// quantile, returns (1, 0).
+func CalibrateInt8Percentile(src []float32, q float64) (scale float32, zp int8) {
+	if !(q > 0 && q <= 1) {
+		return 1, 0
+	}
+
+	h, _ := NewLogHistogram(calibrationGrowth)
+
+	for _, x := range src {
+		h.Add(math.Abs(float64(x)))
+	}
+
+	clip := h.Quantile(q)
+	if !(clip > 0) || math.IsInf(clip, 0) { //nolint:staticcheck // also rejects NaN
+		return 1, 0
+	}
+
+	return float32(clip / math.MaxInt8), 0
+}
+
+const calibrationGrowth = 1.01
@@ -0,0 +1,97 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestQuantizeInt8(t *testing.T) {
+	t.Parallel()
+
+	src := []float32{0, 0.25, 0.75, 1.25, -0.25, 100, -100, float32(math.NaN()), float32(math.Inf(1))}
+	dst := make([]int8, len(src))
+	QuantizeInt8(dst, src, 0.5, 3)
+
+	// 0.5 and 1.5 round to even, 2.5 to 2.
+	want := []int8{3, 3, 5, 5, 3, 127, -128, 3, 127}
+	for i := range want {
+		if dst[i] != want[i] {
+			t.Fatalf("QuantizeInt8(%g) = %d, want %d", src[i], dst[i], want[i])
+		}
+	}
+}
+
+func TestInt8_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	r := Seeded(21)
+	src := make([]float32, 4096)
+
+	for i := range src {
+		src[i] = float32(3*r.NormFloat64() + 1)
+	}
+
+	scale, zp := CalibrateInt8MinMax(src)
+	q := make([]int8, len(src))
+	back := make([]float32, len(src))
+
+	QuantizeInt8(q, src, scale, zp)
+	DequantizeInt8(back, q, scale, zp)
+
+	for i, x := range src {
+		if math.Abs(float64(back[i]-x)) > float64(scale)/2*(1+1e-5) {
+			t.Fatalf("round trip of %g = %g, scale %g", x, back[i], scale)
+		}
+	}
+
+	zero := make([]int8, 1)
+	QuantizeInt8(zero, []float32{0}, scale, zp)
+
+	out := make([]float32, 1)
+	if DequantizeInt8(out, zero, scale, zp); out[0] != 0 {
+		t.Fatalf("zero does not survive the round trip: %g", out[0])
+	}
+}
+
+func TestCalibrateInt8MinMax(t *testing.T) {
+	t.Parallel()
+
+	scale, zp := CalibrateInt8MinMax([]float32{0.5, 2.55, float32(math.NaN())})
+	if math.Abs(float64(scale)-0.01) > 1e-9 || zp != -128 {
+		t.Fatalf("positive range: scale %g, zp %d", scale, zp)
+	}
+
+	scale, zp = CalibrateInt8MinMax([]float32{-1, 1})
+	if math.Abs(float64(scale)-2.0/255) > 1e-9 || zp != 0 {
+		t.Fatalf("symmetric range: scale %g, zp %d", scale, zp)
+	}
+
+	if scale, zp = CalibrateInt8MinMax(nil); scale != 1 || zp != 0 {
+		t.Fatalf("empty input: scale %g, zp %d", scale, zp)
+	}
+}
+
+func TestCalibrateInt8Percentile(t *testing.T) {
+	t.Parallel()
+
+	src := make([]float32, 10000)
+	for i := range src {
+		src[i] = float32(i+1) / 100 * float32(1-2*(i%2))
+	}
+
+	// A single outlier does not move the 99% clip point.
+	src[0] = 1e6
+
+	scale, zp := CalibrateInt8Percentile(src, 0.99)
+	if clip := float64(scale) * 127; zp != 0 || math.Abs(clip-99) > 0.01*99 {
+		t.Fatalf("99%% clip = %g, zp %d", clip, zp)
+	}
+
+	if scale, zp = CalibrateInt8Percentile(src, 1.5); scale != 1 || zp != 0 {
+		t.Fatalf("invalid q: scale %g, zp %d", scale, zp)
+	}
+
+	if scale, _ = CalibrateInt8Percentile(make([]float32, 3), 0.5); scale != 1 {
+		t.Fatalf("all-zero input: scale %g", scale)
+	}
+}
@@ -0,0 +1,70 @@
+package approx
+
+import "math"
+
+// Sigmoid returns an approximate logistic function 1 / (1 + e^-x).
+//
+// The exponential is always taken of -|x|, so it cannot overflow, and the
+// negative branch is evaluated as e^x / (1 + e^x) to keep the relative
+// accuracy of FastExp in the far tail.
+func Sigmoid[T Float](x T, prec Precision) T {
+	if x != x { //nolint:gocritic
+		return x
+	}
+
+	return T(sigmoid(float64(x), prec))
+}
+
+func sigmoid(x float64, prec Precision) float64 {
+	if x >= 0 {
+		return 1 / (1 + Exp(-x, prec))
+	}
+
+	e := Exp(x, prec)
+
+	return e / (1 + e)
+}
+
+// SiLU returns an approximate x · sigmoid(x) (also known as swish).
+func SiLU[T Float](x T, prec Precision) T {
+	if x != x { //nolint:gocritic
+		return x
+	}
+
+	xf := float64(x)
+	if math.IsInf(xf, -1) {
+		return 0
+	}
+
+	return T(xf * sigmoid(xf, prec))
+}
+
+// GELU returns the tanh-form GELU approximation
+//
+//	0.5 · x · (1 + tanh(√(2/π) · (x + 0.044715·x³)))
+//
+// evaluated through the identity 1 + tanh(u) = 2·sigmoid(2u), which avoids
+// the cancellation of 1 + tanh(u) for negative x.
+func GELU[T Float](x T, prec Precision) T {
+	if x != x { //nolint:gocritic
+		return x
+	}
+
+	xf := float64(x)
+
+	switch {
+	case math.IsInf(xf, -1):
+		return 0
+	case math.IsInf(xf, 1):
+		return x
+	}
+
+	u := geluScale * xf * (1 + geluCubic*xf*xf)
+
+	return T(xf * sigmoid(2*u, prec))
+}
+
+const (
+	geluScale = 0.797884560802865355879892119868763737 // √(2/π)
+	geluCubic = 0.044715
+)
@@ -0,0 +1,55 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSigmoidAgainstMath(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		prec Precision
+		tol  float64
+	}{
+		{PrecisionFast, 1e-3},
+		{PrecisionBalanced, 5e-6},
+		{PrecisionHigh, 1e-8},
+	}
+
+	for _, tc := range cases {
+		for x := -40.0; x <= 40; x += 0.013 {
+			want := 1 / (1 + math.Exp(-x))
+			if got := Sigmoid(x, tc.prec); math.Abs(got-want) > tc.tol*want {
+				t.Fatalf("prec %d: Sigmoid(%g) = %.12g, want %.12g", tc.prec, x, got, want)
+			}
+		}
+	}
+}
+
+func TestGELUAndSiLU(t *testing.T) {
+	t.Parallel()
+
+	for x := -12.0; x <= 12; x += 0.01 {
+		u := geluScale * (x + geluCubic*x*x*x)
+		want := 0.5 * x * (1 + math.Tanh(u))
+
+		if got := GELU(x, PrecisionHigh); math.Abs(got-want) > 1e-8*math.Max(1, math.Abs(x)) {
+			t.Fatalf("GELU(%g) = %.12g, want %.12g", x, got, want)
+		}
+
+		want = x / (1 + math.Exp(-x))
+		if got := SiLU(x, PrecisionHigh); math.Abs(got-want) > 1e-8*math.Max(1, math.Abs(x)) {
+			t.Fatalf("SiLU(%g) = %.12g, want %.12g", x, got, want)
+		}
+	}
+
+	inf := math.Inf(1)
+	if GELU(-inf, PrecisionFast) != 0 || GELU(inf, PrecisionFast) != inf || SiLU(-inf, PrecisionFast) != 0 || SiLU(inf, PrecisionFast) != inf {
+		t.Fatal("infinite arguments")
+	}
+
+	if !math.IsNaN(GELU(math.NaN(), PrecisionFast)) || !math.IsNaN(SiLU(math.NaN(), PrecisionFast)) {
+		t.Fatal("NaN is not propagated")
+	}
+}
@@ -45,71 +45,91 @@ func arccotan6Term[T Float](x T) T {
 	return T(math.Pi)/2 - arctan6Term(x)
 }
 
-// arccos3Term computes a 3-term approximation of arccos(x).
-// Valid for x in [-1, 1].
-// Provides approximately 6.6 decimal digits of accuracy.
+// arcsinCoeffs are the Maclaurin coefficients of arcsin(s) = Σ c_n s^(2n+1),
+// c_n = C(2n, n) / (4^n (2n+1)).
 //
-// Uses the identity: arccos(x) = π/2 - arcsin(x)
-// And arcsin(x) ≈ x + x³/6 + 3x⁵/40 for small x
-// For larger x, uses: arccos(x) = 2*arcsin(sqrt((1-x)/2)).
-func arccos3Term[T Float](x T) T {
-	// For x close to 0, use arccos(x) ≈ π/2 - arcsin(x)
-	if x > -0.5 && x < 0.5 {
-		// arcsin(x) ≈ x + x³/6 + 3x⁵/40
-		x2 := x * x
-		x3 := x2 * x
-		x5 := x3 * x2
-		arcsinX := x + x3/6 + 3*x5/40
-
-		return T(math.Pi)/2 - arcsinX
-	}
+//nolint:gochecknoglobals
+var arcsinCoeffs = [...]float64{
+	1, 1.0 / 6, 3.0 / 40, 5.0 / 112, 35.0 / 1152, 63.0 / 2816, 231.0 / 13312,
+	143.0 / 10240, 6435.0 / 557056, 12155.0 / 1245184,
+}
 
-	// For x closer to ±1, use the half-angle formula
-	// arccos(x) = 2*arcsin(sqrt((1-x)/2))
-	arg := T(math.Sqrt(float64((1 - x) / 2)))
-	arg2 := arg * arg
-	arg3 := arg2 * arg
-	arg5 := arg3 * arg2
-	arcsinArg := arg + arg3/6 + 3*arg5/40
+// arcsinSeries returns the first terms terms of the arcsin series at s,
+// evaluated by Horner's rule in s². For |s| <= 1/2 consecutive terms shrink
+// by about 4x, so the truncation error is about 4/3 of the first omitted term.
+func arcsinSeries(s float64, terms int) float64 {
+	s2 := s * s
+	sum := arcsinCoeffs[terms-1]
+
+	for i := terms - 2; i >= 0; i-- {
+		sum = sum*s2 + arcsinCoeffs[i]
+	}
 
-	return 2 * arcsinArg
+	return s * sum
 }
 
-// arccos6Term computes a 6-term approximation of arccos(x).
-// Valid for x in [-1, 1].
-// Provides approximately 13.7 decimal digits of accuracy.
+// arccosSeries computes arccos(x) on [-1, 1] with the arcsin series truncated
+// to terms terms, splitting the domain so the series argument never exceeds
+// 1/2:
+//
+//   - negative x is mirrored through arccos(x) = π - arccos(-x);
+//   - for a = |x| <= 1/2, arccos(a) = π/2 - arcsin(a);
+//   - for a > 1/2, arccos(a) = 2·arcsin(√((1-a)/2)), whose √(1-a) prefactor
+//     captures the square-root behaviour at 1 exactly; 1-a is exact there.
 //
+// The error is therefore uniform across the interval rather than growing
+// towards ±1.
+func arccosSeries(x float64, terms int) float64 {
+	a := math.Abs(x)
+
+	var r float64
+	if a <= 0.5 {
+		r = math.Pi/2 - arcsinSeries(a, terms)
+	} else {
+		r = 2 * arcsinSeries(math.Sqrt((1-a)*0.5), terms)
+	}
 
-func arccos6Term[T Float](x T) T {
-	// For x close to 0, use arccos(x) ≈ π/2 - arcsin(x)
-	if x > -0.5 && x < 0.5 {
-		// arcsin(x) with more terms: x + x³/6 + 3x⁵/40 + 15x⁷/336 + 105x⁹/3456 + 945x¹¹/42240
-		x2 := x * x
-		x3 := x2 * x
-		x5 := x3 * x2
-		x7 := x5 * x2
-		x9 := x7 * x2
-		x11 := x9 * x2
+	if x < 0 {
+		return math.Pi - r
+	}
 
-		arcsinX := x + x3/6 + 3*x5/40 + 15*x7/336 + 105*x9/3456 + 945*x11/42240
+	return r
+}
 
-		return T(math.Pi)/2 - arcsinX
-	}
+// arcsinSplit computes arcsin(x) on [-1, 1] with the same domain split as
+// arccosSeries; for |x| <= 1/2 the series keeps full relative accuracy.
+func arcsinSplit(x float64, terms int) float64 {
+	a := math.Abs(x)
 
-	// For x closer to ±1, use the half-angle formula
-	arg := T(math.Sqrt(float64((1 - x) / 2)))
-	arg2 := arg * arg
-	arg3 := arg2 * arg
-	arg5 := arg3 * arg2
-	arg7 := arg5 * arg2
-	arg9 := arg7 * arg2
-	arg11 := arg9 * arg2
+	var r float64
+	if a <= 0.5 {
+		r = arcsinSeries(a, terms)
+	} else {
+		r = math.Pi/2 - 2*arcsinSeries(math.Sqrt((1-a)*0.5), terms)
+	}
 
-	arcsinArg := arg + arg3/6 + 3*arg5/40 + 15*arg7/336 + 105*arg9/3456 + 945*arg11/42240
+	return math.Copysign(r, x)
+}
 
-	return 2 * arcsinArg
+func arccosTerms(prec Precision) int {
+	switch normalizePrecision(prec) {
+	case PrecisionFast:
+		return arccosTermsFast
+	case PrecisionHigh:
+		return arccosTermsHigh
+	default:
+		return arccosTermsBalanced
+	}
 }
 
+// Series lengths per tier; the resulting maximum absolute errors over
+// [-1, 1] are about 9e-4, 5e-6 and 1e-8.
+const (
+	arccosTermsFast     = 3
+	arccosTermsBalanced = 6
+	arccosTermsHigh     = 10
+)
+
 // Arctan computes arctangent with specified precision.
 func Arctan[T Float](x T, prec Precision) T {
 	switch prec {
@@ -134,14 +154,47 @@ func Arccotan[T Float](x T, prec Precision) T {
 	}
 }
 
-// Arccos computes arccosine with specified precision.
+// Arccos computes arccosine with specified precision over all of [-1, 1].
+// Inputs outside the interval return NaN.
 func Arccos[T Float](x T, prec Precision) T {
-	switch prec {
-	case PrecisionAuto, PrecisionFast, PrecisionBalanced:
-		return arccos3Term(x)
-	case PrecisionHigh:
-		return arccos6Term(x)
-	default:
-		return arccos3Term(x)
+	xf := float64(x)
+	if !(xf >= -1 && xf <= 1) {
+		return T(math.NaN())
 	}
+
+	return T(arccosSeries(xf, arccosTerms(prec)))
+}
+
+// Arcsin computes arcsine with specified precision over [-1, 1]. Inputs
+// outside the interval return NaN.
+func Arcsin[T Float](x T, prec Precision) T {
+	xf := float64(x)
+	if !(xf >= -1 && xf <= 1) {
+		return T(math.NaN())
+	}
+
+	return T(arcsinSplit(xf, arccosTerms(prec)))
+}
+
+// Arcsec computes arcsec(x) = arccos(1/x) for |x| >= 1, with values in
+// [0, π]. ±Inf map to π/2; |x| < 1 and NaN return NaN.
+func Arcsec[T Float](x T, prec Precision) T {
+	xf := float64(x)
+	if !(math.Abs(xf) >= 1) {
+		return T(math.NaN())
+	}
+
+	return T(arccosSeries(1/xf, arccosTerms(prec)))
+}
+
+// Arccsc computes arccsc(x) = arcsin(1/x) for |x| >= 1, with values in
+// [-π/2, π/2]. The arcsin form keeps the relative accuracy for large |x|,
+// where the result is small; ±Inf map to ±0. |x| < 1 and NaN return NaN.
+func Arccsc[T Float](x T, prec Precision) T {
+	xf := float64(x)
+	if !(math.Abs(xf) >= 1) {
+		return T(math.NaN())
+	}
+
+	return T(arcsinSplit(1/xf, arccosTerms(prec)))
 }
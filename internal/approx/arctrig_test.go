@@ -253,147 +253,126 @@ func TestArccotan6Term64(t *testing.T) {
 	}
 }
 
-// TestArccos3Term tests the 3-term arccosine approximation for float32.
-func TestArccos3Term32(t *testing.T) {
+// TestArccosDense checks every tier on a dense grid over all of [-1, 1],
+// including the neighbourhoods of ±1 where the square-root singularity of
+// the derivative used to degrade the series.
+func TestArccosDense(t *testing.T) {
 	t.Parallel()
 
-	tests := []struct {
-		name      string
-		input     float32
-		tolerance float32
+	cases := []struct {
+		prec Precision
+		tol  float64
 	}{
-		{"zero", 0.0, 1e-5},
-		{"half", 0.5, 1e-3},
-		{"sqrt(2)/2", float32(math.Sqrt(2) / 2), 2e-4},
-		{"one", 1.0, 1e-5},
-		{"negative half", -0.5, 0.1}, // Larger tolerance for negative values
+		{PrecisionFast, 1e-3},
+		{PrecisionBalanced, 6e-6},
+		{PrecisionHigh, 1.5e-8},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
+	const n = 200000
 
-			got := arccos3Term(tt.input)
-			want := float32(math.Acos(float64(tt.input)))
+	for _, tc := range cases {
+		worst := 0.0
 
-			diff := abs32(got - want)
-			if diff > tt.tolerance {
-				t.Errorf("arccos3Term(%v) = %v, want %v (diff: %v, tolerance: %v)",
-					tt.input, got, want, diff, tt.tolerance)
-			}
-		})
+		for i := 0; i <= n; i++ {
+			x := -1 + 2*float64(i)/n
+			worst = max(worst, math.Abs(Arccos(x, tc.prec)-math.Acos(x)))
+		}
+
+		for _, x := range []float64{-1, math.Nextafter(-1, 0), -0.5, 0.5, math.Nextafter(1, 0), 1} {
+			worst = max(worst, math.Abs(Arccos(x, tc.prec)-math.Acos(x)))
+		}
+
+		if worst > tc.tol {
+			t.Errorf("prec %d: max |Arccos - math.Acos| = %g, want <= %g", tc.prec, worst, tc.tol)
+		}
 	}
 }
 
-// TestArccos3Term tests the 3-term arccosine approximation for float64.
-func TestArccos3Term64(t *testing.T) {
+func TestArccos32AndEdges(t *testing.T) {
 	t.Parallel()
 
-	tests := []struct {
-		name      string
-		input     float64
-		tolerance float64
-	}{
-		{"zero", 0.0, 1e-10},
-		{"half", 0.5, 1e-3},
-		{"sqrt(2)/2", math.Sqrt(2) / 2, 2e-4},
-		{"one", 1.0, 1e-10},
-		{"negative half", -0.5, 0.1}, // Larger tolerance for negative values
+	for x := float32(-1); x <= 1; x += 1.0 / 1024 {
+		if diff := abs32(Arccos(x, PrecisionHigh) - float32(math.Acos(float64(x)))); diff > 5e-7 {
+			t.Fatalf("Arccos(float32(%g)) diff %g", x, diff)
+		}
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-
-			got := arccos3Term(tt.input)
-			want := math.Acos(tt.input)
+	if Arccos(1.0, PrecisionHigh) != 0 || Arccos(-1.0, PrecisionHigh) != math.Pi {
+		t.Fatal("endpoints are not exact")
+	}
 
-			diff := abs64(got - want)
-			if diff > tt.tolerance {
-				t.Errorf("arccos3Term(%v) = %v, want %v (diff: %v, tolerance: %v)",
-					tt.input, got, want, diff, tt.tolerance)
-			}
-		})
+	for _, x := range []float64{1.0000001, -1.5, math.NaN(), math.Inf(1)} {
+		if !math.IsNaN(Arccos(x, PrecisionFast)) {
+			t.Fatalf("Arccos(%g) is not NaN", x)
+		}
 	}
 }
 
-// TestArccos6Term tests the 6-term arccosine approximation for float32.
-func TestArccos6Term32(t *testing.T) {
-	t.Parallel()
-
-	tests := []struct {
-		name      string
-		input     float32
-		tolerance float32
-	}{
-		{"zero", 0.0, 1e-7},
-		{"half", 0.5, 6e-6},
-		{"sqrt(2)/2", float32(math.Sqrt(2) / 2), 3e-7},
-		{"one", 1.0, 1e-7},
-		{"negative half", -0.5, 0.02}, // Larger tolerance for negative values
+// Helper functions.
+func abs32(x float32) float32 {
+	if x < 0 {
+		return -x
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-
-			got := arccos6Term(tt.input)
-			want := float32(math.Acos(float64(tt.input)))
+	return x
+}
 
-			diff := abs32(got - want)
-			if diff > tt.tolerance {
-				t.Errorf("arccos6Term(%v) = %v, want %v (diff: %v, tolerance: %v)",
-					tt.input, got, want, diff, tt.tolerance)
-			}
-		})
+func abs64(x float64) float64 {
+	if x < 0 {
+		return -x
 	}
+
+	return x
 }
 
-// TestArccos6Term tests the 6-term arccosine approximation for float64.
-func TestArccos6Term64(t *testing.T) {
+func TestArcsinArcsecArccsc(t *testing.T) {
 	t.Parallel()
 
-	tests := []struct {
-		name      string
-		input     float64
-		tolerance float64
+	cases := []struct {
+		prec Precision
+		tol  float64
 	}{
-		{"zero", 0.0, 1e-13},
-		{"half", 0.5, 6e-6},
-		{"sqrt(2)/2", math.Sqrt(2) / 2, 2e-7},
-		{"one", 1.0, 1e-13},
-		{"negative half", -0.5, 0.02}, // Larger tolerance for negative values
+		{PrecisionFast, 1e-3},
+		{PrecisionBalanced, 6e-6},
+		{PrecisionHigh, 1.5e-8},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
+	for _, tc := range cases {
+		for i := 0; i <= 20000; i++ {
+			s := -1 + float64(i)/10000
+			if d := math.Abs(Arcsin(s, tc.prec) - math.Asin(s)); d > tc.tol {
+				t.Fatalf("prec %d: Arcsin(%g) diff %g", tc.prec, s, d)
+			}
 
-			got := arccos6Term(tt.input)
-			want := math.Acos(tt.input)
+			if s == 0 {
+				continue
+			}
 
-			diff := abs64(got - want)
-			if diff > tt.tolerance {
-				t.Errorf("arccos6Term(%v) = %v, want %v (diff: %v, tolerance: %v)",
-					tt.input, got, want, diff, tt.tolerance)
+			// x = 1/s sweeps |x| >= 1 densely near ±1 and out to ±1e4.
+			x := 1 / s
+			if d := math.Abs(Arcsec(x, tc.prec) - math.Acos(s)); d > tc.tol {
+				t.Fatalf("prec %d: Arcsec(%g) diff %g", tc.prec, x, d)
 			}
-		})
-	}
-}
 
-// Helper functions.
-func abs32(x float32) float32 {
-	if x < 0 {
-		return -x
+			if d := math.Abs(Arccsc(x, tc.prec) - math.Asin(s)); d > tc.tol {
+				t.Fatalf("prec %d: Arccsc(%g) diff %g", tc.prec, x, d)
+			}
+		}
 	}
 
-	return x
-}
+	// Small results keep their relative accuracy.
+	if got := Arccsc(1e12, PrecisionHigh); math.Abs(got-1e-12) > 1e-24 {
+		t.Fatalf("Arccsc(1e12) = %g", got)
+	}
 
-func abs64(x float64) float64 {
-	if x < 0 {
-		return -x
+	inf := math.Inf(1)
+	if Arcsec(inf, PrecisionHigh) != math.Pi/2 || Arccsc(-inf, PrecisionHigh) != 0 || !math.Signbit(Arccsc(-inf, PrecisionHigh)) {
+		t.Fatal("infinite arguments")
 	}
 
-	return x
+	for _, x := range []float64{0, 0.5, -0.999, math.NaN()} {
+		if !math.IsNaN(Arcsec(x, PrecisionFast)) || !math.IsNaN(Arccsc(x, PrecisionFast)) {
+			t.Fatalf("|x| < 1 or NaN (%g) is not NaN", x)
+		}
+	}
 }
@@ -0,0 +1,141 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLogBetaAgainstMath(t *testing.T) {
+	t.Parallel()
+
+	for _, a := range []float64{0.1, 0.5, 1, 2.5, 10, 100} {
+		for _, b := range []float64{0.3, 1, 4, 50} {
+			la, _ := math.Lgamma(a)
+			lb, _ := math.Lgamma(b)
+			lab, _ := math.Lgamma(a + b)
+			want := la + lb - lab
+
+			if got := LogBeta(a, b, PrecisionHigh); math.Abs(got-want) > 1e-9*math.Max(1, math.Abs(want)) {
+				t.Fatalf("LogBeta(%g, %g) = %.15g, want %.15g", a, b, got, want)
+			}
+		}
+	}
+
+	if !math.IsNaN(LogBeta(0.0, 1.0, PrecisionHigh)) || !math.IsNaN(LogBeta(1.0, math.NaN(), PrecisionHigh)) {
+		t.Fatal("invalid arguments do not return NaN")
+	}
+}
+
+// betaIncIntegerReference uses the binomial expansion valid for positive
+// integer a, b: I_x(a, b) = Σ_{j=a}^{a+b-1} C(a+b-1, j) x^j (1-x)^(a+b-1-j).
+func betaIncIntegerReference(a, b int, x float64) float64 {
+	n := a + b - 1
+	sum := 0.0
+
+	for j := a; j <= n; j++ {
+		lc, _ := math.Lgamma(float64(n + 1))
+		lj, _ := math.Lgamma(float64(j + 1))
+		lnj, _ := math.Lgamma(float64(n - j + 1))
+		sum += math.Exp(lc - lj - lnj + float64(j)*math.Log(x) + float64(n-j)*math.Log1p(-x))
+	}
+
+	return sum
+}
+
+// betaIncQuadrature integrates t^(a-1)(1-t)^(b-1) with the substitution
+// t = sin²θ, which turns it into the smooth 2·sin^(2a-1)θ·cos^(2b-1)θ for
+// a, b >= 1/2, using composite Simpson.
+func betaIncQuadrature(a, b, x float64) float64 {
+	const n = 20000
+
+	theta := math.Asin(math.Sqrt(x))
+	h := theta / n
+	f := func(t float64) float64 { return 2 * math.Pow(math.Sin(t), 2*a-1) * math.Pow(math.Cos(t), 2*b-1) }
+
+	sum := f(0) + f(theta)
+	for i := 1; i < n; i++ {
+		w := 2.0
+		if i%2 == 1 {
+			w = 4
+		}
+
+		sum += w * f(float64(i)*h)
+	}
+
+	la, _ := math.Lgamma(a)
+	lb, _ := math.Lgamma(b)
+	lab, _ := math.Lgamma(a + b)
+
+	return sum * h / 3 / math.Exp(la+lb-lab)
+}
+
+func TestBetaIncAgainstReference(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		prec Precision
+		tol  float64
+	}{
+		{PrecisionFast, 2e-3},
+		{PrecisionBalanced, 1e-5},
+		{PrecisionHigh, 1e-8},
+	}
+
+	xs := []float64{0.01, 0.2, 0.5, 0.8, 0.99}
+
+	for _, tc := range cases {
+		for _, a := range []int{1, 2, 5, 13, 30} {
+			for _, b := range []int{1, 3, 20} {
+				for _, x := range xs {
+					got := BetaInc(float64(a), float64(b), x, tc.prec)
+					want := betaIncIntegerReference(a, b, x)
+
+					if math.Abs(got-want) > tc.tol {
+						t.Fatalf("prec %d: BetaInc(%d, %d, %g) = %.12g, want %.12g", tc.prec, a, b, x, got, want)
+					}
+				}
+			}
+		}
+
+		for _, a := range []float64{0.5, 1.5, 2.5} {
+			for _, b := range []float64{0.5, 1.5, 3.5} {
+				for _, x := range xs {
+					got := BetaInc(a, b, x, tc.prec)
+					want := betaIncQuadrature(a, b, x)
+
+					if math.Abs(got-want) > tc.tol {
+						t.Fatalf("prec %d: BetaInc(%g, %g, %g) = %.12g, want %.12g", tc.prec, a, b, x, got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestBetaIncClosedForms(t *testing.T) {
+	t.Parallel()
+
+	for x := 0.05; x < 1; x += 0.05 {
+		// I_x(1, 1) = x and I_x(a, 1) = x^a.
+		if got := BetaInc(1.0, 1.0, x, PrecisionHigh); math.Abs(got-x) > 5e-8*x {
+			t.Fatalf("BetaInc(1, 1, %g) = %.15g", x, got)
+		}
+
+		if got := BetaInc(3.0, 1.0, x, PrecisionHigh); math.Abs(got-x*x*x) > 5e-8*x*x*x {
+			t.Fatalf("BetaInc(3, 1, %g) = %.15g", x, got)
+		}
+	}
+
+	// I_x(a, b) + I_{1-x}(b, a) = 1
+	if got := BetaInc(2.5, 4.0, 0.3, PrecisionHigh) + BetaInc(4.0, 2.5, 0.7, PrecisionHigh); math.Abs(got-1) > 1e-7 {
+		t.Fatalf("symmetry: sum = %.15g", got)
+	}
+
+	if BetaInc(2.0, 3.0, 0.0, PrecisionHigh) != 0 || BetaInc(2.0, 3.0, 1.0, PrecisionHigh) != 1 {
+		t.Fatal("endpoints are not exact")
+	}
+
+	if !math.IsNaN(BetaInc(-1.0, 3.0, 0.5, PrecisionHigh)) || !math.IsNaN(BetaInc(1.0, 3.0, math.NaN(), PrecisionHigh)) {
+		t.Fatal("invalid arguments do not return NaN")
+	}
+}
@@ -0,0 +1,156 @@
+package approx
+
+import (
+	"math"
+
+	"github.com/meko-christian/algo-approx/dd"
+)
+
+// RoundDecimal rounds x to places decimal places, ties to even; a negative
+// places rounds to tens, hundreds and so on.
+//
+// Fast multiplies by 10^places, rounds and multiplies by the table
+// reciprocal, which is off the nearest double by up to an ulp. Balanced
+// divides by the exact power instead, so the last step is correctly rounded;
+// for negative places both scale back by an exact multiplication. High
+// also forms the scaled value exactly, which settles inputs whose product
+// or quotient rounds onto or across a half; the result is then the double
+// nearest to the decimal rounding of x itself, for |places| <= 22.
+//
+// Where |x|·10^places >= 2^52 the rounding is within an ulp of x, which is
+// returned unchanged, as are NaN and ±Inf; the sign of a result rounded to
+// zero is that of x, including every finite x once 10^-places overflows.
+func RoundDecimal(x float64, places int, prec Precision) float64 {
+	if places < 0 {
+		s := Pow10(-places)
+		q := x / s
+
+		if !(math.Abs(q) < 1<<52) { //nolint:staticcheck // also catches NaN
+			return x
+		}
+
+		if math.IsInf(s, 1) {
+			return math.Copysign(0, x) // 10^-places overflows; every finite x rounds to zero
+		}
+
+		r := roundEven(q)
+		if normalizePrecision(prec) == PrecisionHigh {
+			r = fixRoundQuotient(x, s, r)
+		}
+
+		return math.Copysign(r*s, x)
+	}
+
+	s := Pow10(places)
+
+	hi := float64(x * s)
+	if !(math.Abs(hi) < 1<<52) { //nolint:staticcheck // also catches NaN
+		return x
+	}
+
+	r := roundEven(hi)
+
+	switch normalizePrecision(prec) {
+	case PrecisionFast:
+		return math.Copysign(r*Pow10(-places), x)
+	case PrecisionHigh:
+		_, lo := dd.TwoProd(x, s)
+
+		// hi - r is exact; only a scaled value that rounded to exactly a
+		// half can lie on the other side of it.
+		switch d := hi - r; {
+		case d == 0.5 && lo > 0:
+			r++
+		case d == -0.5 && lo < 0:
+			r--
+		}
+	}
+
+	return math.Copysign(r/s, x)
+}
+
+// TruncDecimal truncates x towards zero to places decimal places; a negative
+// places truncates to tens, hundreds and so on. The tiers differ as for
+// RoundDecimal: High decides from the exact scaled value, so an input just
+// below a decimal never truncates up to it. Where |x|·10^places >= 2^52, x
+// is within two ulps of the result and is returned unchanged.
+func TruncDecimal(x float64, places int, prec Precision) float64 {
+	if places < 0 {
+		s := Pow10(-places)
+		q := x / s
+
+		if !(math.Abs(q) < 1<<52) { //nolint:staticcheck // also catches NaN
+			return x
+		}
+
+		if math.IsInf(s, 1) {
+			return math.Copysign(0, x) // 10^-places overflows; every finite x rounds to zero
+		}
+
+		t := math.Trunc(q)
+		if normalizePrecision(prec) == PrecisionHigh {
+			// The remainder x - t·s in one rounding has the sign of the
+			// exact one.
+			switch e := math.FMA(-t, s, x); {
+			case x > 0 && e < 0:
+				t--
+			case x < 0 && e > 0:
+				t++
+			}
+		}
+
+		return math.Copysign(t*s, x)
+	}
+
+	s := Pow10(places)
+
+	hi := float64(x * s)
+	if !(math.Abs(hi) < 1<<52) { //nolint:staticcheck // also catches NaN
+		return x
+	}
+
+	t := math.Trunc(hi)
+
+	switch normalizePrecision(prec) {
+	case PrecisionFast:
+		return math.Copysign(t*Pow10(-places), x)
+	case PrecisionHigh:
+		if _, lo := dd.TwoProd(x, s); hi == t {
+			switch {
+			case x > 0 && lo < 0:
+				t--
+			case x < 0 && lo > 0:
+				t++
+			}
+		}
+	}
+
+	return math.Copysign(t/s, x)
+}
+
+// roundEven is RoundHalfEven extended to |x| < 2^52, where a fraction of one
+// half is still possible.
+func roundEven(x float64) float64 {
+	if math.Abs(x) < 1<<51 {
+		return RoundHalfEven(x)
+	}
+
+	return math.RoundToEven(x)
+}
+
+// fixRoundQuotient corrects r = RoundHalfEven(x/s) to the integer nearest
+// the exact quotient, ties to even, from the remainder x - r·s, which a
+// fused multiply-add gets with a single rounding.
+func fixRoundQuotient(x, s, r float64) float64 {
+	e := math.FMA(-r, s, x)
+	half := 0.5 * s
+
+	switch {
+	case e > half, e == half && math.Mod(r, 2) != 0:
+		return r + 1
+	case e < -half, e == -half && math.Mod(r, 2) != 0:
+		return r - 1
+	default:
+		return r
+	}
+}
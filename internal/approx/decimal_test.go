@@ -0,0 +1,165 @@
+package approx
+
+import (
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
+
+// decimalRef returns the double nearest to x rounded (or truncated) to places
+// decimal places in exact rational arithmetic, ties to even.
+func decimalRef(x float64, places int, trunc bool) float64 {
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(places))), nil))
+
+	q := new(big.Rat).SetFloat64(x)
+	if places >= 0 {
+		q.Mul(q, scale)
+	} else {
+		q.Quo(q, scale)
+	}
+
+	n, rem := new(big.Int).QuoRem(q.Num(), q.Denom(), new(big.Int))
+
+	if !trunc {
+		// Compare 2·|rem| with the denominator to find the nearest integer.
+		c := new(big.Int).Abs(rem)
+		c.Lsh(c, 1)
+
+		if cmp := c.Cmp(q.Denom()); cmp > 0 || (cmp == 0 && n.Bit(0) == 1) {
+			n.Add(n, big.NewInt(int64(rem.Sign())))
+		}
+	}
+
+	r := new(big.Rat).SetInt(n)
+	if places >= 0 {
+		r.Quo(r, scale)
+	} else {
+		r.Mul(r, scale)
+	}
+
+	f, _ := r.Float64()
+	if f == 0 {
+		return math.Copysign(0, x)
+	}
+
+	return f
+}
+
+// beyondScale reports whether x·10^places is at least 2^52, where the
+// kernels return x unchanged.
+func beyondScale(x float64, places int) bool {
+	return math.Abs(x)*math.Pow10(places) >= 1<<52
+}
+
+func abs(n int) int { return max(n, -n) }
+
+func TestRoundTruncDecimalHighExact(t *testing.T) {
+	t.Parallel()
+
+	inputs := []float64{
+		1.005, 2.675, 0.125, 0.375, 2.5, -2.5, 1234.5, 0.1 + 0.2, -1.0049999999999999,
+		12345.678901, -0.000123456, 9.995, 1e15 + 0.5, 123456789, 5e-7,
+	}
+
+	r := rand.New(rand.NewPCG(1, 2))
+	for range 3000 {
+		e := r.IntN(20) - 10
+		inputs = append(inputs, (r.Float64()*2-1)*math.Pow10(e))
+
+		// Values with few decimals land near halves after scaling.
+		inputs = append(inputs, float64(r.IntN(200000)-100000)/1000+0.0005)
+	}
+
+	for _, x := range inputs {
+		for places := -4; places <= 12; places++ {
+			if beyondScale(x, places) {
+				continue
+			}
+
+			if got, want := RoundDecimal(x, places, PrecisionHigh), decimalRef(x, places, false); got != want {
+				t.Fatalf("RoundDecimal(%.17g, %d, High) = %.17g, want %.17g", x, places, got, want)
+			}
+
+			if got, want := TruncDecimal(x, places, PrecisionHigh), decimalRef(x, places, true); got != want {
+				t.Fatalf("TruncDecimal(%.17g, %d, High) = %.17g, want %.17g", x, places, got, want)
+			}
+		}
+	}
+}
+
+func TestRoundTruncDecimalLowerTiers(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewPCG(3, 4))
+
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced} {
+		for range 5000 {
+			x := (r.Float64()*2 - 1) * math.Pow10(r.IntN(12)-4)
+			places := r.IntN(14) - 3
+			if beyondScale(x, places) {
+				continue
+			}
+
+			for _, trunc := range []bool{false, true} {
+				got := RoundDecimal(x, places, prec)
+				if trunc {
+					got = TruncDecimal(x, places, prec)
+				}
+
+				// At most one unit in the last decimal place near a
+				// boundary, and otherwise an ulp of the result.
+				want := decimalRef(x, places, trunc)
+				if d := math.Abs(got - want); d > Pow10(-places)+4*ulpOf(want) {
+					t.Fatalf("prec %v trunc %v: (%.17g, %d) = %.17g, want %.17g", prec, trunc, x, places, got, want)
+				} else if d != 0 && d < Pow10(-places)/2 && d > 2*ulpOf(want) {
+					t.Fatalf("prec %v trunc %v: (%.17g, %d) = %.17g, want %.17g within 2 ulps", prec, trunc, x, places, got, want)
+				}
+			}
+		}
+	}
+}
+
+func ulpOf(x float64) float64 { return math.Nextafter(math.Abs(x), math.Inf(1)) - math.Abs(x) }
+
+func TestRoundTruncDecimalSpecial(t *testing.T) {
+	t.Parallel()
+
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		for _, x := range []float64{math.Inf(1), math.Inf(-1), 1e300, -1e20, 0, math.Copysign(0, -1)} {
+			if got := RoundDecimal(x, 3, prec); got != x || math.Signbit(got) != math.Signbit(x) {
+				t.Errorf("RoundDecimal(%g, 3, %v) = %g", x, prec, got)
+			}
+
+			if got := TruncDecimal(x, -2, prec); x != 0 && got != x && math.Abs(x) > 1e19 {
+				t.Errorf("TruncDecimal(%g, -2, %v) = %g", x, prec, got)
+			}
+		}
+
+		if !math.IsNaN(RoundDecimal(math.NaN(), 2, prec)) || !math.IsNaN(TruncDecimal(math.NaN(), -2, prec)) {
+			t.Errorf("prec %v: NaN not propagated", prec)
+		}
+
+		// 10^-places overflows: finite inputs go to a signed zero, not NaN.
+		for _, tc := range []struct {
+			x      float64
+			places int
+		}{{5, -309}, {5e300, -400}, {-5e300, -309}, {math.MaxFloat64, -1000}} {
+			if got := RoundDecimal(tc.x, tc.places, prec); got != 0 || math.Signbit(got) != math.Signbit(tc.x) {
+				t.Errorf("RoundDecimal(%g, %d, %v) = %g, want a zero signed like x", tc.x, tc.places, prec, got)
+			}
+
+			if got := TruncDecimal(tc.x, tc.places, prec); got != 0 || math.Signbit(got) != math.Signbit(tc.x) {
+				t.Errorf("TruncDecimal(%g, %d, %v) = %g, want a zero signed like x", tc.x, tc.places, prec, got)
+			}
+		}
+
+		if got := RoundDecimal(math.Inf(-1), -400, prec); !math.IsInf(got, -1) {
+			t.Errorf("RoundDecimal(-Inf, -400, %v) = %g", prec, got)
+		}
+	}
+
+	if got := RoundDecimal(-0.001, 2, PrecisionHigh); got != 0 || !math.Signbit(got) {
+		t.Errorf("RoundDecimal(-0.001, 2) = %g, want -0", got)
+	}
+}
@@ -0,0 +1,37 @@
+package approx
+
+import "math"
+
+// ReductionLimit returns the largest |x| for which the floating-point range
+// reduction of Sin, Cos, SinCos, Sec, Csc, Tan and Cotan keeps its error
+// below the truncation error of the requested tier.
+//
+// The reduction subtracts a multiple of a rounded 2π (or π), so its absolute
+// error grows like |x|·2^-52; past the limit the result is silently less
+// accurate than the tier promises.
+func ReductionLimit(prec Precision) float64 {
+	switch normalizePrecision(prec) {
+	case PrecisionFast:
+		return 0x1p40
+	case PrecisionHigh:
+		return 0x1p12
+	default:
+		return 0x1p26
+	}
+}
+
+// ReductionDegraded reports whether finite x lies beyond ReductionLimit.
+func ReductionDegraded(x float64, prec Precision) bool {
+	ax := math.Abs(x)
+
+	return ax > ReductionLimit(prec) && ax <= math.MaxFloat64
+}
+
+// LogDegraded reports whether x is a positive subnormal, for which the
+// exponent extraction of Log and Log2 assumes a normal encoding and the
+// result is wrong by up to the full subnormal exponent range.
+func LogDegraded(x float64) bool { return x > 0 && x < 0x1p-1022 }
+
+// ArctanDegraded reports whether the Taylor kernels of Arctan and Arccotan
+// are outside |x| <= π/12, where they meet their tier accuracy.
+func ArctanDegraded(x float64) bool { return math.Abs(x) > math.Pi/12 }
@@ -1,14 +1,18 @@
 package approx
 
-func selectImpl[T Float](fast, balanced, high func(T) T, prec Precision) func(T) T {
+// newtonIters returns the Newton iteration count for the tier selected by
+// prec. It is a plain switch rather than a table of function values, so
+// callers compile to a static call chain the compiler can inline and
+// escape-analyse.
+func newtonIters(prec Precision) int {
 	switch normalizePrecision(prec) {
 	case PrecisionFast:
-		return fast
-	case PrecisionBalanced, PrecisionAuto:
-		return balanced
+		return 1
 	case PrecisionHigh:
-		return high
+		return 3
+	case PrecisionAuto, PrecisionBalanced:
+		return 2
 	default:
-		return balanced
+		return 2
 	}
 }
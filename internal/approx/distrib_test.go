@@ -0,0 +1,108 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func poissonCDFReference(k int, lambda float64) float64 {
+	sum := 0.0
+
+	for i := 0; i <= k; i++ {
+		lg, _ := math.Lgamma(float64(i + 1))
+		sum += math.Exp(-lambda + float64(i)*math.Log(lambda) - lg)
+	}
+
+	return sum
+}
+
+func binomialCDFReference(k, n int, p float64) float64 {
+	ln, _ := math.Lgamma(float64(n + 1))
+	sum := 0.0
+
+	for i := 0; i <= k; i++ {
+		li, _ := math.Lgamma(float64(i + 1))
+		lni, _ := math.Lgamma(float64(n - i + 1))
+		sum += math.Exp(ln - li - lni + float64(i)*math.Log(p) + float64(n-i)*math.Log1p(-p))
+	}
+
+	return sum
+}
+
+func TestPoissonCDFAgainstSum(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		prec Precision
+		tol  float64
+	}{
+		{PrecisionFast, 3.5e-3},
+		{PrecisionBalanced, 3.5e-3},
+		{PrecisionHigh, 1e-8},
+	}
+
+	for _, tc := range cases {
+		for _, lambda := range []float64{1e-3, 0.019, 0.1, 0.5, 1, 1.2, 3, 10, 30, 100, 1000} {
+			for k := 0; k < int(3*lambda)+10; k++ {
+				got := PoissonCDF(k, lambda, tc.prec)
+				want := poissonCDFReference(k, lambda)
+
+				if math.Abs(got-want) > tc.tol {
+					t.Fatalf("prec %d: PoissonCDF(%d, %g) = %.10g, want %.10g", tc.prec, k, lambda, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestBinomialCDFAgainstSum(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		prec Precision
+		tol  float64
+	}{
+		{PrecisionFast, 3.5e-3},
+		{PrecisionBalanced, 3.5e-3},
+		{PrecisionHigh, 1e-8},
+	}
+
+	for _, tc := range cases {
+		for _, n := range []int{1, 5, 10, 16, 20, 50, 200, 1000} {
+			for _, p := range []float64{1e-4, 1e-3, 0.01, 0.1, 0.3, 0.5, 0.9, 0.999} {
+				for k := range n {
+					got := BinomialCDF(k, n, p, tc.prec)
+					want := binomialCDFReference(k, n, p)
+
+					if math.Abs(got-want) > tc.tol {
+						t.Fatalf("prec %d: BinomialCDF(%d, %d, %g) = %.10g, want %.10g", tc.prec, k, n, p, got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDiscreteCDFEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	if PoissonCDF(-1, 2, PrecisionHigh) != 0 || PoissonCDF(3, 0, PrecisionHigh) != 1 {
+		t.Fatal("PoissonCDF edge values")
+	}
+
+	if !math.IsNaN(PoissonCDF(3, -1, PrecisionHigh)) || !math.IsNaN(PoissonCDF(3, math.NaN(), PrecisionHigh)) {
+		t.Fatal("PoissonCDF invalid lambda is not NaN")
+	}
+
+	if BinomialCDF(-1, 5, 0.5, PrecisionHigh) != 0 || BinomialCDF(5, 5, 0.5, PrecisionHigh) != 1 {
+		t.Fatal("BinomialCDF edge values")
+	}
+
+	if BinomialCDF(2, 5, 0, PrecisionHigh) != 1 || BinomialCDF(2, 5, 1, PrecisionHigh) != 0 {
+		t.Fatal("BinomialCDF degenerate p")
+	}
+
+	if !math.IsNaN(BinomialCDF(2, 5, 1.5, PrecisionHigh)) || !math.IsNaN(BinomialCDF(2, -1, 0.5, PrecisionHigh)) {
+		t.Fatal("BinomialCDF invalid arguments are not NaN")
+	}
+}
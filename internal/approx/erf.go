@@ -0,0 +1,78 @@
+package approx
+
+import "math"
+
+// Abramowitz & Stegun 7.1.26 coefficients (|error| <= 1.5e-7).
+const (
+	erfP  = 0.3275911
+	erfA1 = 0.254829592
+	erfA2 = -0.284496736
+	erfA3 = 1.421413741
+	erfA4 = -1.453152027
+	erfA5 = 1.061405429
+)
+
+// Erfc returns the complementary error function erfc(x).
+//
+// Fast and Balanced use the rational approximation of Abramowitz & Stegun
+// 7.1.26 (absolute error about 1.5e-7 plus the exp kernel); High evaluates
+// erfc(x) = Q(½, x²) through GammaIncQ, which also keeps the relative error
+// small far into the tail.
+func Erfc[T Float](x T, prec Precision) T {
+	xf := float64(x)
+
+	switch {
+	case xf != xf: //nolint:gocritic
+		return x
+	case xf < 0:
+		return T(2 - erfcPositive(-xf, prec))
+	default:
+		return T(erfcPositive(xf, prec))
+	}
+}
+
+// Erf returns the error function erf(x) = 1 - erfc(x), odd in x.
+func Erf[T Float](x T, prec Precision) T {
+	xf := float64(x)
+	if xf != xf { //nolint:gocritic
+		return x
+	}
+
+	return T(math.Copysign(1-erfcPositive(math.Abs(xf), prec), xf))
+}
+
+// NormCDF returns the standard normal CDF Φ(z) = ½·erfc(-z/√2). The tail
+// q = ½·erfc(|z|/√2) is computed once and mirrored, Φ(z) = q for z < 0 and
+// 1 - q otherwise, so the lower tail keeps its small relative error.
+func NormCDF[T Float](z T, prec Precision) T {
+	zf := float64(z)
+	if zf != zf { //nolint:gocritic
+		return z
+	}
+
+	q := 0.5 * erfcPositive(math.Abs(zf)/math.Sqrt2, prec)
+	if zf < 0 {
+		return T(q)
+	}
+
+	return T(1 - q)
+}
+
+func erfcPositive(x float64, prec Precision) float64 {
+	if math.IsInf(x, 1) {
+		return 0
+	}
+
+	if normalizePrecision(prec) == PrecisionHigh {
+		if x == 0 {
+			return 1
+		}
+
+		return GammaIncQ(0.5, x*x, prec)
+	}
+
+	t := 1 / (1 + erfP*x)
+	poly := t * (erfA1 + t*(erfA2+t*(erfA3+t*(erfA4+t*erfA5))))
+
+	return poly * Exp(-x*x, prec)
+}
@@ -0,0 +1,58 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestErfcAgainstMath(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		prec Precision
+		tol  float64
+	}{
+		{PrecisionFast, 4e-4},
+		{PrecisionBalanced, 2e-6},
+		{PrecisionHigh, 1e-8},
+	}
+
+	for _, tc := range cases {
+		for x := -6.0; x < 6; x += 0.01 {
+			if got := Erfc(x, tc.prec); math.Abs(got-math.Erfc(x)) > tc.tol {
+				t.Fatalf("prec %d: Erfc(%g) = %.12g, want %.12g", tc.prec, x, got, math.Erfc(x))
+			}
+
+			if got := Erf(x, tc.prec); math.Abs(got-math.Erf(x)) > tc.tol {
+				t.Fatalf("prec %d: Erf(%g) = %.12g, want %.12g", tc.prec, x, got, math.Erf(x))
+			}
+		}
+	}
+}
+
+func TestErfcTailRelative(t *testing.T) {
+	t.Parallel()
+
+	for _, x := range []float64{5, 10, 20} {
+		got := Erfc(x, PrecisionHigh)
+		if want := math.Erfc(x); math.Abs(got-want) > 1e-7*want {
+			t.Fatalf("Erfc(%g) = %g, want %g", x, got, want)
+		}
+	}
+}
+
+func TestNormCDF(t *testing.T) {
+	t.Parallel()
+
+	if got := NormCDF(0.0, PrecisionHigh); math.Abs(got-0.5) > 1e-12 {
+		t.Fatalf("NormCDF(0) = %g", got)
+	}
+
+	if got := NormCDF(1.959963984540054, PrecisionHigh); math.Abs(got-0.975) > 1e-8 {
+		t.Fatalf("NormCDF(1.96) = %.12g", got)
+	}
+
+	if !math.IsNaN(Erfc(math.NaN(), PrecisionHigh)) || Erfc(math.Inf(1), PrecisionHigh) != 0 || Erfc(math.Inf(-1), PrecisionFast) != 2 {
+		t.Fatal("Erfc special values")
+	}
+}
@@ -3,15 +3,52 @@ package approx
 import "math"
 
 // Exp returns an approximate exponential e^x.
+//
+// For |x| < 2^-28 every tier returns 1+x, which is within half an ulp of e^x
+// there, without the reduction and polynomial.
 func Exp[T Float](x T, prec Precision) T {
+	xf := float64(x)
+	if xf > -expTinyArg && xf < expTinyArg {
+		return T(1 + xf)
+	}
+
+	if prec == PrecisionFast && expFastDomain(xf) {
+		return T(expFast(xf))
+	}
+
+	return T(expTiered(xf, prec))
+}
+
+// expFastDomain reports whether expFast applies to x: the result and the
+// 2^k scale stay in the normal range. It is false for NaN.
+func expFastDomain(x float64) bool { return x > -708 && x < 709 }
+
+// expFast is the Fast tier of Exp on expFastDomain, kept within the inlining
+// budget so it can be inlined into caller loops: no edge-case ladder, no
+// precision switch and a floor without a call. It matches expTiered bit for
+// bit there.
+func expFast(x float64) float64 {
+	t := x*invLn2 + 0.5
+
+	k := float64(int64(t))
+	if k > t {
+		k--
+	}
+
+	r := x - k*ln2
+
+	return (1 + r*(1+r*(0.5+r*(1.0/6.0)))) * math.Float64frombits(uint64(int64(k)+1023)<<52) //nolint:gosec
+}
+
+// expTiered is Exp for every tier, including the edge cases.
+func expTiered(xflt float64, prec Precision) float64 {
 	// Edge cases.
-	if x != x { //nolint:gocritic
-		return x
+	if xflt != xflt { //nolint:gocritic
+		return xflt
 	}
 
-	xflt := float64(x)
 	if math.IsInf(xflt, 1) {
-		return T(math.Inf(1))
+		return math.Inf(1)
 	}
 
 	if math.IsInf(xflt, -1) {
@@ -20,7 +57,7 @@ func Exp[T Float](x T, prec Precision) T {
 
 	// Clamp to float64 overflow bounds.
 	if xflt > maxLogFloat64 {
-		return T(math.Inf(1))
+		return math.Inf(1)
 	}
 
 	if xflt < minLogFloat64 {
@@ -33,18 +70,43 @@ func Exp[T Float](x T, prec Precision) T {
 
 	expr := expPoly(r, normalizePrecision(prec))
 
-	// Faster scaling than math.Ldexp for the common normal range.
-	// 2^k is exactly representable as a float64 when k is within the normal exponent range.
-	var res float64
-
+	// Faster scaling than math.Ldexp for the common normal range, where
+	// Pow2 builds 2^k from its bits.
 	if k > -1023 && k < 1024 {
-		pow2k := math.Float64frombits(uint64(k+1023) << 52) //nolint:gosec
-		res = expr * pow2k
-	} else {
-		res = math.Ldexp(expr, k)
+		return expr * Pow2(k)
 	}
 
-	return T(res)
+	return math.Ldexp(expr, k)
+}
+
+// Exp2 returns an approximate base-2 exponential 2^x.
+//
+// The reduction x = k + r with integer k and |r| <= 1/2 is exact, so integer
+// arguments yield exact powers of two.
+func Exp2[T Float](x T, prec Precision) T {
+	// Edge cases.
+	if x != x { //nolint:gocritic
+		return x
+	}
+
+	xflt := float64(x)
+	if xflt > 1024 {
+		return T(math.Inf(1))
+	}
+
+	if xflt < -1075 {
+		return 0
+	}
+
+	k := math.Round(xflt)
+	r := xflt - k
+
+	expr := 1.0
+	if r != 0 {
+		expr = expPoly(r*ln2, normalizePrecision(prec))
+	}
+
+	return T(math.Ldexp(expr, int(k)))
 }
 
 //nolint:varnamelen
@@ -71,4 +133,8 @@ const (
 	maxLogFloat64 = 709.782712893384
 	minLogFloat64 = -745.133219101941
 	invLn2        = 1.442695040888963407359924681001892137
+
+	// expTinyArg bounds the 1+x shortcut: below it the x^2/2 term is under
+	// 2^-57, a sixteenth of an ulp of 1.
+	expTinyArg = 0x1p-28
 )
@@ -3,6 +3,8 @@ package approx
 import (
 	"math"
 	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
 )
 
 func TestExpAgainstMath_Float64(t *testing.T) {
@@ -13,7 +15,7 @@ func TestExpAgainstMath_Float64(t *testing.T) {
 		got := Exp[float64](x, PrecisionBalanced)
 
 		ref := math.Exp(x)
-		if !closeRel(got, ref, 2e-3) {
+		if !testutil.Close(got, ref, 2e-3) {
 			t.Fatalf("exp(%g) got %g ref %g", x, got, ref)
 		}
 	}
@@ -30,3 +32,56 @@ func TestExpEdgeCases(t *testing.T) {
 		t.Fatalf("expected +Inf for +Inf")
 	}
 }
+
+func TestExpTinyArgument(t *testing.T) {
+	t.Parallel()
+
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		for e := -80.0; e <= -20; e += 0.125 {
+			for _, x := range []float64{math.Exp2(e), -math.Exp2(e)} {
+				if got, ref := Exp(x, prec), math.Exp(x); !testutil.Close(got, ref, 0x1p-52) {
+					t.Fatalf("%v: exp(%g) got %.17g ref %.17g", prec, x, got, ref)
+				}
+
+				if got, ref := Exp(float32(x), prec), float32(math.Exp(x)); got != ref {
+					t.Fatalf("%v: float32 exp(%g) got %.9g ref %.9g", prec, x, got, ref)
+				}
+			}
+		}
+
+		// No jump where the shortcut hands over to the polynomial.
+		lo, hi := Exp(math.Nextafter(expTinyArg, 0), prec), Exp(expTinyArg, prec)
+		if !(hi >= lo) || !testutil.Close(hi, math.Exp(expTinyArg), 1e-3) {
+			t.Fatalf("%v: discontinuity at 2^-28: %.17g then %.17g", prec, lo, hi)
+		}
+	}
+
+	if got := Exp(math.Copysign(0, -1), PrecisionFast); got != 1 {
+		t.Fatalf("exp(-0) got %g", got)
+	}
+}
+
+func TestExp2AgainstMath_Float64(t *testing.T) {
+	t.Parallel()
+
+	cases := []float64{-1074, -20.25, -1, -0.5, 0, 0.3, 1, 7.75, 1023.5}
+	for _, x := range cases {
+		got := Exp2[float64](x, PrecisionHigh)
+
+		ref := math.Exp2(x)
+		if !testutil.Close(got, ref, 1e-7) {
+			t.Fatalf("exp2(%g) got %g ref %g", x, got, ref)
+		}
+	}
+
+	// Integer arguments are exact powers of two.
+	for k := -60; k <= 60; k++ {
+		if got := Exp2(float64(k), PrecisionFast); got != math.Ldexp(1, k) {
+			t.Fatalf("exp2(%d) got %g", k, got)
+		}
+	}
+
+	if !math.IsInf(Exp2[float64](2000, PrecisionBalanced), 1) || Exp2[float64](-2000, PrecisionBalanced) != 0 {
+		t.Fatalf("exp2 overflow/underflow handling broken")
+	}
+}
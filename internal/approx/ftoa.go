@@ -0,0 +1,90 @@
+package approx
+
+import (
+	"math"
+
+	"github.com/meko-christian/algo-approx/dd"
+)
+
+// SignificantDigits returns |x| rounded to digits significant decimal
+// digits, ties to even, as the integer n with 10^(digits-1) <= n < 10^digits
+// and the decimal exponent e of its leading digit, so the rounded value is
+// n·10^(e-digits+1). The rounding is that of the exact binary value of x.
+//
+// The scaled value |x|·10^(digits-1-e) is formed with one exact power of
+// ten, so ok is false, and the caller must fall back to an exact formatter,
+// where |digits-1-e| > 22, which for digits = 6 leaves roughly
+// 1e-17 <= |x| < 1e27 on the fast path. It is also false for digits outside
+// [1, 15], zero, subnormals, NaN and ±Inf.
+func SignificantDigits(x float64, digits int) (n uint64, e int, ok bool) {
+	bits := math.Float64bits(x) &^ (1 << 63)
+
+	// floor(log10 2^e2), exact for |e2| < 1650; e is this or one more.
+	// Zero, subnormals, NaN and ±Inf give e2 = -1023 or 1024 and leave by
+	// the range check.
+	e2 := int(bits>>52) - 1023
+	e = e2 * 78913 >> 18
+
+	if digits < 1 || digits > 15 || e < digits-23 || e > digits+20 {
+		return 0, 0, false
+	}
+
+	ax := math.Float64frombits(bits)
+	if ax >= ftoaBounds[e+1-ftoaBoundsMin] {
+		e++
+	}
+
+	// One of m and s is 1, so t/s is the correctly rounded scaled value and
+	// t + lo the exact one, multiplied or divided.
+	k := digits - 1 - e
+	m, s := pow10Table[max(k, 0)], pow10Table[max(-k, 0)]
+
+	t, lo := dd.TwoProd(ax, m)
+	r := RoundHalfEven(t / s)
+
+	// The remainder t - r·s is exact. It settles a scaled value that rounded
+	// onto or across a half; lo only matters when t - r lands on one.
+	if d, half := math.FMA(-r, s, t), 0.5*s; math.Abs(d) >= half {
+		r = fixHalf(r, d, lo, half)
+	}
+
+	switch lo, hi := pow10Table[digits-1], pow10Table[digits]; {
+	case r == hi:
+		// Rounded up to the next power of ten.
+		return uint64(lo), e + 1, true
+	case r < lo || r > hi:
+		// ax sits on a rounded power of ten below the exact one.
+		return 0, 0, false
+	}
+
+	return uint64(r), e, true
+}
+
+// fixHalf moves r to the integer nearest (t + lo)/s, ties to even, given the
+// exact remainder d = t - r·s with |d| >= half = s/2.
+func fixHalf(r, d, lo, half float64) float64 {
+	odd := math.Mod(r, 2) != 0
+
+	switch {
+	case d > half, d == half && (lo > 0 || lo == 0 && odd):
+		return r + 1
+	case d < -half, d == -half && (lo < 0 || lo == 0 && odd):
+		return r - 1
+	default:
+		return r
+	}
+}
+
+// ftoaBounds holds 10^j, rounded to float64, for j from ftoaBoundsMin to
+// 37, the powers SignificantDigits compares against for its exponent.
+//
+//nolint:gochecknoglobals // read-only table
+var ftoaBounds = func() (b [38 - ftoaBoundsMin]float64) {
+	for i := range b {
+		b[i] = Pow10(i + ftoaBoundsMin)
+	}
+
+	return b
+}()
+
+const ftoaBoundsMin = -21
@@ -0,0 +1,100 @@
+package approx
+
+import (
+	"math"
+	"math/rand/v2"
+	"strconv"
+	"testing"
+)
+
+func TestSignificantDigits(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		x      float64
+		digits int
+		n      uint64
+		e      int
+	}{
+		{1.5, 6, 150000, 0},
+		{-0.000731234, 3, 731, -4},
+		{2.5, 1, 2, 0},         // tie to even, down
+		{3.5, 1, 4, 0},         // tie to even, up
+		{0.125, 2, 12, -1},     // exact tie below 1
+		{9.9996, 4, 1000, 1},   // carry into the next power of ten
+		{1e22, 1, 1, 22},       // the largest exact power
+		{123456789, 3, 123, 8}, // division by an exact power
+		{1 << 53, 15, 900719925474099, 15},
+	}
+
+	for _, tc := range cases {
+		n, e, ok := SignificantDigits(tc.x, tc.digits)
+		if !ok || n != tc.n || e != tc.e {
+			t.Errorf("SignificantDigits(%g, %d) = %d, %d, %v, want %d, %d", tc.x, tc.digits, n, e, ok, tc.n, tc.e)
+		}
+	}
+}
+
+func TestSignificantDigitsFallback(t *testing.T) {
+	t.Parallel()
+
+	for _, x := range []float64{0, math.Copysign(0, -1), 5e-324, math.Inf(1), math.NaN(), 1e-30, 1e30} {
+		if _, _, ok := SignificantDigits(x, 6); ok {
+			t.Errorf("SignificantDigits(%g, 6) took the fast path", x)
+		}
+	}
+
+	for _, digits := range []int{-1, 0, 16} {
+		if _, _, ok := SignificantDigits(1.5, digits); ok {
+			t.Errorf("SignificantDigits(1.5, %d) took the fast path", digits)
+		}
+	}
+}
+
+// TestSignificantDigitsMatchesStrconv compares against the digits of
+// strconv's 'e' format, which rounds the exact value ties to even.
+func TestSignificantDigitsMatchesStrconv(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewPCG(5, 6))
+
+	for range 200000 {
+		digits := 1 + rng.IntN(15)
+
+		// Log-uniform over the fast path, plus short decimals that put many
+		// scaled values exactly on a half.
+		x := math.Pow(10, 40*rng.Float64()-15)
+		if rng.IntN(2) == 0 {
+			x = float64(rng.IntN(1e6)) / 1000
+		}
+
+		n, e, ok := SignificantDigits(x, digits)
+		if !ok {
+			continue
+		}
+
+		want := strconv.FormatFloat(x, 'e', digits-1, 64)
+		got := strconv.FormatUint(n, 10)
+
+		if digits > 1 {
+			got = got[:1] + "." + got[1:]
+		}
+
+		if got += "e" + expString(e); got != want {
+			t.Fatalf("SignificantDigits(%v, %d) = %s, want %s", x, digits, got, want)
+		}
+	}
+}
+
+func expString(e int) string {
+	s := "+"
+	if e < 0 {
+		s, e = "-", -e
+	}
+
+	if e < 10 {
+		s += "0"
+	}
+
+	return s + strconv.Itoa(e)
+}
@@ -0,0 +1,41 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGammaIncQClosedForms(t *testing.T) {
+	t.Parallel()
+
+	for x := 0.0; x < 40; x += 0.37 {
+		// Q(1, x) = e^-x and Q(2, x) = (1+x)e^-x.
+		if got := GammaIncQ(1.0, x, PrecisionHigh); math.Abs(got-math.Exp(-x)) > 1e-8 {
+			t.Fatalf("GammaIncQ(1, %g) = %.15g, want %.15g", x, got, math.Exp(-x))
+		}
+
+		want := (1 + x) * math.Exp(-x)
+		if got := GammaIncQ(2.0, x, PrecisionHigh); math.Abs(got-want) > 1e-8 {
+			t.Fatalf("GammaIncQ(2, %g) = %.15g, want %.15g", x, got, want)
+		}
+
+		// Q(½, x²) = erfc(x)
+		if got := GammaIncQ(0.5, x*x/100, PrecisionHigh); math.Abs(got-math.Erfc(x/10)) > 1e-8 {
+			t.Fatalf("GammaIncQ(½, %g) = %.15g, want %.15g", x*x/100, got, math.Erfc(x/10))
+		}
+	}
+}
+
+func TestGammaIncQEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	if GammaIncQ(2.0, 0.0, PrecisionHigh) != 1 || GammaIncQ(2.0, math.Inf(1), PrecisionHigh) != 0 {
+		t.Fatal("GammaIncQ endpoints")
+	}
+
+	for _, args := range [][2]float64{{0, 1}, {-1, 1}, {1, -1}, {math.NaN(), 1}, {1, math.NaN()}} {
+		if !math.IsNaN(GammaIncQ(args[0], args[1], PrecisionHigh)) {
+			t.Fatalf("GammaIncQ%v is not NaN", args)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTanhAgainstMath(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		prec Precision
+		tol  float64
+	}{
+		{PrecisionFast, 4e-4},
+		{PrecisionBalanced, 3e-6},
+		{PrecisionHigh, 6e-9},
+	}
+
+	for _, tc := range cases {
+		for x := -25.0; x <= 25; x += 0.0007 {
+			want := math.Tanh(x)
+			if got := Tanh(x, tc.prec); math.Abs(got-want) > tc.tol*math.Abs(want) {
+				t.Fatalf("prec %d: Tanh(%g) = %.12g, want %.12g", tc.prec, x, got, want)
+			}
+		}
+	}
+}
+
+func TestTanhEdges(t *testing.T) {
+	t.Parallel()
+
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		if got := Tanh(math.Copysign(0, -1), prec); got != 0 || !math.Signbit(got) {
+			t.Errorf("prec %d: Tanh(-0) = %g", prec, got)
+		}
+
+		if Tanh(math.Inf(1), prec) != 1 || Tanh(math.Inf(-1), prec) != -1 || Tanh(-tanhSaturate, prec) != -1 {
+			t.Errorf("prec %d: Tanh does not saturate", prec)
+		}
+
+		if !math.IsNaN(Tanh(math.NaN(), prec)) {
+			t.Errorf("prec %d: NaN is not propagated", prec)
+		}
+
+		// The series is odd and exact to first order.
+		if x := 1e-300; Tanh(x, prec) != x || Tanh(float32(-x), prec) != float32(-x) {
+			t.Errorf("prec %d: tiny arguments", prec)
+		}
+
+		// The branches meet at the switch within the error of the tier.
+		lo, hi := Tanh(math.Nextafter(tanhSeriesMax, 0), prec), Tanh(tanhSeriesMax, prec)
+		if math.Abs(hi-lo) > 2e-4 {
+			t.Errorf("prec %d: branches at %g: %.12g, %.12g", prec, tanhSeriesMax, lo, hi)
+		}
+	}
+
+	if math.Tanh(tanhSaturate) != 1 {
+		t.Fatalf("tanhSaturate %g is below where tanh rounds to 1", tanhSaturate)
+	}
+}
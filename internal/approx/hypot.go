@@ -0,0 +1,32 @@
+package approx
+
+import "math"
+
+// Hypot returns an approximate sqrt(a*a + b*b).
+//
+// The larger magnitude is factored out so that the intermediate square can
+// neither overflow nor underflow: hypot = hi * sqrt(1 + (lo/hi)^2).
+func Hypot[T Float](a, b T, prec Precision) T {
+	hi, lo := math.Abs(float64(a)), math.Abs(float64(b))
+
+	// Edge cases: Inf wins over NaN, as in math.Hypot.
+	if math.IsInf(hi, 0) || math.IsInf(lo, 0) {
+		return T(math.Inf(1))
+	}
+
+	if hi != hi || lo != lo { //nolint:gocritic
+		return T(math.NaN())
+	}
+
+	if lo > hi {
+		hi, lo = lo, hi
+	}
+
+	if hi == 0 {
+		return 0
+	}
+
+	r := lo / hi
+
+	return T(hi * Sqrt(1+r*r, prec))
+}
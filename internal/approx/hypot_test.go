@@ -0,0 +1,38 @@
+package approx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+func TestHypotAgainstMath_Float64(t *testing.T) {
+	t.Parallel()
+
+	cases := [][2]float64{{3, 4}, {-3, 4}, {1, 0}, {0, -2}, {1e-200, 1e-200}, {1e200, 1e200}, {1, 1e-9}}
+	for _, c := range cases {
+		got := Hypot(c[0], c[1], PrecisionBalanced)
+
+		ref := math.Hypot(c[0], c[1])
+		if !testutil.Close(got, ref, 1e-5) {
+			t.Fatalf("hypot(%g, %g) got %g ref %g", c[0], c[1], got, ref)
+		}
+	}
+}
+
+func TestHypotEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	if Hypot[float64](0, 0, PrecisionBalanced) != 0 {
+		t.Fatalf("expected 0 for (0, 0)")
+	}
+
+	if !math.IsInf(Hypot(math.Inf(-1), math.NaN(), PrecisionBalanced), 1) {
+		t.Fatalf("expected +Inf when either argument is infinite")
+	}
+
+	if !math.IsNaN(Hypot(1, math.NaN(), PrecisionBalanced)) {
+		t.Fatalf("expected NaN for NaN argument")
+	}
+}
@@ -0,0 +1,89 @@
+package approx
+
+import (
+	"math"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"testing"
+)
+
+// inlinedKernels must stay within the compiler's inlining budget; inlining
+// into caller loops is what makes the Fast tier fast.
+//
+//nolint:gochecknoglobals // read-only test table
+var inlinedKernels = []string{
+	"expFast", "expFastDomain",
+	"logFast", "logFastDomain",
+	"sinFast", "cosFast", "trigFastDomain",
+	"sqrtFast64", "sqrtFast32",
+	"invSqrtFast64", "invSqrtFast32",
+}
+
+func TestFastKernelsInline(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("builds the package with -gcflags=-m")
+	}
+
+	goTool := filepath.Join(runtime.GOROOT(), "bin", "go")
+
+	out, err := exec.Command(goTool, "build", "-gcflags=-m", ".").CombinedOutput() //nolint:gosec // fixed arguments
+	if err != nil {
+		t.Skipf("go build -gcflags=-m unavailable: %v\n%s", err, out)
+	}
+
+	for _, name := range inlinedKernels {
+		re := regexp.MustCompile(`(?m): can inline ` + name + `( |$)`)
+		if !re.Match(out) {
+			t.Errorf("%s is no longer inlinable; see go build -gcflags=-m=2 for its cost", name)
+		}
+	}
+}
+
+// TestFastKernelsMatchTiered checks that the inlinable kernels are a pure
+// refactor: bit-identical to the general path on their domain. The inputs
+// are rounded with float64() so that, once a kernel is inlined, the product
+// building x cannot fuse into the kernel's first addition on FMA targets.
+func TestFastKernelsMatchTiered(t *testing.T) {
+	t.Parallel()
+
+	same := func(a, b float64) bool { return math.Float64bits(a) == math.Float64bits(b) }
+
+	for i := range 20001 {
+		u := float64(i)/10000 - 1 // [-1, 1]
+
+		if x := float64(707.9 * u); !same(expFast(x), expTiered(x, PrecisionFast)) {
+			t.Fatalf("expFast(%v) = %v, want %v", x, expFast(x), expTiered(x, PrecisionFast))
+		}
+
+		if x := math.Pow(10, 300*u); !same(logFast(x), logTiered(x, PrecisionFast)) {
+			t.Fatalf("logFast(%v) = %v, want %v", x, logFast(x), logTiered(x, PrecisionFast))
+		}
+
+		if x := float64(math.Pi * u); !same(sinFast(x), sin3Term(x)) || !same(cosFast(x), cos3Term(x)) {
+			t.Fatalf("sinFast/cosFast(%v) = %v, %v, want %v, %v", x, sinFast(x), cosFast(x), sin3Term(x), cos3Term(x))
+		}
+
+		x := math.Pow(10, 30*u)
+		if !same(sqrtFast64(x), sqrtBabylonian(x, 1)) || !same(invSqrtFast64(x), invSqrtQuakeNR(x, 1)) {
+			t.Fatalf("float64 sqrt kernels diverge at %v", x)
+		}
+
+		if x32 := float32(x); sqrtFast32(x32) != sqrtBabylonian(x32, 1) || invSqrtFast32(x32) != invSqrtQuakeNR(x32, 1) {
+			t.Fatalf("float32 sqrt kernels diverge at %v", x32)
+		}
+	}
+
+	for _, x := range []float64{math.NaN(), math.Inf(1), math.Inf(-1), 710, -710} {
+		if expFastDomain(x) || logFastDomain(-math.Abs(x)) {
+			t.Fatalf("fast domains must exclude %v", x)
+		}
+	}
+
+	if trigFastDomain(math.NaN()) || trigFastDomain(4) {
+		t.Fatalf("trigFastDomain must exclude NaN and |x| > pi")
+	}
+}
@@ -5,13 +5,38 @@ import (
 )
 
 func InvSqrt[T Float](x T, prec Precision) T {
-	impl := selectImpl(invSqrtFast[T], invSqrtBalanced[T], invSqrtHigh[T], prec)
-	return impl(x)
+	if prec == PrecisionFast && invSqrtFastDomain(x) {
+		switch v := any(x).(type) {
+		case float32:
+			return T(invSqrtFast32(v))
+		case float64:
+			return T(invSqrtFast64(v))
+		}
+	}
+
+	return invSqrtQuakeNR(x, newtonIters(prec))
 }
 
-func invSqrtFast[T Float](x T) T     { return invSqrtQuakeNR(x, 1) }
-func invSqrtBalanced[T Float](x T) T { return invSqrtQuakeNR(x, 2) }
-func invSqrtHigh[T Float](x T) T     { return invSqrtQuakeNR(x, 3) }
+// invSqrtFastDomain reports whether invSqrtFast64/32 apply to x: positive,
+// finite, with the bit-trick seed in use. It is false for NaN.
+func invSqrtFastDomain[T Float](x T) bool {
+	return x > 0 && float64(x) <= math.MaxFloat64 && portableSeed()
+}
+
+// invSqrtFast64 is invSqrtQuakeNR(x, 1) on invSqrtFastDomain in inlinable
+// form.
+func invSqrtFast64(x float64) float64 {
+	y := math.Float64frombits(0x5fe6eb50c7b537a9 - (math.Float64bits(x) >> 1))
+
+	return y * (1.5 - 0.5*x*y*y)
+}
+
+// invSqrtFast32 is the float32 form of invSqrtFast64.
+func invSqrtFast32(x float32) float32 {
+	y := math.Float32frombits(0x5f3759df - (math.Float32bits(x) >> 1))
+
+	return y * (1.5 - 0.5*x*y*y)
+}
 
 //nolint:varnamelen
 func invSqrtQuakeNR[T Float](x T, iters int) T {
@@ -33,7 +58,7 @@ func invSqrtQuakeNR[T Float](x T, iters int) T {
 		return 0
 	}
 
-	y := invSqrtQuake(x)
+	y, iters := invSqrtSeed(x, iters)
 	half := T(0.5)
 	threeHalf := T(1.5)
 
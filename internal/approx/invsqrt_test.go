@@ -3,6 +3,8 @@ package approx
 import (
 	"math"
 	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
 )
 
 func TestInvSqrtAgainstMath_Float64(t *testing.T) {
@@ -13,7 +15,7 @@ func TestInvSqrtAgainstMath_Float64(t *testing.T) {
 		got := InvSqrt[float64](x, PrecisionBalanced)
 
 		ref := 1.0 / math.Sqrt(x)
-		if !closeRel(got, ref, 8e-4) {
+		if !testutil.Close(got, ref, 8e-4) {
 			t.Fatalf("invsqrt(%g) got %g ref %g", x, got, ref)
 		}
 	}
@@ -0,0 +1,88 @@
+package approx
+
+import "math"
+
+// InvSqrt4 computes the inverse square root of four float32 lanes. The lanes
+// are processed in lockstep with no per-lane branches on the hot path, so the
+// compiler can keep the array in registers; non-normal lanes (zero,
+// negative, Inf, NaN) are patched afterwards by the scalar kernel.
+//
+// Results are bit-identical to InvSqrt on each lane. When the scalar kernel
+// starts from a hardware or overridden seed, the lanes are evaluated by it.
+func InvSqrt4(x [4]float32, prec Precision) [4]float32 {
+	iters := invSqrtIters(prec)
+
+	var y [4]float32
+	if !portableSeed() {
+		for i, v := range x {
+			y[i] = invSqrtQuakeNR(v, iters)
+		}
+
+		return y
+	}
+
+	for i := range 4 {
+		y[i] = math.Float32frombits(0x5f3759df - (math.Float32bits(x[i]) >> 1))
+	}
+
+	for range iters {
+		for i := range 4 {
+			y[i] *= 1.5 - 0.5*x[i]*y[i]*y[i]
+		}
+	}
+
+	for i, v := range x {
+		if !(v > 0 && v <= math.MaxFloat32) {
+			y[i] = invSqrtQuakeNR(v, iters)
+		}
+	}
+
+	return y
+}
+
+// InvSqrt2 is the two-lane float64 form of InvSqrt4.
+func InvSqrt2(x [2]float64, prec Precision) [2]float64 {
+	iters := invSqrtIters(prec)
+
+	var y [2]float64
+	if !portableSeed() {
+		for i, v := range x {
+			y[i] = invSqrtQuakeNR(v, iters)
+		}
+
+		return y
+	}
+
+	for i := range 2 {
+		y[i] = math.Float64frombits(0x5fe6eb50c7b537a9 - (math.Float64bits(x[i]) >> 1))
+	}
+
+	for range iters {
+		for i := range 2 {
+			y[i] *= 1.5 - 0.5*x[i]*y[i]*y[i]
+		}
+	}
+
+	for i, v := range x {
+		if !(v > 0 && v <= math.MaxFloat64) {
+			y[i] = invSqrtQuakeNR(v, iters)
+		}
+	}
+
+	return y
+}
+
+// invSqrtIters returns the Newton-Raphson iteration count used by InvSqrt for
+// prec.
+func invSqrtIters(prec Precision) int {
+	switch normalizePrecision(prec) {
+	case PrecisionFast:
+		return 1
+	case PrecisionHigh:
+		return 3
+	case PrecisionAuto, PrecisionBalanced:
+		return 2
+	default:
+		return 2
+	}
+}
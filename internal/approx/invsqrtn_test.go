@@ -0,0 +1,54 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInvSqrt4MatchesScalar(t *testing.T) {
+	t.Parallel()
+
+	inputs := [][4]float32{
+		{1, 2, 4, 16},
+		{1e-30, 1e30, 0.25, 3},
+		{0, -1, float32(math.Inf(1)), float32(math.NaN())},
+		{1e-40, math.MaxFloat32, 7, 0.5},
+	}
+
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		for _, x := range inputs {
+			got := InvSqrt4(x, prec)
+			for i, v := range x {
+				want := InvSqrt(v, prec)
+				if math.Float32bits(got[i]) != math.Float32bits(want) &&
+					!(math.IsNaN(float64(got[i])) && math.IsNaN(float64(want))) {
+					t.Fatalf("prec %d lane %d: InvSqrt4(%g) = %g, scalar %g", prec, i, v, got[i], want)
+				}
+			}
+		}
+	}
+}
+
+func TestInvSqrt2MatchesScalar(t *testing.T) {
+	t.Parallel()
+
+	inputs := [][2]float64{
+		{1, 2},
+		{1e-300, 1e300},
+		{0, -1},
+		{math.Inf(1), math.NaN()},
+	}
+
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		for _, x := range inputs {
+			got := InvSqrt2(x, prec)
+			for i, v := range x {
+				want := InvSqrt(v, prec)
+				if math.Float64bits(got[i]) != math.Float64bits(want) &&
+					!(math.IsNaN(got[i]) && math.IsNaN(want)) {
+					t.Fatalf("prec %d lane %d: InvSqrt2(%g) = %g, scalar %g", prec, i, v, got[i], want)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLgammaAgainstMath(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		prec Precision
+		tol  float64
+	}{
+		{PrecisionFast, 2e-4},
+		{PrecisionBalanced, 5e-8},
+		{PrecisionHigh, 5e-11},
+	}
+
+	for _, tc := range cases {
+		for x := 0.01; x < 500; x *= 1.07 {
+			got := Lgamma(x, tc.prec)
+			ref, _ := math.Lgamma(x)
+
+			if math.Abs(got-ref) > tc.tol*math.Max(1, math.Abs(ref)) {
+				t.Fatalf("prec %d: Lgamma(%g) = %.15g, want %.15g", tc.prec, x, got, ref)
+			}
+		}
+	}
+}
+
+func TestLgammaEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	if !math.IsInf(Lgamma(0.0, PrecisionBalanced), 1) {
+		t.Fatal("Lgamma(0) is not +Inf")
+	}
+
+	if !math.IsInf(Lgamma(math.Inf(1), PrecisionBalanced), 1) {
+		t.Fatal("Lgamma(+Inf) is not +Inf")
+	}
+
+	if !math.IsNaN(Lgamma(-1.5, PrecisionBalanced)) || !math.IsNaN(Lgamma(math.NaN(), PrecisionBalanced)) {
+		t.Fatal("Lgamma of negative or NaN input is not NaN")
+	}
+
+	if Lgamma(1.0, PrecisionFast) != 0 || Lgamma(2.0, PrecisionFast) != 0 {
+		t.Fatal("Lgamma(1) and Lgamma(2) are not exactly 0")
+	}
+}
+
+func TestLogFactorialTableBoundary(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{0, 1, 10, 170, logFactorialTableSize - 1, logFactorialTableSize, 1000, 1 << 20} {
+		got := LogFactorial(n, PrecisionHigh)
+		ref, _ := math.Lgamma(float64(n) + 1)
+
+		if math.Abs(got-ref) > 5e-11*math.Max(1, ref) {
+			t.Fatalf("LogFactorial(%d) = %.17g, want %.17g", n, got, ref)
+		}
+	}
+
+	if !math.IsNaN(LogFactorial(-1, PrecisionHigh)) {
+		t.Fatal("LogFactorial(-1) is not NaN")
+	}
+}
+
+func TestLogBinomial(t *testing.T) {
+	t.Parallel()
+
+	ref := func(n, k int) float64 {
+		k = min(k, n-k)
+		if k <= 64 {
+			sum := 0.0
+			for i := range k {
+				sum += math.Log(float64(n-i) / float64(i+1))
+			}
+
+			return sum
+		}
+
+		a, _ := math.Lgamma(float64(n) + 1)
+		b, _ := math.Lgamma(float64(k) + 1)
+		c, _ := math.Lgamma(float64(n-k) + 1)
+
+		return a - b - c
+	}
+
+	for _, n64 := range []int64{0, 1, 5, 52, 255, 256, 1000, 1_000_000, 1 << 40} {
+		if n64 > math.MaxInt {
+			continue // 32-bit int
+		}
+
+		n := int(n64)
+		for _, k := range []int{0, 1, 2, 5, 31, 32, 33, 100, n / 2, n - 1, n} {
+			if k < 0 || k > n {
+				continue
+			}
+
+			if n > 1_000_000 && min(k, n-k) > logBinomialProductMax {
+				continue // differencing path; no accurate reference at this size
+			}
+
+			got := LogBinomial(n, k, PrecisionHigh)
+			want := ref(n, k)
+
+			// math.Lgamma differencing is itself only good to ~n·ε.
+			tol := 1e-10*math.Max(1, math.Abs(want)) + float64(n)*1e-15
+			if math.Abs(got-want) > tol {
+				t.Fatalf("LogBinomial(%d, %d) = %.17g, want %.17g", n, k, got, want)
+			}
+		}
+	}
+
+	if LogBinomial(52, 5, PrecisionHigh) != LogBinomial(52, 47, PrecisionHigh) {
+		t.Fatal("LogBinomial is not symmetric")
+	}
+
+	if got := math.Exp(LogBinomial(52, 5, PrecisionHigh)); math.Abs(got-2598960) > 1e-4 {
+		t.Fatalf("C(52, 5) = %g", got)
+	}
+
+	if !math.IsInf(LogBinomial(5, 6, PrecisionHigh), -1) || !math.IsInf(LogBinomial(5, -1, PrecisionHigh), -1) {
+		t.Fatal("out-of-range k is not -Inf")
+	}
+
+	if !math.IsNaN(LogBinomial(-1, 0, PrecisionHigh)) {
+		t.Fatal("negative n is not NaN")
+	}
+}
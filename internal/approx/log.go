@@ -4,8 +4,77 @@ import "math"
 
 // Log returns an approximate natural logarithm ln(x).
 //
-//nolint:funlen,varnamelen
+// For x in [0.75, 1.5] the atanh series is evaluated on (x-1)/(x+1) directly:
+// x-1 is exact there and |y| <= 0.2, so the exponent split, with its ln2
+// cancellation for x just above 1, is skipped.
 func Log[T Float](x T, prec Precision) T {
+	xf := float64(x)
+	if xf >= logNearOneLo && xf <= logNearOneHi {
+		return T(atanhSeries((xf-1)/(xf+1), prec))
+	}
+
+	if prec == PrecisionFast && logFastDomain(xf) {
+		return T(logFast(xf))
+	}
+
+	return T(logTiered(xf, prec))
+}
+
+// Bounds of the band where Log evaluates the series without decomposition.
+const (
+	logNearOneLo = 0.75
+	logNearOneHi = 1.5
+)
+
+// logFastDomain reports whether logFast applies to x: positive, normal and
+// finite. It is false for NaN.
+func logFastDomain(x float64) bool { return x >= 0x1p-1022 && x <= math.MaxFloat64 }
+
+// logFast is the Fast tier of Log on logFastDomain in inlinable form: the
+// mantissa split and the two-term series without the edge-case ladder or
+// precision switch. It matches logTiered bit for bit there.
+func logFast(x float64) float64 {
+	bits := math.Float64bits(x)
+	e := float64(int((bits>>52)&0x7ff) - 1022) //nolint:gosec
+	m := (1.0 + float64(bits&((uint64(1)<<52)-1))*(1.0/(1<<52))) * 0.5
+
+	y := (m - 1) / (m + 1)
+
+	return 2*(y+y*(y*y)*(1.0/3.0)) + e*ln2
+}
+
+// logTiered is Log for every tier, including the edge cases.
+//
+//nolint:varnamelen
+func logTiered(x float64, prec Precision) float64 {
+	// Edge cases.
+	if x != x { //nolint:gocritic
+		return x
+	}
+
+	if x == 0 {
+		return math.Inf(-1)
+	}
+
+	if x < 0 {
+		return math.NaN()
+	}
+
+	if math.IsInf(x, 1) {
+		return math.Inf(1)
+	}
+
+	lnm, e := logDecompose(x, prec)
+
+	return lnm + float64(e)*ln2
+}
+
+// Log2 returns an approximate base-2 logarithm log2(x).
+//
+// The mantissa is centered on 1 (m in [√½, √2)) before the series is applied,
+// so the binary exponent is added without rounding and powers of two are
+// returned exactly.
+func Log2[T Float](x T, prec Precision) T {
 	// Edge cases.
 	if x != x { //nolint:gocritic
 		return x
@@ -23,9 +92,42 @@ func Log[T Float](x T, prec Precision) T {
 		return T(math.Inf(1))
 	}
 
+	m, e := centeredDecompose(float64(x))
+
+	return T(atanhSeries((m-1)/(m+1), prec)*invLn2 + float64(e))
+}
+
+// centeredDecompose splits a positive, normal xf into m * 2^e with m in
+// [√½, √2), which keeps the atanh series argument within |y| <= 0.172.
+func centeredDecompose(xf float64) (m float64, e int) {
+	bits := math.Float64bits(xf)
+	e = int((bits>>52)&0x7ff) - 1023 //nolint:gosec
+	m = 1.0 + float64(bits&((uint64(1)<<52)-1))*(1.0/(1<<52))
+
+	if m > math.Sqrt2 {
+		m *= 0.5
+		e++
+	}
+
+	return m, e
+}
+
+// logCentered returns ln(xf) for positive, normal, finite xf using the
+// centered mantissa. It is markedly more accurate than Log for mantissas
+// near 0.5 and is used where the logarithm feeds a larger computation.
+func logCentered(xf float64, prec Precision) float64 {
+	m, e := centeredDecompose(xf)
+
+	return atanhSeries((m-1)/(m+1), prec) + float64(e)*ln2
+}
+
+// logDecompose splits a positive, finite xf into m * 2^e with m in [0.5, 1)
+// and returns ln(m) and e.
+//
+//nolint:varnamelen
+func logDecompose(xf float64, prec Precision) (float64, int) {
 	// Fast range reduction without calling math.Frexp:
 	// x = m * 2^e, with m in [0.5, 1).
-	xf := float64(x)
 	bits := math.Float64bits(xf)
 	expBits := int((bits>>52)&0x7ff) - 1023 //nolint:gosec
 	mant := bits & ((uint64(1) << 52) - 1)
@@ -39,7 +141,16 @@ func Log[T Float](x T, prec Precision) T {
 
 	// Transform to improve convergence:
 	// ln(m) = 2 * ( y + y^3/3 + y^5/5 + ... ), y = (m-1)/(m+1)
-	y := (m - 1) / (m + 1)
+	lnm := atanhSeries((m-1)/(m+1), prec)
+
+	return lnm, e
+}
+
+// atanhSeries returns 2*atanh(y) = ln((1+y)/(1-y)) using the truncated odd
+// power series selected by prec. It converges quickly for |y| <= 1/3.
+//
+//nolint:varnamelen
+func atanhSeries(y float64, prec Precision) float64 {
 	y2 := y * y
 
 	// Unrolled odd-power series; fewer terms for faster precision.
@@ -77,9 +188,7 @@ func Log[T Float](x T, prec Precision) T {
 		sum += p * (1.0 / 7.0)
 	}
 
-	lnm := 2 * sum
-
-	return T(lnm + float64(e)*ln2)
+	return 2 * sum
 }
 
 const ln2 = 0.693147180559945309417232121458176568
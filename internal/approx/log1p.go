@@ -0,0 +1,30 @@
+package approx
+
+import "math"
+
+// Log1p returns an approximate ln(1+x).
+//
+// For 1+x in [0.5, 2] the atanh series is evaluated directly on x/(2+x),
+// which avoids the cancellation of forming 1+x for tiny x. Outside that band
+// it defers to Log.
+func Log1p[T Float](x T, prec Precision) T {
+	// Edge cases.
+	if x != x { //nolint:gocritic
+		return x
+	}
+
+	if x == -1 {
+		return T(math.Inf(-1))
+	}
+
+	if x < -1 {
+		return T(math.NaN())
+	}
+
+	xf := float64(x)
+	if xf >= -0.5 && xf <= 1 {
+		return T(atanhSeries(xf/(2+xf), prec))
+	}
+
+	return T(float64(Log(1+xf, prec)))
+}
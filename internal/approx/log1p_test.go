@@ -0,0 +1,50 @@
+package approx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+func TestLog1pAgainstMath_Float64(t *testing.T) {
+	t.Parallel()
+
+	cases := []float64{-0.9, -0.5, -1e-3, -1e-12, 0, 1e-15, 1e-8, 0.25, 1, 3, 100, 1e10}
+	for _, x := range cases {
+		got := Log1p[float64](x, PrecisionHigh)
+
+		ref := math.Log1p(x)
+		if !testutil.Close(got, ref, 1e-6) {
+			t.Fatalf("log1p(%g) got %g ref %g", x, got, ref)
+		}
+	}
+}
+
+func TestLog1pTinyKeepsPrecision(t *testing.T) {
+	t.Parallel()
+
+	// ln(1+x) ≈ x for tiny x; forming 1+x first would round to exactly 0.
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		got := Log1p[float64](1e-18, prec)
+		if !testutil.Close(got, 1e-18, 1e-12) {
+			t.Fatalf("log1p(1e-18) at %v got %g", prec, got)
+		}
+	}
+}
+
+func TestLog1pEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	if !math.IsInf(Log1p[float64](-1, PrecisionBalanced), -1) {
+		t.Fatalf("expected -Inf for -1")
+	}
+
+	if !math.IsNaN(Log1p[float64](-2, PrecisionBalanced)) {
+		t.Fatalf("expected NaN below -1")
+	}
+
+	if !math.IsNaN(Log1p(math.NaN(), PrecisionBalanced)) {
+		t.Fatalf("expected NaN for NaN")
+	}
+}
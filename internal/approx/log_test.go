@@ -3,6 +3,8 @@ package approx
 import (
 	"math"
 	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
 )
 
 func TestLogAgainstMath_Float64(t *testing.T) {
@@ -13,12 +15,46 @@ func TestLogAgainstMath_Float64(t *testing.T) {
 		got := Log[float64](x, PrecisionBalanced)
 
 		ref := math.Log(x)
-		if !closeRel(got, ref, 2e-3) {
+		if !testutil.Close(got, ref, 2e-3) {
 			t.Fatalf("log(%g) got %g ref %g", x, got, ref)
 		}
 	}
 }
 
+// TestLogNearOne covers the band Log evaluates without decomposition: the
+// absolute error is that of the truncated series at |y| = 0.2, and the
+// relative error stays small as x approaches 1.
+func TestLogNearOne(t *testing.T) {
+	t.Parallel()
+
+	bounds := map[Precision]float64{PrecisionFast: 2e-4, PrecisionBalanced: 2e-7, PrecisionHigh: 2e-10}
+
+	for prec, bound := range bounds {
+		for i := range 10001 {
+			x := logNearOneLo + (logNearOneHi-logNearOneLo)*float64(i)/10000
+			if got, ref := Log(x, prec), math.Log(x); math.Abs(got-ref) > bound {
+				t.Fatalf("%v: log(%g) got %.17g ref %.17g", prec, x, got, ref)
+			}
+		}
+
+		for k := 10; k <= 60; k++ {
+			for _, x := range []float64{1 + math.Ldexp(1, -k), 1 - math.Ldexp(1, -k)} {
+				if got, ref := Log(x, prec), math.Log(x); !testutil.Close(got, ref, 1e-13) {
+					t.Fatalf("%v: log(1%+g) got %.17g ref %.17g", prec, x-1, got, ref)
+				}
+			}
+		}
+
+		if got := Log(1.0, prec); got != 0 {
+			t.Fatalf("%v: log(1) got %g", prec, got)
+		}
+
+		if got := Log(float32(1.25), prec); math.Abs(float64(got)-math.Log(1.25)) > bound+1e-7 {
+			t.Fatalf("%v: float32 log(1.25) got %g", prec, got)
+		}
+	}
+}
+
 func TestLogEdgeCases(t *testing.T) {
 	t.Parallel()
 
@@ -30,3 +66,31 @@ func TestLogEdgeCases(t *testing.T) {
 		t.Fatalf("expected NaN for negative")
 	}
 }
+
+func TestLog2AgainstMath_Float64(t *testing.T) {
+	t.Parallel()
+
+	cases := []float64{1e-9, 0.3, 1, 1.5, 2, 3, 1024, 1e9}
+	for _, x := range cases {
+		got := Log2[float64](x, PrecisionHigh)
+
+		ref := math.Log2(x)
+		if math.Abs(got-ref) > 1e-6 {
+			t.Fatalf("log2(%g) got %g ref %g", x, got, ref)
+		}
+	}
+
+	if !math.IsInf(Log2[float64](0, PrecisionBalanced), -1) || !math.IsNaN(Log2[float64](-1, PrecisionBalanced)) {
+		t.Fatalf("log2 edge cases broken")
+	}
+}
+
+func TestLog2PowersOfTwoExact(t *testing.T) {
+	t.Parallel()
+
+	for k := -100; k <= 100; k++ {
+		if got := Log2(math.Ldexp(1, k), PrecisionFast); got != float64(k) {
+			t.Fatalf("log2(2^%d) got %g", k, got)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package approx
+
+import "math"
+
+// LogAddExp returns an approximate ln(e^a + e^b).
+//
+// It uses the stable form max(a, b) + ln(1 + e^-|a-b|), so the exponential
+// argument is never positive and cannot overflow.
+func LogAddExp[T Float](a, b T, prec Precision) T {
+	// Edge cases.
+	if a != a { //nolint:gocritic
+		return a
+	}
+
+	if b != b { //nolint:gocritic
+		return b
+	}
+
+	hi, lo := float64(a), float64(b)
+	if lo > hi {
+		hi, lo = lo, hi
+	}
+
+	// Covers +Inf in either argument and both arguments being -Inf.
+	if math.IsInf(hi, 0) {
+		return T(hi)
+	}
+
+	return T(hi + Log1p(Exp(lo-hi, prec), prec))
+}
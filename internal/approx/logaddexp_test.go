@@ -0,0 +1,48 @@
+package approx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+func TestLogAddExpAgainstMath_Float64(t *testing.T) {
+	t.Parallel()
+
+	cases := [][2]float64{
+		{0, 0}, {1, 2}, {-3, 5}, {-1000, -1001}, {1000, 999}, {-20, 30}, {1e-9, -1e-9},
+	}
+	for _, c := range cases {
+		got := LogAddExp(c[0], c[1], PrecisionHigh)
+
+		hi := math.Max(c[0], c[1])
+		ref := hi + math.Log1p(math.Exp(-math.Abs(c[0]-c[1])))
+
+		if !testutil.Close(got, ref, 1e-6) {
+			t.Fatalf("logaddexp(%g, %g) got %g ref %g", c[0], c[1], got, ref)
+		}
+	}
+}
+
+func TestLogAddExpEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	inf := math.Inf(1)
+
+	if got := LogAddExp(-inf, -inf, PrecisionBalanced); !math.IsInf(got, -1) {
+		t.Fatalf("logaddexp(-Inf, -Inf) got %g", got)
+	}
+
+	if got := LogAddExp(-inf, 2.5, PrecisionBalanced); got != 2.5 {
+		t.Fatalf("logaddexp(-Inf, 2.5) got %g", got)
+	}
+
+	if got := LogAddExp(inf, 1, PrecisionBalanced); !math.IsInf(got, 1) {
+		t.Fatalf("logaddexp(+Inf, 1) got %g", got)
+	}
+
+	if got := LogAddExp(math.NaN(), 1, PrecisionBalanced); !math.IsNaN(got) {
+		t.Fatalf("logaddexp(NaN, 1) got %g", got)
+	}
+}
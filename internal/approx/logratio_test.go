@@ -0,0 +1,66 @@
+package approx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+func TestLogRatioAgainstMath_Float64(t *testing.T) {
+	t.Parallel()
+
+	cases := [][2]float64{
+		{1, 1}, {3, 2}, {2, 3}, {1e6, 1}, {1, 1e-6}, {1e300, 1e-300}, {-4, -2}, {0.7, 0.7000001},
+	}
+	for _, c := range cases {
+		got := LogRatio(c[0], c[1], PrecisionHigh)
+
+		ref := math.Log(c[0] / c[1])
+		if math.Abs(got-ref) > 1e-7*math.Max(1, math.Abs(ref)) {
+			t.Fatalf("logratio(%g, %g) got %g ref %g", c[0], c[1], got, ref)
+		}
+	}
+}
+
+// TestLogRatioCloseArguments checks the relative error as a approaches b,
+// where ln(a/b) via a quotient loses digits.
+func TestLogRatioCloseArguments(t *testing.T) {
+	t.Parallel()
+
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		for k := 12; k <= 52; k++ {
+			b := 3.7
+			a := b * (1 + math.Ldexp(1, -k))
+			ref := math.Log1p((a - b) / b)
+
+			if got := LogRatio(a, b, prec); !testutil.Close(got, ref, 1e-12) {
+				t.Fatalf("%v: logratio(b(1+2^-%d), b) got %.17g ref %.17g", prec, k, got, ref)
+			}
+		}
+	}
+}
+
+func TestLogRatioEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	inf, nan := math.Inf(1), math.NaN()
+
+	for _, c := range [][2]float64{{nan, 1}, {1, nan}, {-1, 1}, {1, -1}, {0, 0}, {inf, inf}} {
+		if got := LogRatio(c[0], c[1], PrecisionBalanced); !math.IsNaN(got) {
+			t.Fatalf("logratio(%g, %g) got %g, want NaN", c[0], c[1], got)
+		}
+	}
+
+	if got := LogRatio(0.0, 1, PrecisionBalanced); !math.IsInf(got, -1) {
+		t.Fatalf("logratio(0, 1) got %g", got)
+	}
+
+	if got := LogRatio(1, 0.0, PrecisionBalanced); !math.IsInf(got, 1) {
+		t.Fatalf("logratio(1, 0) got %g", got)
+	}
+
+	if got := LogRatio(math.MaxFloat64, math.MaxFloat64/1.5, PrecisionHigh); !testutil.Close(got, math.Log(1.5), 1e-9) {
+		t.Fatalf("logratio near MaxFloat64 got %g", got)
+	}
+}
@@ -0,0 +1,102 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+// quantileError estimates the absolute error of x as a quantile of p <= ½
+// from the residual of the CDF, which math.Erfc gives to full relative
+// precision in the lower tail.
+func quantileError(x, p float64) float64 {
+	cdf := 0.5 * math.Erfc(-x/math.Sqrt2)
+	pdf := math.Exp(-x*x/2) / math.Sqrt(2*math.Pi)
+
+	return math.Abs(cdf-p) / pdf
+}
+
+func TestNormQuantileAccuracy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		prec Precision
+		lo   float64
+		tol  float64
+		rel  bool
+	}{
+		{PrecisionFast, 1e-10, 4e-9, false},
+		{PrecisionBalanced, 1e-10, 4e-9, false},
+		{PrecisionHigh, 1e-300, 2e-15, true},
+	}
+
+	for _, tc := range cases {
+		for lp := math.Log10(tc.lo); lp < math.Log10(0.5); lp += 0.01 {
+			p := math.Pow(10, lp)
+			x := NormQuantile(p, tc.prec)
+
+			tol := tc.tol
+			if tc.rel {
+				tol *= max(1, math.Abs(x))
+			}
+
+			if err := quantileError(x, p); err > tol {
+				t.Fatalf("prec %d: NormQuantile(%g) = %.17g, error %.2g", tc.prec, p, x, err)
+			}
+		}
+	}
+}
+
+func TestNormQuantileSymmetry(t *testing.T) {
+	t.Parallel()
+
+	for _, prec := range []Precision{PrecisionFast, PrecisionHigh} {
+		for _, p := range []float64{0.01, 0.1, 0.3, 0.45} {
+			if lo, hi := NormQuantile(p, prec), NormQuantile(1-p, prec); math.Abs(lo+hi) > 1e-14 {
+				t.Fatalf("prec %d: Φ⁻¹(%g) = %g, Φ⁻¹(%g) = %g", prec, p, lo, 1-p, hi)
+			}
+		}
+
+		if got := NormQuantile(0.5, prec); got != 0 {
+			t.Fatalf("prec %d: Φ⁻¹(0.5) = %g", prec, got)
+		}
+	}
+}
+
+func TestNormQuantileEdges(t *testing.T) {
+	t.Parallel()
+
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		if got := NormQuantile(0.0, prec); !math.IsInf(got, -1) {
+			t.Fatalf("prec %d: Φ⁻¹(0) = %g", prec, got)
+		}
+
+		if got := NormQuantile(1.0, prec); !math.IsInf(got, 1) {
+			t.Fatalf("prec %d: Φ⁻¹(1) = %g", prec, got)
+		}
+
+		for _, p := range []float64{-0.1, 1.5, math.NaN()} {
+			if got := NormQuantile(p, prec); !math.IsNaN(got) {
+				t.Fatalf("prec %d: Φ⁻¹(%g) = %g, want NaN", prec, p, got)
+			}
+		}
+
+		if got := NormQuantile(5e-324, prec); math.IsInf(got, 0) || got > -38 {
+			t.Fatalf("prec %d: Φ⁻¹(5e-324) = %g", prec, got)
+		}
+	}
+}
+
+func TestLogFull(t *testing.T) {
+	t.Parallel()
+
+	for _, x := range []float64{5e-324, 1e-310, 1e-300, 1e-20, 0.3, 0.7, 1, 1.4, 3, 1e10} {
+		want := math.Log(x)
+		if x < 0x1p-1022 {
+			want = math.Log(x*0x1p54) - 54*math.Ln2 // math.Log misses subnormals on amd64
+		}
+
+		if got := logFull(x); math.Abs(got-want) > 4e-16*max(1, math.Abs(want)) {
+			t.Fatalf("logFull(%g) = %.17g, want %.17g", x, got, want)
+		}
+	}
+}
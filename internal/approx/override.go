@@ -0,0 +1,31 @@
+package approx
+
+// Seeds are the innermost estimates that the Newton and Babylonian iterations
+// of Sqrt and InvSqrt refine. They are only called for positive, finite
+// inputs; the callers handle zero, negatives, NaN and infinities beforehand,
+// so a replacement cannot change edge-case semantics.
+//
+// A nil field selects the built-in bit-trick seed.
+type Seeds struct {
+	InvSqrt32 func(float32) float32
+	InvSqrt64 func(float64) float64
+	Sqrt32    func(float32) float32
+	Sqrt64    func(float64) float64
+}
+
+// seeds is read without synchronization on every call, so it must only be
+// replaced before concurrent use. In builds without the approxoverride tag it
+// is never read.
+var seeds Seeds //nolint:gochecknoglobals // replaceable kernel table
+
+// SetSeeds installs s and returns the previously installed seeds.
+func SetSeeds(s Seeds) Seeds {
+	prev := seeds
+	seeds = s
+
+	return prev
+}
+
+// OverridesEnabled reports whether the package was built with the
+// approxoverride tag, i.e. whether SetSeeds has any effect.
+func OverridesEnabled() bool { return overridesEnabled }
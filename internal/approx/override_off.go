@@ -0,0 +1,7 @@
+//go:build !approxoverride
+
+package approx
+
+// overridesEnabled is false in regular builds so the seed kernels are called
+// directly and stay inlinable.
+const overridesEnabled = false
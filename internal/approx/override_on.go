@@ -0,0 +1,7 @@
+//go:build approxoverride
+
+package approx
+
+// overridesEnabled routes the seed kernels through the replaceable function
+// variables in override.go.
+const overridesEnabled = true
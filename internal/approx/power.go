@@ -2,12 +2,28 @@ package approx
 
 import (
 	"math"
+
+	"github.com/meko-christian/algo-approx/dd"
 )
 
-// Power computes base^exponent using the exp/log composition.
-// This function uses the identity: base^exponent = exp(exponent * ln(base)).
-func Power[T Float](base, exponent T) T {
+// Power computes base^exponent using the exp/log composition at the
+// Balanced tier; see PowerPrec.
+func Power[T Float](base, exponent T) T { return PowerPrec(base, exponent, PrecisionBalanced) }
+
+// PowerPrec computes base^exponent = exp(exponent * ln(base)) at the given
+// tier.
+//
+// Fast and Balanced compose Log and Exp of the tier, so the relative error
+// grows with |exponent * ln(base)|: the absolute error of the log is
+// multiplied by the exponent. High carries ln(base) and the product in
+// double-double arithmetic and evaluates the exponential of the pair to
+// full precision, for a relative error of about 1e-15 over the whole range.
+func PowerPrec[T Float](base, exponent T, prec Precision) T {
 	// Handle special cases
+	if base != base || exponent != exponent { //nolint:gocritic
+		return T(math.NaN())
+	}
+
 	if base <= 0 {
 		// For negative bases with non-integer exponents, result is undefined
 		if base < 0 {
@@ -33,9 +49,70 @@ func Power[T Float](base, exponent T) T {
 		return base
 	}
 
+	prec = normalizePrecision(prec)
+
+	b, y := float64(base), float64(exponent)
+	if prec == PrecisionHigh && !math.IsInf(b, 0) && !math.IsInf(y, 0) {
+		return T(powFull(b, y))
+	}
+
 	// Use exp/log composition: base^exponent = exp(exponent * ln(base))
-	// Use balanced precision for both exp and log
-	return Exp(exponent*Log(base, PrecisionBalanced), PrecisionBalanced)
+	return Exp(exponent*Log(base, prec), prec)
+}
+
+// powFull returns b^y for positive finite b, including subnormals, and
+// finite y: y·ln(b) as a double-double, then its exponential.
+func powFull(b, y float64) float64 { return powFullDD(b, dd.From(y)) }
+
+// powFullDD is powFull for an exponent carried in double-double, such as the
+// 1/n of RootF, which float64 cannot hold exactly.
+func powFullDD(b float64, y dd.Float) float64 { return expDD(logDD(b).Mul(y)) }
+
+// logDD returns ln(x) for positive finite x in double-double, to about 100
+// bits: the atanh series of logFull, with its leading term 2(m-1)/(m+1) and
+// e·ln 2 carried in double-double and only the tail in float64.
+func logDD(x float64) dd.Float {
+	var k float64
+	if x < 0x1p-1022 {
+		x *= 0x1p54
+		k = -54
+	}
+
+	m, e := centeredDecompose(x)
+
+	// m-1 is exact; m+1 is split exactly.
+	den, denLo := dd.TwoSum(m, 1)
+	y := dd.From(m - 1).Div(dd.Float{Hi: den, Lo: denLo})
+	y2 := y.Hi * y.Hi
+
+	s := 1.0 / 21
+	for _, c := range [...]float64{1.0 / 19, 1.0 / 17, 1.0 / 15, 1.0 / 13, 1.0 / 11, 1.0 / 9, 1.0 / 7, 1.0 / 5, 1.0 / 3} {
+		s = s*y2 + c
+	}
+
+	return dd.Ln2.MulFloat(float64(e) + k).Add(y.MulFloat(2)).AddFloat(2 * y.Hi * y2 * s)
+}
+
+// expDD returns e^x, reducing x = k·ln 2 + r in double-double and evaluating
+// a Taylor polynomial of degree 13 on |r| <= 0.35, whose truncation is below
+// 2^-57.
+func expDD(x dd.Float) float64 {
+	switch {
+	case x.Hi > maxLogFloat64:
+		return math.Inf(1)
+	case x.Hi < minLogFloat64:
+		return 0
+	}
+
+	k := math.Round(x.Hi * invLn2)
+	r := x.Sub(dd.Ln2.MulFloat(k)).Float64()
+
+	p := 1.0
+	for i := 13; i >= 1; i-- {
+		p = 1 + r/float64(i)*p
+	}
+
+	return math.Ldexp(p, int(k))
 }
 
 // Root computes the nth root of value using Power.
@@ -68,6 +145,144 @@ func Root[T Float](value T, n int) T {
 	return Power(value, T(1)/T(n))
 }
 
+// RootF computes the nth root value^(1/n) for real n at the given tier.
+//
+// It uses PowerPrec, with the exponent 1/n carried in double-double at the
+// High tier so that the rounding of 1/n does not show; n = 2 uses Sqrt
+// below High. A negative value has a real root only for
+// odd integer n, which returns -RootF(-value, n); any other negative value,
+// n = 0 and NaN return NaN. 0 returns 0 for n > 0 and +Inf for n < 0.
+func RootF[T Float](value T, n float64, prec Precision) T {
+	switch {
+	case n == 0 || n != n || value != value: //nolint:gocritic
+		return T(math.NaN())
+	case value < 0:
+		if !isOddInt(n) {
+			return T(math.NaN())
+		}
+
+		return -RootF(-value, n, prec)
+	case n == 1:
+		return value
+	case value == 0:
+		if n < 0 {
+			return T(math.Inf(1))
+		}
+
+		return 0
+	}
+
+	prec = normalizePrecision(prec)
+
+	if n == 2 && prec != PrecisionHigh {
+		return Sqrt(value, prec)
+	}
+
+	v := float64(value)
+	if prec == PrecisionHigh && !math.IsInf(v, 0) && !math.IsInf(n, 0) {
+		return T(powFullDD(v, dd.From(1).Div(dd.From(n))))
+	}
+
+	return PowerPrec(value, T(1/n), prec)
+}
+
+// InvRoot computes value^(-1/n) for integer n at the given tier, such as
+// 1/∛x, in one pass instead of a root followed by a division.
+//
+// For 1 <= n <= invRootMaxN the binary exponent of value is split as
+// e = n·q + s, the reduced z = m·2^s in [1, 2^n) is seeded from its bits to
+// within 3.5% and refined by second-order Newton steps on r = 1 - z·y^n,
+//
+//	y += y·(r/n)·(1 + (1 + 1/n)·r/2),
+//
+// each cubing the relative error: 1 step for Fast (about 1e-3), 2 for
+// Balanced (1e-8) and 3 for High (a few ulps). The result is y·2^-q. Larger
+// n return RootF(value, -n), as does negative n.
+//
+// A negative value returns -InvRoot(-value, n) for odd n and NaN otherwise;
+// n = 0 and NaN return NaN, 0 returns +Inf and +Inf returns 0.
+func InvRoot[T Float](value T, n int, prec Precision) T {
+	v := float64(value)
+
+	switch {
+	case n < 0:
+		return RootF(value, float64(-n), prec)
+	case n == 0 || v != v: //nolint:gocritic
+		return T(math.NaN())
+	case v < 0:
+		if n%2 == 0 {
+			return T(math.NaN())
+		}
+
+		return -InvRoot(-value, n, prec)
+	case v == 0:
+		return T(math.Inf(1))
+	case math.IsInf(v, 1):
+		return 0
+	case n > invRootMaxN:
+		return RootF(value, float64(-n), prec)
+	}
+
+	return T(invRoot(v, n, normalizePrecision(prec)))
+}
+
+// invRootMaxN is the largest n InvRoot refines by Newton steps; beyond it the
+// seed is too far off for three steps to reach full precision.
+const invRootMaxN = 8
+
+// invRootSteps is the number of Newton steps of InvRoot per tier.
+//
+//nolint:gochecknoglobals // read-only table indexed by precision
+var invRootSteps = [...]int{
+	PrecisionAuto:     2,
+	PrecisionFast:     1,
+	PrecisionBalanced: 2,
+	PrecisionHigh:     3,
+}
+
+// invRootSeedBias lowers the seed of invRoot to balance the error of the
+// piecewise-linear log2 of the exponent field above and below.
+const invRootSeedBias = 0xF << 44
+
+// invRoot is InvRoot for positive finite x and 1 <= n <= invRootMaxN.
+func invRoot(x float64, n int, prec Precision) float64 {
+	m, e := math.Frexp(x) // subnormals included
+	m *= 2
+	e--
+
+	q := e / n
+	if e%n < 0 {
+		q--
+	}
+
+	z := math.Ldexp(m, e-q*n)
+
+	// The exponent field offset from 1.0 is a piecewise-linear log2, so
+	// dividing it by -n seeds z^(-1/n).
+	const one = int64(1023) << 52
+
+	y := math.Float64frombits(uint64(one - (int64(math.Float64bits(z))-one)/int64(n) - invRootSeedBias)) //nolint:gosec
+
+	inv := 1 / float64(n)
+	for range invRootSteps[prec] {
+		r := 1 - z*IntPower(y, n)
+		y += y * r * inv * (1 + (1+inv)*0.5*r)
+	}
+
+	return math.Ldexp(y, -q)
+}
+
+// isOddInt reports whether x is an odd integer.
+func isOddInt(x float64) bool {
+	if math.Abs(x) >= 1<<53 {
+		return false // every float64 of this size is even
+	}
+
+	_, frac := math.Modf(x / 2)
+
+	return frac != 0 && x == math.Trunc(x)
+}
+
 // IntPower computes base^exponent for integer exponents using binary exponentiation.
 // This is more efficient than the general Power function for integer exponents.
 func IntPower[T Float](base T, exponent int) T {
@@ -2,7 +2,10 @@ package approx
 
 import (
 	"math"
+	"math/big"
 	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
 )
 
 func TestPower32(t *testing.T) {
@@ -81,6 +84,232 @@ func TestPower64(t *testing.T) {
 	}
 }
 
+// powRef returns b^(num/2^shift) rounded to float64, from an exact-to-256-bit
+// integer power followed by shift square roots in math/big.
+func powRef(b float64, num int, shift uint) float64 {
+	x := new(big.Float).SetPrec(256).SetFloat64(b)
+	r := new(big.Float).SetPrec(256).SetInt64(1)
+
+	for n := max(num, -num); n > 0; n >>= 1 {
+		if n&1 == 1 {
+			r.Mul(r, x)
+		}
+
+		x.Mul(x, x)
+	}
+
+	for range shift {
+		r.Sqrt(r)
+	}
+
+	if num < 0 {
+		r.Quo(new(big.Float).SetPrec(256).SetInt64(1), r)
+	}
+
+	f, _ := r.Float64()
+
+	return f
+}
+
+// TestPowerPrecMatrix runs PowerPrec over a grid of bases and dyadic
+// exponents num/8, whose exact powers math/big can evaluate; math.Pow itself
+// is off by up to 1e-14 in this range. High stays within 1e-15 relative
+// where the result is normal. Fast and Balanced are bounded by the error of
+// Exp plus the exponent times the absolute error of Log.
+func TestPowerPrecMatrix(t *testing.T) {
+	t.Parallel()
+
+	bases := []float64{
+		0x1p-1060, 1e-300, 1e-10, 0.001, 0.1, 0.5, 0.9, 0.999, 1 - 0x1p-40, 1 + 0x1p-40,
+		1.001, 1.5, 2, 3, math.E, 10, 123.456, 1e10, 1e100, 1e300,
+	}
+	nums := []int{-5600, -801, -80, -24, -12, -8, -4, -3, -1, 1, 2, 3, 4, 7, 12, 16, 24, 57, 267, 800, 5600}
+
+	bounds := map[Precision]struct{ exp, log float64 }{
+		PrecisionFast:     {1e-3, 3e-3},
+		PrecisionBalanced: {5e-6, 2e-5},
+	}
+
+	for _, b := range bases {
+		for _, num := range nums {
+			want := powRef(b, num, 3)
+			if !(want >= 0x1p-1022 && want <= math.MaxFloat64) {
+				continue
+			}
+
+			y := float64(num) / 8
+
+			if got := PowerPrec(b, y, PrecisionHigh); !testutil.Close(got, want, 1e-15) {
+				t.Errorf("High: PowerPrec(%g, %g) = %.17g, want %.17g", b, y, got, want)
+			}
+
+			if b < 0x1p-1022 {
+				continue // the Log tiers are not accurate for subnormals
+			}
+
+			for prec, bd := range bounds {
+				tol := bd.exp + math.Abs(y)*bd.log
+				if tol > 0.1 {
+					continue
+				}
+
+				if got := PowerPrec(b, y, prec); !testutil.Close(got, want, tol) {
+					t.Errorf("%v: PowerPrec(%g, %g) = %.17g, want %.17g within %g", prec, b, y, got, want, tol)
+				}
+			}
+		}
+	}
+}
+
+func TestPowerPrecHighSpecial(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct{ base, exponent, want float64 }{
+		{3, 2, 9},
+		{2, -1074, math.SmallestNonzeroFloat64},
+		{2, 1024, math.Inf(1)},
+		{0.5, 1100, 0},
+		{0x1p-1060, -0.25, 0x1p265},
+		{math.Inf(1), 0.5, math.Inf(1)},
+		{math.Inf(1), -2, 0},
+		{4, math.Inf(1), math.Inf(1)},
+		{0.25, math.Inf(1), 0},
+		{1, 1e300, 1},
+	}
+
+	for _, c := range cases {
+		if got := PowerPrec(c.base, c.exponent, PrecisionHigh); got != c.want {
+			t.Errorf("PowerPrec(%g, %g) = %g, want %g", c.base, c.exponent, got, c.want)
+		}
+	}
+
+	if got := PowerPrec(math.NaN(), 2.0, PrecisionHigh); !math.IsNaN(got) {
+		t.Errorf("PowerPrec(NaN, 2) = %g", got)
+	}
+
+	// float32 rounds the float64 result.
+	if got := PowerPrec[float32](10, 0.5, PrecisionHigh); got != float32(math.Sqrt(10)) {
+		t.Errorf("PowerPrec[float32](10, 0.5) = %v", got)
+	}
+}
+
+// invRootErr returns the relative error of y as x^(-1/n), from the exact
+// x·y^n in math/big: y(1+ε) gives x·y^n = 1 + nε to first order.
+func invRootErr(x, y float64, n int) float64 {
+	p := new(big.Float).SetPrec(4096).SetFloat64(x)
+	for range n {
+		p.Mul(p, new(big.Float).SetFloat64(y))
+	}
+
+	r, _ := p.Sub(p, big.NewFloat(1)).Float64()
+
+	return math.Abs(r) / float64(n)
+}
+
+func TestInvRoot(t *testing.T) {
+	t.Parallel()
+
+	bounds := map[Precision]float64{PrecisionFast: 1e-3, PrecisionBalanced: 2e-8, PrecisionHigh: 5e-16}
+	powBounds := map[Precision]float64{PrecisionFast: 2e-3, PrecisionBalanced: 1e-5, PrecisionHigh: 1e-15}
+
+	for prec, tol := range bounds {
+		for n := 1; n <= invRootMaxN+2; n++ {
+			for x := 0x1p-1074; x < math.MaxFloat64/1.9; x *= 1.9 {
+				got := InvRoot(x, n, prec)
+				if math.IsInf(got, 1) && n == 1 && x < 1/math.MaxFloat64 {
+					continue
+				}
+
+				bound := tol
+				if n > invRootMaxN {
+					// PowerPrec: the error of Exp plus |ln x|/n times that of Log.
+					bound = powBounds[prec]
+					if prec != PrecisionHigh && x < 0x1p-1022 {
+						continue // the Log tiers are not accurate for subnormals
+					}
+				}
+
+				if e := invRootErr(x, got, n); e > bound {
+					t.Fatalf("%v: InvRoot(%g, %d) = %g, relative error %g", prec, x, n, got, e)
+				}
+			}
+		}
+	}
+}
+
+func TestInvRootSpecial(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		x    float64
+		n    int
+		want float64
+	}{
+		{8, 3, 0.5},
+		{-8, 3, -0.5},
+		{0, 3, math.Inf(1)},
+		{math.Inf(1), 2, 0},
+		{16, -4, 2},
+		{0.25, 2, 2},
+	}
+
+	for _, c := range cases {
+		if got := InvRoot(c.x, c.n, PrecisionHigh); got != c.want {
+			t.Errorf("InvRoot(%g, %d) = %g, want %g", c.x, c.n, got, c.want)
+		}
+	}
+
+	for _, c := range []struct {
+		x float64
+		n int
+	}{{-4, 2}, {2, 0}, {math.NaN(), 3}} {
+		if got := InvRoot(c.x, c.n, PrecisionHigh); !math.IsNaN(got) {
+			t.Errorf("InvRoot(%g, %d) = %g, want NaN", c.x, c.n, got)
+		}
+	}
+
+	if got := InvRoot[float32](27, 3, PrecisionBalanced); got != float32(1.0/3) {
+		t.Errorf("InvRoot[float32](27, 3) = %v", got)
+	}
+}
+
+func TestRootF(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct{ x, n, want float64 }{
+		{1024, 10, 2},
+		{-1024, 5, -4},
+		{9, 0.5, 81},
+		{1e-300, 3, 1e-100},
+		{27, -3, 1.0 / 3},
+		{2, 2, math.Sqrt2},
+		{0, 2.5, 0},
+		{0, -2.5, math.Inf(1)},
+	}
+
+	for _, c := range cases {
+		if got := RootF(c.x, c.n, PrecisionHigh); !testutil.Close(got, c.want, 1e-15) {
+			t.Errorf("RootF(%g, %g) = %.17g, want %.17g", c.x, c.n, got, c.want)
+		}
+	}
+
+	// 1/3 rounds in float64; carrying it in double-double keeps the root
+	// exact where x^fl(1/3) is off by ln(x)·2^-54/3.
+	if got := RootF(0x1p-1071, 3, PrecisionHigh); got != 0x1p-357 {
+		t.Errorf("RootF(2^-1071, 3) = %g, want 2^-357", got)
+	}
+
+	for _, c := range [][2]float64{{-8, 2}, {-8, 2.5}, {8, 0}, {math.NaN(), 3}, {8, math.NaN()}} {
+		if got := RootF(c[0], c[1], PrecisionHigh); !math.IsNaN(got) {
+			t.Errorf("RootF(%g, %g) = %g, want NaN", c[0], c[1], got)
+		}
+	}
+
+	if got := RootF(100.0, 2.5, PrecisionBalanced); !testutil.Close(got, math.Pow(100, 0.4), 1e-5) {
+		t.Errorf("RootF(100, 2.5, Balanced) = %g", got)
+	}
+}
+
 func TestRoot32(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,49 @@
+package approx
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+func TestPow10Table(t *testing.T) {
+	t.Parallel()
+
+	ten := big.NewInt(10)
+
+	for k := -330; k <= 315; k++ {
+		// strconv rounds correctly; out of range it returns 0 or +Inf
+		// together with an error.
+		want, _ := strconv.ParseFloat("1e"+strconv.Itoa(k), 64)
+		if got := Pow10(k); got != want {
+			t.Fatalf("Pow10(%d) = %g, want %g", k, got, want)
+		}
+
+		if k >= 0 && k <= 22 {
+			exact := new(big.Int).Exp(ten, big.NewInt(int64(k)), nil)
+			if got, _ := new(big.Float).SetFloat64(Pow10(k)).Int(nil); got.Cmp(exact) != 0 {
+				t.Fatalf("Pow10(%d) = %v is not exact", k, got)
+			}
+		}
+	}
+}
+
+func TestPow2(t *testing.T) {
+	t.Parallel()
+
+	for k := -1100; k <= 1100; k++ {
+		got := Pow2(k)
+		if want := math.Ldexp(1, k); got != want {
+			t.Fatalf("Pow2(%d) = %g, want %g", k, got, want)
+		}
+
+		if k < -1074 || k > 1023 {
+			continue
+		}
+
+		if mant := new(big.Float); new(big.Float).SetFloat64(got).MantExp(mant) != k+1 || mant.Cmp(big.NewFloat(0.5)) != 0 {
+			t.Fatalf("Pow2(%d) = %g is not exactly 2^%d", k, got, k)
+		}
+	}
+}
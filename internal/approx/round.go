@@ -0,0 +1,40 @@
+package approx
+
+import "math"
+
+// RoundHalfEven rounds x to the nearest integer, ties to even, without a
+// call into math.RoundToEven.
+//
+// Adding and subtracting 1.5·2^52 forces the FPU to discard the fraction bits
+// under the default round-to-nearest-even mode. Values with |x| >= 2^51 are
+// already integers (or Inf/NaN) and are returned unchanged.
+func RoundHalfEven(x float64) float64 {
+	if math.Abs(x) >= 1<<51 || x != x { //nolint:gocritic
+		return x
+	}
+
+	return (x + roundMagic) - roundMagic
+}
+
+// DecimalExponent returns floor(log10(|x|)) for finite non-zero x.
+//
+// The estimate comes from the binary exponent (log10(2) ≈ 0.30103) and is
+// corrected by at most one step against Pow10, so no logarithm is evaluated.
+func DecimalExponent(x float64) int {
+	ax := math.Abs(x)
+	_, e2 := math.Frexp(ax)
+	e10 := int(math.Floor(float64(e2-1) * log10Of2))
+
+	if ax >= Pow10(e10+1) {
+		e10++
+	} else if ax < Pow10(e10) {
+		e10--
+	}
+
+	return e10
+}
+
+const (
+	roundMagic = 1.5 * (1 << 52)
+	log10Of2   = 0.301029995663981195213738894724493027
+)
@@ -0,0 +1,36 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRoundHalfEvenMatchesMath(t *testing.T) {
+	t.Parallel()
+
+	cases := []float64{
+		0, 0.4, 0.5, 1.5, 2.5, -0.5, -1.5, -2.5, 3.49999, -7.7, 1e15 + 0.5, 1 << 53, math.Inf(1), math.Inf(-1),
+	}
+	for _, x := range cases {
+		if got, want := RoundHalfEven(x), math.RoundToEven(x); got != want {
+			t.Fatalf("RoundHalfEven(%g) got %g want %g", x, got, want)
+		}
+	}
+
+	if !math.IsNaN(RoundHalfEven(math.NaN())) {
+		t.Fatalf("expected NaN for NaN")
+	}
+}
+
+func TestDecimalExponent(t *testing.T) {
+	t.Parallel()
+
+	for k := -300; k <= 300; k++ {
+		p := Pow10(k)
+		for _, x := range []float64{p, p * 1.0000001, p * 9.99999, -p * 3} {
+			if got := DecimalExponent(x); got != k {
+				t.Fatalf("DecimalExponent(%g) got %d want %d", x, got, k)
+			}
+		}
+	}
+}
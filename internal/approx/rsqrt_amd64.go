@@ -0,0 +1,12 @@
+//go:build amd64
+
+package approx
+
+// hwRsqrtAvailable reports that rsqrtEstimate is a hardware instruction.
+// RSQRTSS is part of SSE, which every amd64 CPU implements.
+const hwRsqrtAvailable = true
+
+// rsqrtEstimate returns the RSQRTSS estimate of 1/√x, with a relative error
+// of at most 1.5·2⁻¹² for normal, positive x. The estimate tables differ
+// between CPU vendors, so the result is not bit-reproducible across machines.
+func rsqrtEstimate(x float32) float32
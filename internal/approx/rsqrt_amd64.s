@@ -0,0 +1,8 @@
+#include "textflag.h"
+
+// func rsqrtEstimate(x float32) float32
+TEXT ·rsqrtEstimate(SB), NOSPLIT, $0-12
+	MOVSS   x+0(FP), X0
+	RSQRTSS X0, X0
+	MOVSS   X0, ret+8(FP)
+	RET
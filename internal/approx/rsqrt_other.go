@@ -0,0 +1,10 @@
+//go:build !amd64
+
+package approx
+
+// hwRsqrtAvailable is false where no hardware estimate is wired up; the
+// bit-trick seeds are used instead.
+const hwRsqrtAvailable = false
+
+// rsqrtEstimate is never called when hwRsqrtAvailable is false.
+func rsqrtEstimate(x float32) float32 { return invSqrtQuake(x) }
@@ -0,0 +1,108 @@
+package approx
+
+import (
+	"math"
+	"sync/atomic"
+)
+
+// hwSeed selects the hardware reciprocal-square-root estimate as the seed of
+// Sqrt and InvSqrt. It is off by default: the estimate differs between CPU
+// vendors, and deterministic, bit-reproducible results are the default.
+var hwSeed atomic.Bool //nolint:gochecknoglobals
+
+// SetDeterministic turns deterministic mode on or off and returns the
+// previous setting. Turning it off enables the hardware seed where
+// available.
+func SetDeterministic(on bool) bool { return !hwSeed.Swap(!on) }
+
+// Deterministic reports whether deterministic mode is on.
+func Deterministic() bool { return !hwSeed.Load() }
+
+// HardwareSeed reports whether Sqrt and InvSqrt currently start from the
+// hardware reciprocal-square-root estimate.
+func HardwareSeed() bool { return hwRsqrtAvailable && hwSeed.Load() }
+
+// portableSeed reports whether the scalar kernels start from the built-in
+// bit-trick seeds, which the lane kernels in invsqrtn.go replicate.
+func portableSeed() bool {
+	if overridesEnabled && (seeds.InvSqrt32 != nil || seeds.InvSqrt64 != nil) {
+		return false
+	}
+
+	return !HardwareSeed()
+}
+
+// portableSqrtSeed is portableSeed for the Sqrt seeds.
+func portableSqrtSeed() bool {
+	if overridesEnabled && (seeds.Sqrt32 != nil || seeds.Sqrt64 != nil) {
+		return false
+	}
+
+	return !HardwareSeed()
+}
+
+// hwSeedInput reports whether x is in the range where the float32 hardware
+// estimate is valid: positive and normal as a float32. Denormal inputs would
+// be estimated as zero.
+func hwSeedInput(x float64) bool {
+	return x >= 0x1p-126 && x <= math.MaxFloat32
+}
+
+// invSqrtSeed returns the starting estimate of 1/√x for positive, finite x
+// and the number of Newton iterations still needed to reach the accuracy of
+// iters iterations from the bit-trick seed. The hardware estimate is about
+// 75 times closer than the bit trick, worth one iteration.
+func invSqrtSeed[T Float](x T, iters int) (T, int) {
+	if overridesEnabled {
+		if y, ok := invSqrtOverride(x); ok {
+			return y, iters
+		}
+	}
+
+	if HardwareSeed() && hwSeedInput(float64(x)) {
+		return T(rsqrtEstimate(float32(x))), iters - 1
+	}
+
+	return invSqrtQuake(x), iters
+}
+
+// sqrtSeed is invSqrtSeed for √x: the hardware path uses x·rsqrt(x).
+func sqrtSeed[T Float](x T, iters int) (T, int) {
+	if overridesEnabled {
+		if y, ok := sqrtOverride(x); ok {
+			return y, iters
+		}
+	}
+
+	if HardwareSeed() && hwSeedInput(float64(x)) {
+		return x * T(rsqrtEstimate(float32(x))), iters - 1
+	}
+
+	return sqrtInitialGuess(x), iters
+}
+
+func invSqrtOverride[T Float](x T) (T, bool) {
+	var zero T
+	if _, ok := any(zero).(float32); ok {
+		if seeds.InvSqrt32 != nil {
+			return T(seeds.InvSqrt32(float32(x))), true
+		}
+	} else if seeds.InvSqrt64 != nil {
+		return T(seeds.InvSqrt64(float64(x))), true
+	}
+
+	return 0, false
+}
+
+func sqrtOverride[T Float](x T) (T, bool) {
+	var zero T
+	if _, ok := any(zero).(float32); ok {
+		if seeds.Sqrt32 != nil {
+			return T(seeds.Sqrt32(float32(x))), true
+		}
+	} else if seeds.Sqrt64 != nil {
+		return T(seeds.Sqrt64(float64(x))), true
+	}
+
+	return 0, false
+}
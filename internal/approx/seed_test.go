@@ -0,0 +1,116 @@
+package approx
+
+import (
+	"math"
+	"runtime"
+	"testing"
+)
+
+// withHardwareSeed runs f with deterministic mode off.
+func withHardwareSeed(t *testing.T, f func()) {
+	t.Helper()
+
+	prev := SetDeterministic(false)
+	defer SetDeterministic(prev)
+
+	f()
+}
+
+//nolint:paralleltest // toggles the global seed mode
+func TestHardwareSeedAvailability(t *testing.T) {
+	if !Deterministic() || HardwareSeed() {
+		t.Fatalf("deterministic mode must be the default")
+	}
+
+	withHardwareSeed(t, func() {
+		if HardwareSeed() != (runtime.GOARCH == "amd64") {
+			t.Fatalf("HardwareSeed() = %v on %s", HardwareSeed(), runtime.GOARCH)
+		}
+	})
+}
+
+//nolint:paralleltest // toggles the global seed mode
+func TestHardwareSeedAccuracy(t *testing.T) {
+	if !hwRsqrtAvailable {
+		t.Skip("no hardware estimate on " + runtime.GOARCH)
+	}
+
+	// Worst relative error per tier with the hardware seed and one iteration
+	// fewer; each must beat the bit-trick seed with the full count.
+	limits := map[Precision][2]float64{
+		PrecisionFast:     {4e-4, 4e-4},
+		PrecisionBalanced: {3e-7, 1e-7},
+		PrecisionHigh:     {1e-13, 1e-14},
+	}
+
+	withHardwareSeed(t, func() {
+		for prec, lim := range limits {
+			var worstInv, worstSqrt float64
+
+			for i := range 20001 {
+				x := math.Pow(10, -30+60*float64(i)/20000)
+
+				inv := InvSqrt(x, prec)
+				worstInv = max(worstInv, math.Abs(inv*math.Sqrt(x)-1))
+
+				sq := Sqrt(x, prec)
+				worstSqrt = max(worstSqrt, math.Abs(sq/math.Sqrt(x)-1))
+			}
+
+			if worstInv > lim[0] || worstSqrt > lim[1] {
+				t.Errorf("%v: InvSqrt %.3g, Sqrt %.3g exceed %v", prec, worstInv, worstSqrt, lim)
+			}
+		}
+	})
+}
+
+//nolint:paralleltest // toggles the global seed mode
+func TestHardwareSeedEdgeCasesAndLanes(t *testing.T) {
+	withHardwareSeed(t, func() {
+		inf := math.Inf(1)
+
+		for _, tc := range []struct{ x, sqrt, inv float64 }{
+			{0, 0, inf},
+			{inf, inf, 0},
+			{-4, math.NaN(), math.NaN()},
+		} {
+			for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+				if got := Sqrt(tc.x, prec); got != tc.sqrt && !(math.IsNaN(got) && math.IsNaN(tc.sqrt)) {
+					t.Fatalf("Sqrt(%g) = %g", tc.x, got)
+				}
+
+				if got := InvSqrt(tc.x, prec); got != tc.inv && !(math.IsNaN(got) && math.IsNaN(tc.inv)) {
+					t.Fatalf("InvSqrt(%g) = %g", tc.x, got)
+				}
+			}
+		}
+
+		// Outside the float32 normal range the bit-trick seed still applies.
+		for _, x := range []float64{1e-300, 1e300, 0x1p-140} {
+			if got, want := InvSqrt(x, PrecisionHigh), 1/math.Sqrt(x); math.Abs(got/want-1) > 1e-9 {
+				t.Fatalf("InvSqrt(%g) = %g, want %g", x, got, want)
+			}
+		}
+
+		x4 := [4]float32{0.3, 2, 0, 1e-40}
+		got4 := InvSqrt4(x4, PrecisionFast)
+
+		for i, v := range x4 {
+			if want := InvSqrt(v, PrecisionFast); got4[i] != want {
+				t.Fatalf("InvSqrt4 lane %d = %g, want %g", i, got4[i], want)
+			}
+		}
+	})
+}
+
+//nolint:paralleltest // reads the global seed mode
+func TestDeterministicSeedIsBitTrick(t *testing.T) {
+	const x = 3.0
+
+	y := math.Float64frombits(0x5fe6eb50c7b537a9 - (math.Float64bits(x) >> 1))
+	y *= 1.5 - 0.5*x*y*y
+
+	if got := InvSqrt(x, PrecisionFast); got != y {
+		t.Fatalf("deterministic InvSqrt(3) = %v, want bit-trick result %v", got, y)
+	}
+}
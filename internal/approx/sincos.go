@@ -0,0 +1,37 @@
+package approx
+
+import "math"
+
+// SinCos computes sine and cosine together with the requested precision
+// level, sharing a single range reduction.
+//
+// x is reduced to r in [-π, π], then to y = r - q·π/2 with |y| <= π/4 and a
+// quadrant q, so both series run on the short interval where they converge
+// fastest. Term counts are Fast=3, Balanced=5, High=7.
+func SinCos[T Float](x T, prec Precision) (sin, cos T) {
+	xf := float64(x)
+	if math.IsNaN(xf) || math.IsInf(xf, 0) {
+		nan := T(math.NaN())
+		return nan, nan
+	}
+
+	const twoPi = 2 * math.Pi
+
+	r := xf - twoPi*math.Round(xf*(1/twoPi))
+	q := math.Round(r * (2 / math.Pi))
+	y := r - q*(math.Pi/2)
+
+	prec = normalizePrecision(prec)
+	s, c := sinPoly(y, prec), cosPoly(y, prec)
+
+	switch int(q) & 3 {
+	case 1:
+		s, c = c, -s
+	case 2:
+		s, c = -s, -c
+	case 3:
+		s, c = -c, s
+	}
+
+	return T(s), T(c)
+}
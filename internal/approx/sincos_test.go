@@ -0,0 +1,39 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSinCosAgainstMath_Float64(t *testing.T) {
+	t.Parallel()
+
+	tolerances := map[Precision]float64{
+		PrecisionFast:     5e-4,
+		PrecisionBalanced: 5e-8,
+		PrecisionHigh:     1e-12,
+	}
+
+	for prec, tol := range tolerances {
+		for i := -500; i <= 500; i++ {
+			x := float64(i) * 0.0377
+			s, c := SinCos(x, prec)
+
+			if math.Abs(s-math.Sin(x)) > tol || math.Abs(c-math.Cos(x)) > tol {
+				t.Fatalf("sincos(%g) at %v got (%g, %g) ref (%g, %g)", x, prec, s, c, math.Sin(x), math.Cos(x))
+			}
+		}
+	}
+}
+
+func TestSinCosEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	if s, c := SinCos[float64](0, PrecisionBalanced); s != 0 || c != 1 {
+		t.Fatalf("sincos(0) got (%g, %g)", s, c)
+	}
+
+	if s, c := SinCos(math.Inf(1), PrecisionBalanced); !math.IsNaN(s) || !math.IsNaN(c) {
+		t.Fatalf("expected NaN for +Inf")
+	}
+}
@@ -5,13 +5,37 @@ import (
 )
 
 func Sqrt[T Float](x T, prec Precision) T {
-	impl := selectImpl(sqrtFast[T], sqrtBalanced[T], sqrtHigh[T], prec)
-	return impl(x)
+	if prec == PrecisionFast && sqrtFastDomain(x) {
+		switch v := any(x).(type) {
+		case float32:
+			return T(sqrtFast32(v))
+		case float64:
+			return T(sqrtFast64(v))
+		}
+	}
+
+	return sqrtBabylonian(x, newtonIters(prec))
 }
 
-func sqrtFast[T Float](x T) T     { return sqrtBabylonian(x, 1) }
-func sqrtBalanced[T Float](x T) T { return sqrtBabylonian(x, 2) }
-func sqrtHigh[T Float](x T) T     { return sqrtBabylonian(x, 3) }
+// sqrtFastDomain reports whether sqrtFast64/32 apply to x: positive, finite,
+// with the bit-trick seed in use. It is false for NaN.
+func sqrtFastDomain[T Float](x T) bool {
+	return x > 0 && float64(x) <= math.MaxFloat64 && portableSqrtSeed()
+}
+
+// sqrtFast64 is sqrtBabylonian(x, 1) on sqrtFastDomain in inlinable form.
+func sqrtFast64(x float64) float64 {
+	y := math.Float64frombits((math.Float64bits(x) >> 1) + 0x1ff8000000000000)
+
+	return 0.5 * (y + x/y)
+}
+
+// sqrtFast32 is the float32 form of sqrtFast64.
+func sqrtFast32(x float32) float32 {
+	y := math.Float32frombits((math.Float32bits(x) >> 1) + 0x1fc00000)
+
+	return 0.5 * (y + x/y)
+}
 
 //nolint:varnamelen
 func sqrtBabylonian[T Float](x T, iterations int) T {
@@ -33,7 +57,7 @@ func sqrtBabylonian[T Float](x T, iterations int) T {
 		return x
 	}
 
-	y := sqrtInitialGuess(x)
+	y, iterations := sqrtSeed(x, iterations)
 	if y == 0 {
 		// Fallback, should be rare.
 		y = x
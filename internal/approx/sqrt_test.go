@@ -3,6 +3,8 @@ package approx
 import (
 	"math"
 	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
 )
 
 func TestSqrtAgainstMath_Float64(t *testing.T) {
@@ -13,7 +15,7 @@ func TestSqrtAgainstMath_Float64(t *testing.T) {
 		got := Sqrt[float64](x, PrecisionBalanced)
 
 		ref := math.Sqrt(x)
-		if !closeRel(got, ref, 5e-4) {
+		if !testutil.Close(got, ref, 5e-4) {
 			t.Fatalf("sqrt(%g) got %g ref %g", x, got, ref)
 		}
 	}
@@ -30,14 +32,3 @@ func TestSqrtEdgeCases(t *testing.T) {
 		t.Fatalf("expected 0 for zero")
 	}
 }
-
-func closeRel(got, ref, tol float64) bool {
-	dval := math.Abs(got - ref)
-
-	den := math.Abs(ref)
-	if den == 0 {
-		return dval <= tol
-	}
-
-	return dval/den <= tol
-}
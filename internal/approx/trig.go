@@ -73,6 +73,44 @@ func cos3Term[T Float](x T) T {
 	return T(result)
 }
 
+// trigFastDomain reports whether sinFast and cosFast apply to x: |x| <= π,
+// where the math.Mod reduction of the term kernels is the identity. It is
+// false for NaN.
+func trigFastDomain(x float64) bool { return x >= -math.Pi && x <= math.Pi }
+
+// sinFast is sin3Term on trigFastDomain in inlinable form, skipping the
+// math.Mod call. It matches sin3Term bit for bit there.
+func sinFast(x float64) float64 {
+	if x > math.Pi/2 {
+		x = math.Pi - x
+	} else if x < -math.Pi/2 {
+		x = -math.Pi - x
+	}
+
+	x2 := x * x
+	x3 := x * x2
+
+	return x - x3/6.0 + x3*x2/120.0
+}
+
+// cosFast is cos3Term on trigFastDomain in inlinable form, with the same
+// folding steps so results match bit for bit.
+func cosFast(x float64) float64 {
+	const twoPi = 2 * math.Pi
+
+	if x < 0 {
+		x += twoPi
+	}
+
+	if x > math.Pi {
+		x = twoPi - x
+	}
+
+	x2 := x * x
+
+	return 1.0 - x2/2.0 + x2*x2/24.0
+}
+
 // sec3Term computes secant (1/cos) using the 3-term cosine approximation.
 // sec(x) = 1 / cos(x)
 // Expected accuracy: ~3.2 decimal digits for |x| < π/2.
@@ -414,6 +452,10 @@ func csc7Term[T Float](x T) T {
 // Sin computes sine with the requested precision level.
 // Maps precision to term count: Fast=3, Balanced=5, High=7.
 func Sin[T Float](x T, prec Precision) T {
+	if xf := float64(x); prec == PrecisionFast && trigFastDomain(xf) {
+		return T(sinFast(xf))
+	}
+
 	switch prec {
 	case PrecisionAuto, PrecisionBalanced:
 		return sin5Term(x)
@@ -429,6 +471,10 @@ func Sin[T Float](x T, prec Precision) T {
 // Cos computes cosine with the requested precision level.
 // Maps precision to term count: Fast=3, Balanced=5, High=7.
 func Cos[T Float](x T, prec Precision) T {
+	if xf := float64(x); prec == PrecisionFast && trigFastDomain(xf) {
+		return T(cosFast(xf))
+	}
+
 	switch prec {
 	case PrecisionAuto, PrecisionBalanced:
 		return cos5Term(x)
@@ -0,0 +1,119 @@
+package approx
+
+import "math"
+
+// SinPi computes sin(πx) with the requested precision level.
+//
+// The argument is reduced exactly: x - 2*round(x/2) involves no rounding, so
+// unlike Sin there is no error from multiplying by an inexact π before the
+// reduction. The reduced angle is folded to |θ| <= π/4 before the series is
+// evaluated, with term counts Fast=3, Balanced=5, High=7.
+func SinPi[T Float](x T, prec Precision) T {
+	xf := float64(x)
+	if math.IsNaN(xf) || math.IsInf(xf, 0) {
+		return T(math.NaN())
+	}
+
+	// r in [-1, 1], exact.
+	r := xf - 2*math.Round(0.5*xf)
+
+	// sin(π(1-r)) = sin(πr) folds r into [-1/2, 1/2].
+	if r > 0.5 {
+		r = 1 - r
+	} else if r < -0.5 {
+		r = -1 - r
+	}
+
+	prec = normalizePrecision(prec)
+
+	ar := math.Abs(r)
+	if ar <= 0.25 {
+		return T(sinPoly(math.Pi*r, prec))
+	}
+
+	// sin(πr) = ±cos(π(1/2-|r|)) for |r| in (1/4, 1/2].
+	return T(math.Copysign(cosPoly(math.Pi*(0.5-ar), prec), r))
+}
+
+// CosPi computes cos(πx) with the requested precision level, using the same
+// exact reduction as SinPi.
+func CosPi[T Float](x T, prec Precision) T {
+	xf := float64(x)
+	if math.IsNaN(xf) || math.IsInf(xf, 0) {
+		return T(math.NaN())
+	}
+
+	// |r| in [0, 1], exact; cos is even.
+	r := math.Abs(xf - 2*math.Round(0.5*xf))
+
+	// cos(πr) = -cos(π(1-r)) folds r into [0, 1/2].
+	sign := 1.0
+	if r > 0.5 {
+		r = 1 - r
+		sign = -1
+	}
+
+	prec = normalizePrecision(prec)
+
+	if r <= 0.25 {
+		return T(sign * cosPoly(math.Pi*r, prec))
+	}
+
+	// cos(πr) = sin(π(1/2-r)) for r in (1/4, 1/2].
+	return T(sign * sinPoly(math.Pi*(0.5-r), prec))
+}
+
+// sinPoly evaluates the truncated sine Taylor series for |x| <= π/4.
+//
+//nolint:varnamelen
+func sinPoly(x float64, prec Precision) float64 {
+	x2 := x * x
+
+	switch prec {
+	case PrecisionFast:
+		// x - x³/3! + x⁵/5!
+		return x * (1 + x2*(-1.0/6.0+x2*(1.0/120.0)))
+	case PrecisionHigh:
+		// up to x¹³/13!
+		return x * (1 + x2*(-1.0/6.0+x2*(1.0/120.0+x2*(-1.0/5040.0+x2*(1.0/362880.0+
+			x2*(-1.0/39916800.0+x2*(1.0/6227020800.0)))))))
+	default:
+		// up to x⁹/9!
+		return x * (1 + x2*(-1.0/6.0+x2*(1.0/120.0+x2*(-1.0/5040.0+x2*(1.0/362880.0)))))
+	}
+}
+
+// cosPoly evaluates the truncated cosine Taylor series for |x| <= π/4.
+//
+//nolint:varnamelen
+func cosPoly(x float64, prec Precision) float64 {
+	x2 := x * x
+
+	switch prec {
+	case PrecisionFast:
+		// 1 - x²/2! + x⁴/4!
+		return 1 + x2*(-0.5+x2*(1.0/24.0))
+	case PrecisionHigh:
+		// up to x¹²/12!
+		return 1 + x2*(-0.5+x2*(1.0/24.0+x2*(-1.0/720.0+x2*(1.0/40320.0+
+			x2*(-1.0/3628800.0+x2*(1.0/479001600.0))))))
+	default:
+		// up to x⁸/8!
+		return 1 + x2*(-0.5+x2*(1.0/24.0+x2*(-1.0/720.0+x2*(1.0/40320.0))))
+	}
+}
+
+// SinTurns computes sin(2πx) for an angle x in full revolutions, reducing by
+// the exact fractional part x - round(x) before deferring to SinPi.
+func SinTurns[T Float](x T, prec Precision) T {
+	xf := float64(x)
+
+	return T(SinPi(2*(xf-math.Round(xf)), prec))
+}
+
+// CosTurns computes cos(2πx) for an angle x in full revolutions.
+func CosTurns[T Float](x T, prec Precision) T {
+	xf := float64(x)
+
+	return T(CosPi(2*(xf-math.Round(xf)), prec))
+}
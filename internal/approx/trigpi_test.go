@@ -0,0 +1,95 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSinPiCosPiAgainstMath_Float64(t *testing.T) {
+	t.Parallel()
+
+	tolerances := map[Precision]float64{
+		PrecisionFast:     5e-4,
+		PrecisionBalanced: 5e-8,
+		PrecisionHigh:     5e-13,
+	}
+
+	for prec, tol := range tolerances {
+		for i := -400; i <= 400; i++ {
+			x := float64(i) * 0.01237
+
+			if got, ref := SinPi(x, prec), math.Sin(math.Pi*x); math.Abs(got-ref) > tol {
+				t.Fatalf("sinpi(%g) at %v got %g ref %g", x, prec, got, ref)
+			}
+
+			if got, ref := CosPi(x, prec), math.Cos(math.Pi*x); math.Abs(got-ref) > tol {
+				t.Fatalf("cospi(%g) at %v got %g ref %g", x, prec, got, ref)
+			}
+		}
+	}
+}
+
+func TestSinPiExactValues(t *testing.T) {
+	t.Parallel()
+
+	// Integers and half-integers are hit exactly, even for huge arguments
+	// where sin(math.Pi*x) is pure rounding noise.
+	for _, x := range []float64{0, 1, -1, 2, 1e15, -7, 1 << 60} {
+		if got := SinPi(x, PrecisionHigh); got != 0 {
+			t.Fatalf("sinpi(%g) got %g, want 0", x, got)
+		}
+	}
+
+	cases := map[float64]float64{0.5: 1, -0.5: -1, 2.5: 1, 1e15 + 0.5: 1, 1.5: -1}
+	for x, want := range cases {
+		if got := SinPi(x, PrecisionHigh); got != want {
+			t.Fatalf("sinpi(%g) got %g, want %g", x, got, want)
+		}
+	}
+
+	if got := CosPi(1e15+1, PrecisionFast); got != -1 {
+		t.Fatalf("cospi(1e15+1) got %g, want -1", got)
+	}
+
+	if got := CosPi(0.5, PrecisionBalanced); got != 0 {
+		t.Fatalf("cospi(0.5) got %g, want 0", got)
+	}
+}
+
+func TestSinPiEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	for _, x := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if !math.IsNaN(SinPi(x, PrecisionBalanced)) || !math.IsNaN(CosPi(x, PrecisionBalanced)) {
+			t.Fatalf("expected NaN for %g", x)
+		}
+	}
+}
+
+func TestSinCosTurns(t *testing.T) {
+	t.Parallel()
+
+	for i := -200; i <= 200; i++ {
+		x := float64(i) * 0.0173
+
+		if got, ref := SinTurns(x, PrecisionBalanced), math.Sin(2*math.Pi*x); math.Abs(got-ref) > 5e-8 {
+			t.Fatalf("sinturns(%g) got %g ref %g", x, got, ref)
+		}
+
+		if got, ref := CosTurns(x, PrecisionBalanced), math.Cos(2*math.Pi*x); math.Abs(got-ref) > 5e-8 {
+			t.Fatalf("costurns(%g) got %g ref %g", x, got, ref)
+		}
+	}
+
+	// Quarter turns are exact, including for float32 encoder counts.
+	cases := map[float32][2]float32{0.25: {1, 0}, 0.5: {0, -1}, -0.25: {-1, 0}, 4096.75: {-1, 0}}
+	for x, want := range cases {
+		if s, c := SinTurns(x, PrecisionFast), CosTurns(x, PrecisionFast); s != want[0] || c != want[1] {
+			t.Fatalf("turns(%g) got (%g, %g), want %v", x, s, c, want)
+		}
+	}
+
+	if !math.IsNaN(SinTurns(math.Inf(1), PrecisionHigh)) {
+		t.Fatalf("expected NaN for +Inf")
+	}
+}
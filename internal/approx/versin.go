@@ -0,0 +1,38 @@
+package approx
+
+import "math"
+
+// Hav computes the haversine sin²(x/2) = (1 - cos x)/2.
+//
+// After reducing x to r in [-π, π], it squares the sine series at r/2 for
+// |r| <= π/2, where 1 - cos r would cancel: the result keeps its relative
+// accuracy down to the smallest x, including where cos x rounds to 1.
+// Beyond, cos r <= 0 and (1 - cos r)/2 is exact apart from cos r itself.
+func Hav[T Float](x T, prec Precision) T {
+	xf := float64(x)
+	if math.IsNaN(xf) || math.IsInf(xf, 0) {
+		return T(math.NaN())
+	}
+
+	const twoPi = 2 * math.Pi
+
+	prec = normalizePrecision(prec)
+	r := xf - twoPi*math.Round(xf*(1/twoPi))
+
+	if math.Abs(r) <= math.Pi/2 {
+		s := sinPoly(0.5*r, prec)
+
+		return T(s * s)
+	}
+
+	// |r - sign(r)·π/2| <= π/2 and cos r = -sin(|r| - π/2).
+	y := math.Abs(r) - math.Pi/2
+	if y <= math.Pi/4 {
+		return T(0.5 * (1 + sinPoly(y, prec)))
+	}
+
+	return T(0.5 * (1 + cosPoly(math.Pi/2-y, prec)))
+}
+
+// Versin computes the versine 1 - cos x = 2·sin²(x/2); see Hav.
+func Versin[T Float](x T, prec Precision) T { return 2 * Hav(x, prec) }
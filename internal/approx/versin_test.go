@@ -0,0 +1,61 @@
+package approx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+func TestHavAgainstMath_Float64(t *testing.T) {
+	t.Parallel()
+
+	tols := map[Precision]float64{PrecisionFast: 2e-4, PrecisionBalanced: 2e-8, PrecisionHigh: 1e-12}
+
+	for prec, tol := range tols {
+		for x := -20.0; x <= 20; x += 0.01 {
+			ref := 0.5 * (1 - math.Cos(x))
+			if got := Hav(x, prec); math.Abs(got-ref) > tol {
+				t.Fatalf("%v: hav(%g) got %g ref %g", prec, x, got, ref)
+			}
+		}
+	}
+}
+
+// TestHavSmallAngles checks the relative error where 1 - cos x cancels.
+func TestHavSmallAngles(t *testing.T) {
+	t.Parallel()
+
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		for k := 4; k <= 500; k += 4 {
+			x := math.Ldexp(1, -k)
+			ref := math.Pow(math.Sin(x/2), 2)
+
+			if got := Hav(x, prec); !testutil.Close(got, ref, 1e-12) {
+				t.Fatalf("%v: hav(2^-%d) got %.17g ref %.17g", prec, k, got, ref)
+			}
+
+			if got := Versin(-x, prec); !testutil.Close(got, 2*ref, 1e-12) {
+				t.Fatalf("%v: versin(-2^-%d) got %.17g ref %.17g", prec, k, got, 2*ref)
+			}
+		}
+	}
+}
+
+func TestHavEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	for _, x := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if got := Hav(x, PrecisionBalanced); !math.IsNaN(got) {
+			t.Fatalf("hav(%g) got %g, want NaN", x, got)
+		}
+	}
+
+	if got := Hav(math.Copysign(0, -1), PrecisionBalanced); got != 0 {
+		t.Fatalf("hav(-0) got %g, want 0", got)
+	}
+
+	if got := Versin(math.Pi, PrecisionHigh); math.Abs(got-2) > 1e-15 {
+		t.Fatalf("versin(π) got %.17g, want 2", got)
+	}
+}
@@ -0,0 +1,244 @@
+// Package fuzzcorpus reads and writes the seed corpus files of Go fuzz
+// targets, testdata/fuzz/<FuzzName>/<file> in the "go test fuzz v1"
+// encoding, for corpora holding a single float64 per entry.
+//
+// The accuracy generator uses it to export the worst-error inputs of every
+// tier into the corpus of the matching fuzz target, where go test runs them
+// as seeds before any random input, so a regression in the hardest region
+// of a kernel is the first thing a fuzz run or a plain test run sees.
+// Generated entries carry the Prefix in their name; Sync replaces them and
+// leaves entries found by fuzzing alone.
+package fuzzcorpus
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Prefix starts the name of every generated corpus entry.
+const Prefix = "accuracy-"
+
+const header = "go test fuzz v1\n"
+
+// ErrFormat reports a corpus file that is not a single float64 entry.
+var ErrFormat = errors.New("fuzzcorpus: not a float64 corpus entry")
+
+// Dir is the corpus directory of the fuzz target name under the package
+// directory pkg.
+func Dir(pkg, name string) string { return filepath.Join(pkg, "testdata", "fuzz", name) }
+
+// Encode returns the corpus file holding x, as go test writes it: the
+// default NaN and all other values in float64(...) form, other NaNs by
+// their bits.
+func Encode(x float64) []byte {
+	if x != x && math.Float64bits(x) != math.Float64bits(math.NaN()) { //nolint:gocritic
+		return fmt.Appendf([]byte(header), "math.Float64frombits(0x%x)\n", math.Float64bits(x))
+	}
+
+	return fmt.Appendf([]byte(header), "float64(%v)\n", x)
+}
+
+// Decode parses a corpus file written by Encode or by go test.
+func Decode(data []byte) (float64, error) {
+	rest, ok := bytes.CutPrefix(data, []byte(header))
+	if !ok {
+		return 0, ErrFormat
+	}
+
+	line := strings.TrimSpace(string(rest))
+
+	if arg, ok := cutCall(line, "math.Float64frombits"); ok {
+		bits, err := strconv.ParseUint(arg, 0, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %w", ErrFormat, err)
+		}
+
+		return math.Float64frombits(bits), nil
+	}
+
+	arg, ok := cutCall(line, "float64")
+	if !ok {
+		return 0, ErrFormat
+	}
+
+	x, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrFormat, err)
+	}
+
+	return x, nil
+}
+
+func cutCall(line, fn string) (string, bool) {
+	arg, ok := strings.CutPrefix(line, fn+"(")
+	if !ok {
+		return "", false
+	}
+
+	return strings.CutSuffix(arg, ")")
+}
+
+// Name is the file name of the generated entry holding x: Prefix and the
+// first 16 hex digits of the SHA-256 of its contents, so the same input
+// always lands in the same file.
+func Name(x float64) string {
+	sum := sha256.Sum256(Encode(x))
+
+	return Prefix + hex.EncodeToString(sum[:8])
+}
+
+// Load returns the values of every entry in dir, sorted by file name. A
+// missing directory is an empty corpus.
+func Load(dir string) ([]float64, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	xs := make([]float64, 0, len(entries))
+
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		x, err := Decode(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Join(dir, e.Name()), err)
+		}
+
+		xs = append(xs, x)
+	}
+
+	return xs, nil
+}
+
+// Sync makes the generated entries of dir exactly xs, creating dir if
+// needed: entries for new values are written and generated entries for
+// values no longer in xs are removed. Entries without the Prefix are kept.
+func Sync(dir string, xs []float64) error {
+	want := make(map[string]bool, len(xs))
+	for _, x := range xs {
+		want[Name(x)] = true
+	}
+
+	have, err := generated(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range have {
+		if !want[name] {
+			if err := os.Remove(filepath.Join(dir, name)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(xs) == 0 {
+		return nil
+	}
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+
+	for _, x := range xs {
+		if slices.Contains(have, Name(x)) {
+			continue
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, Name(x)), Encode(x), 0o600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// InSync reports whether the generated entries of dir are exactly xs.
+func InSync(dir string, xs []float64) (bool, error) {
+	have, err := generated(dir)
+	if err != nil {
+		return false, err
+	}
+
+	names := make([]string, 0, len(xs))
+	for _, x := range xs {
+		names = append(names, Name(x))
+	}
+
+	slices.Sort(names)
+
+	return slices.Equal(have, slices.Compact(names)), nil
+}
+
+// generated returns the sorted names of the generated entries in dir.
+func generated(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var names []string
+
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasPrefix(e.Name(), Prefix) {
+			names = append(names, e.Name())
+		}
+	}
+
+	return names, nil
+}
+
+// Worst returns the k inputs of xs with the largest errors, largest first;
+// ties keep the order of xs. A NaN error counts as infinite.
+func Worst(xs []float64, errAt func(x float64) float64, k int) []float64 {
+	type scored struct{ x, err float64 }
+
+	all := make([]scored, len(xs))
+	for i, x := range xs {
+		e := errAt(x)
+		if e != e { //nolint:gocritic
+			e = math.Inf(1)
+		}
+
+		all[i] = scored{x, e}
+	}
+
+	slices.SortStableFunc(all, func(a, b scored) int {
+		switch {
+		case a.err > b.err:
+			return -1
+		case a.err < b.err:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	out := make([]float64, 0, min(k, len(all)))
+	for _, s := range all[:min(k, len(all))] {
+		out = append(out, s.x)
+	}
+
+	return out
+}
@@ -0,0 +1,111 @@
+package fuzzcorpus
+
+import (
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	payload := math.Float64frombits(0x7ff8000000000123)
+
+	for _, x := range []float64{0, math.Copysign(0, -1), 1.5, -2e-310, 1e300, math.Inf(1), math.Inf(-1), math.NaN(), payload} {
+		got, err := Decode(Encode(x))
+		if err != nil {
+			t.Fatalf("Decode(Encode(%v)): %v", x, err)
+		}
+
+		if math.Float64bits(got) != math.Float64bits(x) {
+			t.Fatalf("Decode(Encode(%v)) = %v (%x)", x, got, math.Float64bits(got))
+		}
+	}
+
+	if got := string(Encode(0.25)); got != "go test fuzz v1\nfloat64(0.25)\n" {
+		t.Fatalf("Encode(0.25) = %q", got)
+	}
+}
+
+func TestDecodeRejects(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{
+		"",
+		"float64(1)\n",
+		"go test fuzz v1\nint(1)\n",
+		"go test fuzz v1\nfloat64(x)\n",
+		"go test fuzz v1\nmath.Float64frombits(zz)\n",
+	} {
+		if _, err := Decode([]byte(data)); !errors.Is(err, ErrFormat) {
+			t.Fatalf("Decode(%q) error = %v, want ErrFormat", data, err)
+		}
+	}
+}
+
+func TestSync(t *testing.T) {
+	t.Parallel()
+
+	dir := Dir(t.TempDir(), "FuzzX")
+
+	if xs, err := Load(dir); err != nil || xs != nil {
+		t.Fatalf("Load of a missing directory = %v, %v", xs, err)
+	}
+
+	if err := Sync(dir, []float64{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	// An entry found by fuzzing survives regeneration.
+	found := filepath.Join(dir, "0123456789abcdef")
+	if err := os.WriteFile(found, Encode(42), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Sync(dir, []float64{2, 3, 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	if ok, err := InSync(dir, []float64{4, 3, 2}); err != nil || !ok {
+		t.Fatalf("InSync after Sync = %v, %v", ok, err)
+	}
+
+	if ok, _ := InSync(dir, []float64{1, 2, 3}); ok {
+		t.Fatal("InSync accepts a stale set")
+	}
+
+	xs, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slices.Sort(xs)
+
+	if want := []float64{2, 3, 4, 42}; !slices.Equal(xs, want) {
+		t.Fatalf("Load = %v, want %v", xs, want)
+	}
+}
+
+func TestWorst(t *testing.T) {
+	t.Parallel()
+
+	xs := []float64{0, 1, 2, 3, 4}
+	errAt := func(x float64) float64 {
+		if x == 3 {
+			return math.NaN()
+		}
+
+		return math.Abs(x - 1.2)
+	}
+
+	if got, want := Worst(xs, errAt, 3), []float64{3, 4, 0}; !slices.Equal(got, want) {
+		t.Fatalf("Worst = %v, want %v", got, want)
+	}
+
+	if got := Worst(xs, errAt, 10); len(got) != len(xs) {
+		t.Fatalf("Worst with k > len = %v", got)
+	}
+}
@@ -0,0 +1,292 @@
+// Command accuracy measures every bound of the accuracy contract over its
+// domain and writes the results to accuracy/measured.go as constants, with
+// a summary table in their doc comment, so the documented errors come from
+// the same measurement the contract tests enforce. It also writes the
+// contract bounds to contractbounds.go in the root package, which cannot
+// import accuracy, for PrecisionInfo, and exports the worst-error inputs of
+// each tier into the seed corpus of the matching root fuzz target under
+// testdata/fuzz. Run it from the module root through go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/accuracy"
+	"github.com/meko-christian/algo-approx/internal/fuzzcorpus"
+	"github.com/meko-christian/algo-approx/internal/reference"
+)
+
+// samples is the number of points measured per bound, as documented for the
+// contract.
+const samples = 100001
+
+//nolint:gochecknoglobals // read-only tier order of the table columns
+var tiers = []approx.Precision{approx.PrecisionFast, approx.PrecisionBalanced, approx.PrecisionHigh}
+
+func main() {
+	src, err := generate()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(outputPath("."), src, 0o600); err != nil {
+		log.Fatal(err)
+	}
+
+	bounds, err := generateBounds()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(boundsPath("."), bounds, 0o600); err != nil {
+		log.Fatal(err)
+	}
+
+	corpus, err := generateCorpus()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, e := range corpus {
+		if err := fuzzcorpus.Sync(e.dir("."), e.inputs); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// outputPath is the generated file under root.
+func outputPath(root string) string {
+	return filepath.Join(root, "accuracy", "measured.go")
+}
+
+// boundsPath is the generated root-package file under root.
+func boundsPath(root string) string {
+	return filepath.Join(root, "contractbounds.go")
+}
+
+// fuzzTargets names the root-package fuzz target of each Function that has
+// one; the worst inputs of its tiers become seeds of that target.
+//
+//nolint:gochecknoglobals // read-only table
+var fuzzTargets = map[approx.Function]string{
+	approx.FuncSqrt:    "FuzzFastSqrt",
+	approx.FuncInvSqrt: "FuzzFastInvSqrt",
+	approx.FuncLog:     "FuzzFastLog",
+	approx.FuncExp:     "FuzzFastExp",
+}
+
+// corpusWorst is the number of worst inputs exported per tier.
+const corpusWorst = 4
+
+// corpusEntry is the generated seed corpus of one fuzz target.
+type corpusEntry struct {
+	target string
+	inputs []float64
+}
+
+// dir is the corpus directory of e under root.
+func (e corpusEntry) dir(root string) string { return fuzzcorpus.Dir(root, e.target) }
+
+// generateCorpus returns the corpusWorst worst inputs of every tier of each
+// Function with a fuzz target, over the same samples as the measured table,
+// merged and sorted per target.
+func generateCorpus() ([]corpusEntry, error) {
+	c := accuracy.Current()
+
+	var out []corpusEntry
+
+	for _, fn := range approx.Functions() {
+		target, ok := fuzzTargets[fn]
+		if !ok {
+			continue
+		}
+
+		impl, _ := reference.ForFunction(fn)
+
+		var inputs []float64
+
+		for _, prec := range tiers {
+			b, ok := c.Lookup(fn, prec)
+			if !ok {
+				return nil, fmt.Errorf("no bound for %v/%v", fn, prec)
+			}
+
+			inputs = append(inputs, fuzzcorpus.Worst(b.Domain.Samples(samples), func(x float64) float64 {
+				return b.Error(impl.Approx(x, prec), impl.Ref(x))
+			}, corpusWorst)...)
+		}
+
+		slices.Sort(inputs)
+		out = append(out, corpusEntry{target: target, inputs: slices.Compact(inputs)})
+	}
+
+	return out, nil
+}
+
+// row is one Function of the table.
+type row struct {
+	fn     approx.Function
+	bound  accuracy.Bound // the Fast bound; metric and domain are shared
+	errors [3]float64
+}
+
+// generate returns the source of accuracy/measured.go.
+func generate() ([]byte, error) {
+	c := accuracy.Current()
+	rows := make([]row, 0, len(approx.Functions()))
+
+	for _, fn := range approx.Functions() {
+		impl, ok := reference.ForFunction(fn)
+		if !ok {
+			return nil, fmt.Errorf("no implementation registered for %v", fn)
+		}
+
+		r := row{fn: fn} //nolint:exhaustruct
+
+		for i, prec := range tiers {
+			b, ok := c.Lookup(fn, prec)
+			if !ok {
+				return nil, fmt.Errorf("no bound for %v/%v", fn, prec)
+			}
+
+			worst, _ := b.Measure(func(x float64) float64 { return impl.Approx(x, prec) }, impl.Ref, samples)
+			if math.IsInf(worst, 0) || worst != worst { //nolint:gocritic
+				return nil, fmt.Errorf("%v/%v: non-finite error", fn, prec)
+			}
+
+			r.bound, r.errors[i] = b, roundUp(worst)
+		}
+
+		rows = append(rows, r)
+	}
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "// Code generated by go run ./internal/gen/accuracy; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package accuracy\n\n")
+	fmt.Fprintf(&buf, "import approx \"github.com/meko-christian/algo-approx\"\n\n")
+	fmt.Fprintf(&buf, "// Measured worst-case errors of the float64 implementation over the\n")
+	fmt.Fprintf(&buf, "// contract domains, %d samples each, rounded up to two digits. Every\n", samples)
+	fmt.Fprintf(&buf, "// contract bound is at or above its measured error.\n")
+	fmt.Fprintf(&buf, "//\n")
+	fmt.Fprintf(&buf, "//\t%-9s %-7s %-22s %-8s %-8s %s\n", "Function", "Metric", "Domain", "Fast", "Balanced", "High")
+
+	for _, r := range rows {
+		fmt.Fprintf(&buf, "//\t%-9v %-7v %-22s %-8s %-8s %s\n", r.fn, r.bound.Metric, domainString(r.bound.Domain),
+			num(r.errors[0]), num(r.errors[1]), num(r.errors[2]))
+	}
+
+	fmt.Fprintf(&buf, "const (\n")
+
+	for _, r := range rows {
+		for i, prec := range tiers {
+			fmt.Fprintf(&buf, "\t%s = %s\n", constName(r.fn, prec), num(r.errors[i]))
+		}
+	}
+
+	fmt.Fprintf(&buf, ")\n\n")
+	fmt.Fprintf(&buf, "// measured holds the constants above by Function and tier.\n")
+	fmt.Fprintf(&buf, "//\n//nolint:gochecknoglobals // read-only generated table\n")
+	fmt.Fprintf(&buf, "var measured = map[approx.Function][3]float64{\n")
+
+	for _, r := range rows {
+		fmt.Fprintf(&buf, "\tapprox.Func%v: {%s, %s, %s},\n", r.fn,
+			constName(r.fn, tiers[0]), constName(r.fn, tiers[1]), constName(r.fn, tiers[2]))
+	}
+
+	fmt.Fprintf(&buf, "}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+// generateBounds returns the source of contractbounds.go.
+func generateBounds() ([]byte, error) {
+	c := accuracy.Current()
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "// Code generated by go run ./internal/gen/accuracy; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package approx\n\n")
+	fmt.Fprintf(&buf, "// contractBounds mirrors the bounds of accuracy.Current %s by Function:\n", accuracy.Version)
+	fmt.Fprintf(&buf, "// the maximum error per tier, Fast, Balanced and High, whether it is\n")
+	fmt.Fprintf(&buf, "// relative, and the domain it holds on.\n")
+	fmt.Fprintf(&buf, "//\n//nolint:gochecknoglobals // read-only generated table\n")
+	fmt.Fprintf(&buf, "var contractBounds = [...]contractBound{\n")
+
+	for _, fn := range approx.Functions() {
+		var errs [3]string
+
+		var (
+			metric accuracy.Metric
+			domain accuracy.Domain
+		)
+
+		for i, prec := range tiers {
+			b, ok := c.Lookup(fn, prec)
+			if !ok {
+				return nil, fmt.Errorf("no bound for %v/%v", fn, prec)
+			}
+
+			if i > 0 && (b.Metric != metric || b.Domain != domain) {
+				return nil, fmt.Errorf("%v: metric or domain differs between tiers", fn)
+			}
+
+			errs[i], metric, domain = fmt.Sprintf("%g", b.MaxError), b.Metric, b.Domain
+		}
+
+		fmt.Fprintf(&buf, "\tFunc%v: {maxError: [3]float64{%s, %s, %s}, relative: %t, lo: %s, hi: %s, log: %t},\n",
+			fn, errs[0], errs[1], errs[2], metric == accuracy.Relative,
+			lit(domain.Lo), lit(domain.Hi), domain.Log)
+	}
+
+	fmt.Fprintf(&buf, "}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+// constName is the constant of fn at prec, for example MeasuredExpFast.
+func constName(fn approx.Function, prec approx.Precision) string {
+	names := map[approx.Precision]string{
+		approx.PrecisionFast:     "Fast",
+		approx.PrecisionBalanced: "Balanced",
+		approx.PrecisionHigh:     "High",
+	}
+
+	return fmt.Sprintf("Measured%v%s", fn, names[prec])
+}
+
+// roundUp rounds v up to two significant digits, so the constants stay
+// upper bounds of the measurement.
+func roundUp(v float64) float64 {
+	if v <= 0 {
+		return 0
+	}
+
+	scale := math.Pow(10, math.Floor(math.Log10(v))-1)
+
+	return math.Ceil(v/scale) * scale
+}
+
+// lit formats v as an exact Go literal.
+func lit(v float64) string { return strconv.FormatFloat(v, 'g', -1, 64) }
+
+// num formats a rounded error as a Go literal.
+func num(v float64) string { return fmt.Sprintf("%.1e", v) }
+
+func domainString(d accuracy.Domain) string {
+	s := fmt.Sprintf("[%.4g, %.4g]", d.Lo, d.Hi)
+	if d.Log {
+		s += " log"
+	}
+
+	return s
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/internal/fuzzcorpus"
+)
+
+// TestGeneratedUpToDate fails when a kernel or the contract changed without
+// regenerating the measured tables.
+func TestGeneratedUpToDate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		path string
+		gen  func() ([]byte, error)
+	}{
+		{outputPath("../../.."), generate},
+		{boundsPath("../../.."), generateBounds},
+	} {
+		want, err := tc.gen()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := os.ReadFile(tc.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is stale; run go generate in the module root", tc.path)
+		}
+	}
+}
+
+// TestCorpusUpToDate fails when the worst-error inputs moved without
+// regenerating the fuzz seed corpus. The worst-error search is sensitive to
+// the last bit of every kernel, so the corpus is only checked on the platform
+// it is generated on: amd64 without FMA contraction.
+func TestCorpusUpToDate(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOARCH != "amd64" || fusedMulAdd() {
+		t.Skip("corpus is generated on amd64 without FMA contraction")
+	}
+
+	corpus, err := generateCorpus()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, e := range corpus {
+		ok, err := fuzzcorpus.InSync(e.dir("../../.."), e.inputs)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !ok {
+			t.Errorf("%s is stale; run go generate in the module root", e.dir("../../.."))
+		}
+	}
+}
+
+// fusedMulAdd reports whether the compiler contracts a*b+c into an FMA for
+// this build: unfused, the 0x1p-60 term of (1+0x1p-30)² is rounded away.
+func fusedMulAdd() bool { return mulAdd(1+0x1p-30, 1+0x1p-30, -(1+0x1p-29)) != 0 }
+
+// mulAdd is kept out of line so its operands are registers, which is where
+// the compiler contracts.
+//
+//go:noinline
+func mulAdd(a, b, c float64) float64 { return a*b + c }
+
+func TestRoundUp(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct{ in, want string }{
+		{num(roundUp(1.234e-5)), "1.3e-05"},
+		{num(roundUp(1.2e-5)), "1.2e-05"},
+		{num(roundUp(9.91e-3)), "1.0e-02"},
+		{num(roundUp(0)), "0.0e+00"},
+	} {
+		if tc.in != tc.want {
+			t.Errorf("got %s, want %s", tc.in, tc.want)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+// Command flavors generates the fast32 and fast64 packages from the public
+// API: one non-generic wrapper per FastX32/FastX64 function, plus an XPrec
+// wrapper wherever a generic FastXPrec exists. Run it from the module root
+// through go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+func main() {
+	for _, bits := range []string{"32", "64"} {
+		src, err := generate(".", bits)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := os.WriteFile(outputPath(".", bits), src, 0o600); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// outputPath is the generated file of the given flavor under root.
+func outputPath(root, bits string) string {
+	return filepath.Join(root, "fast"+bits, "fast"+bits+".go")
+}
+
+// generate returns the source of package fast<bits> for the approx package
+// in root.
+func generate(root, bits string) ([]byte, error) {
+	funcs, err := parseAPI(root)
+	if err != nil {
+		return nil, err
+	}
+
+	floatType := "float" + bits
+
+	var names []string
+
+	for name := range funcs {
+		if base, ok := strings.CutSuffix(name, bits); ok && strings.HasPrefix(name, "Fast") && funcs[base] != nil {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "// Code generated by go run ./internal/gen/flavors; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package fast%s\n\n", bits)
+	fmt.Fprintf(&buf, "import approx \"github.com/meko-christian/algo-approx\"\n")
+
+	for _, name := range names {
+		short := strings.TrimPrefix(strings.TrimSuffix(name, bits), "Fast")
+		writeWrapper(&buf, short, name, "", funcs[name], floatType)
+
+		prec := "Fast" + short + "Prec"
+		if decl := funcs[prec]; decl != nil && isFloatGeneric(decl) {
+			writeWrapper(&buf, short+"Prec", prec, "["+floatType+"]", decl, floatType)
+		}
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// parseAPI returns the exported top-level functions of the non-test files in
+// root, by name.
+func parseAPI(root string) (map[string]*ast.FuncDecl, error) {
+	fset := token.NewFileSet()
+	filter := func(fi fs.FileInfo) bool { return !strings.HasSuffix(fi.Name(), "_test.go") }
+
+	pkgs, err := parser.ParseDir(fset, root, filter, 0) //nolint:staticcheck // one package, no build tags matter
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, ok := pkgs["approx"]
+	if !ok {
+		return nil, fmt.Errorf("no package approx in %s", root)
+	}
+
+	funcs := make(map[string]*ast.FuncDecl)
+
+	for _, file := range pkg.Files {
+		for _, d := range file.Decls {
+			if fd, ok := d.(*ast.FuncDecl); ok && fd.Recv == nil && fd.Name.IsExported() {
+				funcs[fd.Name.Name] = fd
+			}
+		}
+	}
+
+	return funcs, nil
+}
+
+// isFloatGeneric reports whether decl has the single type parameter T Float.
+func isFloatGeneric(decl *ast.FuncDecl) bool {
+	tp := decl.Type.TypeParams
+	if tp == nil || len(tp.List) != 1 || len(tp.List[0].Names) != 1 || tp.List[0].Names[0].Name != "T" {
+		return false
+	}
+
+	c, ok := tp.List[0].Type.(*ast.Ident)
+
+	return ok && c.Name == "Float"
+}
+
+// writeWrapper emits func short(params) results { return approx.target(args) }.
+func writeWrapper(buf *bytes.Buffer, short, target, inst string, decl *ast.FuncDecl, floatType string) {
+	var args []string
+
+	for _, f := range decl.Type.Params.List {
+		for _, n := range f.Names {
+			args = append(args, n.Name)
+		}
+	}
+
+	params := fieldList(decl.Type.Params, floatType)
+	results := fieldList(decl.Type.Results, floatType)
+
+	if decl.Type.Results != nil && (len(decl.Type.Results.List) > 1 || len(decl.Type.Results.List[0].Names) > 0) {
+		results = "(" + results + ")"
+	}
+
+	fmt.Fprintf(buf, "\n// %s calls approx.%s%s.\n", short, target, inst)
+	fmt.Fprintf(buf, "func %s(%s) %s { return approx.%s(%s) }\n", short, params, results, target, strings.Join(args, ", "))
+}
+
+// fieldList renders fields with T replaced by floatType and the approx
+// package's own types qualified.
+func fieldList(fl *ast.FieldList, floatType string) string {
+	if fl == nil {
+		return ""
+	}
+
+	parts := make([]string, 0, len(fl.List))
+
+	for _, f := range fl.List {
+		typ := typeString(f.Type, floatType)
+
+		names := make([]string, 0, len(f.Names))
+		for _, n := range f.Names {
+			names = append(names, n.Name)
+		}
+
+		if len(names) == 0 {
+			parts = append(parts, typ)
+		} else {
+			parts = append(parts, strings.Join(names, ", ")+" "+typ)
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func typeString(e ast.Expr, floatType string) string {
+	switch t := e.(type) {
+	case *ast.Ident:
+		switch {
+		case t.Name == "T":
+			return floatType
+		case ast.IsExported(t.Name):
+			return "approx." + t.Name
+		default:
+			return t.Name
+		}
+	case *ast.ArrayType:
+		n := ""
+		if t.Len != nil {
+			n = t.Len.(*ast.BasicLit).Value //nolint:forcetypeassert // only literal lengths in the API
+		}
+
+		return "[" + n + "]" + typeString(t.Elt, floatType)
+	case *ast.StarExpr:
+		return "*" + typeString(t.X, floatType)
+	case *ast.IndexExpr:
+		return typeString(t.X, floatType) + "[" + typeString(t.Index, floatType) + "]"
+	default:
+		panic(fmt.Sprintf("flavors: unsupported parameter type %T", e))
+	}
+}
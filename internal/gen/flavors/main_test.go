@@ -0,0 +1,50 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// TestGeneratedUpToDate fails when the API changed without regenerating the
+// flavor packages.
+func TestGeneratedUpToDate(t *testing.T) {
+	t.Parallel()
+
+	const root = "../../.."
+
+	for _, bits := range []string{"32", "64"} {
+		want, err := generate(root, bits)
+		if err != nil {
+			t.Fatalf("generate fast%s: %v", bits, err)
+		}
+
+		got, err := os.ReadFile(outputPath(root, bits))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Fatalf("fast%s is stale; run go generate in the module root", bits)
+		}
+	}
+}
+
+func TestGenerateCoversPrecVariants(t *testing.T) {
+	t.Parallel()
+
+	src, err := generate("../../..", "64")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"func Exp(x float64) float64 { return approx.FastExp64(x) }",
+		"func ExpPrec(x float64, prec approx.Precision) float64 { return approx.FastExpPrec(x, prec) }",
+		"func SinCos(x float64) (sin, cos float64) { return approx.FastSinCos64(x) }",
+	} {
+		if !bytes.Contains(src, []byte(want)) {
+			t.Fatalf("generated source lacks %q", want)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Package lut manages lazily built, shared lookup tables.
+package lut
+
+import "sync"
+
+// Generator builds the table for a given size.
+type Generator func(size int) []float64
+
+// Cache hands out one shared table per size, building each at most once.
+//
+// Tables are built lazily on first use (outside the cache lock, guarded by a
+// per-entry sync.Once, so concurrent first callers wait for a single build).
+// The total footprint is bounded by a byte limit: inserting a table evicts
+// the oldest entries until it fits, and a table larger than the whole limit
+// is built for the caller but not retained. Evicted tables stay valid for
+// callers that still hold them; they are simply no longer shared.
+type Cache struct {
+	gen Generator
+
+	mu      sync.Mutex
+	limit   int // bytes
+	used    int // bytes
+	entries map[int]*entry
+	order   []int // insertion order, oldest first
+}
+
+type entry struct {
+	once  sync.Once
+	table []float64
+}
+
+// NewCache returns an empty cache using gen to build tables and retaining at
+// most limit bytes of table data.
+func NewCache(limit int, gen Generator) *Cache {
+	return &Cache{gen: gen, limit: limit, entries: make(map[int]*entry)} //nolint:exhaustruct
+}
+
+// Get returns the table for size, building it if necessary. The returned
+// slice is shared and must not be modified.
+func (c *Cache) Get(size int) []float64 {
+	e := c.lookup(size)
+	e.once.Do(func() { e.table = c.gen(size) })
+
+	return e.table
+}
+
+// Put installs a prebuilt table for size, replacing any cached one. It
+// reports whether the table was retained (false if it exceeds the limit).
+func (c *Cache) Put(size int, table []float64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[size]; ok {
+		c.removeLocked(size)
+	}
+
+	need := Bytes(size)
+	if need > c.limit {
+		return false
+	}
+
+	e := &entry{} //nolint:exhaustruct
+	e.once.Do(func() { e.table = table })
+
+	c.evictLocked(need)
+	c.entries[size] = e
+	c.order = append(c.order, size)
+	c.used += need
+
+	return true
+}
+
+// Purge drops all cached tables.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.entries)
+	c.order = c.order[:0]
+	c.used = 0
+}
+
+// SetLimit changes the byte limit, evicting tables as needed, and returns
+// the previous limit.
+func (c *Cache) SetLimit(limit int) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prev := c.limit
+	c.limit = limit
+	c.evictLocked(0)
+
+	return prev
+}
+
+// Stats returns the number of cached tables and their footprint in bytes.
+func (c *Cache) Stats() (tables, bytes int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries), c.used
+}
+
+func (c *Cache) lookup(size int) *entry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[size]; ok {
+		return e
+	}
+
+	e := &entry{} //nolint:exhaustruct
+
+	need := Bytes(size)
+	if need > c.limit {
+		return e // built for this caller only
+	}
+
+	c.evictLocked(need)
+	c.entries[size] = e
+	c.order = append(c.order, size)
+	c.used += need
+
+	return e
+}
+
+// evictLocked drops the oldest tables until need more bytes fit.
+func (c *Cache) evictLocked(need int) {
+	for len(c.order) > 0 && c.used+need > c.limit {
+		oldest := c.order[0]
+		c.order = c.order[1:]
+		delete(c.entries, oldest)
+		c.used -= Bytes(oldest)
+	}
+}
+
+// removeLocked drops the table for size from the cache.
+func (c *Cache) removeLocked(size int) {
+	delete(c.entries, size)
+
+	for i, s := range c.order {
+		if s == size {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+
+			break
+		}
+	}
+
+	c.used -= Bytes(size)
+}
+
+// Bytes returns the accounted footprint of a table of the given size: size+1
+// float64 entries (the extra entry closes the period for interpolation).
+func Bytes(size int) int { return (size + 1) * 8 }
+
+// cacheLine is the assumed cache-line size in float64 entries (64 bytes).
+const cacheLine = 8
+
+// Alloc returns a zeroed slice of n float64 entries padded by a full cache
+// line on both sides, so that no other heap object shares a cache line with
+// the table. Readers on many cores then never contend with writers of
+// unrelated data (false sharing).
+func Alloc(n int) []float64 {
+	buf := make([]float64, n+2*cacheLine)
+
+	return buf[cacheLine : cacheLine+n : cacheLine+n]
+}
@@ -0,0 +1,145 @@
+package lut
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func countingGen(builds *atomic.Int64) Generator {
+	return func(size int) []float64 {
+		builds.Add(1)
+		return make([]float64, size+1)
+	}
+}
+
+func TestCache_BuildsOncePerSize(t *testing.T) {
+	t.Parallel()
+
+	var builds atomic.Int64
+
+	c := NewCache(1<<20, countingGen(&builds))
+
+	var wg sync.WaitGroup
+	for range 32 {
+		wg.Go(func() {
+			if got := len(c.Get(64)); got != 65 {
+				t.Errorf("table length %d", got)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	if builds.Load() != 1 {
+		t.Fatalf("built %d times, want 1", builds.Load())
+	}
+
+	if n, b := c.Stats(); n != 1 || b != Bytes(64) {
+		t.Fatalf("Stats = (%d, %d)", n, b)
+	}
+}
+
+func TestCache_EvictsOldest(t *testing.T) {
+	t.Parallel()
+
+	var builds atomic.Int64
+
+	c := NewCache(Bytes(16)+Bytes(32), countingGen(&builds))
+
+	c.Get(16)
+	c.Get(32)
+	c.Get(8) // evicts 16
+
+	if n, _ := c.Stats(); n != 2 {
+		t.Fatalf("tables = %d, want 2", n)
+	}
+
+	c.Get(32) // still cached
+	c.Get(16) // rebuilt
+
+	if builds.Load() != 4 {
+		t.Fatalf("builds = %d, want 4", builds.Load())
+	}
+}
+
+func TestCache_OversizedNotRetained(t *testing.T) {
+	t.Parallel()
+
+	var builds atomic.Int64
+
+	c := NewCache(Bytes(8), countingGen(&builds))
+
+	if len(c.Get(1024)) != 1025 {
+		t.Fatalf("oversized table not built")
+	}
+
+	if n, b := c.Stats(); n != 0 || b != 0 {
+		t.Fatalf("oversized table retained: (%d, %d)", n, b)
+	}
+}
+
+func TestCache_PurgeAndSetLimit(t *testing.T) {
+	t.Parallel()
+
+	var builds atomic.Int64
+
+	c := NewCache(1<<20, countingGen(&builds))
+	c.Get(8)
+	c.Get(16)
+
+	if prev := c.SetLimit(Bytes(16)); prev != 1<<20 {
+		t.Fatalf("SetLimit returned %d", prev)
+	}
+
+	if n, _ := c.Stats(); n != 1 {
+		t.Fatalf("SetLimit kept %d tables, want 1", n)
+	}
+
+	c.Purge()
+
+	if n, b := c.Stats(); n != 0 || b != 0 {
+		t.Fatalf("Purge left (%d, %d)", n, b)
+	}
+}
+
+func TestCache_Put(t *testing.T) {
+	t.Parallel()
+
+	var builds atomic.Int64
+
+	c := NewCache(Bytes(16)*2, countingGen(&builds))
+	c.Get(16)
+
+	tab := make([]float64, 17)
+	tab[0] = 42
+
+	if !c.Put(16, tab) {
+		t.Fatal("Put rejected a table within the limit")
+	}
+
+	if got := c.Get(16)[0]; got != 42 {
+		t.Fatalf("Get after Put returned %g", got)
+	}
+
+	if n, b := c.Stats(); n != 1 || b != Bytes(16) {
+		t.Fatalf("Stats = (%d, %d)", n, b)
+	}
+
+	if c.Put(1024, make([]float64, 1025)) {
+		t.Fatal("Put retained an oversized table")
+	}
+
+	if builds.Load() != 1 {
+		t.Fatalf("builds = %d, want 1", builds.Load())
+	}
+}
+
+func TestAlloc_Padded(t *testing.T) {
+	t.Parallel()
+
+	s := Alloc(10)
+	if len(s) != 10 || cap(s) != 10 {
+		t.Fatalf("len %d cap %d", len(s), cap(s))
+	}
+}
@@ -2,6 +2,7 @@ package reference
 
 import (
 	"math"
+	"slices"
 
 	approx "github.com/meko-christian/algo-approx"
 )
@@ -13,6 +14,13 @@ type AccuracyMetrics struct {
 	MeanAbsError  float64
 	RMSError      float64
 	DecimalDigits float64 // -log10(maxRelError)
+
+	// Medians and 99th percentiles of the errors, for workloads where the
+	// typical error matters more than the worst case.
+	P50AbsError float64
+	P99AbsError float64
+	P50RelError float64
+	P99RelError float64
 }
 
 // MeasureAccuracy computes error metrics between approxFn and refFn over samples.
@@ -31,6 +39,9 @@ func MeasureAccuracy[T approx.Float](samples []T, refFn, approxFn func(T) T) Acc
 		sumSq  float64
 	)
 
+	absErrs := make([]float64, 0, len(samples))
+	relErrs := make([]float64, 0, len(samples))
+
 	for _, x := range samples {
 		ref := float64(refFn(x))
 		got := float64(approxFn(x))
@@ -54,6 +65,9 @@ func MeasureAccuracy[T approx.Float](samples []T, refFn, approxFn func(T) T) Acc
 		if rel > maxRel {
 			maxRel = rel
 		}
+
+		absErrs = append(absErrs, absErr)
+		relErrs = append(relErrs, rel)
 	}
 
 	meanAbs := sumAbs / float64(len(samples))
@@ -70,5 +84,25 @@ func MeasureAccuracy[T approx.Float](samples []T, refFn, approxFn func(T) T) Acc
 		MeanAbsError:  meanAbs,
 		RMSError:      rms,
 		DecimalDigits: digits,
+		P50AbsError:   percentile(absErrs, 0.5),
+		P99AbsError:   percentile(absErrs, 0.99),
+		P50RelError:   percentile(relErrs, 0.5),
+		P99RelError:   percentile(relErrs, 0.99),
+	}
+}
+
+// percentile sorts errs and returns the smallest value that at least a
+// fraction q of them do not exceed. NaN errors count as infinite.
+func percentile(errs []float64, q float64) float64 {
+	for i, e := range errs {
+		if e != e { //nolint:gocritic
+			errs[i] = math.Inf(1)
+		}
 	}
+
+	slices.Sort(errs)
+
+	i := int(math.Ceil(q*float64(len(errs)))) - 1
+
+	return errs[max(i, 0)]
 }
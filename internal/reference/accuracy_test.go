@@ -23,3 +23,25 @@ func TestMeasureAccuracyBasic(t *testing.T) {
 		t.Fatalf("precision validity broke")
 	}
 }
+
+func TestMeasureAccuracyPercentiles(t *testing.T) {
+	t.Parallel()
+
+	// Errors 1..100 in shuffled order, relative to a reference of 1, with
+	// one outlier that moves the maximum but neither percentile.
+	samples := make([]float64, 100)
+	for i := range samples {
+		samples[i] = float64((i*37)%100 + 1)
+	}
+
+	samples[50] = 1000
+
+	m := MeasureAccuracy[float64](samples,
+		func(float64) float64 { return 1 },
+		func(x float64) float64 { return 1 + x },
+	)
+
+	if m.P50AbsError != 50 || m.P99AbsError != 100 || m.P50RelError != 50 || m.MaxAbsError != 1000 {
+		t.Fatalf("percentiles = %+v", m)
+	}
+}
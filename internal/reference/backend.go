@@ -0,0 +1,63 @@
+package reference
+
+import (
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// Reference is a backend computing the exact value of a Function that the
+// approximations are measured against. The math package is the default;
+// for some functions, such as tan near π/2, its own error is close to the
+// error being measured, and a higher-precision backend gives a cleaner
+// baseline.
+type Reference interface {
+	// Name identifies the backend in reports.
+	Name() string
+
+	// Eval returns fn(x) rounded to float64. ok is false for a Function the
+	// backend does not cover; callers then fall back to the math package.
+	Eval(fn approx.Function, x float64) (y float64, ok bool)
+}
+
+// Libm is the Reference backed by the math package. It covers every
+// Function.
+type Libm struct{}
+
+// Name returns "libm".
+func (Libm) Name() string { return "libm" }
+
+// Eval returns the math package value of fn at x.
+func (Libm) Eval(fn approx.Function, x float64) (float64, bool) {
+	p, ok := pairs[fn]
+	if !ok {
+		return 0, false
+	}
+
+	return p.Ref(x), true
+}
+
+// Backend returns the Reference that ForFunction measures against: Libm,
+// or BigFloat when built with the approxbigref tag.
+func Backend() Reference { return backend }
+
+// ForFunctionWith is ForFunction measuring against r instead of Backend;
+// functions r does not cover keep the math package reference.
+func ForFunctionWith(r Reference, fn approx.Function) (Pair, bool) {
+	p, ok := pairs[fn]
+	if !ok {
+		return Pair{}, false //nolint:exhaustruct
+	}
+
+	if _, libm := r.(Libm); libm {
+		return p, true
+	}
+
+	if _, covered := r.Eval(fn, 1); !covered {
+		return p, true
+	}
+
+	return Pair{Approx: p.Approx, Ref: func(x float64) float64 {
+		y, _ := r.Eval(fn, x)
+
+		return y
+	}}, true
+}
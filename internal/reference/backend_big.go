@@ -0,0 +1,10 @@
+//go:build approxbigref
+
+package reference
+
+// backend is BigFloat under the approxbigref tag: every measurement of the
+// trigonometric functions runs against arbitrary-precision values, at the
+// cost of a much slower accuracy generator and contract test.
+//
+//nolint:gochecknoglobals // fixed at build time
+var backend Reference = BigFloat{}
@@ -0,0 +1,8 @@
+//go:build !approxbigref
+
+package reference
+
+// backend is the math package in regular builds, keeping measurements fast.
+//
+//nolint:gochecknoglobals // fixed at build time
+var backend Reference = Libm{}
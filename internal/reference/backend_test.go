@@ -0,0 +1,86 @@
+package reference
+
+import (
+	"math"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func TestBigFloatMatchesLibm(t *testing.T) {
+	t.Parallel()
+
+	// math.Sin and math.Cos are within an ulp on moderate arguments.
+	for x := -10.0; x <= 10; x += 0.37 {
+		for _, fn := range []approx.Function{approx.FuncSin, approx.FuncCos} {
+			got, ok := BigFloat{}.Eval(fn, x)
+			want, _ := Libm{}.Eval(fn, x)
+
+			if !ok || math.Abs(got-want) > 2e-16*max(1, math.Abs(want)) {
+				t.Fatalf("BigFloat %v(%g) = %.17g, libm %.17g", fn, x, got, want)
+			}
+		}
+	}
+}
+
+func TestBigFloatHardCases(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		fn   approx.Function
+		x    float64
+		want float64
+	}{
+		// cos and tan of the float64 nearest π/2.
+		{approx.FuncCos, math.Pi / 2, 6.123233995736766e-17},
+		{approx.FuncTan, math.Pi / 2, 1.633123935319537e16},
+		{approx.FuncCotan, math.Pi / 2, 6.123233995736766e-17},
+		// A huge argument needing the full-width reduction.
+		{approx.FuncSin, 1e22, -0.8522008497671888},
+		{approx.FuncSin, math.Copysign(0, -1), math.Copysign(0, -1)},
+		{approx.FuncSec, 0, 1},
+		{approx.FuncCsc, math.Pi / 6, 1.9999999999999998}, // the float64 is above π/6
+	}
+
+	for _, tc := range cases {
+		got, ok := BigFloat{}.Eval(tc.fn, tc.x)
+		if !ok || got != tc.want || math.Signbit(got) != math.Signbit(tc.want) {
+			t.Errorf("BigFloat %v(%v) = %v, want %v", tc.fn, tc.x, got, tc.want)
+		}
+	}
+
+	if got, _ := (BigFloat{}).Eval(approx.FuncCotan, 0); !math.IsInf(got, 1) {
+		t.Errorf("BigFloat Cotan(0) = %v, want +Inf", got)
+	}
+
+	if got, _ := (BigFloat{}).Eval(approx.FuncTan, math.Inf(1)); !math.IsNaN(got) {
+		t.Errorf("BigFloat Tan(+Inf) = %v, want NaN", got)
+	}
+}
+
+func TestForFunctionWith(t *testing.T) {
+	t.Parallel()
+
+	// Uncovered functions keep the math package reference.
+	if _, ok := (BigFloat{}).Eval(approx.FuncExp, 1); ok {
+		t.Fatal("BigFloat claims to cover Exp")
+	}
+
+	exp, _ := ForFunctionWith(BigFloat{}, approx.FuncExp)
+	if exp.Ref(1) != math.E {
+		t.Fatalf("Exp reference = %v", exp.Ref(1))
+	}
+
+	tan, _ := ForFunctionWith(BigFloat{}, approx.FuncTan)
+	if want, _ := (BigFloat{}).Eval(approx.FuncTan, 1.5); tan.Ref(1.5) != want {
+		t.Fatalf("Tan reference = %v, want %v", tan.Ref(1.5), want)
+	}
+
+	if _, ok := ForFunctionWith(Libm{}, approx.Function(-1)); ok {
+		t.Fatal("ForFunctionWith accepts an unknown Function")
+	}
+
+	if Backend().Name() == "" {
+		t.Fatal("Backend has no name")
+	}
+}
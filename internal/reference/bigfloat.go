@@ -0,0 +1,168 @@
+package reference
+
+import (
+	"math"
+	"math/big"
+	"sync"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// BigFloat is a Reference evaluating the trigonometric Functions (Sin, Cos,
+// Tan, Cotan, Sec and Csc) in math/big arithmetic: the argument is reduced
+// modulo π/2 with 1344 bits of π, exactly enough for any float64, and the
+// Taylor series are summed with 192 bits, so the results are correctly
+// rounded apart from rare double-rounding ties. It depends only on the
+// standard library, but is about a thousand times slower than Libm.
+type BigFloat struct{}
+
+// Name returns "bigfloat".
+func (BigFloat) Name() string { return "bigfloat" }
+
+// Eval returns fn(x) for the trigonometric Functions.
+func (BigFloat) Eval(fn approx.Function, x float64) (float64, bool) {
+	switch fn {
+	case approx.FuncSin, approx.FuncCos, approx.FuncTan, approx.FuncCotan, approx.FuncSec, approx.FuncCsc:
+	default:
+		return 0, false
+	}
+
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return math.NaN(), true
+	}
+
+	s, c := bigSinCos(x)
+	one := big.NewFloat(1).SetPrec(workPrec)
+
+	var y *big.Float
+
+	switch fn {
+	case approx.FuncSin:
+		y = s
+	case approx.FuncCos:
+		y = c
+	case approx.FuncTan:
+		y = new(big.Float).SetPrec(workPrec).Quo(s, c)
+	case approx.FuncCotan:
+		y = new(big.Float).SetPrec(workPrec).Quo(c, s)
+	case approx.FuncSec:
+		y = new(big.Float).SetPrec(workPrec).Quo(one, c)
+	default:
+		y = new(big.Float).SetPrec(workPrec).Quo(one, s)
+	}
+
+	f, _ := y.Float64()
+
+	return f, true
+}
+
+const (
+	// reducePrec covers the 1024-bit quotient of the largest float64 by π/2
+	// plus the 60-odd bits lost when it lands near a multiple, with margin.
+	reducePrec = 1344
+	workPrec   = 192
+)
+
+// bigSinCos returns sin x and cos x at workPrec.
+func bigSinCos(x float64) (sin, cos *big.Float) {
+	if x == 0 {
+		// The series would lose the sign of zero.
+		return new(big.Float).SetPrec(workPrec).SetFloat64(x), new(big.Float).SetPrec(workPrec).SetInt64(1)
+	}
+
+	halfPi := bigHalfPi()
+	xb := new(big.Float).SetPrec(reducePrec).SetFloat64(x)
+
+	// k = round(x / (π/2)), r = x - k·π/2.
+	q := new(big.Float).SetPrec(reducePrec).Quo(xb, halfPi)
+	if q.Signbit() {
+		q.Sub(q, big.NewFloat(0.5))
+	} else {
+		q.Add(q, big.NewFloat(0.5))
+	}
+
+	k, _ := q.Int(nil)
+
+	kp := new(big.Float).SetPrec(reducePrec).SetInt(k)
+	r := new(big.Float).SetPrec(reducePrec).Mul(kp, halfPi)
+	r.Sub(xb, r)
+
+	r.SetPrec(workPrec)
+	s, c := taylorSinCos(r)
+
+	switch new(big.Int).Mod(k, big.NewInt(4)).Int64() {
+	case 1:
+		s, c = c, s.Neg(s)
+	case 2:
+		s, c = s.Neg(s), c.Neg(c)
+	case 3:
+		s, c = c.Neg(c), s
+	}
+
+	return s, c
+}
+
+// taylorSinCos sums the series of sin r and cos r for |r| <= π/4 until the
+// terms drop below 2^-workPrec.
+func taylorSinCos(r *big.Float) (sin, cos *big.Float) {
+	r2 := new(big.Float).SetPrec(workPrec).Mul(r, r)
+
+	sin = new(big.Float).SetPrec(workPrec).Set(r)
+	cos = new(big.Float).SetPrec(workPrec).SetInt64(1)
+
+	st := new(big.Float).SetPrec(workPrec).Set(r)
+	ct := new(big.Float).SetPrec(workPrec).SetInt64(1)
+
+	for n := int64(1); ; n++ {
+		// st = -st·r²/((2n)(2n+1)), ct = -ct·r²/((2n-1)(2n)).
+		st.Mul(st, r2).Quo(st, new(big.Float).SetInt64(2*n*(2*n+1))).Neg(st)
+		ct.Mul(ct, r2).Quo(ct, new(big.Float).SetInt64((2*n-1)*2*n)).Neg(ct)
+
+		sin.Add(sin, st)
+		cos.Add(cos, ct)
+
+		if ct.Sign() == 0 || ct.MantExp(nil) < -workPrec-4 {
+			return sin, cos
+		}
+	}
+}
+
+//nolint:gochecknoglobals // computed once on first use
+var (
+	halfPiOnce sync.Once
+	halfPi     *big.Float
+)
+
+// bigHalfPi returns π/2 at reducePrec from Machin's formula,
+// π = 16·atan(1/5) - 4·atan(1/239).
+func bigHalfPi() *big.Float {
+	halfPiOnce.Do(func() {
+		const prec = reducePrec + 64
+
+		pi := new(big.Float).SetPrec(prec).Mul(big.NewFloat(16), atanInv(5, prec))
+		pi.Sub(pi, new(big.Float).SetPrec(prec).Mul(big.NewFloat(4), atanInv(239, prec)))
+
+		halfPi = new(big.Float).SetPrec(reducePrec).Quo(pi, big.NewFloat(2))
+	})
+
+	return halfPi
+}
+
+// atanInv returns atan(1/n) = Σ (-1)^k / ((2k+1)·n^(2k+1)) at prec bits.
+func atanInv(n int64, prec uint) *big.Float {
+	sum := new(big.Float).SetPrec(prec)
+	pow := new(big.Float).SetPrec(prec).Quo(big.NewFloat(1), new(big.Float).SetInt64(n)) // n^-(2k+1)
+	n2 := new(big.Float).SetPrec(prec).SetInt64(n * n)
+
+	for k := int64(0); pow.MantExp(nil) > -int(prec)-8; k++ {
+		term := new(big.Float).SetPrec(prec).Quo(pow, new(big.Float).SetInt64(2*k+1))
+		if k%2 == 1 {
+			term.Neg(term)
+		}
+
+		sum.Add(sum, term)
+		pow.Quo(pow, n2)
+	}
+
+	return sum
+}
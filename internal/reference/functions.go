@@ -0,0 +1,94 @@
+package reference
+
+import (
+	"math"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// Pair couples the public float64 entry point of a Function with the
+// reference it is measured against.
+type Pair struct {
+	Approx func(float64, approx.Precision) float64
+	Ref    func(float64) float64
+}
+
+// ForFunction returns the Pair of fn, measured against Backend; ok is false
+// for an unknown Function.
+func ForFunction(fn approx.Function) (Pair, bool) { return ForFunctionWith(backend, fn) }
+
+// pairs holds the math package references of Libm.
+//
+//nolint:gochecknoglobals // read-only lookup table
+var pairs = map[approx.Function]Pair{
+	approx.FuncSqrt:     {approx.FastSqrtPrec[float64], math.Sqrt},
+	approx.FuncInvSqrt:  {approx.FastInvSqrtPrec[float64], InvSqrt[float64]},
+	approx.FuncLog:      {approx.FastLogPrec[float64], math.Log},
+	approx.FuncLog2:     {approx.FastLog2Prec[float64], math.Log2},
+	approx.FuncExp:      {approx.FastExpPrec[float64], math.Exp},
+	approx.FuncExp2:     {approx.FastExp2Prec[float64], math.Exp2},
+	approx.FuncSin:      {approx.FastSinPrec[float64], math.Sin},
+	approx.FuncCos:      {approx.FastCosPrec[float64], math.Cos},
+	approx.FuncTan:      {approx.FastTanPrec[float64], math.Tan},
+	approx.FuncCotan:    {approx.FastCotanPrec[float64], func(x float64) float64 { return 1 / math.Tan(x) }},
+	approx.FuncSec:      {approx.FastSecPrec[float64], func(x float64) float64 { return 1 / math.Cos(x) }},
+	approx.FuncCsc:      {approx.FastCscPrec[float64], func(x float64) float64 { return 1 / math.Sin(x) }},
+	approx.FuncArctan:   {approx.FastArctanPrec[float64], math.Atan},
+	approx.FuncArccotan: {approx.FastArccotanPrec[float64], func(x float64) float64 { return math.Pi/2 - math.Atan(x) }},
+	approx.FuncArccos:   {approx.FastArccosPrec[float64], math.Acos},
+	approx.FuncArcsec:   {approx.FastArcsecPrec[float64], func(x float64) float64 { return math.Acos(1 / x) }},
+	approx.FuncArccsc:   {approx.FastArccscPrec[float64], func(x float64) float64 { return math.Asin(1 / x) }},
+}
+
+// ForFunction32 returns the public float32 instantiation of fn, measured
+// against the same reference as ForFunction; ok is false for an unknown
+// Function.
+func ForFunction32(fn approx.Function) (func(float32, approx.Precision) float32, bool) {
+	f, ok := pairs32[fn]
+
+	return f, ok
+}
+
+//nolint:gochecknoglobals // read-only lookup table
+var pairs32 = map[approx.Function]func(float32, approx.Precision) float32{
+	approx.FuncSqrt:     approx.FastSqrtPrec[float32],
+	approx.FuncInvSqrt:  approx.FastInvSqrtPrec[float32],
+	approx.FuncLog:      approx.FastLogPrec[float32],
+	approx.FuncLog2:     approx.FastLog2Prec[float32],
+	approx.FuncExp:      approx.FastExpPrec[float32],
+	approx.FuncExp2:     approx.FastExp2Prec[float32],
+	approx.FuncSin:      approx.FastSinPrec[float32],
+	approx.FuncCos:      approx.FastCosPrec[float32],
+	approx.FuncTan:      approx.FastTanPrec[float32],
+	approx.FuncCotan:    approx.FastCotanPrec[float32],
+	approx.FuncSec:      approx.FastSecPrec[float32],
+	approx.FuncCsc:      approx.FastCscPrec[float32],
+	approx.FuncArctan:   approx.FastArctanPrec[float32],
+	approx.FuncArccotan: approx.FastArccotanPrec[float32],
+	approx.FuncArccos:   approx.FastArccosPrec[float32],
+	approx.FuncArcsec:   approx.FastArcsecPrec[float32],
+	approx.FuncArccsc:   approx.FastArccscPrec[float32],
+}
+
+// Pair2 is Pair for two-argument functions.
+type Pair2 struct {
+	Approx func(x, y float64, prec approx.Precision) float64
+	Ref    func(x, y float64) float64
+}
+
+// ForFunction2 returns the Pair2 of a two-argument function by name:
+// "Power" (base, exponent) or "Hypot".
+func ForFunction2(name string) (Pair2, bool) {
+	p, ok := pairs2[name]
+
+	return p, ok
+}
+
+//nolint:gochecknoglobals // read-only lookup table
+var pairs2 = map[string]Pair2{
+	"Power": {approx.FastPowerPrec[float64], math.Pow},
+	"Hypot": {approx.FastHypotPrec[float64], math.Hypot},
+}
+
+// Functions2 lists the names ForFunction2 accepts.
+func Functions2() []string { return []string{"Hypot", "Power"} }
@@ -0,0 +1,59 @@
+package approx
+
+import iapprox "github.com/meko-christian/algo-approx/internal/approx"
+
+// Slerp3 spherically interpolates between the unit vectors a and b using the
+// default precision. t=0 yields a and t=1 yields b, at constant angular speed.
+func Slerp3[T Float](a, b [3]T, t T) [3]T { return Slerp3Prec(a, b, t, PrecisionAuto) }
+
+// Slerp3Prec is Slerp3 using the requested precision.
+//
+// When the vectors are nearly parallel, sin(θ) is too small to divide by and
+// the result falls back to linear interpolation, which is indistinguishable
+// at that angle. Antiparallel inputs have no unique great circle; the result
+// is then the (degenerate) linear interpolation as well.
+func Slerp3Prec[T Float](a, b [3]T, t T, prec Precision) [3]T {
+	p := iapprox.Precision(normalizePrecision(prec))
+
+	cosTheta := min(max(compensatedDot(a[:], b[:]), -1), 1)
+	theta := iapprox.Arccos(cosTheta, p)
+
+	sinTheta := iapprox.Sin(theta, p)
+	if sinTheta < slerpLerpThreshold {
+		return lerp3(a, b, t)
+	}
+
+	tf := float64(t)
+	inv := 1 / sinTheta
+	wa := T(iapprox.Sin((1-tf)*theta, p) * inv)
+	wb := T(iapprox.Sin(tf*theta, p) * inv)
+
+	return [3]T{wa*a[0] + wb*b[0], wa*a[1] + wb*b[1], wa*a[2] + wb*b[2]}
+}
+
+// Nlerp3 linearly interpolates between a and b and normalizes the result to
+// unit length using the default precision. It is cheaper than Slerp3 but does
+// not move at constant angular speed.
+func Nlerp3[T Float](a, b [3]T, t T) [3]T { return Nlerp3Prec(a, b, t, PrecisionAuto) }
+
+// Nlerp3Prec is Nlerp3 using the requested precision. A zero-length
+// interpolant (antiparallel inputs at the midpoint) is returned unnormalized.
+func Nlerp3Prec[T Float](a, b [3]T, t T, prec Precision) [3]T {
+	v := lerp3(a, b, t)
+
+	n2 := v[0]*v[0] + v[1]*v[1] + v[2]*v[2]
+	if n2 == 0 {
+		return v
+	}
+
+	inv := iapprox.InvSqrt(n2, iapprox.Precision(normalizePrecision(prec)))
+
+	return [3]T{v[0] * inv, v[1] * inv, v[2] * inv}
+}
+
+func lerp3[T Float](a, b [3]T, t T) [3]T {
+	return [3]T{a[0] + t*(b[0]-a[0]), a[1] + t*(b[1]-a[1]), a[2] + t*(b[2]-a[2])}
+}
+
+// Below this sin(θ) slerp weights lose more precision than lerp's error.
+const slerpLerpThreshold = 1e-6
@@ -0,0 +1,58 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSlerp3(t *testing.T) {
+	t.Parallel()
+
+	a := [3]float64{1, 0, 0}
+	b := [3]float64{0, 1, 0}
+
+	for _, tt := range []float64{0, 0.25, 0.5, 0.75, 1} {
+		got := Slerp3Prec(a, b, tt, PrecisionHigh)
+		want := [3]float64{math.Cos(tt * math.Pi / 2), math.Sin(tt * math.Pi / 2), 0}
+
+		for i := range 3 {
+			if math.Abs(got[i]-want[i]) > 1e-5 {
+				t.Fatalf("Slerp3(t=%v) = %v, want %v", tt, got, want)
+			}
+		}
+	}
+}
+
+func TestSlerp3_SmallAngleFallback(t *testing.T) {
+	t.Parallel()
+
+	a := [3]float32{1, 0, 0}
+	b := [3]float32{1, 1e-8, 0}
+
+	got := Slerp3(a, b, 0.5)
+	for _, c := range got {
+		if math.IsNaN(float64(c)) || math.IsInf(float64(c), 0) {
+			t.Fatalf("Slerp3 near-parallel = %v", got)
+		}
+	}
+
+	if math.Abs(float64(got[0])-1) > 1e-6 {
+		t.Fatalf("Slerp3 near-parallel = %v", got)
+	}
+}
+
+func TestNlerp3(t *testing.T) {
+	t.Parallel()
+
+	got := Nlerp3Prec([3]float64{1, 0, 0}, [3]float64{0, 0, 1}, 0.5, PrecisionHigh)
+	want := 1 / math.Sqrt2
+
+	if math.Abs(got[0]-want) > 1e-9 || got[1] != 0 || math.Abs(got[2]-want) > 1e-9 {
+		t.Fatalf("Nlerp3 = %v, want [%v 0 %v]", got, want, want)
+	}
+
+	zero := Nlerp3([3]float64{1, 0, 0}, [3]float64{-1, 0, 0}, 0.5)
+	if zero != [3]float64{} {
+		t.Fatalf("Nlerp3 antiparallel midpoint = %v, want zero", zero)
+	}
+}
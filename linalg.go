@@ -0,0 +1,182 @@
+package approx
+
+import (
+	"math"
+
+	iapprox "github.com/meko-christian/algo-approx/internal/approx"
+)
+
+// GivensRotation returns c, s and r such that
+//
+//	[ c  s] [a]   [r]
+//	[-s  c] [b] = [0]
+//
+// using the default precision.
+func GivensRotation[T Float](a, b T) (c, s, r T) { return GivensRotationPrec(a, b, PrecisionAuto) }
+
+// GivensRotationPrec returns the Givens rotation that zeroes b using the requested precision.
+//
+// The common case uses a single inverse square root; inputs whose squares
+// would overflow or underflow fall back to the scaled FastHypot.
+func GivensRotationPrec[T Float](a, b T, prec Precision) (c, s, r T) {
+	if b == 0 {
+		return 1, 0, a
+	}
+
+	if a == 0 {
+		return 0, 1, b
+	}
+
+	p := iapprox.Precision(normalizePrecision(prec))
+
+	sum := float64(a)*float64(a) + float64(b)*float64(b)
+	if sum > minNormalFloat64 && sum < maxSquareFloat64 {
+		inv := iapprox.InvSqrt(sum, p)
+		return T(float64(a) * inv), T(float64(b) * inv), T(sum * inv)
+	}
+
+	h := float64(iapprox.Hypot(a, b, p))
+
+	return T(float64(a) / h), T(float64(b) / h), T(h)
+}
+
+// JacobiEigen2 returns the eigenvalues of the symmetric 2x2 matrix m in
+// ascending order together with the matching unit eigenvectors
+// (vectors[k] belongs to values[k]), using the default precision.
+//
+// Only m[0][1] is read for the off-diagonal element.
+func JacobiEigen2[T Float](m [2][2]T) (values [2]T, vectors [2][2]T) {
+	return JacobiEigen2Prec(m, PrecisionAuto)
+}
+
+// JacobiEigen2Prec is JacobiEigen2 using the requested precision.
+// A single Jacobi rotation diagonalizes a 2x2 matrix.
+func JacobiEigen2Prec[T Float](m [2][2]T, prec Precision) (values [2]T, vectors [2][2]T) {
+	var a [3][3]float64
+
+	a[0][0], a[0][1], a[1][1] = float64(m[0][0]), float64(m[0][1]), float64(m[1][1])
+
+	v := [3][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+	jacobiRotate(&a, &v, 0, 1, 2, iapprox.Precision(normalizePrecision(prec)))
+
+	values = [2]T{T(a[0][0]), T(a[1][1])}
+	vectors = [2][2]T{{T(v[0][0]), T(v[1][0])}, {T(v[0][1]), T(v[1][1])}}
+
+	if values[1] < values[0] {
+		values[0], values[1] = values[1], values[0]
+		vectors[0], vectors[1] = vectors[1], vectors[0]
+	}
+
+	return values, vectors
+}
+
+// JacobiEigen3 returns the eigenvalues of the symmetric 3x3 matrix m in
+// ascending order together with the matching unit eigenvectors
+// (vectors[k] belongs to values[k]), using the default precision.
+//
+// At most sweeps cyclic Jacobi sweeps are performed; 4-6 sweeps converge to
+// the accuracy of the inverse square root kernel for typical covariance
+// matrices. Only the upper triangle of m is read.
+func JacobiEigen3[T Float](m [3][3]T, sweeps int) (values [3]T, vectors [3][3]T) {
+	return JacobiEigen3Prec(m, sweeps, PrecisionAuto)
+}
+
+// JacobiEigen3Prec is JacobiEigen3 using the requested precision.
+func JacobiEigen3Prec[T Float](m [3][3]T, sweeps int, prec Precision) (values [3]T, vectors [3][3]T) {
+	var a [3][3]float64
+
+	for i := range 3 {
+		for j := i; j < 3; j++ {
+			a[i][j] = float64(m[i][j])
+		}
+	}
+
+	v := [3][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+	p := iapprox.Precision(normalizePrecision(prec))
+
+	for range sweeps {
+		off := math.Abs(a[0][1]) + math.Abs(a[0][2]) + math.Abs(a[1][2])
+		if off == 0 {
+			break
+		}
+
+		jacobiRotate(&a, &v, 0, 1, 2, p)
+		jacobiRotate(&a, &v, 0, 2, 1, p)
+		jacobiRotate(&a, &v, 1, 2, 0, p)
+	}
+
+	// Eigenvectors are the columns of v; sort ascending by eigenvalue.
+	order := [3]int{0, 1, 2}
+	for i := 1; i < 3; i++ {
+		for j := i; j > 0 && a[order[j]][order[j]] < a[order[j-1]][order[j-1]]; j-- {
+			order[j], order[j-1] = order[j-1], order[j]
+		}
+	}
+
+	for k, col := range order {
+		values[k] = T(a[col][col])
+		vectors[k] = [3]T{T(v[0][col]), T(v[1][col]), T(v[2][col])}
+	}
+
+	return values, vectors
+}
+
+// jacobiRotate annihilates a[p][q] (p < q, r is the remaining index) of the
+// symmetric matrix stored in the upper triangle of a, accumulating the
+// rotation into the columns of v.
+//
+//nolint:varnamelen
+func jacobiRotate(a, v *[3][3]float64, p, q, r int, prec iapprox.Precision) {
+	apq := a[p][q]
+	if apq == 0 {
+		return
+	}
+
+	// Smaller root of t^2 + 2*tau*t - 1 = 0 keeps the rotation angle <= π/4.
+	tau := (a[q][q] - a[p][p]) / (2 * apq)
+
+	t := 1 / (math.Abs(tau) + float64(iapprox.Hypot(1, tau, prec)))
+	if tau < 0 {
+		t = -t
+	}
+
+	c := iapprox.InvSqrt(1+t*t, prec)
+	s := t * c
+
+	a[p][p] -= t * apq
+	a[q][q] += t * apq
+	a[p][q] = 0
+
+	// Rotate the coupling to the third index (upper-triangle storage).
+	arp, arq := upper(a, r, p), upper(a, r, q)
+	setUpper(a, r, p, c*arp-s*arq)
+	setUpper(a, r, q, s*arp+c*arq)
+
+	for i := range 3 {
+		vip, viq := v[i][p], v[i][q]
+		v[i][p] = c*vip - s*viq
+		v[i][q] = s*vip + c*viq
+	}
+}
+
+func upper(a *[3][3]float64, i, j int) float64 {
+	if i > j {
+		i, j = j, i
+	}
+
+	return a[i][j]
+}
+
+func setUpper(a *[3][3]float64, i, j int, x float64) {
+	if i > j {
+		i, j = j, i
+	}
+
+	a[i][j] = x
+}
+
+const (
+	minNormalFloat64 = 0x1p-1022
+	// Largest value whose inverse square root scaling stays finite.
+	maxSquareFloat64 = 0x1p1000
+)
@@ -0,0 +1,90 @@
+package approx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+func TestGivensRotation(t *testing.T) {
+	t.Parallel()
+
+	cases := [][2]float64{{3, 4}, {-1, 2}, {5, 0}, {0, -7}, {1e-170, 2e-170}, {1e170, -1e170}}
+	for _, tc := range cases {
+		a, b := tc[0], tc[1]
+		c, s, r := GivensRotationPrec(a, b, PrecisionHigh)
+
+		if got := c*a + s*b; !testutil.Close(got, r, 1e-9) {
+			t.Errorf("GivensRotation(%g, %g): c*a+s*b = %g, want r = %g", a, b, got, r)
+		}
+
+		if got := -s*a + c*b; math.Abs(got) > 1e-9*math.Abs(r) {
+			t.Errorf("GivensRotation(%g, %g): -s*a+c*b = %g, want 0", a, b, got)
+		}
+
+		if math.Abs(c*c+s*s-1) > 1e-9 {
+			t.Errorf("GivensRotation(%g, %g): c^2+s^2 = %g", a, b, c*c+s*s)
+		}
+	}
+}
+
+func TestJacobiEigen2(t *testing.T) {
+	t.Parallel()
+
+	m := [2][2]float64{{2, 1}, {1, 2}}
+	vals, vecs := JacobiEigen2Prec(m, PrecisionHigh)
+
+	if !testutil.Close(vals[0], 1, 1e-9) || !testutil.Close(vals[1], 3, 1e-9) {
+		t.Fatalf("JacobiEigen2 values = %v, want [1 3]", vals)
+	}
+
+	for k := range 2 {
+		v := vecs[k]
+		mv0 := m[0][0]*v[0] + m[0][1]*v[1]
+		mv1 := m[0][1]*v[0] + m[1][1]*v[1]
+
+		if math.Abs(mv0-vals[k]*v[0]) > 1e-9 || math.Abs(mv1-vals[k]*v[1]) > 1e-9 {
+			t.Errorf("vector %d = %v is not an eigenvector", k, v)
+		}
+	}
+}
+
+func TestJacobiEigen3(t *testing.T) {
+	t.Parallel()
+
+	m := [3][3]float64{
+		{4, 1, 0.5},
+		{1, 3, 0.25},
+		{0.5, 0.25, 1},
+	}
+	vals, vecs := JacobiEigen3Prec(m, 6, PrecisionHigh)
+
+	if vals[0] > vals[1] || vals[1] > vals[2] {
+		t.Fatalf("eigenvalues not ascending: %v", vals)
+	}
+
+	// Trace is preserved by similarity transforms.
+	if tr := vals[0] + vals[1] + vals[2]; !testutil.Close(tr, 8, 1e-9) {
+		t.Fatalf("trace = %g, want 8", tr)
+	}
+
+	for k := range 3 {
+		v := vecs[k]
+		for i := range 3 {
+			mv := m[i][0]*v[0] + m[i][1]*v[1] + m[i][2]*v[2]
+			if math.Abs(mv-vals[k]*v[i]) > 1e-8 {
+				t.Fatalf("vector %d = %v is not an eigenvector for %g", k, v, vals[k])
+			}
+		}
+	}
+}
+
+func TestJacobiEigen3_Diagonal(t *testing.T) {
+	t.Parallel()
+
+	vals, _ := JacobiEigen3([3][3]float32{{3, 0, 0}, {0, 1, 0}, {0, 0, 2}}, 4)
+	if vals != [3]float32{1, 2, 3} {
+		t.Fatalf("JacobiEigen3(diag) = %v", vals)
+	}
+}
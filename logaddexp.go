@@ -0,0 +1,44 @@
+package approx
+
+import iapprox "github.com/meko-christian/algo-approx/internal/approx"
+
+// FastLogAddExp returns an approximate ln(e^a + e^b) using the default precision.
+func FastLogAddExp[T Float](a, b T) T { return FastLogAddExpPrec(a, b, PrecisionAuto) }
+
+// FastLogAddExpPrec returns an approximate ln(e^a + e^b) using the requested precision.
+// Evaluated as max(a, b) + ln(1 + e^-|a-b|), so it never overflows for large inputs.
+func FastLogAddExpPrec[T Float](a, b T, prec Precision) T {
+	return iapprox.LogAddExp(a, b, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastLogAddExp32(a, b float32) float32 { return FastLogAddExp[float32](a, b) }
+func FastLogAddExp64(a, b float64) float64 { return FastLogAddExp[float64](a, b) }
+
+// FastLogAddExpInto stores FastLogAddExpPrec(a[i], b[i], prec) in dst[i].
+// It panics with ErrLengthMismatch if the slices differ in length.
+func FastLogAddExpInto[T Float](dst, a, b []T, prec Precision) {
+	if len(dst) != len(a) || len(a) != len(b) {
+		panicLengthMismatch("FastLogAddExpInto")
+	}
+
+	p := iapprox.Precision(normalizePrecision(prec))
+	for i := range dst {
+		dst[i] = iapprox.LogAddExp(a[i], b[i], p)
+	}
+}
+
+// FastLogAddExpIntoClamp is FastLogAddExpInto with each result limited by c,
+// for example to keep accumulated log-probabilities above a floor. It panics
+// with ErrDomainError if c.Lo > c.Hi or a bound is NaN.
+func FastLogAddExpIntoClamp[T Float](dst, a, b []T, prec Precision, c Clamp[T]) {
+	if len(dst) != len(a) || len(a) != len(b) {
+		panicLengthMismatch("FastLogAddExpIntoClamp")
+	}
+
+	c.check("FastLogAddExpIntoClamp")
+
+	p := iapprox.Precision(normalizePrecision(prec))
+	for i := range dst {
+		dst[i] = c.Apply(iapprox.LogAddExp(a[i], b[i], p))
+	}
+}
@@ -0,0 +1,55 @@
+package approx
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+func TestFastLogAddExp(t *testing.T) {
+	t.Parallel()
+
+	// Naive ln(e^a + e^b) overflows here; the stable form must not.
+	got := FastLogAddExp(1000.0, 1000.0)
+	if !testutil.Close(got, 1000+math.Ln2, 1e-6) {
+		t.Fatalf("FastLogAddExp(1000, 1000) = %v", got)
+	}
+
+	got32 := FastLogAddExp32(-2, 3)
+
+	want := 3 + math.Log1p(math.Exp(-5))
+	if !testutil.Close(float64(got32), want, 1e-5) {
+		t.Fatalf("FastLogAddExp32(-2, 3) = %v, want %v", got32, want)
+	}
+}
+
+func TestFastLogAddExpInto(t *testing.T) {
+	t.Parallel()
+
+	a := []float64{0, -5, 10, math.Inf(-1)}
+	b := []float64{0, 5, -10, 1}
+	dst := make([]float64, len(a))
+
+	FastLogAddExpInto(dst, a, b, PrecisionHigh)
+
+	for i := range dst {
+		if dst[i] != FastLogAddExpPrec(a[i], b[i], PrecisionHigh) {
+			t.Fatalf("dst[%d] = %v, scalar path disagrees", i, dst[i])
+		}
+	}
+}
+
+func TestFastLogAddExpInto_LengthMismatch(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, ErrLengthMismatch) {
+			t.Fatalf("expected ErrLengthMismatch panic, got %v", err)
+		}
+	}()
+
+	FastLogAddExpInto(make([]float64, 2), []float64{1, 2}, []float64{1}, PrecisionAuto)
+}
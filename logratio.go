@@ -0,0 +1,30 @@
+package approx
+
+import iapprox "github.com/meko-christian/algo-approx/internal/approx"
+
+// FastLogRatio returns an approximate ln(a/b) using the default precision.
+func FastLogRatio[T Float](a, b T) T { return FastLogRatioPrec(a, b, PrecisionAuto) }
+
+// FastLogRatioPrec returns an approximate ln(a/b) using the requested precision.
+// For close arguments it evaluates 2·atanh((a-b)/(a+b)) without forming a/b, so
+// the result keeps its relative accuracy as a approaches b (likelihood ratios,
+// KL terms); far apart it is ln(a) - ln(b), which never overflows.
+func FastLogRatioPrec[T Float](a, b T, prec Precision) T {
+	return iapprox.LogRatio(a, b, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastLogRatio32(a, b float32) float32 { return FastLogRatio[float32](a, b) }
+func FastLogRatio64(a, b float64) float64 { return FastLogRatio[float64](a, b) }
+
+// FastLogRatioInto stores FastLogRatioPrec(a[i], b[i], prec) in dst[i].
+// It panics with ErrLengthMismatch if the slices differ in length.
+func FastLogRatioInto[T Float](dst, a, b []T, prec Precision) {
+	if len(dst) != len(a) || len(a) != len(b) {
+		panicLengthMismatch("FastLogRatioInto")
+	}
+
+	p := iapprox.Precision(normalizePrecision(prec))
+	for i := range dst {
+		dst[i] = iapprox.LogRatio(a[i], b[i], p)
+	}
+}
@@ -0,0 +1,53 @@
+package approx
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+func TestFastLogRatio(t *testing.T) {
+	t.Parallel()
+
+	// a/b rounds to 1 + 2^-52 here; the atanh form keeps the exact ratio.
+	a, b := 1+0x1p-40, 1-0x1p-40
+	if got, want := FastLogRatio(a, b), math.Log1p(a-b); !testutil.Close(got, want, 1e-12) {
+		t.Fatalf("FastLogRatio(%v, %v) = %v, want %v", a, b, got, want)
+	}
+
+	got32 := FastLogRatio32(3, 2)
+	if !testutil.Close(float64(got32), math.Log(1.5), 1e-5) {
+		t.Fatalf("FastLogRatio32(3, 2) = %v", got32)
+	}
+}
+
+func TestFastLogRatioInto(t *testing.T) {
+	t.Parallel()
+
+	a := []float64{1, 2, 1e10, 5}
+	b := []float64{1, 3, 1e-10, 0}
+	dst := make([]float64, len(a))
+
+	FastLogRatioInto(dst, a, b, PrecisionHigh)
+
+	for i := range dst {
+		if dst[i] != FastLogRatioPrec(a[i], b[i], PrecisionHigh) {
+			t.Fatalf("dst[%d] = %v, scalar path disagrees", i, dst[i])
+		}
+	}
+}
+
+func TestFastLogRatioInto_LengthMismatch(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, ErrLengthMismatch) {
+			t.Fatalf("expected ErrLengthMismatch panic, got %v", err)
+		}
+	}()
+
+	FastLogRatioInto(make([]float64, 2), []float64{1, 2}, []float64{1}, PrecisionAuto)
+}
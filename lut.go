@@ -0,0 +1,201 @@
+package approx
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/meko-christian/algo-approx/internal/lut"
+)
+
+// DefaultTableCacheLimit is the default number of bytes of precomputed
+// tables retained by the shared table cache (16 MiB, enough for one
+// 1M-entry table next to a handful of small ones).
+const DefaultTableCacheLimit = 16 << 20
+
+// MinTableSize is the smallest table size accepted by the LUT functions.
+const MinTableSize = 4
+
+//nolint:gochecknoglobals // process-wide table cache
+var sinTables = lut.NewCache(DefaultTableCacheLimit, buildSinTable)
+
+// buildSinTable samples one period of sine at size evenly spaced points, plus
+// a closing entry equal to the first so interpolation never wraps. The data
+// is cache-line isolated so goroutines sharing it never see false sharing.
+func buildSinTable(size int) []float64 {
+	tab := lut.Alloc(size + 1)
+	for i := range size {
+		tab[i] = math.Sin(2 * math.Pi * float64(i) / float64(size))
+	}
+
+	tab[size] = tab[0]
+
+	return tab
+}
+
+// SinTable is a read-only, shared lookup table of one period of sine with
+// linear interpolation between entries. It is safe for concurrent use.
+//
+// Tables come from a process-wide cache: every SinTable of the same size
+// shares the same backing data, built once on first use. Use Preload to move
+// that cost out of the request path and Purge or SetTableCacheLimit to bound
+// memory in long-running services.
+type SinTable struct {
+	tab   []float64
+	mask  int
+	scale float64 // entries per radian
+}
+
+// NewSinTable returns the shared sine table with size entries per period.
+// size must be a power of two of at least MinTableSize.
+//
+// The interpolation error is about (π/size)²/2, e.g. 1.2e-6 for size 2048.
+func NewSinTable(size int) (*SinTable, error) {
+	if err := checkTableSize(size); err != nil {
+		return nil, err
+	}
+
+	return newSinTable(size, sinTables.Get(size)), nil
+}
+
+func newSinTable(size int, tab []float64) *SinTable {
+	return &SinTable{
+		tab:   tab,
+		mask:  size - 1,
+		scale: float64(size) / (2 * math.Pi),
+	}
+}
+
+// Size returns the number of entries per period.
+func (t *SinTable) Size() int { return t.mask + 1 }
+
+// Sin returns sin(x) interpolated from the table.
+func (t *SinTable) Sin(x float64) float64 {
+	return t.lookup(x * t.scale)
+}
+
+// Cos returns cos(x) interpolated from the table (a quarter-period shift).
+func (t *SinTable) Cos(x float64) float64 {
+	return t.lookup(x*t.scale + float64(t.mask+1)/4)
+}
+
+// Sin32 is the float32 form of Sin.
+func (t *SinTable) Sin32(x float32) float32 { return float32(t.Sin(float64(x))) }
+
+// Cos32 is the float32 form of Cos.
+func (t *SinTable) Cos32(x float32) float32 { return float32(t.Cos(float64(x))) }
+
+// lookup interpolates at position u, measured in table entries.
+func (t *SinTable) lookup(u float64) float64 {
+	if math.IsNaN(u) || math.IsInf(u, 0) {
+		return math.NaN()
+	}
+
+	fl := math.Floor(u)
+	frac := u - fl
+	// Reduce modulo the period before converting so huge arguments do not
+	// overflow int; the period is a power of two, so Mod is exact.
+	i := int(math.Mod(fl, float64(t.mask+1)))
+	i &= t.mask // fold negative remainders
+
+	return t.tab[i] + (t.tab[i+1]-t.tab[i])*frac
+}
+
+// sinTableMagic starts the binary encoding of a SinTable.
+const sinTableMagic = "ALUTsin1"
+
+// MarshalBinary encodes the table as the magic "ALUTsin1", the size as a
+// little-endian uint32 and size little-endian float64 entries. The result
+// can be committed as an asset and restored with LoadSinTable, typically
+// through go:embed:
+//
+//	//go:embed sin1m.lut
+//	var sin1m []byte
+//
+//	tab, err := approx.LoadSinTable(sin1m)
+func (t *SinTable) MarshalBinary() ([]byte, error) {
+	size := t.Size()
+	buf := make([]byte, 0, len(sinTableMagic)+4+8*size)
+	buf = append(buf, sinTableMagic...)
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(size)) //nolint:gosec // size is bounded by the slice length
+
+	for _, v := range t.tab[:size] {
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(v))
+	}
+
+	return buf, nil
+}
+
+// LoadSinTable decodes a table produced by MarshalBinary and installs it in
+// the shared cache, so subsequent NewSinTable calls of the same size reuse it
+// instead of computing one. The data is copied; data may be read-only memory
+// such as an embedded asset.
+func LoadSinTable(data []byte) (*SinTable, error) {
+	header := len(sinTableMagic) + 4
+	if len(data) < header || string(data[:len(sinTableMagic)]) != sinTableMagic {
+		return nil, fmt.Errorf("approx: LoadSinTable: bad header: %w", ErrDomainError)
+	}
+
+	size := int(binary.LittleEndian.Uint32(data[len(sinTableMagic):]))
+	if err := checkTableSize(size); err != nil {
+		return nil, err
+	}
+
+	if len(data) != header+8*size {
+		return nil, fmt.Errorf("approx: LoadSinTable: %d bytes of data for size %d: %w",
+			len(data)-header, size, ErrDomainError)
+	}
+
+	tab := lut.Alloc(size + 1)
+	for i := range size {
+		v := math.Float64frombits(binary.LittleEndian.Uint64(data[header+8*i:]))
+		if !(math.Abs(v) <= 1) { //nolint:staticcheck // also rejects NaN
+			return nil, fmt.Errorf("approx: LoadSinTable: entry %d = %g: %w", i, v, ErrDomainError)
+		}
+
+		tab[i] = v
+	}
+
+	tab[size] = tab[0]
+	sinTables.Put(size, tab)
+
+	return newSinTable(size, tab), nil
+}
+
+// Preload builds the shared tables for the given sizes so that the first
+// NewSinTable call for each does not pay the construction cost.
+// It returns an error, without preloading anything, if any size is invalid.
+func Preload(sizes ...int) error {
+	for _, size := range sizes {
+		if err := checkTableSize(size); err != nil {
+			return err
+		}
+	}
+
+	for _, size := range sizes {
+		sinTables.Get(size)
+	}
+
+	return nil
+}
+
+// Purge drops all cached tables. Existing SinTable values keep working; new
+// ones rebuild their data on first use.
+func Purge() { sinTables.Purge() }
+
+// SetTableCacheLimit sets the number of bytes of table data the shared cache
+// may retain and returns the previous limit. Oldest tables are evicted first;
+// a table larger than the limit is built for its caller but not cached.
+func SetTableCacheLimit(bytes int) int { return sinTables.SetLimit(bytes) }
+
+// TableCacheStats reports the number of cached tables and their total size
+// in bytes.
+func TableCacheStats() (tables, bytes int) { return sinTables.Stats() }
+
+func checkTableSize(size int) error {
+	if size < MinTableSize || size&(size-1) != 0 {
+		return fmt.Errorf("approx: table size %d must be a power of two >= %d: %w", size, MinTableSize, ErrDomainError)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package approx
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSinTable_Accuracy(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range []int{256, 2048, 1 << 14} {
+		tab, err := NewSinTable(size)
+		if err != nil {
+			t.Fatalf("NewSinTable(%d): %v", size, err)
+		}
+
+		if tab.Size() != size {
+			t.Fatalf("Size = %d, want %d", tab.Size(), size)
+		}
+
+		bound := math.Pow(math.Pi/float64(size), 2)/2 + 1e-15
+
+		for i := range 4000 {
+			x := -20 + 40*float64(i)/4000
+			if d := math.Abs(tab.Sin(x) - math.Sin(x)); d > bound {
+				t.Fatalf("size %d: Sin(%g) error %g > %g", size, x, d, bound)
+			}
+
+			if d := math.Abs(tab.Cos(x) - math.Cos(x)); d > bound {
+				t.Fatalf("size %d: Cos(%g) error %g > %g", size, x, d, bound)
+			}
+		}
+	}
+}
+
+func TestSinTable_EdgeCases(t *testing.T) {
+	t.Parallel()
+
+	tab, err := NewSinTable(1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, x := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if !math.IsNaN(tab.Sin(x)) {
+			t.Errorf("Sin(%g) = %g, want NaN", x, tab.Sin(x))
+		}
+	}
+
+	if got := tab.Sin(1e300); got < -1 || got > 1 {
+		t.Errorf("Sin(1e300) = %g out of range", got)
+	}
+
+	if got := tab.Sin32(float32(math.Pi / 2)); math.Abs(float64(got)-1) > 1e-5 {
+		t.Errorf("Sin32(π/2) = %g", got)
+	}
+}
+
+func TestSinTable_InvalidSize(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range []int{0, 2, 3, 100, -8} {
+		if _, err := NewSinTable(size); !errors.Is(err, ErrDomainError) {
+			t.Errorf("NewSinTable(%d) error = %v, want ErrDomainError", size, err)
+		}
+	}
+
+	if err := Preload(64, 100); !errors.Is(err, ErrDomainError) {
+		t.Errorf("Preload with invalid size: error = %v", err)
+	}
+}
+
+//nolint:paralleltest // mutates the process-wide table cache
+func TestTableCache_Lifecycle(t *testing.T) {
+	prev := SetTableCacheLimit(DefaultTableCacheLimit)
+	defer SetTableCacheLimit(prev)
+
+	Purge()
+
+	if err := Preload(64, 128); err != nil {
+		t.Fatal(err)
+	}
+
+	if n, b := TableCacheStats(); n != 2 || b != (65+129)*8 {
+		t.Fatalf("after Preload: (%d, %d)", n, b)
+	}
+
+	a, _ := NewSinTable(64)
+	b, _ := NewSinTable(64)
+
+	if &a.tab[0] != &b.tab[0] {
+		t.Fatal("tables of the same size are not shared")
+	}
+
+	Purge()
+
+	if n, _ := TableCacheStats(); n != 0 {
+		t.Fatalf("after Purge: %d tables", n)
+	}
+
+	if math.Abs(a.Sin(1)-math.Sin(1)) > 1e-3 {
+		t.Fatal("table unusable after Purge")
+	}
+
+	SetTableCacheLimit(0)
+
+	if _, err := NewSinTable(64); err != nil {
+		t.Fatal(err)
+	}
+
+	if n, _ := TableCacheStats(); n != 0 {
+		t.Fatalf("limit 0 retained %d tables", n)
+	}
+}
+
+//nolint:paralleltest // installs into the process-wide table cache
+func TestSinTable_MarshalLoad(t *testing.T) {
+	orig, err := NewSinTable(512)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := orig.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadSinTable(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range 100 {
+		x := float64(i) * 0.37
+		if loaded.Sin(x) != orig.Sin(x) {
+			t.Fatalf("Sin(%g): loaded %g, original %g", x, loaded.Sin(x), orig.Sin(x))
+		}
+	}
+
+	shared, _ := NewSinTable(512)
+	if &shared.tab[0] != &loaded.tab[0] {
+		t.Fatal("NewSinTable does not reuse the loaded table")
+	}
+
+	bad := [][]byte{
+		nil,
+		[]byte("ALUTsin1"),
+		append([]byte("XLUTsin1"), data[8:]...),
+		data[:len(data)-1],
+	}
+
+	for i, b := range bad {
+		if _, err := LoadSinTable(b); !errors.Is(err, ErrDomainError) {
+			t.Errorf("case %d: error = %v, want ErrDomainError", i, err)
+		}
+	}
+
+	corrupt := append([]byte(nil), data...)
+	copy(corrupt[12:], []byte{0, 0, 0, 0, 0, 0, 0xf8, 0x7f}) // NaN
+
+	if _, err := LoadSinTable(corrupt); !errors.Is(err, ErrDomainError) {
+		t.Errorf("NaN entry: error = %v, want ErrDomainError", err)
+	}
+}
@@ -0,0 +1,98 @@
+package approx
+
+import (
+	"math"
+	"slices"
+	"strconv"
+)
+
+// Interval is a real interval; LoOpen and HiOpen exclude the endpoints.
+// Infinite endpoints are always open.
+type Interval struct {
+	Lo, Hi         float64
+	LoOpen, HiOpen bool
+}
+
+// Contains reports whether x lies in i. It is false for NaN.
+func (i Interval) Contains(x float64) bool {
+	return (x > i.Lo || (!i.LoOpen && x == i.Lo)) && (x < i.Hi || (!i.HiOpen && x == i.Hi))
+}
+
+// String formats i in interval notation, e.g. "(0, +Inf)".
+func (i Interval) String() string {
+	lo, hi := "[", "]"
+	if i.LoOpen {
+		lo = "("
+	}
+
+	if i.HiOpen {
+		hi = ")"
+	}
+
+	return lo + formatEndpoint(i.Lo) + ", " + formatEndpoint(i.Hi) + hi
+}
+
+func formatEndpoint(v float64) string {
+	switch v {
+	case math.Pi:
+		return "π"
+	case math.Pi / 2:
+		return "π/2"
+	case -math.Pi / 2:
+		return "-π/2"
+	}
+
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// Metadata describes a Function for code generators, bindings and reports,
+// so they need no hand-maintained list of the package's functions.
+type Metadata struct {
+	Function Function
+
+	// Entry is the generic entry point, e.g. "FastSqrt". The package also
+	// provides Entry+"Prec", Entry+"32" and Entry+"64".
+	Entry string
+
+	// Domain and Codomain are the mathematical domain and range as unions of
+	// intervals. Periodic poles are not cut out; see NearestSingularity.
+	Domain, Codomain []Interval
+
+	// Tiers lists the precision tiers with a kernel of their own, cheapest
+	// first. Every tier is accepted; an unlisted one resolves to the next
+	// more accurate listed tier, and PrecisionAuto to PrecisionBalanced.
+	Tiers []Precision
+
+	// Batch names the entry points that evaluate several inputs per call,
+	// if any.
+	Batch []string
+}
+
+// InDomain reports whether x lies in m.Domain.
+func (m Metadata) InDomain(x float64) bool {
+	return slices.ContainsFunc(m.Domain, func(i Interval) bool { return i.Contains(x) })
+}
+
+// Describe returns the registry metadata of fn. The slices are copies and may
+// be modified freely. An unknown fn returns the zero Metadata.
+func Describe(fn Function) Metadata {
+	if !fn.valid() {
+		return Metadata{} //nolint:exhaustruct
+	}
+
+	meta := &functions[fn]
+
+	tiers := meta.tiers
+	if tiers == nil {
+		tiers = []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh}
+	}
+
+	return Metadata{
+		Function: fn,
+		Entry:    "Fast" + meta.name,
+		Domain:   slices.Clone(meta.domain),
+		Codomain: slices.Clone(meta.codomain),
+		Tiers:    slices.Clone(tiers),
+		Batch:    slices.Clone(meta.batch),
+	}
+}
@@ -0,0 +1,143 @@
+package approx_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/internal/reference"
+)
+
+// probes are inputs spread over every registered domain.
+//
+//nolint:gochecknoglobals // read-only test table
+var probes = []float64{-300, -7.5, -2, -1, -0.9, -0.3, 0, 0.2, 0.7, 1, 1.3, 2.5, 40, 300}
+
+func TestDescribeMatchesFunctions(t *testing.T) {
+	t.Parallel()
+
+	for _, fn := range approx.Functions() {
+		md := approx.Describe(fn)
+		if md.Function != fn || md.Entry != "Fast"+fn.String() || len(md.Domain) == 0 || len(md.Codomain) == 0 {
+			t.Fatalf("Describe(%v) = %+v", fn, md)
+		}
+
+		pair, _ := reference.ForFunction(fn)
+
+		for _, x := range probes {
+			// Poles and open domain edges give ±Inf and are not checked.
+			y := pair.Ref(x)
+			if math.IsInf(y, 0) {
+				continue
+			}
+
+			if math.IsNaN(y) == md.InDomain(x) {
+				t.Errorf("%v: InDomain(%g) = %v, but the reference gives %g", fn, x, md.InDomain(x), y)
+			}
+
+			if math.IsNaN(y) {
+				continue
+			}
+
+			inRange := false
+			for _, iv := range md.Codomain {
+				inRange = inRange || iv.Contains(y)
+			}
+
+			if !inRange {
+				t.Errorf("%v(%g) = %g lies outside the codomain %v", fn, x, y, md.Codomain)
+			}
+		}
+	}
+
+	if md := approx.Describe(approx.Function(0)); md.Entry != "" || md.Domain != nil {
+		t.Fatalf("Describe(invalid) = %+v, want zero", md)
+	}
+}
+
+// TestDescribeTiers checks that the listed tiers are distinct kernels and
+// that every unlisted tier aliases a listed one.
+func TestDescribeTiers(t *testing.T) {
+	t.Parallel()
+
+	all := []approx.Precision{approx.PrecisionFast, approx.PrecisionBalanced, approx.PrecisionHigh}
+
+	for _, fn := range approx.Functions() {
+		md := approx.Describe(fn)
+		pair, _ := reference.ForFunction(fn)
+		x := 0.3
+		if !md.InDomain(x) {
+			x = 1.3
+		}
+
+		got := map[approx.Precision]float64{}
+		for _, prec := range all {
+			got[prec] = pair.Approx(x, prec)
+		}
+
+		for i, p := range md.Tiers {
+			if i > 0 && got[p] == got[md.Tiers[i-1]] {
+				t.Errorf("%v: listed tiers %v and %v give the same result", fn, md.Tiers[i-1], p)
+			}
+		}
+
+		for _, prec := range all {
+			// The next more accurate listed tier.
+			alias := md.Tiers[len(md.Tiers)-1]
+			for i := len(md.Tiers) - 1; i >= 0 && md.Tiers[i] >= prec; i-- {
+				alias = md.Tiers[i]
+			}
+
+			if got[prec] != got[alias] {
+				t.Errorf("%v: tier %v is unlisted but differs from %v", fn, prec, alias)
+			}
+		}
+	}
+}
+
+// TestDescribeEntryPointsExist parses the package so the names in the
+// registry cannot drift from the declared functions.
+func TestDescribeEntryPointsExist(t *testing.T) {
+	t.Parallel()
+
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	declared := map[string]bool{}
+	fset := token.NewFileSet()
+
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, name, nil, parser.SkipObjectResolution)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, decl := range f.Decls {
+			if fd, ok := decl.(*ast.FuncDecl); ok && fd.Recv == nil {
+				declared[fd.Name.Name] = true
+			}
+		}
+	}
+
+	for _, fn := range approx.Functions() {
+		md := approx.Describe(fn)
+
+		names := append([]string{md.Entry, md.Entry + "Prec", md.Entry + "32", md.Entry + "64"}, md.Batch...)
+		for _, name := range names {
+			if !declared[name] {
+				t.Errorf("%v: metadata names %s, which the package does not declare", fn, name)
+			}
+		}
+	}
+}
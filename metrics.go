@@ -0,0 +1,140 @@
+package approx
+
+import "sync/atomic"
+
+// MetricsSink receives usage statistics from the package's instrumentation,
+// so a service can feed them into whatever observability stack it already
+// runs without this package importing a metrics library; examples/prometheus
+// adapts it to the Prometheus text format. Count adds one to a counter and
+// Observe records one value of a distribution.
+//
+// The names written are valid Prometheus metric names:
+//
+//	approx_batch_elements        Observe: elements finished per RunBatch or
+//	                             ScaledSoftmaxRowsContext call (rows for the
+//	                             latter)
+//	approx_batch_canceled        Count: batch calls stopped by their context
+//	approx_debug_violations      Count: input-range violations, approxdebug
+//	                             builds only
+//	approx_evaluator_calls_<fn>  Count: calls of an Instrument-ed Evaluator,
+//	                             with <fn> the lower-case method name
+//
+// Implementations must be safe for concurrent use and should return
+// quickly; the evaluator counts are written on every call.
+type MetricsSink interface {
+	Count(name string)
+	Observe(name string, v float64)
+}
+
+// NopMetrics is the MetricsSink installed by default. It discards
+// everything.
+type NopMetrics struct{}
+
+func (NopMetrics) Count(string)            {}
+func (NopMetrics) Observe(string, float64) {}
+
+var metricsSink atomic.Pointer[MetricsSink] //nolint:gochecknoglobals
+
+// SetMetricsSink installs s as the receiver of the package's statistics and
+// returns the previously installed sink. A nil s restores NopMetrics.
+func SetMetricsSink(s MetricsSink) MetricsSink {
+	var prev *MetricsSink
+	if s == nil {
+		prev = metricsSink.Swap(nil)
+	} else {
+		prev = metricsSink.Swap(&s)
+	}
+
+	if prev == nil {
+		return NopMetrics{}
+	}
+
+	return *prev
+}
+
+// metrics returns the installed sink.
+func metrics() MetricsSink {
+	if s := metricsSink.Load(); s != nil {
+		return *s
+	}
+
+	return NopMetrics{}
+}
+
+// batchDone records the outcome of a batch driver and passes it through.
+func batchDone(n int, err error) (int, error) {
+	m := metrics()
+	m.Observe("approx_batch_elements", float64(n))
+
+	if err != nil {
+		m.Count("approx_batch_canceled")
+	}
+
+	return n, err
+}
+
+// Instrument returns an Evaluator that calls e and counts every call in
+// sink, or in the sink installed by SetMetricsSink at the time of the call
+// when sink is nil, under approx_evaluator_calls_<fn>. Results are those of
+// e.
+func Instrument(e Evaluator, sink MetricsSink) Evaluator {
+	return instrumentedEvaluator{e: e, sink: sink}
+}
+
+type instrumentedEvaluator struct {
+	e    Evaluator
+	sink MetricsSink
+}
+
+func (i instrumentedEvaluator) count(name string) {
+	if i.sink != nil {
+		i.sink.Count(name)
+	} else {
+		metrics().Count(name)
+	}
+}
+
+func (i instrumentedEvaluator) Sin(x float64) float64 {
+	i.count("approx_evaluator_calls_sin")
+	return i.e.Sin(x)
+}
+
+func (i instrumentedEvaluator) Cos(x float64) float64 {
+	i.count("approx_evaluator_calls_cos")
+	return i.e.Cos(x)
+}
+
+func (i instrumentedEvaluator) Tan(x float64) float64 {
+	i.count("approx_evaluator_calls_tan")
+	return i.e.Tan(x)
+}
+
+func (i instrumentedEvaluator) Exp(x float64) float64 {
+	i.count("approx_evaluator_calls_exp")
+	return i.e.Exp(x)
+}
+
+func (i instrumentedEvaluator) Log(x float64) float64 {
+	i.count("approx_evaluator_calls_log")
+	return i.e.Log(x)
+}
+
+func (i instrumentedEvaluator) Sqrt(x float64) float64 {
+	i.count("approx_evaluator_calls_sqrt")
+	return i.e.Sqrt(x)
+}
+
+func (i instrumentedEvaluator) InvSqrt(x float64) float64 {
+	i.count("approx_evaluator_calls_invsqrt")
+	return i.e.InvSqrt(x)
+}
+
+func (i instrumentedEvaluator) Pow(b, p float64) float64 {
+	i.count("approx_evaluator_calls_pow")
+	return i.e.Pow(b, p)
+}
+
+func (i instrumentedEvaluator) Hypot(a, b float64) float64 {
+	i.count("approx_evaluator_calls_hypot")
+	return i.e.Hypot(a, b)
+}
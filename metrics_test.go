@@ -0,0 +1,87 @@
+package approx
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"testing"
+)
+
+// recordingSink counts calls and collects observations for the tests.
+type recordingSink struct {
+	mu       sync.Mutex
+	counts   map[string]int
+	observed map[string][]float64
+}
+
+func newRecordingSink() *recordingSink {
+	return &recordingSink{counts: map[string]int{}, observed: map[string][]float64{}}
+}
+
+func (s *recordingSink) Count(name string) {
+	s.mu.Lock()
+	s.counts[name]++
+	s.mu.Unlock()
+}
+
+func (s *recordingSink) Observe(name string, v float64) {
+	s.mu.Lock()
+	s.observed[name] = append(s.observed[name], v)
+	s.mu.Unlock()
+}
+
+func TestSetMetricsSink(t *testing.T) {
+	if _, ok := SetMetricsSink(nil).(NopMetrics); !ok {
+		t.Fatal("default sink is not NopMetrics")
+	}
+
+	s := newRecordingSink()
+	SetMetricsSink(s)
+
+	defer SetMetricsSink(nil)
+
+	if prev := SetMetricsSink(s); prev != s {
+		t.Fatalf("SetMetricsSink returned %v, want the installed sink", prev)
+	}
+
+	buf := make([]float64, 10)
+	if _, err := RunBatch(context.Background(), buf, buf, copyKernel, BatchOptions{Chunk: 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _ = RunBatch(ctx, buf, buf, copyKernel, BatchOptions{})
+
+	if got := s.observed["approx_batch_elements"]; len(got) != 2 || got[0] != 10 || got[1] != 0 {
+		t.Errorf("approx_batch_elements = %v, want [10 0]", got)
+	}
+
+	if got := s.counts["approx_batch_canceled"]; got != 1 {
+		t.Errorf("approx_batch_canceled = %d, want 1", got)
+	}
+}
+
+func TestInstrument(t *testing.T) {
+	t.Parallel()
+
+	s := newRecordingSink()
+	e := Instrument(Stdlib(), s)
+
+	if e.Sqrt(4) != 2 || e.Pow(2, 3) != 8 || e.Hypot(3, 4) != 5 {
+		t.Fatal("instrumented results differ from the wrapped Evaluator")
+	}
+
+	_ = e.Sin(0) + e.Cos(0) + e.Tan(0) + e.Exp(0) + e.Log(1) + e.InvSqrt(1) + e.Sqrt(9)
+
+	want := map[string]int{
+		"approx_evaluator_calls_sin": 1, "approx_evaluator_calls_cos": 1, "approx_evaluator_calls_tan": 1,
+		"approx_evaluator_calls_exp": 1, "approx_evaluator_calls_log": 1, "approx_evaluator_calls_sqrt": 2,
+		"approx_evaluator_calls_invsqrt": 1, "approx_evaluator_calls_pow": 1, "approx_evaluator_calls_hypot": 1,
+	}
+
+	if !maps.Equal(s.counts, want) {
+		t.Errorf("counts = %v, want %v", s.counts, want)
+	}
+}
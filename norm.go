@@ -0,0 +1,105 @@
+package approx
+
+import iapprox "github.com/meko-christian/algo-approx/internal/approx"
+
+// LayerNorm stores the layer normalization of src in dst using the default
+// precision:
+//
+//	dst[i] = (src[i] - mean) / sqrt(variance + eps) * gamma[i] + beta[i]
+//
+// See LayerNormPrec.
+func LayerNorm[T Float](dst, src, gamma, beta []T, eps T) {
+	LayerNormPrec(dst, src, gamma, beta, eps, PrecisionAuto)
+}
+
+// LayerNormPrec is LayerNorm using the requested precision for the inverse
+// square root.
+//
+// The mean and the population variance are accumulated in float64 in a
+// single Welford pass, which stays accurate when the mean is large compared
+// to the spread; a second pass writes dst, so dst may alias src. A nil gamma
+// scales by 1 and a nil beta shifts by 0.
+//
+// It panics with ErrLengthMismatch if dst, or a non-nil gamma or beta,
+// differs in length from src. An empty src is a no-op.
+func LayerNormPrec[T Float](dst, src, gamma, beta []T, eps T, prec Precision) {
+	checkAffineLengths("LayerNorm", dst, src, gamma, beta)
+	checkNonNegative("LayerNorm", eps, prec)
+
+	if len(src) == 0 {
+		return
+	}
+
+	mean, m2 := 0.0, 0.0
+
+	for i, v := range src {
+		x := float64(v)
+		d := x - mean
+		mean += d / float64(i+1)
+		m2 += d * (x - mean)
+	}
+
+	variance := m2 / float64(len(src))
+	inv := iapprox.InvSqrt(variance+float64(eps), iapprox.Precision(normalizePrecision(prec)))
+
+	writeAffine(dst, src, gamma, beta, mean, inv)
+}
+
+// RMSNorm stores the root-mean-square normalization of src in dst using the
+// default precision:
+//
+//	dst[i] = src[i] / sqrt(mean(src²) + eps) * gamma[i]
+//
+// See RMSNormPrec.
+func RMSNorm[T Float](dst, src, gamma []T, eps T) {
+	RMSNormPrec(dst, src, gamma, eps, PrecisionAuto)
+}
+
+// RMSNormPrec is RMSNorm using the requested precision for the inverse
+// square root. The mean square is accumulated in float64, dst may alias src
+// and a nil gamma scales by 1.
+//
+// It panics with ErrLengthMismatch if dst, or a non-nil gamma, differs in
+// length from src. An empty src is a no-op.
+func RMSNormPrec[T Float](dst, src, gamma []T, eps T, prec Precision) {
+	checkAffineLengths("RMSNorm", dst, src, gamma, nil)
+	checkNonNegative("RMSNorm", eps, prec)
+
+	if len(src) == 0 {
+		return
+	}
+
+	sumSq := 0.0
+	for _, v := range src {
+		sumSq += float64(v) * float64(v)
+	}
+
+	inv := iapprox.InvSqrt(sumSq/float64(len(src))+float64(eps), iapprox.Precision(normalizePrecision(prec)))
+
+	writeAffine(dst, src, gamma, nil, 0, inv)
+}
+
+func checkAffineLengths[T Float](fn string, dst, src, gamma, beta []T) {
+	if len(dst) != len(src) ||
+		(gamma != nil && len(gamma) != len(src)) ||
+		(beta != nil && len(beta) != len(src)) {
+		panicLengthMismatch(fn)
+	}
+}
+
+// writeAffine stores (src[i]-shift)*inv*gamma[i] + beta[i] in dst[i].
+func writeAffine[T Float](dst, src, gamma, beta []T, shift, inv float64) {
+	for i, v := range src {
+		y := (float64(v) - shift) * inv
+
+		if gamma != nil {
+			y *= float64(gamma[i])
+		}
+
+		if beta != nil {
+			y += float64(beta[i])
+		}
+
+		dst[i] = T(y)
+	}
+}
@@ -0,0 +1,118 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLayerNorm(t *testing.T) {
+	t.Parallel()
+
+	r := Seeded(11)
+	src := make([]float64, 257)
+
+	for i := range src {
+		src[i] = 1e4 + 3*r.NormFloat64()
+	}
+
+	gamma := make([]float64, len(src))
+	beta := make([]float64, len(src))
+
+	for i := range gamma {
+		gamma[i] = 0.5 + float64(i)/float64(len(src))
+		beta[i] = float64(i%7) - 3
+	}
+
+	mean, variance := 0.0, 0.0
+	for _, v := range src {
+		mean += v
+	}
+
+	mean /= float64(len(src))
+	for _, v := range src {
+		variance += (v - mean) * (v - mean)
+	}
+
+	variance /= float64(len(src))
+	sd := math.Sqrt(variance + 1e-5)
+
+	cases := []struct {
+		prec Precision
+		tol  float64
+	}{
+		{PrecisionFast, 1e-2},
+		{PrecisionBalanced, 1e-5},
+		{PrecisionHigh, 1e-10},
+	}
+
+	for _, tc := range cases {
+		dst := make([]float64, len(src))
+		LayerNormPrec(dst, src, gamma, beta, 1e-5, tc.prec)
+
+		for i, v := range src {
+			want := (v-mean)/sd*gamma[i] + beta[i]
+			if math.Abs(dst[i]-want) > tc.tol*math.Max(1, math.Abs(want)) {
+				t.Fatalf("prec %v: dst[%d] = %.12g, want %.12g", tc.prec, i, dst[i], want)
+			}
+		}
+	}
+}
+
+func TestLayerNorm_InPlaceNilAffine(t *testing.T) {
+	t.Parallel()
+
+	x := []float32{1, 2, 3, 4}
+	LayerNormPrec(x, x, nil, nil, 0, PrecisionHigh)
+
+	// mean 2.5, variance 1.25
+	for i, want := range []float64{-1.5, -0.5, 0.5, 1.5} {
+		want /= math.Sqrt(1.25)
+		if math.Abs(float64(x[i])-want) > 1e-6 {
+			t.Fatalf("x[%d] = %g, want %g", i, x[i], want)
+		}
+	}
+}
+
+func TestRMSNorm(t *testing.T) {
+	t.Parallel()
+
+	src := []float64{3, -4, 0, 12}
+	gamma := []float64{1, 2, 0.5, -1}
+	rms := math.Sqrt((9 + 16 + 144) / 4.0)
+
+	dst := make([]float64, len(src))
+	RMSNormPrec(dst, src, gamma, 0, PrecisionHigh)
+
+	for i, v := range src {
+		if want := v / rms * gamma[i]; math.Abs(dst[i]-want) > 1e-10 {
+			t.Fatalf("dst[%d] = %.12g, want %.12g", i, dst[i], want)
+		}
+	}
+
+	RMSNorm(src, src, nil, 1e-6)
+
+	if got := src[3]; math.Abs(got-12/rms) > 1e-5 {
+		t.Fatalf("in-place RMSNorm = %g, want %g", got, 12/rms)
+	}
+}
+
+func TestNorm_LengthMismatchPanics(t *testing.T) {
+	t.Parallel()
+
+	for name, fn := range map[string]func(){
+		"LayerNorm dst":   func() { LayerNorm(make([]float64, 2), make([]float64, 3), nil, nil, 0) },
+		"LayerNorm gamma": func() { LayerNorm(make([]float64, 3), make([]float64, 3), make([]float64, 2), nil, 0) },
+		"LayerNorm beta":  func() { LayerNorm(make([]float64, 3), make([]float64, 3), nil, make([]float64, 4), 0) },
+		"RMSNorm gamma":   func() { RMSNorm(make([]float64, 3), make([]float64, 3), make([]float64, 1), 0) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("%s did not panic", name)
+				}
+			}()
+
+			fn()
+		}()
+	}
+}
@@ -0,0 +1,89 @@
+package approx
+
+// RK4Step advances the scalar ODE y' = f(t, y) from (t, y) by one classical
+// fourth-order Runge–Kutta step of size dt and returns y(t+dt).
+//
+// The step is small enough to inline into the caller's loop, and so is a
+// forcing function built from Fast kernels, so a whole simulation step
+// compiles into straight-line code:
+//
+//	for i := range n {
+//		y = approx.RK4Step(func(t, y float64) float64 {
+//			return approx.FastSin(w*t) - k*y
+//		}, t, y, dt)
+//		t += dt
+//	}
+func RK4Step[T Float](f func(t, y T) T, t, y, dt T) T {
+	h := dt / 2
+	k1 := f(t, y)
+	k2 := f(t+h, y+h*k1)
+	k3 := f(t+h, y+h*k2)
+	k4 := f(t+dt, y+dt*k3)
+
+	return y + dt/6*(k1+2*(k2+k3)+k4)
+}
+
+// RK4Step2 is RK4Step for a two-dimensional state, such as the position
+// and velocity of an oscillator.
+func RK4Step2[T Float](f func(t T, y Vec2[T]) Vec2[T], t T, y Vec2[T], dt T) Vec2[T] {
+	h := dt / 2
+	k1 := f(t, y)
+	k2 := f(t+h, y.Add(k1.Scale(h)))
+	k3 := f(t+h, y.Add(k2.Scale(h)))
+	k4 := f(t+dt, y.Add(k3.Scale(dt)))
+
+	return y.Add(k1.Add(k2.Add(k3).Scale(2)).Add(k4).Scale(dt / 6))
+}
+
+// RK4Step3 is RK4Step for a three-dimensional state.
+func RK4Step3[T Float](f func(t T, y Vec3[T]) Vec3[T], t T, y Vec3[T], dt T) Vec3[T] {
+	h := dt / 2
+	k1 := f(t, y)
+	k2 := f(t+h, y.Add(k1.Scale(h)))
+	k3 := f(t+h, y.Add(k2.Scale(h)))
+	k4 := f(t+dt, y.Add(k3.Scale(dt)))
+
+	return y.Add(k1.Add(k2.Add(k3).Scale(2)).Add(k4).Scale(dt / 6))
+}
+
+// RK4StepN advances the system y' = f(t, y) of any size by one RK4 step in
+// place. f must write the derivative at (t, y) to dydt without retaining
+// either slice. work is scratch space of at least 3·len(y) elements, so a
+// step allocates nothing.
+//
+// It panics with ErrLengthMismatch if work is too short.
+func RK4StepN[T Float](f func(t T, y, dydt []T), t T, y []T, dt T, work []T) {
+	n := len(y)
+	if len(work) < 3*n {
+		panicLengthMismatch("RK4StepN")
+	}
+
+	acc, k, tmp := work[:n], work[n:2*n], work[2*n:3*n]
+	h := dt / 2
+
+	f(t, y, acc)
+
+	for i := range n {
+		tmp[i] = y[i] + h*acc[i]
+	}
+
+	f(t+h, tmp, k)
+
+	for i := range n {
+		acc[i] += 2 * k[i]
+		tmp[i] = y[i] + h*k[i]
+	}
+
+	f(t+h, tmp, k)
+
+	for i := range n {
+		acc[i] += 2 * k[i]
+		tmp[i] = y[i] + dt*k[i]
+	}
+
+	f(t+dt, tmp, k)
+
+	for i := range n {
+		y[i] += dt / 6 * (acc[i] + k[i])
+	}
+}
@@ -0,0 +1,106 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRK4StepDecay(t *testing.T) {
+	t.Parallel()
+
+	// y' = -y from y(0) = 1; RK4 is fourth order, so halving dt divides the
+	// error at t = 1 by about 16.
+	solve := func(steps int) float64 {
+		dt := 1 / float64(steps)
+		y := 1.0
+
+		for i := range steps {
+			y = RK4Step(func(_, y float64) float64 { return -y }, float64(i)*dt, y, dt)
+		}
+
+		return math.Abs(y - math.Exp(-1))
+	}
+
+	coarse, fine := solve(10), solve(20)
+	if coarse > 1e-6 || coarse/fine < 14 || coarse/fine > 18 {
+		t.Fatalf("errors %.3g (dt 0.1) and %.3g (dt 0.05)", coarse, fine)
+	}
+}
+
+func TestRK4StepTimeDependent(t *testing.T) {
+	t.Parallel()
+
+	// y' = cos t integrates to sin t; each step is exact up to O(dt^5).
+	y, dt := 0.0, 0.01
+	for i := range 150 {
+		y = RK4Step(func(t, _ float64) float64 { return FastCosPrec(t, PrecisionHigh) }, float64(i)*dt, y, dt)
+	}
+
+	if want := math.Sin(1.5); math.Abs(y-want) > 1e-9 {
+		t.Fatalf("∫cos = %.12g, want %.12g", y, want)
+	}
+}
+
+func TestRK4StepOscillator(t *testing.T) {
+	t.Parallel()
+
+	// x'' = -x over one period returns to the start.
+	osc := func(_ float64, y Vec2[float64]) Vec2[float64] { return Vec2[float64]{y[1], -y[0]} }
+
+	y := Vec2[float64]{1, 0}
+	dt := 2 * math.Pi / 1000
+
+	for i := range 1000 {
+		y = RK4Step2(osc, float64(i)*dt, y, dt)
+	}
+
+	if math.Abs(y[0]-1) > 1e-9 || math.Abs(y[1]) > 1e-9 {
+		t.Fatalf("after one period y = %v", y)
+	}
+}
+
+func TestRK4StepVariantsAgree(t *testing.T) {
+	t.Parallel()
+
+	// A rotation about z plus decay along it, stepped by RK4Step3 and RK4StepN.
+	f3 := func(_ float64, y Vec3[float64]) Vec3[float64] { return Vec3[float64]{-y[1], y[0], -0.5 * y[2]} }
+	fn := func(_ float64, y, dy []float64) { dy[0], dy[1], dy[2] = -y[1], y[0], -0.5*y[2] }
+
+	y3 := Vec3[float64]{1, 2, 3}
+	yn := []float64{1, 2, 3}
+	work := make([]float64, 9)
+
+	for i := range 50 {
+		ti := float64(i) * 0.1
+		y3 = RK4Step3(f3, ti, y3, 0.1)
+		RK4StepN(fn, ti, yn, 0.1, work)
+	}
+
+	for k := range 3 {
+		if math.Abs(y3[k]-yn[k]) > 1e-12 {
+			t.Fatalf("RK4Step3 %v, RK4StepN %v", y3, yn)
+		}
+	}
+
+	if want := 3 * math.Exp(-2.5); math.Abs(yn[2]-want) > 1e-6 {
+		t.Fatalf("z = %.9g, want %.9g", yn[2], want)
+	}
+}
+
+func TestRK4StepNAllocs(t *testing.T) {
+	y := []float64{1, 0}
+	work := make([]float64, 6)
+	f := func(_ float64, y, dy []float64) { dy[0], dy[1] = y[1], -y[0] }
+
+	if n := testing.AllocsPerRun(100, func() { RK4StepN(f, 0, y, 0.01, work) }); n != 0 {
+		t.Fatalf("RK4StepN allocates %v times per step", n)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("RK4StepN did not panic on short work")
+		}
+	}()
+
+	RK4StepN(f, 0, y, 0.01, work[:5])
+}
@@ -1,5 +1,10 @@
 package approx
 
+import (
+	"fmt"
+	"strconv"
+)
+
 // Precision controls the accuracy/speed tradeoff of approximation routines.
 //
 // PrecisionBalanced is the recommended default.
@@ -44,6 +49,56 @@ func (p Precision) IsValid() bool {
 	}
 }
 
+// MarshalText encodes p as its String form, so contracts and configs
+// serialize tiers by name.
+func (p Precision) MarshalText() ([]byte, error) {
+	if !p.IsValid() {
+		return nil, fmt.Errorf("approx: marshal Precision(%d): %w", int(p), ErrDomainError)
+	}
+
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText decodes a name produced by MarshalText. It also accepts the
+// decimal value of a tier, the form used before Precision had MarshalText.
+func (p *Precision) UnmarshalText(text []byte) error {
+	for q := PrecisionAuto; q <= PrecisionHigh; q++ {
+		if q.String() == string(text) {
+			*p = q
+
+			return nil
+		}
+	}
+
+	if n, err := strconv.Atoi(string(text)); err == nil && Precision(n).IsValid() {
+		*p = Precision(n)
+
+		return nil
+	}
+
+	return fmt.Errorf("approx: unknown precision %q: %w", text, ErrDomainError)
+}
+
+// UnmarshalJSON decodes a JSON string through UnmarshalText and, for JSON
+// written before Precision had MarshalText, a bare tier number. Null leaves
+// p unchanged.
+func (p *Precision) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		name, err := strconv.Unquote(string(data))
+		if err != nil {
+			return fmt.Errorf("approx: unknown precision %s: %w", data, ErrDomainError)
+		}
+
+		data = []byte(name)
+	}
+
+	return p.UnmarshalText(data)
+}
+
 func normalizePrecision(p Precision) Precision {
 	if p == PrecisionAuto {
 		return PrecisionBalanced
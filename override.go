@@ -0,0 +1,39 @@
+package approx
+
+import iapprox "github.com/meko-christian/algo-approx/internal/approx"
+
+// Seeds replaces the innermost kernels of FastSqrt and FastInvSqrt: the
+// initial estimates that their Babylonian and Newton iterations refine. A
+// platform intrinsic such as RSQRTSS or VRSQRT14PD can be plugged in as the
+// InvSqrt seed without forking the package.
+//
+// Seeds are only called for positive, finite inputs. Zero, negative, NaN and
+// infinite arguments are handled before the seed is consulted, so an override
+// cannot change edge-case results; it only changes the accuracy the fixed
+// number of iterations per tier reaches. A nil field keeps the built-in
+// bit-trick seed.
+type Seeds struct {
+	InvSqrt32 func(float32) float32
+	InvSqrt64 func(float64) float64
+	Sqrt32    func(float32) float32
+	Sqrt64    func(float64) float64
+}
+
+// SetSeeds installs s and returns the previously installed seeds.
+//
+// Overrides take effect only in builds using the approxoverride build tag. In
+// those builds the seed is called through a function variable that is
+// checked for nil on every call, a branch that always goes the same way;
+// without the tag the check is compiled out and SetSeeds only records s.
+//
+// The seeds are read without synchronization: install them from an init
+// function or otherwise before the package is used concurrently.
+func SetSeeds(s Seeds) Seeds {
+	prev := iapprox.SetSeeds(iapprox.Seeds(s))
+
+	return Seeds(prev)
+}
+
+// OverridesEnabled reports whether the package was built with the
+// approxoverride tag, i.e. whether SetSeeds affects results.
+func OverridesEnabled() bool { return iapprox.OverridesEnabled() }
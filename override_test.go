@@ -0,0 +1,102 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+// brokenSeeds return nonsense for every input; edge cases must still be
+// handled before they are consulted.
+func brokenSeeds() Seeds {
+	return Seeds{
+		InvSqrt32: func(float32) float32 { return float32(math.NaN()) },
+		InvSqrt64: func(float64) float64 { return -1 },
+		Sqrt32:    func(float32) float32 { return 0 },
+		Sqrt64:    func(float64) float64 { return float64(math.Inf(1)) },
+	}
+}
+
+//nolint:paralleltest // mutates the global seed table
+func TestSetSeedsReturnsPrevious(t *testing.T) {
+	t.Cleanup(func() { SetSeeds(Seeds{}) })
+
+	if prev := SetSeeds(brokenSeeds()); prev.InvSqrt64 != nil || prev.Sqrt32 != nil {
+		t.Fatalf("default seeds must be reported as nil")
+	}
+
+	if prev := SetSeeds(Seeds{}); prev.InvSqrt64 == nil || prev.Sqrt64 == nil {
+		t.Fatalf("SetSeeds must return the installed seeds")
+	}
+}
+
+//nolint:paralleltest // mutates the global seed table
+func TestSeedOverridePreservesEdgeCases(t *testing.T) {
+	t.Cleanup(func() { SetSeeds(Seeds{}) })
+	SetSeeds(brokenSeeds())
+
+	inf := math.Inf(1)
+
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		for _, tc := range []struct {
+			x, sqrt, invSqrt float64
+		}{
+			{0, 0, inf},
+			{-1, math.NaN(), math.NaN()},
+			{inf, inf, 0},
+			{math.NaN(), math.NaN(), math.NaN()},
+		} {
+			if got := FastSqrtPrec(tc.x, prec); !sameFloat(got, tc.sqrt) {
+				t.Fatalf("FastSqrtPrec(%g, %v) = %g, want %g", tc.x, prec, got, tc.sqrt)
+			}
+
+			if got := FastInvSqrtPrec(tc.x, prec); !sameFloat(got, tc.invSqrt) {
+				t.Fatalf("FastInvSqrtPrec(%g, %v) = %g, want %g", tc.x, prec, got, tc.invSqrt)
+			}
+
+			if got := FastSqrtPrec(float32(tc.x), prec); !sameFloat(float64(got), tc.sqrt) {
+				t.Fatalf("float32 FastSqrtPrec(%g, %v) = %g, want %g", tc.x, prec, got, tc.sqrt)
+			}
+
+			if got := FastInvSqrtPrec(float32(tc.x), prec); !sameFloat(float64(got), tc.invSqrt) {
+				t.Fatalf("float32 FastInvSqrtPrec(%g, %v) = %g, want %g", tc.x, prec, got, tc.invSqrt)
+			}
+		}
+	}
+}
+
+//nolint:paralleltest // mutates the global seed table
+func TestSeedOverrideTakesEffect(t *testing.T) {
+	t.Cleanup(func() { SetSeeds(Seeds{}) })
+
+	const x = 7.0
+
+	builtin := FastInvSqrtPrec(x, PrecisionFast)
+
+	SetSeeds(Seeds{
+		InvSqrt64: func(v float64) float64 { return 1 / math.Sqrt(v) },
+		Sqrt64:    math.Sqrt,
+	})
+
+	got := FastInvSqrtPrec(x, PrecisionFast)
+
+	if !OverridesEnabled() {
+		if got != builtin {
+			t.Fatalf("without approxoverride seeds must be ignored: %g != %g", got, builtin)
+		}
+
+		return
+	}
+
+	// Newton and Babylonian steps keep an exact seed exact.
+	if want := 1 / math.Sqrt(x); math.Abs(got-want) > 1e-15 {
+		t.Fatalf("exact seed: FastInvSqrtPrec = %g, want %g", got, want)
+	}
+
+	if got, want := FastSqrtPrec(x, PrecisionFast), math.Sqrt(x); math.Abs(got-want) > 1e-15 {
+		t.Fatalf("exact seed: FastSqrtPrec = %g, want %g", got, want)
+	}
+}
+
+func sameFloat(a, b float64) bool {
+	return a == b || (math.IsNaN(a) && math.IsNaN(b))
+}
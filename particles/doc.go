@@ -0,0 +1,15 @@
+// Package particles provides update kernels for particle systems stored as
+// a struct of arrays: one slice per component (X, Y, VX, ...) rather than a
+// slice of particle structs.
+//
+// The layout is what lets the batch kernels run at full speed. Every kernel
+// streams through a few contiguous slices with unit stride and no
+// per-particle branching, so loads are sequential, bounds checks are hoisted
+// and the compiler keeps the loop body in registers; a []Particle of mixed
+// fields touches every cache line for the one field it updates. Kernels take
+// the component slices directly, so callers keep any layout of their own,
+// and System2 bundles the common 2D case.
+//
+// Kernels write into caller-provided slices, do not allocate and panic with
+// approx.ErrLengthMismatch on slices of different lengths.
+package particles
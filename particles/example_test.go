@@ -0,0 +1,40 @@
+package particles_test
+
+import (
+	"fmt"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/particles"
+)
+
+func ExampleSystem2() {
+	s := particles.NewSystem2[float32](3)
+	for i := range s.Len() {
+		s.Angle[i] = float32(i) * 0.5
+	}
+
+	// One frame: accelerate along the headings, then move.
+	s.Thrust(10, 0.1, approx.PrecisionFast)
+	s.Step(0.1)
+
+	speeds := make([]float32, s.Len())
+	s.Speeds(speeds, approx.PrecisionFast)
+
+	fmt.Printf("x %.3f\ny %.3f\nspeed %.2f\n", s.X, s.Y, speeds)
+	// Output:
+	// x [0.100 0.088 0.054]
+	// y [0.000 0.048 0.084]
+	// speed [1.00 1.00 1.00]
+}
+
+func ExampleIntegrate() {
+	// Components live in their own slices, so one axis updates as one
+	// contiguous stream.
+	x := []float64{0, 10, 20}
+	vx := []float64{1, 2, 3}
+
+	particles.Integrate(x, vx, 0.5)
+	fmt.Println(x)
+	// Output:
+	// [0.5 11 21.5]
+}
@@ -0,0 +1,125 @@
+package particles
+
+import (
+	"fmt"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+// Integrate advances one component of every particle by an explicit Euler
+// step, pos[i] += vel[i]·dt. Call it once per axis.
+// It panics if len(pos) != len(vel).
+func Integrate[T approx.Float](pos, vel []T, dt T) {
+	if len(pos) != len(vel) {
+		panicLengthMismatch("Integrate")
+	}
+
+	for i, v := range vel {
+		pos[i] += v * dt
+	}
+}
+
+// Speeds2 sets dst[i] to the length of (vx[i], vy[i]) using the default
+// precision.
+func Speeds2[T approx.Float](dst, vx, vy []T) { Speeds2Prec(dst, vx, vy, approx.PrecisionAuto) }
+
+// Speeds2Prec is Speeds2 with the requested precision. Each length is
+// s²·FastInvSqrt(s²), which needs no division; resting particles get 0.
+// It panics if the slices differ in length.
+func Speeds2Prec[T approx.Float](dst, vx, vy []T, prec approx.Precision) {
+	if len(dst) != len(vx) || len(dst) != len(vy) {
+		panicLengthMismatch("Speeds2")
+	}
+
+	for i := range dst {
+		dst[i] = length(vx[i]*vx[i]+vy[i]*vy[i], prec)
+	}
+}
+
+// Speeds3 sets dst[i] to the length of (vx[i], vy[i], vz[i]) using the
+// default precision.
+func Speeds3[T approx.Float](dst, vx, vy, vz []T) { Speeds3Prec(dst, vx, vy, vz, approx.PrecisionAuto) }
+
+// Speeds3Prec is Speeds3 with the requested precision; see Speeds2Prec.
+// It panics if the slices differ in length.
+func Speeds3Prec[T approx.Float](dst, vx, vy, vz []T, prec approx.Precision) {
+	if len(dst) != len(vx) || len(dst) != len(vy) || len(dst) != len(vz) {
+		panicLengthMismatch("Speeds3")
+	}
+
+	for i := range dst {
+		dst[i] = length(vx[i]*vx[i]+vy[i]*vy[i]+vz[i]*vz[i], prec)
+	}
+}
+
+func length[T approx.Float](sq T, prec approx.Precision) T {
+	if sq > 0 {
+		return sq * approx.FastInvSqrtPrec(sq, prec)
+	}
+
+	return 0
+}
+
+// Normalize2 scales every (vx[i], vy[i]) to unit length in place using the
+// default precision.
+func Normalize2[T approx.Float](vx, vy []T) { Normalize2Prec(vx, vy, approx.PrecisionAuto) }
+
+// Normalize2Prec is Normalize2 with the requested precision. Zero vectors stay
+// zero. It panics if len(vx) != len(vy).
+func Normalize2Prec[T approx.Float](vx, vy []T, prec approx.Precision) {
+	if len(vx) != len(vy) {
+		panicLengthMismatch("Normalize2")
+	}
+
+	for i := range vx {
+		if sq := vx[i]*vx[i] + vy[i]*vy[i]; sq > 0 {
+			inv := approx.FastInvSqrtPrec(sq, prec)
+			vx[i] *= inv
+			vy[i] *= inv
+		}
+	}
+}
+
+// Headings sets (dx[i], dy[i]) to the unit direction (cos, sin) of angle[i]
+// in radians using the default precision, for sprites and emitters that
+// store an orientation angle.
+func Headings[T approx.Float](dx, dy, angle []T) { HeadingsPrec(dx, dy, angle, approx.PrecisionAuto) }
+
+// HeadingsPrec is Headings with the requested precision. Each pair comes from
+// one FastSinCosPrec call, so the two components share a range reduction.
+// It panics if the slices differ in length.
+func HeadingsPrec[T approx.Float](dx, dy, angle []T, prec approx.Precision) {
+	if len(dx) != len(angle) || len(dy) != len(angle) {
+		panicLengthMismatch("Headings")
+	}
+
+	for i, a := range angle {
+		dy[i], dx[i] = approx.FastSinCosPrec(a, prec)
+	}
+}
+
+// Thrust adds accel·dt along each heading angle[i] to (vx[i], vy[i]) using the
+// default precision.
+func Thrust[T approx.Float](vx, vy, angle []T, accel, dt T) {
+	ThrustPrec(vx, vy, angle, accel, dt, approx.PrecisionAuto)
+}
+
+// ThrustPrec is Thrust with the requested precision. It panics if the slices
+// differ in length.
+func ThrustPrec[T approx.Float](vx, vy, angle []T, accel, dt T, prec approx.Precision) {
+	if len(vx) != len(angle) || len(vy) != len(angle) {
+		panicLengthMismatch("Thrust")
+	}
+
+	dv := accel * dt
+
+	for i, a := range angle {
+		s, c := approx.FastSinCosPrec(a, prec)
+		vx[i] += c * dv
+		vy[i] += s * dv
+	}
+}
+
+func panicLengthMismatch(fn string) {
+	panic(fmt.Errorf("particles: %s: %w", fn, approx.ErrLengthMismatch))
+}
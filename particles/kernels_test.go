@@ -0,0 +1,180 @@
+package particles
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func TestIntegrate(t *testing.T) {
+	t.Parallel()
+
+	pos := []float64{0, 1, -2}
+	Integrate(pos, []float64{1, -2, 0.5}, 0.5)
+
+	want := []float64{0.5, 0, -1.75}
+	for i := range pos {
+		if pos[i] != want[i] {
+			t.Errorf("pos[%d] = %v, want %v", i, pos[i], want[i])
+		}
+	}
+}
+
+func TestSpeeds(t *testing.T) {
+	t.Parallel()
+
+	vx := []float64{3, 0, -1, 1e-3}
+	vy := []float64{4, 0, 2, 0}
+	vz := []float64{12, 0, -2, 0}
+
+	d2 := make([]float64, len(vx))
+	d3 := make([]float64, len(vx))
+	Speeds2Prec(d2, vx, vy, approx.PrecisionHigh)
+	Speeds3Prec(d3, vx, vy, vz, approx.PrecisionHigh)
+
+	for i := range vx {
+		want2 := math.Hypot(vx[i], vy[i])
+		want3 := math.Sqrt(vx[i]*vx[i] + vy[i]*vy[i] + vz[i]*vz[i])
+
+		if math.Abs(d2[i]-want2) > 1e-9*want2 {
+			t.Errorf("Speeds2[%d] = %v, want %v", i, d2[i], want2)
+		}
+
+		if math.Abs(d3[i]-want3) > 1e-9*want3 {
+			t.Errorf("Speeds3[%d] = %v, want %v", i, d3[i], want3)
+		}
+	}
+}
+
+func TestNormalize2(t *testing.T) {
+	t.Parallel()
+
+	vx := []float32{3, 0, -5}
+	vy := []float32{4, 0, 0}
+	Normalize2Prec(vx, vy, approx.PrecisionBalanced)
+
+	if vx[1] != 0 || vy[1] != 0 {
+		t.Errorf("zero vector became (%v, %v)", vx[1], vy[1])
+	}
+
+	for _, i := range []int{0, 2} {
+		if l := math.Hypot(float64(vx[i]), float64(vy[i])); math.Abs(l-1) > 1e-5 {
+			t.Errorf("|v[%d]| = %v, want 1", i, l)
+		}
+	}
+}
+
+func TestHeadingsAndThrust(t *testing.T) {
+	t.Parallel()
+
+	angle := []float64{0, math.Pi / 2, 2.5, -7}
+	dx := make([]float64, len(angle))
+	dy := make([]float64, len(angle))
+	HeadingsPrec(dx, dy, angle, approx.PrecisionHigh)
+
+	vx := make([]float64, len(angle))
+	vy := make([]float64, len(angle))
+	ThrustPrec(vx, vy, angle, 4, 0.5, approx.PrecisionHigh)
+
+	for i, a := range angle {
+		if math.Abs(dx[i]-math.Cos(a)) > 1e-9 || math.Abs(dy[i]-math.Sin(a)) > 1e-9 {
+			t.Errorf("heading(%v) = (%v, %v)", a, dx[i], dy[i])
+		}
+
+		if vx[i] != 2*dx[i] || vy[i] != 2*dy[i] {
+			t.Errorf("thrust(%v) = (%v, %v), want 2·heading", a, vx[i], vy[i])
+		}
+	}
+}
+
+func TestLengthMismatch(t *testing.T) {
+	t.Parallel()
+
+	short, long := make([]float64, 2), make([]float64, 3)
+
+	for name, f := range map[string]func(){
+		"Integrate":  func() { Integrate(short, long, 1) },
+		"Speeds2":    func() { Speeds2(short, short, long) },
+		"Speeds3":    func() { Speeds3(short, short, short, long) },
+		"Normalize2": func() { Normalize2(short, long) },
+		"Headings":   func() { Headings(short, long, short) },
+		"Thrust":     func() { Thrust(short, short, long, 1, 1) },
+	} {
+		func() {
+			defer func() {
+				err, _ := recover().(error)
+				if !errors.Is(err, approx.ErrLengthMismatch) {
+					t.Errorf("%s: recovered %v, want ErrLengthMismatch", name, err)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestKernelsDoNotAllocate(t *testing.T) {
+	s := NewSystem2[float32](256)
+	dst := make([]float32, s.Len())
+
+	allocs := testing.AllocsPerRun(10, func() {
+		s.Thrust(1, 0.016, approx.PrecisionFast)
+		s.Step(0.016)
+		s.Speeds(dst, approx.PrecisionFast)
+	})
+	if allocs != 0 {
+		t.Errorf("got %v allocs per frame, want 0", allocs)
+	}
+}
+
+// particle is the array-of-structs layout BenchmarkStep_AoS compares against.
+type particle struct {
+	x, y, vx, vy, angle, spin float32
+}
+
+func BenchmarkStep_SoA(b *testing.B) {
+	s := NewSystem2[float32](4096)
+	for i := range s.Len() {
+		s.VX[i], s.VY[i] = 1, float32(i%7)
+	}
+
+	b.SetBytes(int64(s.Len() * 24))
+
+	for b.Loop() {
+		s.Step(0.016)
+	}
+}
+
+func BenchmarkStep_AoS(b *testing.B) {
+	ps := make([]particle, 4096)
+	for i := range ps {
+		ps[i].vx, ps[i].vy = 1, float32(i%7)
+	}
+
+	b.SetBytes(int64(len(ps) * 24))
+
+	for b.Loop() {
+		for i := range ps {
+			p := &ps[i]
+			p.x += p.vx * 0.016
+			p.y += p.vy * 0.016
+			p.angle += p.spin * 0.016
+		}
+	}
+}
+
+func BenchmarkSpeeds2_Float32(b *testing.B) {
+	s := NewSystem2[float32](4096)
+	for i := range s.Len() {
+		s.VX[i], s.VY[i] = 1, float32(i%7)
+	}
+
+	dst := make([]float32, s.Len())
+
+	b.SetBytes(int64(s.Len() * 4))
+
+	for b.Loop() {
+		s.Speeds(dst, approx.PrecisionFast)
+	}
+}
@@ -0,0 +1,47 @@
+package particles
+
+import approx "github.com/meko-christian/algo-approx"
+
+// System2 is a 2D particle system in struct-of-arrays form: particle i is
+// (X[i], Y[i]) moving at (VX[i], VY[i]), oriented at Angle[i] radians and
+// turning at Spin[i] radians per second. All slices have the same length.
+type System2[T approx.Float] struct {
+	X, Y, VX, VY, Angle, Spin []T
+}
+
+// NewSystem2 returns a system of n particles at rest at the origin, with the
+// components carved from one allocation so they sit next to each other in
+// memory.
+func NewSystem2[T approx.Float](n int) *System2[T] {
+	buf := make([]T, 6*n)
+	part := func(k int) []T { return buf[k*n : (k+1)*n : (k+1)*n] }
+
+	return &System2[T]{X: part(0), Y: part(1), VX: part(2), VY: part(3), Angle: part(4), Spin: part(5)}
+}
+
+// Len returns the number of particles.
+func (s *System2[T]) Len() int { return len(s.X) }
+
+// Step advances positions by the velocities and angles by the spins over dt
+// seconds. It panics if the components differ in length.
+func (s *System2[T]) Step(dt T) {
+	Integrate(s.X, s.VX, dt)
+	Integrate(s.Y, s.VY, dt)
+	Integrate(s.Angle, s.Spin, dt)
+}
+
+// Speeds sets dst[i] to the speed of particle i using the requested
+// precision; see Speeds2Prec.
+func (s *System2[T]) Speeds(dst []T, prec approx.Precision) { Speeds2Prec(dst, s.VX, s.VY, prec) }
+
+// Headings sets (dx[i], dy[i]) to the unit direction of particle i's angle
+// using the requested precision; see HeadingsPrec.
+func (s *System2[T]) Headings(dx, dy []T, prec approx.Precision) {
+	HeadingsPrec(dx, dy, s.Angle, prec)
+}
+
+// Thrust accelerates every particle by accel along its heading for dt
+// seconds using the requested precision; see ThrustPrec.
+func (s *System2[T]) Thrust(accel, dt T, prec approx.Precision) {
+	ThrustPrec(s.VX, s.VY, s.Angle, accel, dt, prec)
+}
@@ -0,0 +1,52 @@
+package particles
+
+import (
+	"math"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func TestNewSystem2(t *testing.T) {
+	t.Parallel()
+
+	s := NewSystem2[float64](3)
+	if s.Len() != 3 {
+		t.Fatalf("Len = %d, want 3", s.Len())
+	}
+
+	// The components share a buffer but must not overrun each other.
+	s.X = append(s.X, 1)
+	if s.Y[0] != 0 {
+		t.Error("appending to X overwrote Y")
+	}
+}
+
+func TestSystem2_Step(t *testing.T) {
+	t.Parallel()
+
+	s := NewSystem2[float64](2)
+	s.VX[0], s.VY[1], s.Spin[1] = 2, -1, math.Pi
+
+	for range 10 {
+		s.Step(0.1)
+	}
+
+	if math.Abs(s.X[0]-2) > 1e-12 || math.Abs(s.Y[1]+1) > 1e-12 || math.Abs(s.Angle[1]-math.Pi) > 1e-12 {
+		t.Errorf("after 1 s: X=%v Y=%v Angle=%v", s.X, s.Y, s.Angle)
+	}
+
+	speeds := make([]float64, 2)
+	s.Speeds(speeds, approx.PrecisionHigh)
+
+	if math.Abs(speeds[0]-2) > 1e-9 || math.Abs(speeds[1]-1) > 1e-9 {
+		t.Errorf("speeds = %v, want [2 1]", speeds)
+	}
+
+	dx, dy := make([]float64, 2), make([]float64, 2)
+	s.Headings(dx, dy, approx.PrecisionHigh)
+
+	if math.Abs(dx[1]+1) > 1e-9 || math.Abs(dy[1]) > 1e-9 {
+		t.Errorf("heading after a half turn = (%v, %v), want (-1, 0)", dx[1], dy[1])
+	}
+}
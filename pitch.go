@@ -0,0 +1,57 @@
+package approx
+
+import iapprox "github.com/meko-christian/algo-approx/internal/approx"
+
+// FastRatioToCents converts a frequency ratio to cents, 1200·log2(r), using
+// the default precision.
+//
+// A ratio of 0 yields -Inf and negative ratios yield NaN, matching log2.
+func FastRatioToCents[T Float](r T) T { return FastRatioToCentsPrec(r, PrecisionAuto) }
+
+// FastRatioToCentsPrec converts a frequency ratio to cents using the requested precision.
+func FastRatioToCentsPrec[T Float](r T, prec Precision) T {
+	checkPositive("FastRatioToCents", r, prec)
+
+	return 1200 * iapprox.Log2(r, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastRatioToCents32(r float32) float32 { return FastRatioToCents[float32](r) }
+func FastRatioToCents64(r float64) float64 { return FastRatioToCents[float64](r) }
+
+// FastCentsToRatio converts cents to a frequency ratio, 2^(c/1200), using the
+// default precision. Whole octaves (multiples of 1200 cents) are exact.
+func FastCentsToRatio[T Float](c T) T { return FastCentsToRatioPrec(c, PrecisionAuto) }
+
+// FastCentsToRatioPrec converts cents to a frequency ratio using the requested precision.
+func FastCentsToRatioPrec[T Float](c T, prec Precision) T {
+	return iapprox.Exp2(c/1200, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastCentsToRatio32(c float32) float32 { return FastCentsToRatio[float32](c) }
+func FastCentsToRatio64(c float64) float64 { return FastCentsToRatio[float64](c) }
+
+// FastRatioToCentsInto stores FastRatioToCentsPrec(src[i], prec) in dst[i].
+// It panics with ErrLengthMismatch if the slices differ in length.
+func FastRatioToCentsInto[T Float](dst, src []T, prec Precision) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("FastRatioToCentsInto")
+	}
+
+	p := iapprox.Precision(normalizePrecision(prec))
+	for i, r := range src {
+		dst[i] = 1200 * iapprox.Log2(r, p)
+	}
+}
+
+// FastCentsToRatioInto stores FastCentsToRatioPrec(src[i], prec) in dst[i].
+// It panics with ErrLengthMismatch if the slices differ in length.
+func FastCentsToRatioInto[T Float](dst, src []T, prec Precision) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("FastCentsToRatioInto")
+	}
+
+	p := iapprox.Precision(normalizePrecision(prec))
+	for i, c := range src {
+		dst[i] = iapprox.Exp2(c/1200, p)
+	}
+}
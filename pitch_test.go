@@ -0,0 +1,71 @@
+package approx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+func TestFastRatioToCents(t *testing.T) {
+	t.Parallel()
+
+	cases := map[float64]float64{
+		1:                   0,
+		2:                   1200,
+		0.5:                 -1200,
+		1.5:                 1200 * math.Log2(1.5),
+		math.Pow(2, 1.0/12): 100,
+		440.0 / 441:         1200 * math.Log2(440.0/441),
+	}
+	for r, want := range cases {
+		if got := FastRatioToCentsPrec(r, PrecisionHigh); math.Abs(got-want) > 1e-4 {
+			t.Errorf("FastRatioToCents(%v) = %v, want %v", r, got, want)
+		}
+	}
+
+	if !math.IsInf(FastRatioToCents(0.0), -1) {
+		t.Errorf("FastRatioToCents(0) should be -Inf")
+	}
+
+	if !math.IsNaN(FastRatioToCents(-1.0)) {
+		t.Errorf("FastRatioToCents(-1) should be NaN")
+	}
+}
+
+func TestFastCentsToRatio(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []float64{-2400, -1200, 0, 1200, 3600} {
+		if got, want := FastCentsToRatio(c), math.Exp2(c/1200); got != want {
+			t.Errorf("FastCentsToRatio(%v) = %v, want exactly %v", c, got, want)
+		}
+	}
+
+	for _, c := range []float64{-700, -3.5, 1, 100, 701.955} {
+		if got, want := FastCentsToRatio(c), math.Exp2(c/1200); !testutil.Close(got, want, 5e-6) {
+			t.Errorf("FastCentsToRatio(%v) = %v, want %v", c, got, want)
+		}
+	}
+}
+
+func TestCentsBatchMatchesScalar(t *testing.T) {
+	t.Parallel()
+
+	src := []float32{0.25, 1, 1.059463, 3}
+	cents := make([]float32, len(src))
+	back := make([]float32, len(src))
+
+	FastRatioToCentsInto(cents, src, PrecisionBalanced)
+	FastCentsToRatioInto(back, cents, PrecisionBalanced)
+
+	for i := range src {
+		if cents[i] != FastRatioToCentsPrec(src[i], PrecisionBalanced) {
+			t.Fatalf("batch cents[%d] = %v disagrees with scalar", i, cents[i])
+		}
+
+		if !testutil.Close(float64(back[i]), float64(src[i]), 1e-5) {
+			t.Fatalf("round trip %v -> %v -> %v", src[i], cents[i], back[i])
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package approx
+
+import iapprox "github.com/meko-christian/algo-approx/internal/approx"
+
+// Pow10 returns 10^k rounded to the nearest float64, by a table lookup: the
+// same tables scale FastRoundDecimal, FastRoundToDigits and the decimal
+// exponent estimates. Entries for 0 <= k <= 22 are exact, and every entry
+// is correctly rounded, unlike math.Pow10, which multiplies two table
+// entries and can be an ulp off. It returns +Inf for k > 308 and 0 for
+// k < -323.
+func Pow10(k int) float64 { return iapprox.Pow10(k) }
+
+// Pow2 returns 2^k exactly, built from its bits in the normal range, which
+// is cheaper than math.Ldexp(1, k), and down to the subnormal 2^-1074. It
+// returns +Inf for k > 1023 and 0 for k < -1074.
+func Pow2(k int) float64 { return iapprox.Pow2(k) }
@@ -0,0 +1,23 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPow10Pow2(t *testing.T) {
+	t.Parallel()
+
+	// math.Pow10 multiplies two table entries; the table here does not.
+	if got := Pow10(-286); got != 1e-286 {
+		t.Errorf("Pow10(-286) = %g, want the nearest double 1e-286", got)
+	}
+
+	if Pow10(22) != 1e22 || Pow10(309) != math.Inf(1) || Pow10(-324) != 0 {
+		t.Error("Pow10 range ends")
+	}
+
+	if Pow2(-1074) != math.SmallestNonzeroFloat64 || Pow2(1023) != 0x1p1023 || Pow2(1024) != math.Inf(1) || Pow2(-1075) != 0 {
+		t.Error("Pow2 range ends")
+	}
+}
@@ -0,0 +1,114 @@
+package approx
+
+import "math"
+
+// TierInfo describes what one precision tier of a Function costs and
+// guarantees, so a scheduler can trade quality for time programmatically,
+// for example a renderer picking cheaper math for distant geometry.
+type TierInfo struct {
+	Function Function
+
+	// Precision is the tier that runs: PrecisionAuto and tiers without a
+	// kernel of their own resolve as described for Metadata.Tiers.
+	Precision Precision
+
+	// Terms is the length of the kernel: series terms, or Newton iterations
+	// for Sqrt and InvSqrt.
+	Terms int
+
+	// Cost is the time per call relative to the function's Fast tier, which
+	// is 1, measured on amd64 with approx-cli bench and rounded to a tenth.
+	Cost float64
+
+	// MaxError is the bound of the accuracy contract for the tier, within
+	// the contract domain; see the accuracy package. Relative reports
+	// whether it bounds the relative error rather than the absolute one.
+	MaxError float64
+	Relative bool
+
+	// Digits is -log10(MaxError): significant digits for a relative bound,
+	// correct decimals for an absolute one.
+	Digits float64
+}
+
+// PrecisionInfo returns the kernel length, relative cost and contracted
+// accuracy of fn at prec. An unknown fn returns the zero TierInfo.
+func PrecisionInfo(fn Function, prec Precision) TierInfo {
+	if !fn.valid() {
+		return TierInfo{} //nolint:exhaustruct
+	}
+
+	prec = resolveTier(fn, prec)
+	k := &tierKernels[fn]
+	b := &contractBounds[fn]
+	i := int(prec) - 1
+
+	return TierInfo{
+		Function:  fn,
+		Precision: prec,
+		Terms:     k.terms[i],
+		Cost:      k.cost[i],
+		MaxError:  b.maxError[i],
+		Relative:  b.relative,
+		Digits:    -math.Log10(b.maxError[i]),
+	}
+}
+
+// resolveTier maps prec to the tier of fn whose kernel runs for it.
+func resolveTier(fn Function, prec Precision) Precision {
+	prec = normalizePrecision(prec)
+
+	tiers := functions[fn].tiers
+	if tiers == nil {
+		return prec
+	}
+
+	for _, t := range tiers {
+		if t >= prec {
+			return t
+		}
+	}
+
+	return tiers[len(tiers)-1]
+}
+
+// contractBound is an entry of the generated contractBounds. The domain
+// is [lo, hi], sampled logarithmically when log is set.
+type contractBound struct {
+	maxError [3]float64
+	relative bool
+	lo, hi   float64
+	log      bool
+}
+
+// tierKernel holds the kernel length and measured relative cost of the
+// Fast, Balanced and High tiers of a Function.
+type tierKernel struct {
+	terms [3]int
+	cost  [3]float64
+}
+
+// tierKernels is indexed by Function. Re-measure the costs with
+// approx-cli bench when a kernel changes; a tier is never listed as cheaper
+// than the one before it.
+//
+//nolint:gochecknoglobals // read-only registry indexed by Function
+var tierKernels = [...]tierKernel{
+	FuncSqrt:     {terms: [3]int{1, 2, 3}, cost: [3]float64{1, 2.0, 2.6}},
+	FuncInvSqrt:  {terms: [3]int{1, 2, 3}, cost: [3]float64{1, 2.3, 2.5}},
+	FuncLog:      {terms: [3]int{2, 4, 6}, cost: [3]float64{1, 1.5, 1.7}},
+	FuncLog2:     {terms: [3]int{2, 4, 6}, cost: [3]float64{1, 1.0, 1.1}},
+	FuncExp:      {terms: [3]int{4, 6, 8}, cost: [3]float64{1, 1.7, 1.9}},
+	FuncExp2:     {terms: [3]int{4, 6, 8}, cost: [3]float64{1, 1.1, 1.1}},
+	FuncSin:      {terms: [3]int{3, 5, 7}, cost: [3]float64{1, 1.7, 1.8}},
+	FuncCos:      {terms: [3]int{3, 5, 7}, cost: [3]float64{1, 1.7, 1.8}},
+	FuncTan:      {terms: [3]int{2, 3, 6}, cost: [3]float64{1, 1.1, 1.1}},
+	FuncCotan:    {terms: [3]int{2, 3, 6}, cost: [3]float64{1, 1.0, 1.0}},
+	FuncSec:      {terms: [3]int{3, 5, 7}, cost: [3]float64{1, 1.1, 1.2}},
+	FuncCsc:      {terms: [3]int{3, 5, 7}, cost: [3]float64{1, 1.1, 1.1}},
+	FuncArctan:   {terms: [3]int{3, 3, 6}, cost: [3]float64{1, 1.0, 1.0}},
+	FuncArccotan: {terms: [3]int{3, 3, 6}, cost: [3]float64{1, 1.0, 1.0}},
+	FuncArccos:   {terms: [3]int{3, 6, 10}, cost: [3]float64{1, 1.2, 1.4}},
+	FuncArcsec:   {terms: [3]int{3, 6, 10}, cost: [3]float64{1, 1.2, 1.4}},
+	FuncArccsc:   {terms: [3]int{3, 6, 10}, cost: [3]float64{1, 1.2, 1.5}},
+}
@@ -0,0 +1,71 @@
+package approx_test
+
+import (
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/accuracy"
+)
+
+func TestPrecisionInfo_MatchesContract(t *testing.T) {
+	t.Parallel()
+
+	c := accuracy.Current()
+
+	for _, fn := range approx.Functions() {
+		for _, prec := range []approx.Precision{approx.PrecisionFast, approx.PrecisionBalanced, approx.PrecisionHigh} {
+			info := approx.PrecisionInfo(fn, prec)
+
+			b, ok := c.Lookup(fn, info.Precision)
+			if !ok {
+				t.Fatalf("%v/%v: no contract bound", fn, info.Precision)
+			}
+
+			if info.MaxError != b.MaxError || info.Relative != (b.Metric == accuracy.Relative) {
+				t.Errorf("%v/%v: got %g relative=%t, contract %g %v", fn, prec, info.MaxError, info.Relative, b.MaxError, b.Metric)
+			}
+
+			if info.Terms <= 0 || info.Cost < 1 || info.Digits <= 0 {
+				t.Errorf("%v/%v: implausible %+v", fn, prec, info)
+			}
+		}
+	}
+}
+
+func TestPrecisionInfo_Monotonic(t *testing.T) {
+	t.Parallel()
+
+	for _, fn := range approx.Functions() {
+		fast := approx.PrecisionInfo(fn, approx.PrecisionFast)
+		bal := approx.PrecisionInfo(fn, approx.PrecisionBalanced)
+		high := approx.PrecisionInfo(fn, approx.PrecisionHigh)
+
+		if fast.Terms > bal.Terms || bal.Terms > high.Terms {
+			t.Errorf("%v: terms %d, %d, %d not increasing", fn, fast.Terms, bal.Terms, high.Terms)
+		}
+
+		if fast.Cost > bal.Cost || bal.Cost > high.Cost {
+			t.Errorf("%v: cost %g, %g, %g not increasing", fn, fast.Cost, bal.Cost, high.Cost)
+		}
+
+		if fast.Digits > bal.Digits || bal.Digits > high.Digits {
+			t.Errorf("%v: digits %g, %g, %g not increasing", fn, fast.Digits, bal.Digits, high.Digits)
+		}
+	}
+}
+
+func TestPrecisionInfo_Resolution(t *testing.T) {
+	t.Parallel()
+
+	if got := approx.PrecisionInfo(approx.FuncExp, approx.PrecisionAuto); got != approx.PrecisionInfo(approx.FuncExp, approx.PrecisionBalanced) {
+		t.Errorf("Auto = %+v, want the Balanced tier", got)
+	}
+
+	if got := approx.PrecisionInfo(approx.FuncArctan, approx.PrecisionFast).Precision; got != approx.PrecisionBalanced {
+		t.Errorf("Arctan Fast resolves to %v, want Balanced", got)
+	}
+
+	if got := approx.PrecisionInfo(approx.Function(0), approx.PrecisionFast); got != (approx.TierInfo{}) {
+		t.Errorf("invalid Function = %+v, want zero", got)
+	}
+}
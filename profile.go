@@ -0,0 +1,17 @@
+package approx
+
+// Profile assigns a precision tier to individual Functions, for programs that
+// tune tiers per function instead of using one setting everywhere. It
+// encodes as a JSON object keyed by function name, e.g.
+// {"Exp":"fast","Log":"high"}, which is the format cmd/approx-tune emits.
+type Profile map[Function]Precision
+
+// Precision returns the tier recorded for fn, or PrecisionAuto when the
+// profile has none.
+func (p Profile) Precision(fn Function) Precision {
+	if prec, ok := p[fn]; ok {
+		return prec
+	}
+
+	return PrecisionAuto
+}
@@ -0,0 +1,34 @@
+package approx
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestProfile(t *testing.T) {
+	t.Parallel()
+
+	p := Profile{FuncExp: PrecisionFast, FuncLog: PrecisionHigh}
+
+	if p.Precision(FuncExp) != PrecisionFast || p.Precision(FuncSin) != PrecisionAuto {
+		t.Fatalf("Precision lookup wrong: %v", p)
+	}
+
+	if Profile(nil).Precision(FuncExp) != PrecisionAuto {
+		t.Fatalf("nil profile must report Auto")
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != `{"Exp":"fast","Log":"high"}` {
+		t.Fatalf("Marshal = %s", data)
+	}
+
+	var back Profile
+	if err := json.Unmarshal(data, &back); err != nil || len(back) != 2 || back[FuncLog] != PrecisionHigh {
+		t.Fatalf("round trip = %v, %v", back, err)
+	}
+}
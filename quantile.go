@@ -0,0 +1,176 @@
+package approx
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// RollingQuantile estimates one quantile of a stream in constant memory with
+// the P² algorithm (Jain & Chlamtac, 1985): five markers track the minimum,
+// the q/2, q and (1+q)/2 quantiles and the maximum, and the middle marker is
+// moved along a piecewise-parabolic fit as values arrive. It complements
+// LogHistogram when a single percentile is needed without buckets.
+//
+// With a half-life the estimate is rolling: marker positions are decayed
+// exponentially, so a value recorded halfLife observations ago carries half
+// the weight of the newest one and the estimate follows a drifting latency
+// distribution. NaN is ignored. A RollingQuantile is not safe for concurrent
+// use.
+type RollingQuantile struct {
+	q     float64
+	decay float64 // per-observation position decay; 1 disables it
+
+	heights [5]float64
+	pos     [5]float64 // marker positions, 1-based ranks
+	want    [5]float64 // desired marker positions
+	step    [5]float64 // desired position increments
+
+	count uint64
+}
+
+// NewRollingQuantile returns an estimator of the q-quantile, q in (0, 1).
+// A halfLife of 0 weighs all observations equally; otherwise it is the age,
+// in observations, at which a value's weight has halved, and must be at least
+// minRollingHalfLife so the markers stay apart.
+func NewRollingQuantile(q, halfLife float64) (*RollingQuantile, error) {
+	if !(q > 0 && q < 1) { //nolint:staticcheck // also rejects NaN
+		return nil, fmt.Errorf("approx: rolling quantile q %g: %w", q, ErrDomainError)
+	}
+
+	if halfLife != 0 && !(halfLife >= minRollingHalfLife) { //nolint:staticcheck // also rejects NaN
+		return nil, fmt.Errorf("approx: rolling quantile half-life %g: %w", halfLife, ErrDomainError)
+	}
+
+	r := &RollingQuantile{q: q, decay: 1} //nolint:exhaustruct
+	if halfLife != 0 && !math.IsInf(halfLife, 1) {
+		r.decay = FastPower(0.5, 1/halfLife)
+	}
+
+	r.step = [5]float64{0, q / 2, q, (1 + q) / 2, 1}
+	r.Reset()
+
+	return r, nil
+}
+
+// minRollingHalfLife is the shortest accepted half-life. Below it the decayed
+// span of the five markers is too short for them to move independently.
+const minRollingHalfLife = 16
+
+// Q returns the quantile being estimated.
+func (r *RollingQuantile) Q() float64 { return r.q }
+
+// Count returns the number of recorded values.
+func (r *RollingQuantile) Count() uint64 { return r.count }
+
+// Add records one value.
+func (r *RollingQuantile) Add(x float64) {
+	if x != x { //nolint:gocritic
+		return
+	}
+
+	if r.count < 5 {
+		r.heights[r.count] = x
+		r.count++
+
+		if r.count == 5 {
+			slices.Sort(r.heights[:])
+		}
+
+		return
+	}
+
+	r.count++
+
+	h := &r.heights
+
+	var k int
+
+	switch {
+	case x < h[0]:
+		h[0] = x
+	case x >= h[4]:
+		h[4] = x
+		k = 3
+	default:
+		for x >= h[k+1] {
+			k++
+		}
+	}
+
+	for i := range 5 {
+		if i > k {
+			r.pos[i]++
+		}
+
+		r.want[i] += r.step[i]
+
+		if r.decay != 1 {
+			r.pos[i] = 1 + (r.pos[i]-1)*r.decay
+			r.want[i] = 1 + (r.want[i]-1)*r.decay
+		}
+	}
+
+	for i := 1; i <= 3; i++ {
+		r.adjust(i)
+	}
+}
+
+// adjust moves marker i one position towards its desired position if it has
+// drifted by at least one and its neighbours leave room.
+func (r *RollingQuantile) adjust(i int) {
+	d := r.want[i] - r.pos[i]
+
+	var s float64
+
+	switch {
+	case d >= 1 && r.pos[i+1]-r.pos[i] > 1:
+		s = 1
+	case d <= -1 && r.pos[i-1]-r.pos[i] < -1:
+		s = -1
+	default:
+		return
+	}
+
+	h, n := &r.heights, &r.pos
+
+	// Piecewise-parabolic prediction, falling back to linear interpolation
+	// when it would leave the neighbouring heights.
+	hp := h[i] + s/(n[i+1]-n[i-1])*
+		((n[i]-n[i-1]+s)*(h[i+1]-h[i])/(n[i+1]-n[i])+
+			(n[i+1]-n[i]-s)*(h[i]-h[i-1])/(n[i]-n[i-1]))
+
+	if !(h[i-1] < hp && hp < h[i+1]) {
+		j := i + int(s)
+		hp = h[i] + s*(h[j]-h[i])/(n[j]-n[i])
+	}
+
+	h[i] = hp
+	n[i] += s
+}
+
+// Quantile returns the current estimate, or NaN before any value was
+// recorded. Until five values have been seen it is the nearest-rank
+// quantile of those values.
+func (r *RollingQuantile) Quantile() float64 {
+	switch {
+	case r.count == 0:
+		return math.NaN()
+	case r.count < 5:
+		seen := slices.Clone(r.heights[:r.count])
+		slices.Sort(seen)
+
+		return seen[int(math.Round(r.q*float64(r.count-1)))]
+	default:
+		return r.heights[2]
+	}
+}
+
+// Reset removes all recorded values.
+func (r *RollingQuantile) Reset() {
+	q := r.q
+	r.heights = [5]float64{}
+	r.pos = [5]float64{1, 2, 3, 4, 5}
+	r.want = [5]float64{1, 1 + 2*q, 1 + 4*q, 3 + 2*q, 5}
+	r.count = 0
+}
@@ -0,0 +1,112 @@
+package approx
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestRollingQuantile_Stationary(t *testing.T) {
+	t.Parallel()
+
+	for _, q := range []float64{0.5, 0.9, 0.99} {
+		r, err := NewRollingQuantile(q, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		src := Seeded(11)
+		values := make([]float64, 100000)
+
+		for i := range values {
+			// Log-normal latencies around 1ms.
+			values[i] = 1e-3 * math.Exp(0.5*src.NormFloat64())
+			r.Add(values[i])
+		}
+
+		slices.Sort(values)
+
+		want := values[int(q*float64(len(values)-1))]
+		if got := r.Quantile(); math.Abs(got-want)/want > 0.02 {
+			t.Errorf("q=%v: Quantile = %v, want %v (±2%%)", q, got, want)
+		}
+
+		if r.Count() != uint64(len(values)) || r.Q() != q {
+			t.Fatalf("Count = %d, Q = %v", r.Count(), r.Q())
+		}
+	}
+}
+
+func TestRollingQuantile_HalfLifeTracksShift(t *testing.T) {
+	t.Parallel()
+
+	rolling, err := NewRollingQuantile(0.5, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fixed, err := NewRollingQuantile(0.5, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := Seeded(3)
+	for i := range 20000 {
+		// The latency regime moves from ~10 to ~100 halfway through.
+		base := 10.0
+		if i >= 10000 {
+			base = 100
+		}
+
+		x := base * (1 + 0.1*src.NormFloat64())
+		rolling.Add(x)
+		fixed.Add(x)
+	}
+
+	if got := rolling.Quantile(); math.Abs(got-100) > 5 {
+		t.Fatalf("rolling median = %v, want ≈100", got)
+	}
+
+	// Equal weights put the median at the regime boundary.
+	if got := fixed.Quantile(); got > 90 {
+		t.Fatalf("unweighted median = %v, expected it to lag the shift", got)
+	}
+}
+
+func TestRollingQuantile_FewValuesAndReset(t *testing.T) {
+	t.Parallel()
+
+	r, err := NewRollingQuantile(0.5, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !math.IsNaN(r.Quantile()) {
+		t.Fatalf("empty estimator must return NaN")
+	}
+
+	for _, x := range []float64{5, math.NaN(), 1, 3} {
+		r.Add(x)
+	}
+
+	if got := r.Quantile(); got != 3 || r.Count() != 3 {
+		t.Fatalf("Quantile = %v with Count %d, want 3 with 3", got, r.Count())
+	}
+
+	r.Reset()
+
+	if r.Count() != 0 || !math.IsNaN(r.Quantile()) {
+		t.Fatalf("Reset left %d values", r.Count())
+	}
+}
+
+func TestNewRollingQuantile_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range [][2]float64{{0, 0}, {1, 0}, {math.NaN(), 0}, {0.5, 4}, {0.5, -1}, {0.5, math.NaN()}} {
+		if _, err := NewRollingQuantile(c[0], c[1]); !errors.Is(err, ErrDomainError) {
+			t.Errorf("NewRollingQuantile(%v, %v) err = %v, want ErrDomainError", c[0], c[1], err)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package approx
+
+import (
+	"math"
+
+	iapprox "github.com/meko-christian/algo-approx/internal/approx"
+)
+
+// FastRoundToDigits rounds x to the given number of significant decimal
+// digits (ties to even), e.g. FastRoundToDigits(123.456, 2) = 120.
+//
+// digits is clamped to [1, 17]. Zero, NaN and ±Inf are returned unchanged.
+// The decimal exponent is derived from the binary exponent instead of a
+// logarithm, and the scale is an exact power of ten whenever possible.
+func FastRoundToDigits[T Float](x T, digits int) T {
+	xf := float64(x)
+	if xf == 0 || math.IsNaN(xf) || math.IsInf(xf, 0) {
+		return x
+	}
+
+	digits = min(max(digits, 1), 17)
+	k := digits - 1 - iapprox.DecimalExponent(xf)
+
+	return T(roundScaled(xf, k))
+}
+
+// FastQuantize rounds x to the nearest multiple of step (ties to even).
+//
+// The quotient is formed as x·(1/step), so inputs lying exactly halfway
+// between two multiples may round either way. A zero step returns x and
+// the sign of step is ignored.
+func FastQuantize[T Float](x, step T) T {
+	if step == 0 {
+		return x
+	}
+
+	s := math.Abs(float64(step))
+
+	return T(iapprox.RoundHalfEven(float64(x)*(1/s)) * s)
+}
+
+// FastRoundToDigitsInto stores FastRoundToDigits(src[i], digits) in dst[i].
+// It panics with ErrLengthMismatch if the slices differ in length.
+func FastRoundToDigitsInto[T Float](dst, src []T, digits int) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("FastRoundToDigitsInto")
+	}
+
+	for i, x := range src {
+		dst[i] = FastRoundToDigits(x, digits)
+	}
+}
+
+// FastQuantizeInto stores FastQuantize(src[i], step) in dst[i]. The
+// reciprocal of step is computed once for the whole slice.
+// It panics with ErrLengthMismatch if the slices differ in length.
+func FastQuantizeInto[T Float](dst, src []T, step T) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("FastQuantizeInto")
+	}
+
+	if step == 0 {
+		copy(dst, src)
+		return
+	}
+
+	s := math.Abs(float64(step))
+	inv := 1 / s
+
+	for i, x := range src {
+		dst[i] = T(iapprox.RoundHalfEven(float64(x)*inv) * s)
+	}
+}
+
+// FastRoundDecimal rounds x to places decimal places (ties to even) using
+// the default precision, e.g. FastRoundDecimal(19.999, 2) = 20. A negative
+// places rounds to tens, hundreds and so on.
+//
+// The scale comes from a table of exact powers of ten and the rounding
+// itself is branchless. Fast multiplies back by the reciprocal power and may
+// be an ulp off; Balanced divides, so the last step is correctly rounded;
+// High also decides halves from the exact scaled value and returns the
+// double nearest to the decimal rounding of x, for |places| <= 22. Near a
+// half, Fast and Balanced may round the other way: the double nearest 2.675
+// lies below it, so High rounds it to 2.67, but its product with 100 rounds
+// up to 267.5 and the lower tiers return 2.68.
+//
+// NaN, ±Inf and values with no digits beyond places are returned unchanged.
+func FastRoundDecimal[T Float](x T, places int) T {
+	return FastRoundDecimalPrec(x, places, PrecisionAuto)
+}
+
+// FastRoundDecimalPrec rounds x to places decimal places using the requested
+// precision.
+func FastRoundDecimalPrec[T Float](x T, places int, prec Precision) T {
+	return T(iapprox.RoundDecimal(float64(x), places, iapprox.Precision(normalizePrecision(prec))))
+}
+
+func FastRoundDecimal32(x float32, places int) float32 { return FastRoundDecimal(x, places) }
+func FastRoundDecimal64(x float64, places int) float64 { return FastRoundDecimal(x, places) }
+
+// FastTruncDecimal truncates x towards zero to places decimal places using
+// the default precision, e.g. FastTruncDecimal(-1.239, 2) = -1.23. The
+// tiers differ as for FastRoundDecimal; High never truncates an input just
+// below a decimal up to it.
+func FastTruncDecimal[T Float](x T, places int) T {
+	return FastTruncDecimalPrec(x, places, PrecisionAuto)
+}
+
+// FastTruncDecimalPrec truncates x to places decimal places using the
+// requested precision.
+func FastTruncDecimalPrec[T Float](x T, places int, prec Precision) T {
+	return T(iapprox.TruncDecimal(float64(x), places, iapprox.Precision(normalizePrecision(prec))))
+}
+
+func FastTruncDecimal32(x float32, places int) float32 { return FastTruncDecimal(x, places) }
+func FastTruncDecimal64(x float64, places int) float64 { return FastTruncDecimal(x, places) }
+
+// FastRoundDecimalInto stores FastRoundDecimalPrec(src[i], places, prec) in
+// dst[i], for example to normalize a column of prices to cents. dst may
+// alias src. It panics with ErrLengthMismatch if the slices differ in length.
+func FastRoundDecimalInto[T Float](dst, src []T, places int, prec Precision) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("FastRoundDecimalInto")
+	}
+
+	p := iapprox.Precision(normalizePrecision(prec))
+	for i, x := range src {
+		dst[i] = T(iapprox.RoundDecimal(float64(x), places, p))
+	}
+}
+
+// FastTruncDecimalInto stores FastTruncDecimalPrec(src[i], places, prec) in
+// dst[i]. dst may alias src. It panics with ErrLengthMismatch if the slices
+// differ in length.
+func FastTruncDecimalInto[T Float](dst, src []T, places int, prec Precision) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("FastTruncDecimalInto")
+	}
+
+	p := iapprox.Precision(normalizePrecision(prec))
+	for i, x := range src {
+		dst[i] = T(iapprox.TruncDecimal(float64(x), places, p))
+	}
+}
+
+// roundScaled rounds xf to k decimal places (k may be negative), multiplying
+// and dividing by the exact power 10^|k| so the final step is a single
+// correctly rounded operation.
+func roundScaled(xf float64, k int) float64 {
+	if k >= 0 {
+		scale := iapprox.Pow10(k)
+
+		scaled := xf * scale
+		if math.IsInf(scaled, 0) {
+			return xf
+		}
+
+		return iapprox.RoundHalfEven(scaled) / scale
+	}
+
+	scale := iapprox.Pow10(-k)
+
+	return iapprox.RoundHalfEven(xf/scale) * scale
+}
@@ -0,0 +1,169 @@
+package approx
+
+import (
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestFastRoundToDigits(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		x      float64
+		digits int
+		want   float64
+	}{
+		{123.456, 2, 120},
+		{123.456, 4, 123.5},
+		{-0.00123456, 3, -0.00123},
+		{9.99, 2, 10},
+		{1e300 * 1.2345, 3, 1.23e300},
+		{5e-320, 1, 5e-320},
+		{2.5, 1, 2},
+		{0, 3, 0},
+	}
+
+	for _, tc := range cases {
+		if got := FastRoundToDigits(tc.x, tc.digits); got != tc.want {
+			t.Errorf("FastRoundToDigits(%v, %d) = %v, want %v", tc.x, tc.digits, got, tc.want)
+		}
+	}
+
+	if !math.IsNaN(FastRoundToDigits(math.NaN(), 2)) || !math.IsInf(FastRoundToDigits(math.Inf(1), 2), 1) {
+		t.Errorf("non-finite inputs must be returned unchanged")
+	}
+}
+
+func TestFastRoundToDigits_MatchesStrconv(t *testing.T) {
+	t.Parallel()
+
+	// strconv rounds the exact binary value, so ties are excluded by
+	// construction of the inputs (irrational multiples).
+	for i := 1; i < 2000; i++ {
+		x := float64(i) * math.Pi * 1.37e-3
+		for _, digits := range []int{1, 3, 6} {
+			want, err := strconv.ParseFloat(strconv.FormatFloat(x, 'g', digits, 64), 64)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := FastRoundToDigits(x, digits); got != want {
+				t.Fatalf("FastRoundToDigits(%v, %d) = %v, want %v", x, digits, got, want)
+			}
+		}
+	}
+}
+
+func TestFastQuantize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct{ x, step, want float64 }{
+		{1.26, 0.25, 1.25},
+		{-1.4, 0.5, -1.5},
+		{7, 2, 8},
+		{3.3, -1, 3},
+		{3.3, 0, 3.3},
+	}
+
+	for _, tc := range cases {
+		if got := FastQuantize(tc.x, tc.step); math.Abs(got-tc.want) > 1e-12 {
+			t.Errorf("FastQuantize(%v, %v) = %v, want %v", tc.x, tc.step, got, tc.want)
+		}
+	}
+}
+
+func TestQuantizeBatchMatchesScalar(t *testing.T) {
+	t.Parallel()
+
+	src := []float32{0.11, -3.96, 12.5, 1e6 + 0.3}
+	dst := make([]float32, len(src))
+
+	FastQuantizeInto(dst, src, 0.1)
+
+	for i := range src {
+		if dst[i] != FastQuantize(src[i], 0.1) {
+			t.Fatalf("FastQuantizeInto[%d] = %v, scalar %v", i, dst[i], FastQuantize(src[i], 0.1))
+		}
+	}
+
+	FastRoundToDigitsInto(dst, src, 2)
+
+	for i := range src {
+		if dst[i] != FastRoundToDigits(src[i], 2) {
+			t.Fatalf("FastRoundToDigitsInto[%d] = %v", i, dst[i])
+		}
+	}
+}
+
+func TestFastRoundTruncDecimal(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		x            float64
+		places       int
+		round, trunc float64
+	}{
+		{2.675, 2, 2.67, 2.67},
+		{1.005, 2, 1, 1},
+		{0.125, 2, 0.12, 0.12},
+		{-1.239, 2, -1.24, -1.23},
+		{1234.5678, 0, 1235, 1234},
+		{1234.5678, -2, 1200, 1200},
+		{-1250, -2, -1200, -1200},
+		{19.99, 1, 20, 19.9},
+		{1e20, 3, 1e20, 1e20},
+	}
+
+	for _, tc := range cases {
+		if got := FastRoundDecimalPrec(tc.x, tc.places, PrecisionHigh); got != tc.round {
+			t.Errorf("FastRoundDecimalPrec(%v, %d, High) = %v, want %v", tc.x, tc.places, got, tc.round)
+		}
+
+		if got := FastTruncDecimalPrec(tc.x, tc.places, PrecisionHigh); got != tc.trunc {
+			t.Errorf("FastTruncDecimalPrec(%v, %d, High) = %v, want %v", tc.x, tc.places, got, tc.trunc)
+		}
+
+		// The lower tiers may be one unit off near a half.
+		if got := FastRoundDecimal(tc.x, tc.places); math.Abs(got-tc.round) > 1.01*math.Pow10(-tc.places) {
+			t.Errorf("FastRoundDecimal(%v, %d) = %v, want %v", tc.x, tc.places, got, tc.round)
+		}
+	}
+
+	if got := FastRoundDecimal32(3.14159, 3); got != 3.142 {
+		t.Errorf("FastRoundDecimal32(3.14159, 3) = %v, want 3.142", got)
+	}
+
+	if got := FastTruncDecimal64(-0.0049, 2); got != 0 || !math.Signbit(got) {
+		t.Errorf("FastTruncDecimal64(-0.0049, 2) = %v, want -0", got)
+	}
+
+	if !math.IsNaN(FastRoundDecimal(math.NaN(), 2)) || !math.IsInf(FastTruncDecimal(math.Inf(-1), 2), -1) {
+		t.Error("non-finite inputs must be returned unchanged")
+	}
+}
+
+func TestDecimalBatchMatchesScalar(t *testing.T) {
+	t.Parallel()
+
+	src := []float64{19.995, -4.005, 0.1 + 0.2, 1e6 + 0.125}
+	dst := make([]float64, len(src))
+
+	for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+		FastRoundDecimalInto(dst, src, 2, prec)
+
+		for i := range src {
+			if want := FastRoundDecimalPrec(src[i], 2, prec); dst[i] != want {
+				t.Fatalf("FastRoundDecimalInto[%d] = %v, scalar %v", i, dst[i], want)
+			}
+		}
+
+		FastTruncDecimalInto(dst, src, 1, prec)
+
+		for i := range src {
+			if want := FastTruncDecimalPrec(src[i], 1, prec); dst[i] != want {
+				t.Fatalf("FastTruncDecimalInto[%d] = %v, scalar %v", i, dst[i], want)
+			}
+		}
+	}
+}
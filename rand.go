@@ -0,0 +1,123 @@
+package approx
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+)
+
+// Every stochastic feature of the package (sampling, stochastic rounding,
+// the accuracy package's distributions) takes a *rand.Rand. Any rand.Source
+// plugs in through rand.New, including CryptoSource, and a nil generator
+// selects Default.
+
+// Seeded returns a deterministic random generator for the package's
+// stochastic features (sampling, noise, stochastic rounding, calibration).
+//
+// Outputs for a given seed are part of the package's compatibility promise:
+// the generator is math/rand/v2's PCG, whose output sequence is fixed, seeded
+// through a fixed SplitMix64 expansion of seed. The returned generator is not
+// safe for concurrent use; give each goroutine its own via SeededStream.
+func Seeded(seed uint64) *rand.Rand { return rand.New(SeededSource(seed)) }
+
+// SeededSource returns the rand.Source behind Seeded.
+func SeededSource(seed uint64) rand.Source {
+	s := seed
+	hi := splitMix64(&s)
+	lo := splitMix64(&s)
+
+	return rand.NewPCG(hi, lo)
+}
+
+// SeededStream returns the generator for an independent, reproducible
+// sub-stream of seed, e.g. one per worker or per chunk in parallel code.
+// The outputs depend only on (seed, stream), never on scheduling.
+func SeededStream(seed, stream uint64) *rand.Rand {
+	s := seed ^ (stream * 0xd1342543de82ef95)
+	s = splitMix64(&s)
+
+	return rand.New(SeededSource(s ^ stream))
+}
+
+// splitMix64 advances *state and returns the next SplitMix64 output; it is
+// used to spread small, correlated seeds over the PCG state space.
+func splitMix64(state *uint64) uint64 {
+	*state += 0x9e3779b97f4a7c15
+	z := *state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+
+	return z ^ (z >> 31)
+}
+
+// Default returns the package default generator, used wherever a nil
+// *rand.Rand is passed. It is safe for concurrent use and, unless replaced
+// with SetDefaultSource, draws from math/rand/v2's runtime source, so it is
+// not reproducible; pass a Seeded generator for that.
+func Default() *rand.Rand { return defaultRand.Load() }
+
+// SetDefaultSource replaces the source behind Default, for example with
+// CryptoSource. The source is guarded by a mutex, so it need not be safe for
+// concurrent use itself. A nil src restores the runtime source.
+func SetDefaultSource(src rand.Source) {
+	if src == nil {
+		defaultRand.Store(rand.New(runtimeSource{}))
+
+		return
+	}
+
+	defaultRand.Store(rand.New(&lockedSource{src: src})) //nolint:exhaustruct
+}
+
+// CryptoSource returns a rand.Source reading from crypto/rand, for callers
+// whose noise or sampling must not be predictable. It is safe for concurrent
+// use and much slower than the PCG behind Seeded.
+func CryptoSource() rand.Source { return cryptoSource{} }
+
+// orDefault returns r, or Default if r is nil.
+func orDefault(r *rand.Rand) *rand.Rand {
+	if r == nil {
+		return Default()
+	}
+
+	return r
+}
+
+//nolint:gochecknoglobals // package default generator, swapped atomically
+var defaultRand = func() *atomic.Pointer[rand.Rand] {
+	var p atomic.Pointer[rand.Rand]
+	p.Store(rand.New(runtimeSource{}))
+
+	return &p
+}()
+
+// runtimeSource is math/rand/v2's goroutine-safe top-level generator.
+type runtimeSource struct{}
+
+func (runtimeSource) Uint64() uint64 { return rand.Uint64() }
+
+// lockedSource serializes access to a source that is not safe for
+// concurrent use.
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source
+}
+
+func (s *lockedSource) Uint64() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.src.Uint64()
+}
+
+type cryptoSource struct{}
+
+func (cryptoSource) Uint64() uint64 {
+	var b [8]byte
+
+	_, _ = crand.Read(b[:]) // never fails; crypto/rand aborts the program instead
+
+	return binary.LittleEndian.Uint64(b[:])
+}
@@ -0,0 +1,118 @@
+package approx
+
+import (
+	"math"
+	"math/rand/v2"
+	"sync"
+	"testing"
+)
+
+// The golden values lock the generator sequence per seed. Changing them breaks
+// reproducibility for every downstream user and requires a major version.
+func TestSeeded_Golden(t *testing.T) {
+	t.Parallel()
+
+	golden := map[uint64][3]uint64{
+		0:  {0xb18c97843f57bb91, 0xd7046f21f79eba31, 0x50a2e241ff366667},
+		1:  {0xaf9d32bf75779748, 0xb7672df032bf0473, 0x17e851b73891d79},
+		42: {0x61c88529c9612c1b, 0x2608d8a3075aa168, 0x5418c9208d9f1374},
+	}
+
+	for seed, want := range golden {
+		r := Seeded(seed)
+		for i, w := range want {
+			if got := r.Uint64(); got != w {
+				t.Fatalf("Seeded(%d) output %d = %#x, want %#x", seed, i, got, w)
+			}
+		}
+	}
+}
+
+func TestSeededStream_Golden(t *testing.T) {
+	t.Parallel()
+
+	golden := map[uint64][2]uint64{
+		0: {0x8b57dedcdddfb8a0, 0xe623035167d7aac9},
+		1: {0xa812aa49b54feca6, 0x4a4b3cf538e668a2},
+	}
+
+	for stream, want := range golden {
+		r := SeededStream(42, stream)
+		for i, w := range want {
+			if got := r.Uint64(); got != w {
+				t.Fatalf("SeededStream(42, %d) output %d = %#x, want %#x", stream, i, got, w)
+			}
+		}
+	}
+}
+
+func TestSeeded_DerivedDistributionsReproducible(t *testing.T) {
+	t.Parallel()
+
+	r := Seeded(7)
+	if got := r.Float64(); got != 0.9153469951474317 {
+		t.Fatalf("Seeded(7).Float64() = %v", got)
+	}
+
+	if got := r.NormFloat64(); got != -1.2115589815051848 {
+		t.Fatalf("Seeded(7).NormFloat64() = %v", got)
+	}
+}
+
+func TestSeededStream_Independent(t *testing.T) {
+	t.Parallel()
+
+	seen := map[uint64]bool{}
+
+	for stream := range uint64(64) {
+		v := SeededStream(1, stream).Uint64()
+		if seen[v] {
+			t.Fatalf("stream %d repeats an earlier stream's first output", stream)
+		}
+
+		seen[v] = true
+	}
+}
+
+// TestSetDefaultSource is not parallel: it swaps the package default.
+func TestSetDefaultSource(t *testing.T) {
+	SetDefaultSource(SeededSource(3))
+	defer SetDefaultSource(nil)
+
+	want := Seeded(3)
+	for i := range 3 {
+		if got, w := Default().Uint64(), want.Uint64(); got != w {
+			t.Fatalf("output %d = %#x, want %#x", i, got, w)
+		}
+	}
+
+	// The locked wrapper makes a plain PCG safe to share.
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			dst := make([]uint16, 256)
+			F32ToF16Stochastic(dst, make([]float32, 256), nil)
+		})
+	}
+
+	wg.Wait()
+
+	SetDefaultSource(nil)
+
+	if orDefault(nil) != Default() {
+		t.Fatal("nil generators must resolve to Default")
+	}
+}
+
+func TestCryptoSource(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(CryptoSource())
+	if a, b := r.Uint64(), r.Uint64(); a == b {
+		t.Fatalf("crypto source repeated %#x", a)
+	}
+
+	if i := SampleCategorical([]float64{0, math.Inf(-1)}, 1, r); i != 0 {
+		t.Fatalf("sample over one usable logit = %d", i)
+	}
+}
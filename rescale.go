@@ -0,0 +1,76 @@
+package approx
+
+import "math"
+
+// Rescale maps src linearly from [fromLo, fromHi] onto [toLo, toHi] and
+// stores the result in dst, one fused multiply-add per element in float64:
+// fromLo maps to toLo exactly and fromHi to toHi up to rounding. Values
+// outside the source range are extrapolated, not clamped; either range may
+// be reversed to flip the direction. dst may alias src.
+//
+// Its shape fits a RunBatch kernel, so a rescale placed next to a
+// transcendental batch function in the same kernel runs on each chunk while
+// it is still in cache instead of taking its own pass over memory.
+//
+// A degenerate source range, fromLo == fromHi, maps every element to toLo.
+// It panics with ErrLengthMismatch if the slices differ in length.
+func Rescale[T Float](dst, src []T, fromLo, fromHi, toLo, toHi T) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("Rescale")
+	}
+
+	lo, to := float64(fromLo), float64(toLo)
+
+	span := float64(fromHi) - lo
+	if span == 0 {
+		for i := range dst {
+			dst[i] = toLo
+		}
+
+		return
+	}
+
+	scale := (float64(toHi) - to) / span
+
+	for i, x := range src {
+		dst[i] = T(math.FMA(float64(x)-lo, scale, to))
+	}
+}
+
+// MinMax returns the smallest and largest element of src in one pass. NaN
+// elements are skipped; an empty slice, or one holding only NaN, returns
+// NaN for both. Its result is the source range for Rescale.
+func MinMax[T Float](src []T) (lo, hi T) {
+	lo, hi = T(math.Inf(1)), T(math.Inf(-1))
+	seen := false
+
+	for _, x := range src {
+		if x != x { //nolint:gocritic
+			continue
+		}
+
+		seen = true
+		lo, hi = min(lo, x), max(hi, x)
+	}
+
+	if !seen {
+		nan := T(math.NaN())
+
+		return nan, nan
+	}
+
+	return lo, hi
+}
+
+// Normalize rescales src onto [toLo, toHi] by its own minimum and maximum,
+// min-max scaling in two passes, MinMax and Rescale. A constant src maps to
+// toLo, and NaN elements stay NaN. It panics with ErrLengthMismatch if the
+// slices differ in length.
+func Normalize[T Float](dst, src []T, toLo, toHi T) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("Normalize")
+	}
+
+	lo, hi := MinMax(src)
+	Rescale(dst, src, lo, hi, toLo, toHi)
+}
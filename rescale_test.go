@@ -0,0 +1,140 @@
+package approx
+
+import (
+	"context"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+func TestRescale(t *testing.T) {
+	t.Parallel()
+
+	src := []float64{-1, 0, 0.5, 1, 3}
+	dst := make([]float64, len(src))
+
+	Rescale(dst, src, -1, 1, 0, 100)
+	testutil.AssertSliceClose(t, dst, []float64{0, 50, 75, 100, 200}, 1e-15)
+
+	// A reversed target range flips the direction; dst may alias src.
+	Rescale(src, src, -1, 1, 1, -1)
+	testutil.AssertSliceClose(t, src, []float64{1, 0, -0.5, -1, -3}, 1e-15)
+
+	f32 := []float32{10, 15, 20}
+	Rescale(f32, f32, 10, 20, 0, 1)
+
+	if !slices.Equal(f32, []float32{0, 0.5, 1}) {
+		t.Errorf("float32 Rescale = %v, want [0 0.5 1]", f32)
+	}
+}
+
+func TestRescale_Degenerate(t *testing.T) {
+	t.Parallel()
+
+	dst := []float64{1, 2, 3}
+	Rescale(dst, []float64{4, 4, math.NaN()}, 4, 4, -1, 1)
+
+	if !slices.Equal(dst, []float64{-1, -1, -1}) {
+		t.Errorf("degenerate range: %v, want all -1", dst)
+	}
+
+	Rescale(dst, []float64{0, math.NaN(), 1}, 0, 1, 0, 2)
+
+	if dst[0] != 0 || !math.IsNaN(dst[1]) || dst[2] != 2 {
+		t.Errorf("NaN element: %v, want [0 NaN 2]", dst)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	t.Parallel()
+
+	nan := math.NaN()
+
+	tests := []struct {
+		name   string
+		src    []float64
+		lo, hi float64
+	}{
+		{"single", []float64{2}, 2, 2},
+		{"mixed", []float64{3, -1, 7, 0}, -1, 7},
+		{"NaN skipped", []float64{nan, 5, nan, -2}, -2, 5},
+		{"infinities", []float64{math.Inf(1), 0, math.Inf(-1)}, math.Inf(-1), math.Inf(1)},
+	}
+
+	for _, tt := range tests {
+		if lo, hi := MinMax(tt.src); lo != tt.lo || hi != tt.hi {
+			t.Errorf("%s: MinMax = (%v, %v), want (%v, %v)", tt.name, lo, hi, tt.lo, tt.hi)
+		}
+	}
+
+	for _, src := range [][]float32{nil, {float32(nan)}} {
+		if lo, hi := MinMax(src); !math.IsNaN(float64(lo)) || !math.IsNaN(float64(hi)) {
+			t.Errorf("MinMax(%v) = (%v, %v), want NaN", src, lo, hi)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	src := []float64{5, 1, 3, 9}
+	dst := make([]float64, len(src))
+
+	Normalize(dst, src, 0, 1)
+	testutil.AssertSliceClose(t, dst, []float64{0.5, 0, 0.25, 1}, 1e-15)
+
+	Normalize(dst, []float64{2, 2, 2, 2}, -1, 1)
+
+	if !slices.Equal(dst, []float64{-1, -1, -1, -1}) {
+		t.Errorf("constant input: %v, want all -1", dst)
+	}
+}
+
+func TestRescale_RunBatchFused(t *testing.T) {
+	t.Parallel()
+
+	src := make([]float64, 1000)
+	for i := range src {
+		src[i] = float64(i)
+	}
+
+	dst := make([]float64, len(src))
+
+	_, err := RunBatch(context.Background(), dst, src, func(d, s []float64) {
+		Rescale(d, s, 0, 999, -4, 4)
+		FastGELUInto(d, d, PrecisionHigh)
+	}, BatchOptions{Chunk: 64})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The fused kernel must match the two separate passes exactly.
+	want := make([]float64, len(src))
+	Rescale(want, src, 0, 999, -4, 4)
+	FastGELUInto(want, want, PrecisionHigh)
+
+	if !slices.Equal(dst, want) {
+		t.Error("fused RunBatch kernel differs from separate passes")
+	}
+}
+
+func TestRescale_LengthMismatchPanics(t *testing.T) {
+	t.Parallel()
+
+	for name, f := range map[string]func(){
+		"Rescale":   func() { Rescale(make([]float64, 2), make([]float64, 3), 0, 1, 0, 1) },
+		"Normalize": func() { Normalize(make([]float64, 2), make([]float64, 3), 0, 1) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic", name)
+				}
+			}()
+
+			f()
+		}()
+	}
+}
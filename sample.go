@@ -0,0 +1,230 @@
+package approx
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	iapprox "github.com/meko-christian/algo-approx/internal/approx"
+)
+
+// SampleCategorical draws an index i with probability softmax(logits/temperature)[i],
+// the token-sampling step of language-model decoding.
+//
+// The softmax is fused into a single pass over logits: a running maximum and
+// a running sum of FastExp weights are kept, and a weighted reservoir keeps
+// the current choice, so no probability vector is materialized and nothing is
+// allocated. It consumes one r.Float64 per usable logit.
+//
+// A temperature <= 0 (or NaN) selects the greedy argmax. NaN and -Inf logits
+// are never chosen. It returns -1 if logits holds no usable entry. A nil r
+// draws from Default.
+func SampleCategorical[T Float](logits []T, temperature T, r *rand.Rand) int {
+	checkNonNegative("SampleCategorical", temperature, PrecisionBalanced)
+
+	if !(temperature > 0) { //nolint:staticcheck // also catches NaN
+		return argmaxLogit(logits)
+	}
+
+	r = orDefault(r)
+	invT := 1 / float64(temperature)
+	res := newSoftmaxReservoir()
+
+	for i, l := range logits {
+		if usableLogit(l) {
+			res.add(i, float64(l)*invT, r)
+		}
+	}
+
+	return res.choice
+}
+
+// SampleCategoricalGumbel draws from the same distribution as
+// SampleCategorical using the Gumbel-max trick: it returns
+// argmax(logits[i]/temperature + g[i]) with g[i] standard Gumbel noise built
+// from FastLog. It needs no running sum, so the choice is independent of the
+// order of logits, but it costs two logarithms per entry instead of one
+// exponential.
+//
+// Temperature, NaN, -Inf and nil r handling match SampleCategorical.
+func SampleCategoricalGumbel[T Float](logits []T, temperature T, r *rand.Rand) int {
+	checkNonNegative("SampleCategoricalGumbel", temperature, PrecisionBalanced)
+
+	if !(temperature > 0) { //nolint:staticcheck // also catches NaN
+		return argmaxLogit(logits)
+	}
+
+	r = orDefault(r)
+	invT := 1 / float64(temperature)
+	best := math.Inf(-1)
+	choice := -1
+
+	for i, l := range logits {
+		if !usableLogit(l) {
+			continue
+		}
+
+		if score := float64(l)*invT + gumbel(r); choice < 0 || score > best {
+			best, choice = score, i
+		}
+	}
+
+	return choice
+}
+
+// SampleTopK is SampleCategorical restricted to the k largest logits, the
+// usual top-k filter of language-model decoding. Ties at the k-th value are
+// broken towards the lower index. k <= 0 or k >= len(logits) applies no
+// restriction.
+//
+// Finding the top k keeps a heap of k indices, which is the only allocation.
+func SampleTopK[T Float](logits []T, k int, temperature T, r *rand.Rand) int {
+	checkNonNegative("SampleTopK", temperature, PrecisionBalanced)
+
+	if k <= 0 || k >= len(logits) {
+		return SampleCategorical(logits, temperature, r)
+	}
+
+	// The greedy choice always lies in the top k.
+	if !(temperature > 0) { //nolint:staticcheck // also catches NaN
+		return argmaxLogit(logits)
+	}
+
+	r = orDefault(r)
+	top := topKIndices(logits, k)
+	invT := 1 / float64(temperature)
+	res := newSoftmaxReservoir()
+
+	for _, i := range top {
+		res.add(i, float64(logits[i])*invT, r)
+	}
+
+	return res.choice
+}
+
+// softmaxReservoir samples from softmax over a stream of scaled logits in one
+// pass. Weights are kept relative to the running maximum and rescaled when it
+// moves, so the ratio of the current entry's weight to the total is exact up
+// to FastExp's error.
+type softmaxReservoir struct {
+	maxX, sum float64
+	choice    int
+}
+
+func newSoftmaxReservoir() softmaxReservoir {
+	return softmaxReservoir{maxX: math.Inf(-1), sum: 0, choice: -1}
+}
+
+// add offers entry i with scaled logit x and keeps it with probability
+// w/sum, which leaves each entry chosen with probability proportional to e^x.
+func (s *softmaxReservoir) add(i int, x float64, r *rand.Rand) {
+	w := 1.0
+
+	if x > s.maxX {
+		if s.sum > 0 {
+			s.sum *= iapprox.Exp(s.maxX-x, iapprox.PrecisionBalanced)
+		}
+
+		s.maxX = x
+	} else {
+		w = iapprox.Exp(x-s.maxX, iapprox.PrecisionBalanced)
+	}
+
+	s.sum += w
+	if r.Float64()*s.sum < w {
+		s.choice = i
+	}
+}
+
+// usableLogit reports whether l can carry probability mass.
+func usableLogit[T Float](l T) bool {
+	return l == l && !math.IsInf(float64(l), -1) //nolint:gocritic
+}
+
+// argmaxLogit returns the index of the first largest usable logit, or -1.
+func argmaxLogit[T Float](logits []T) int {
+	choice := -1
+
+	for i, l := range logits {
+		if usableLogit(l) && (choice < 0 || l > logits[choice]) {
+			choice = i
+		}
+	}
+
+	return choice
+}
+
+// gumbel returns a standard Gumbel variate -ln(-ln u) for u uniform in (0, 1).
+func gumbel(r *rand.Rand) float64 {
+	u := r.Float64()
+	if u == 0 {
+		u = 0x1p-64
+	}
+
+	return -iapprox.Log(-iapprox.Log(u, iapprox.PrecisionBalanced), iapprox.PrecisionBalanced)
+}
+
+// topKIndices returns the indices of the k largest usable logits in
+// ascending index order, using a min-heap ordered by (value, -index).
+func topKIndices[T Float](logits []T, k int) []int {
+	heap := make([]int, 0, k)
+
+	// less reports whether entry a ranks below entry b.
+	less := func(a, b int) bool {
+		if logits[a] != logits[b] {
+			return logits[a] < logits[b]
+		}
+
+		return a > b
+	}
+
+	siftDown := func(i int) {
+		for {
+			smallest := i
+
+			for _, c := range [2]int{2*i + 1, 2*i + 2} {
+				if c < len(heap) && less(heap[c], heap[smallest]) {
+					smallest = c
+				}
+			}
+
+			if smallest == i {
+				return
+			}
+
+			heap[i], heap[smallest] = heap[smallest], heap[i]
+			i = smallest
+		}
+	}
+
+	for i, l := range logits {
+		if !usableLogit(l) {
+			continue
+		}
+
+		if len(heap) < k {
+			heap = append(heap, i)
+
+			for c := len(heap) - 1; c > 0; {
+				p := (c - 1) / 2
+				if !less(heap[c], heap[p]) {
+					break
+				}
+
+				heap[c], heap[p] = heap[p], heap[c]
+				c = p
+			}
+
+			continue
+		}
+
+		if less(heap[0], i) {
+			heap[0] = i
+			siftDown(0)
+		}
+	}
+
+	slices.Sort(heap)
+
+	return heap
+}
@@ -0,0 +1,134 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func softmaxReference(logits []float64, temperature float64) []float64 {
+	maxL := math.Inf(-1)
+	for _, l := range logits {
+		maxL = math.Max(maxL, l)
+	}
+
+	p := make([]float64, len(logits))
+	sum := 0.0
+
+	for i, l := range logits {
+		p[i] = math.Exp((l - maxL) / temperature)
+		sum += p[i]
+	}
+
+	for i := range p {
+		p[i] /= sum
+	}
+
+	return p
+}
+
+func checkSampleFrequencies(t *testing.T, name string, logits []float64, want []float64, sample func() int) {
+	t.Helper()
+
+	const draws = 200000
+
+	counts := make([]int, len(logits))
+	for range draws {
+		counts[sample()]++
+	}
+
+	for i, c := range counts {
+		// Five standard deviations of a binomial proportion.
+		tol := 5*math.Sqrt(want[i]*(1-want[i])/draws) + 1e-9
+		if got := float64(c) / draws; math.Abs(got-want[i]) > tol {
+			t.Fatalf("%s: frequency of %d = %.4f, want %.4f ± %.4f", name, i, got, want[i], tol)
+		}
+	}
+}
+
+func TestSampleCategorical_Distribution(t *testing.T) {
+	t.Parallel()
+
+	logits := []float64{1.5, -0.5, 0, 2, 0.25}
+
+	for _, temp := range []float64{0.5, 1, 3} {
+		want := softmaxReference(logits, temp)
+
+		r := Seeded(7)
+		checkSampleFrequencies(t, "SampleCategorical", logits, want, func() int {
+			return SampleCategorical(logits, temp, r)
+		})
+
+		g := Seeded(8)
+		checkSampleFrequencies(t, "SampleCategoricalGumbel", logits, want, func() int {
+			return SampleCategoricalGumbel(logits, temp, g)
+		})
+	}
+}
+
+func TestSampleTopK_Distribution(t *testing.T) {
+	t.Parallel()
+
+	logits := []float64{1.5, -0.5, 0, 2, 0.25}
+	want := softmaxReference([]float64{1.5, math.Inf(-1), math.Inf(-1), 2, 0.25}, 1)
+
+	r := Seeded(9)
+	checkSampleFrequencies(t, "SampleTopK", logits, want, func() int {
+		return SampleTopK(logits, 3, 1.0, r)
+	})
+}
+
+func TestSampleCategorical_EdgeCases(t *testing.T) {
+	t.Parallel()
+
+	r := Seeded(1)
+	nan := math.NaN()
+	inf := math.Inf(-1)
+
+	if got := SampleCategorical([]float64{}, 1, r); got != -1 {
+		t.Fatalf("empty logits: got %d", got)
+	}
+
+	if got := SampleCategorical([]float64{nan, inf}, 1, r); got != -1 {
+		t.Fatalf("no usable logit: got %d", got)
+	}
+
+	for range 100 {
+		if got := SampleCategorical([]float64{nan, 0, inf, 0}, 1, r); got != 1 && got != 3 {
+			t.Fatalf("unusable logit chosen: %d", got)
+		}
+
+		if got := SampleCategoricalGumbel([]float64{inf, 0, nan}, 1, r); got != 1 {
+			t.Fatalf("Gumbel: unusable logit chosen: %d", got)
+		}
+	}
+
+	// Temperature 0 is greedy and does not consume randomness.
+	logits := []float32{0.5, 3, 3, -1}
+	if got := SampleCategorical(logits, 0, r); got != 1 {
+		t.Fatalf("greedy: got %d, want 1", got)
+	}
+
+	if got := SampleTopK(logits, 2, 0, r); got != 1 {
+		t.Fatalf("greedy top-k: got %d, want 1", got)
+	}
+
+	// Top-1 is greedy at any temperature.
+	for range 100 {
+		if got := SampleTopK(logits, 1, float32(10), r); got != 1 {
+			t.Fatalf("top-1: got %d, want 1", got)
+		}
+	}
+}
+
+func TestSampleCategorical_Reproducible(t *testing.T) {
+	t.Parallel()
+
+	logits := []float64{0.1, 0.2, 0.3, 0.4}
+	a, b := Seeded(3), Seeded(3)
+
+	for range 1000 {
+		if SampleCategorical(logits, 0.7, a) != SampleCategorical(logits, 0.7, b) {
+			t.Fatal("same seed produced different samples")
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package approx
+
+import (
+	"fmt"
+	"math"
+)
+
+// selfTestPoints is the number of inputs SelfTest sweeps per Function.
+const selfTestPoints = 256
+
+// SelfTest sweeps every Function at every tier, in float64 and float32,
+// over selfTestPoints inputs of its contracted domain and checks the results
+// against the math package within the accuracy contract. It takes about a
+// millisecond and is meant for service start-up, to catch a
+// miscompiled or broken platform-specific path, such as the hardware
+// reciprocal square root seed or an installed override, before serving
+// traffic:
+//
+//	if err := approx.SelfTest(); err != nil {
+//		log.Fatal(err)
+//	}
+//
+// float32 results are allowed four float32 ulps on top of the contract. The
+// error wraps ErrSelfTest and describes the first failures; nil means every
+// check passed.
+func SelfTest() error { return selfTest(contractBounds[:]) }
+
+// selfTest is SelfTest against the given bounds, indexed by Function.
+func selfTest(bounds []contractBound) error {
+	var failures []string
+
+	for _, fn := range Functions() {
+		for _, prec := range []Precision{PrecisionFast, PrecisionBalanced, PrecisionHigh} {
+			if msg := selfTestTier(fn, prec, &bounds[fn]); msg != "" {
+				failures = append(failures, msg)
+			}
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	const shown = 3
+
+	msg := failures[0]
+	for _, f := range failures[1:min(len(failures), shown)] {
+		msg += "; " + f
+	}
+
+	if len(failures) > shown {
+		msg += fmt.Sprintf("; and %d more", len(failures)-shown)
+	}
+
+	return fmt.Errorf("approx: %w: %s", ErrSelfTest, msg)
+}
+
+// selfTestTier returns a description of the first violation of b by fn at
+// prec, or "" if there is none.
+func selfTestTier(fn Function, prec Precision, b *contractBound) string {
+	bound := b.maxError[prec-1]
+	ref := libm[fn]
+
+	for i := range selfTestPoints {
+		t := float64(i) / (selfTestPoints - 1)
+
+		x := b.lo + (b.hi-b.lo)*t
+		if b.log {
+			x = b.lo * math.Pow(b.hi/b.lo, t)
+		}
+
+		x = min(max(x, b.lo), b.hi)
+
+		if err := selfTestError(b.relative, sampleKernels[fn](x, prec), ref(x)); !(err <= bound) {
+			return fmt.Sprintf("%v %v (%v) = error %.3g > %.3g", fn, prec, x, err, bound)
+		}
+
+		// Round the float32 input into the domain, which the debug checks of
+		// FastArctan enforce to the last bit.
+		x32 := float32(x)
+		if float64(x32) > b.hi {
+			x32 = math.Nextafter32(x32, float32(math.Inf(-1)))
+		} else if float64(x32) < b.lo {
+			x32 = math.Nextafter32(x32, float32(math.Inf(1)))
+		}
+		want := ref(float64(x32))
+		got := float64(selfTestKernels32[fn](x32, prec))
+
+		if err := selfTestError(b.relative, got, want); !(err <= bound+selfTestSlack32(b.relative, want)) {
+			return fmt.Sprintf("%v %v float32(%v) = error %.3g > %.3g", fn, prec, x32, err, bound)
+		}
+	}
+
+	return ""
+}
+
+func selfTestError(relative bool, got, want float64) float64 {
+	err := math.Abs(got - want)
+	if relative && want != 0 {
+		err /= math.Abs(want)
+	}
+
+	return err
+}
+
+// selfTestSlack32 is four float32 ulps of want in the metric of the bound.
+func selfTestSlack32(relative bool, want float64) float64 {
+	if relative {
+		return 0x1p-21
+	}
+
+	return 0x1p-21 * math.Abs(want)
+}
+
+// selfTestKernels32 is the float32 entry point of every Function.
+//
+//nolint:gochecknoglobals // read-only table indexed by Function
+var selfTestKernels32 = [...]func(float32, Precision) float32{
+	FuncSqrt:     FastSqrtPrec[float32],
+	FuncInvSqrt:  FastInvSqrtPrec[float32],
+	FuncLog:      FastLogPrec[float32],
+	FuncLog2:     FastLog2Prec[float32],
+	FuncExp:      FastExpPrec[float32],
+	FuncExp2:     FastExp2Prec[float32],
+	FuncSin:      FastSinPrec[float32],
+	FuncCos:      FastCosPrec[float32],
+	FuncTan:      FastTanPrec[float32],
+	FuncCotan:    FastCotanPrec[float32],
+	FuncSec:      FastSecPrec[float32],
+	FuncCsc:      FastCscPrec[float32],
+	FuncArctan:   FastArctanPrec[float32],
+	FuncArccotan: FastArccotanPrec[float32],
+	FuncArccos:   FastArccosPrec[float32],
+	FuncArcsec:   FastArcsecPrec[float32],
+	FuncArccsc:   FastArccscPrec[float32],
+}
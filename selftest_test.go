@@ -0,0 +1,49 @@
+package approx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSelfTestPasses(t *testing.T) {
+	t.Parallel()
+
+	if err := SelfTest(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSelfTestReportsViolations(t *testing.T) {
+	t.Parallel()
+
+	// Bounds a thousand times tighter than the contract fail every tier of
+	// most functions.
+	tight := contractBounds
+	for i := range tight {
+		for k := range tight[i].maxError {
+			tight[i].maxError[k] /= 1e3
+		}
+	}
+
+	err := selfTest(tight[:])
+	if !errors.Is(err, ErrSelfTest) {
+		t.Fatalf("selfTest with tight bounds = %v, want ErrSelfTest", err)
+	}
+
+	if msg := err.Error(); !strings.Contains(msg, "Sqrt fast") || !strings.Contains(msg, "more") {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
+
+func TestSelfTestAllocs(t *testing.T) {
+	if n := testing.AllocsPerRun(3, func() { _ = SelfTest() }); n != 0 {
+		t.Fatalf("SelfTest allocates %v times when passing", n)
+	}
+}
+
+func BenchmarkSelfTest(b *testing.B) {
+	for range b.N {
+		_ = SelfTest()
+	}
+}
@@ -0,0 +1,140 @@
+package approx
+
+import (
+	"math"
+	"runtime"
+	"sync"
+
+	iapprox "github.com/meko-christian/algo-approx/internal/approx"
+)
+
+// ScaledSoftmaxRows stores softmax(scale · row) for every row of the
+// row-major rows×cols matrix src in the same position of dst, using the
+// default precision. See ScaledSoftmaxRowsPrec.
+func ScaledSoftmaxRows[T Float](dst, src []T, rows, cols int, scale T) {
+	ScaledSoftmaxRowsPrec(dst, src, rows, cols, scale, PrecisionAuto)
+}
+
+// ScaledSoftmaxRowsPrec is ScaledSoftmaxRows using the requested precision
+// for FastExp. It is the attention-score kernel: scale is typically
+// 1/√d_head.
+//
+// Each row is processed while it is hot in cache: the scaled maximum is
+// subtracted before FastExp, so no exponential overflows, the row sum is
+// accumulated in float64, and the row is normalized in place. dst may alias
+// src. A row whose scaled entries are all -Inf (a fully masked row) is set
+// to zeros instead of NaN; a row holding NaN or +Inf becomes NaN.
+//
+// It panics with ErrLengthMismatch unless len(dst) == len(src) == rows*cols.
+func ScaledSoftmaxRowsPrec[T Float](dst, src []T, rows, cols int, scale T, prec Precision) {
+	checkSoftmaxShape("ScaledSoftmaxRows", dst, src, rows, cols)
+
+	p := iapprox.Precision(normalizePrecision(prec))
+	for r := range rows {
+		scaledSoftmaxRow(dst[r*cols:(r+1)*cols], src[r*cols:(r+1)*cols], float64(scale), p)
+	}
+}
+
+// ScaledSoftmaxRowsParallel is ScaledSoftmaxRowsPrec with the rows split
+// into contiguous blocks processed by up to workers goroutines. workers <= 0
+// uses GOMAXPROCS. Small matrices are processed on the calling goroutine,
+// where the cost of starting workers would dominate. The result is
+// identical to the sequential call.
+func ScaledSoftmaxRowsParallel[T Float](dst, src []T, rows, cols int, scale T, prec Precision, workers int) {
+	checkSoftmaxShape("ScaledSoftmaxRowsParallel", dst, src, rows, cols)
+
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	workers = min(workers, rows, rows*cols/softmaxMinParallelElems)
+	if workers <= 1 {
+		ScaledSoftmaxRowsPrec(dst, src, rows, cols, scale, prec)
+		return
+	}
+
+	p := iapprox.Precision(normalizePrecision(prec))
+	per := (rows + workers - 1) / workers
+
+	var wg sync.WaitGroup
+
+	for lo := 0; lo < rows; lo += per {
+		hi := min(lo+per, rows)
+
+		wg.Go(func() {
+			for r := lo; r < hi; r++ {
+				scaledSoftmaxRow(dst[r*cols:(r+1)*cols], src[r*cols:(r+1)*cols], float64(scale), p)
+			}
+		})
+	}
+
+	wg.Wait()
+}
+
+// ExpWeights stores exp(logits[i] - max(logits)) in dst[i] using the
+// default precision. See ExpWeightsPrec.
+func ExpWeights[T Float](dst, logits []T) { ExpWeightsPrec(dst, logits, PrecisionAuto) }
+
+// ExpWeightsPrec is the lighter sibling of ScaledSoftmaxRowsPrec for callers
+// that only need relative weights, such as particle-filter resampling: the
+// maximum is subtracted so no exponential overflows and the largest weight is
+// exactly 1, but no normalization pass follows. dst may alias logits. If
+// every logit is -Inf, dst is set to zeros; a NaN or +Inf logit makes every
+// weight NaN.
+//
+// It panics with ErrLengthMismatch if the slices differ in length.
+func ExpWeightsPrec[T Float](dst, logits []T, prec Precision) {
+	if len(dst) != len(logits) {
+		panicLengthMismatch("ExpWeights")
+	}
+
+	maxX := math.Inf(-1)
+	for _, v := range logits {
+		maxX = max(maxX, float64(v))
+	}
+
+	if math.IsInf(maxX, -1) {
+		clear(dst)
+		return
+	}
+
+	p := iapprox.Precision(normalizePrecision(prec))
+	for i, v := range logits {
+		dst[i] = T(iapprox.Exp(float64(v)-maxX, p))
+	}
+}
+
+// softmaxMinParallelElems is the number of matrix elements below which an
+// extra worker does not pay for itself.
+const softmaxMinParallelElems = 16 << 10
+
+func checkSoftmaxShape[T Float](fn string, dst, src []T, rows, cols int) {
+	if rows < 0 || cols < 0 || len(src) != rows*cols || len(dst) != len(src) {
+		panicLengthMismatch(fn)
+	}
+}
+
+func scaledSoftmaxRow[T Float](dst, src []T, scale float64, prec iapprox.Precision) {
+	maxX := math.Inf(-1)
+	for _, v := range src {
+		maxX = max(maxX, scale*float64(v))
+	}
+
+	if math.IsInf(maxX, -1) {
+		clear(dst)
+		return
+	}
+
+	sum := 0.0
+
+	for i, v := range src {
+		e := iapprox.Exp(scale*float64(v)-maxX, prec)
+		dst[i] = T(e)
+		sum += e
+	}
+
+	inv := 1 / sum
+	for i := range dst {
+		dst[i] = T(float64(dst[i]) * inv)
+	}
+}
@@ -0,0 +1,166 @@
+package approx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+func TestScaledSoftmaxRows(t *testing.T) {
+	t.Parallel()
+
+	const rows, cols = 7, 33
+
+	r := Seeded(5)
+	src := make([]float64, rows*cols)
+
+	for i := range src {
+		src[i] = 20 * r.NormFloat64()
+	}
+
+	scale := 1 / math.Sqrt(cols)
+
+	cases := []struct {
+		prec Precision
+		tol  float64
+	}{
+		{PrecisionFast, 2e-3},
+		{PrecisionBalanced, 1e-5},
+		{PrecisionHigh, 1e-8},
+	}
+
+	for _, tc := range cases {
+		dst := make([]float64, len(src))
+		ScaledSoftmaxRowsPrec(dst, src, rows, cols, scale, tc.prec)
+
+		for row := range rows {
+			want := softmaxReference(src[row*cols:(row+1)*cols], 1/scale)
+			sum := 0.0
+
+			for j, w := range want {
+				got := dst[row*cols+j]
+				sum += got
+
+				if math.Abs(got-w) > tc.tol*w+1e-300 {
+					t.Fatalf("prec %v: [%d][%d] = %.10g, want %.10g", tc.prec, row, j, got, w)
+				}
+			}
+
+			if math.Abs(sum-1) > 1e-12 {
+				t.Fatalf("prec %v: row %d sums to %.15g", tc.prec, row, sum)
+			}
+		}
+	}
+}
+
+func TestScaledSoftmaxRows_MaskedAndInPlace(t *testing.T) {
+	t.Parallel()
+
+	inf := float32(math.Inf(-1))
+	m := []float32{
+		0, inf, 0, inf,
+		inf, inf, inf, inf,
+		1e30, 1e30, 0, 0,
+	}
+
+	ScaledSoftmaxRows(m, m, 3, 4, 1)
+
+	want := []float32{0.5, 0, 0.5, 0, 0, 0, 0, 0, 0.5, 0.5, 0, 0}
+	for i := range want {
+		if math.Abs(float64(m[i]-want[i])) > 1e-6 {
+			t.Fatalf("m[%d] = %g, want %g", i, m[i], want[i])
+		}
+	}
+}
+
+func TestScaledSoftmaxRowsParallel_MatchesSequential(t *testing.T) {
+	t.Parallel()
+
+	const rows, cols = 301, 257
+
+	r := Seeded(6)
+	src := make([]float32, rows*cols)
+
+	for i := range src {
+		src[i] = float32(r.NormFloat64())
+	}
+
+	seq := make([]float32, len(src))
+	ScaledSoftmaxRowsPrec(seq, src, rows, cols, 0.125, PrecisionFast)
+
+	for _, workers := range []int{0, 1, 3, 64} {
+		par := make([]float32, len(src))
+		ScaledSoftmaxRowsParallel(par, src, rows, cols, 0.125, PrecisionFast, workers)
+
+		for i := range seq {
+			if par[i] != seq[i] {
+				t.Fatalf("workers %d: element %d = %g, want %g", workers, i, par[i], seq[i])
+			}
+		}
+	}
+}
+
+func TestScaledSoftmaxRows_ShapeMismatchPanics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("shape mismatch did not panic")
+		}
+	}()
+
+	ScaledSoftmaxRows(make([]float64, 6), make([]float64, 6), 2, 4, 1)
+}
+
+func TestExpWeights(t *testing.T) {
+	t.Parallel()
+
+	logits := []float64{-1000, -1001, -1003.5, math.Inf(-1)}
+	dst := make([]float64, len(logits))
+
+	ExpWeightsPrec(dst, logits, PrecisionHigh)
+
+	want := make([]float64, len(logits))
+	for i, x := range logits {
+		want[i] = math.Exp(x + 1000)
+	}
+
+	if !testutil.AssertSliceClose(t, dst, want, 1e-8) {
+		t.FailNow()
+	}
+
+	if dst[0] != 1 {
+		t.Fatalf("largest weight = %v, want exactly 1", dst[0])
+	}
+
+	// In place, float32, and the degenerate rows.
+	w32 := []float32{2, 1, 0}
+	ExpWeights(w32, w32)
+
+	if w32[0] != 1 || math.Abs(float64(w32[2])-math.Exp(-2)) > 1e-5 {
+		t.Fatalf("in-place float32 weights = %v", w32)
+	}
+
+	masked := []float64{math.Inf(-1), math.Inf(-1)}
+	if ExpWeights(masked, masked); masked[0] != 0 || masked[1] != 0 {
+		t.Fatalf("all -Inf logits gave %v, want zeros", masked)
+	}
+
+	bad := []float64{0, math.NaN()}
+	if ExpWeights(bad, bad); !math.IsNaN(bad[0]) {
+		t.Fatalf("NaN logit gave %v, want NaN weights", bad)
+	}
+}
+
+func TestExpWeights_LengthMismatchPanics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected a panic")
+		}
+	}()
+
+	ExpWeights(make([]float64, 1), make([]float64, 2))
+}
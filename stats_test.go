@@ -0,0 +1,174 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFastLogBeta(t *testing.T) {
+	t.Parallel()
+
+	// B(2, 3) = 1/12
+	if got := FastLogBetaPrec(2.0, 3.0, PrecisionHigh); math.Abs(got+math.Log(12)) > 1e-10 {
+		t.Fatalf("FastLogBeta(2, 3) = %.15g", got)
+	}
+
+	// B(½, ½) = π
+	if got := FastLogBeta32(0.5, 0.5); math.Abs(float64(got)-math.Log(math.Pi)) > 1e-4 {
+		t.Fatalf("FastLogBeta32(½, ½) = %g", got)
+	}
+}
+
+func TestFastBetaInc(t *testing.T) {
+	t.Parallel()
+
+	// I_x(2, 2) = 3x² - 2x³
+	for x := 0.0; x <= 1; x += 0.125 {
+		want := 3*x*x - 2*x*x*x
+		if got := FastBetaIncPrec(2.0, 2.0, x, PrecisionHigh); math.Abs(got-want) > 1e-8 {
+			t.Fatalf("FastBetaInc(2, 2, %g) = %.12g, want %.12g", x, got, want)
+		}
+	}
+
+	// Student-t: P(|T| < t) for ν = 1 (Cauchy) is (2/π)·atan(t), and equals
+	// 1 - I_{ν/(ν+t²)}(ν/2, ½).
+	tv := 1.5
+	want := 2 / math.Pi * math.Atan(tv)
+
+	if got := 1 - FastBetaInc(0.5, 0.5, 1/(1+tv*tv)); math.Abs(got-want) > 1e-5 {
+		t.Fatalf("Cauchy CDF via FastBetaInc = %.8g, want %.8g", got, want)
+	}
+
+	if !math.IsNaN(float64(FastBetaInc32(0, 1, 0.5))) {
+		t.Fatal("a = 0 does not return NaN")
+	}
+}
+
+func TestFastPoissonCDF(t *testing.T) {
+	t.Parallel()
+
+	// P(X <= 2) for λ = 3 is e^-3 (1 + 3 + 9/2).
+	want := math.Exp(-3) * 8.5
+
+	if got := FastPoissonCDFPrec(2, 3, PrecisionHigh); math.Abs(got-want) > 1e-8 {
+		t.Fatalf("FastPoissonCDFPrec(2, 3, High) = %.12g, want %.12g", got, want)
+	}
+
+	if got := FastPoissonCDF(2, 3); math.Abs(got-want) > 7e-3 {
+		t.Fatalf("FastPoissonCDF(2, 3) = %.6g, want %.6g", got, want)
+	}
+
+	if FastPoissonCDF(-1, 3) != 0 || !math.IsNaN(FastPoissonCDF(2, math.NaN())) {
+		t.Fatal("FastPoissonCDF edge values")
+	}
+}
+
+func TestFastBinomialCDF(t *testing.T) {
+	t.Parallel()
+
+	// P(X <= 1) for n = 4, p = ½ is 5/16.
+	if got := FastBinomialCDFPrec(1, 4, 0.5, PrecisionHigh); math.Abs(got-5.0/16) > 1e-8 {
+		t.Fatalf("FastBinomialCDFPrec(1, 4, ½, High) = %.12g", got)
+	}
+
+	if got := FastBinomialCDF(1, 4, 0.5); math.Abs(got-5.0/16) > 1.2e-2 {
+		t.Fatalf("FastBinomialCDF(1, 4, ½) = %.6g", got)
+	}
+
+	if FastBinomialCDF(4, 4, 0.3) != 1 || !math.IsNaN(FastBinomialCDF(1, 4, 2)) {
+		t.Fatal("FastBinomialCDF edge values")
+	}
+}
+
+func TestFastPhiInv(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct{ p, want float64 }{
+		{0.975, 1.959963984540054},
+		{0.5, 0},
+		{0.1, -1.2815515655446004},
+		{1e-10, -6.361340902404056},
+	}
+
+	for _, tc := range cases {
+		if got := FastPhiInvPrec(tc.p, PrecisionHigh); math.Abs(got-tc.want) > 1e-15*max(1, math.Abs(tc.want)) {
+			t.Fatalf("FastPhiInvPrec(%g, High) = %.17g, want %.17g", tc.p, got, tc.want)
+		}
+
+		if got := FastPhiInv(tc.p); math.Abs(got-tc.want) > 4e-9 {
+			t.Fatalf("FastPhiInv(%g) = %.12g, want %.12g", tc.p, got, tc.want)
+		}
+	}
+
+	if got, want := FastPhiInv32(0.975), float32(1.959963984540054); math.Abs(float64(got-want)) > 1e-6 {
+		t.Fatalf("FastPhiInv32(0.975) = %v, want %v", got, want)
+	}
+
+	if !math.IsInf(FastPhiInv64(0), -1) || !math.IsInf(FastPhiInv64(1), 1) {
+		t.Fatal("FastPhiInv edge values")
+	}
+}
+
+func TestFastNormCDF(t *testing.T) {
+	t.Parallel()
+
+	for _, z := range []float64{-7, -1.959963984540054, -0.5, 0, 0.5, 1.959963984540054, 7} {
+		want := 0.5 * math.Erfc(-z/math.Sqrt2)
+
+		if got := FastNormCDFPrec(z, PrecisionHigh); math.Abs(got-want) > 5e-9 {
+			t.Errorf("FastNormCDFPrec(%g, High) = %.12g, want %.12g", z, got, want)
+		}
+
+		if got := FastNormCDF(z); math.Abs(got-want) > 1e-6 {
+			t.Errorf("FastNormCDF(%g) = %.12g, want %.12g", z, got, want)
+		}
+
+		// The mirrored tails sum to one.
+		if s := FastNormCDF(z) + FastNormCDF(-z); z != 0 && math.Abs(s-1) > 1e-15 {
+			t.Errorf("Φ(%g) + Φ(%g) = %.17g, want 1", z, -z, s)
+		}
+	}
+
+	if got := FastNormCDF32(-1.959964); math.Abs(float64(got)-0.025) > 1e-6 {
+		t.Errorf("FastNormCDF32(-1.96) = %v, want 0.025", got)
+	}
+
+	if !math.IsNaN(FastNormCDF64(math.NaN())) || FastNormCDF64(math.Inf(-1)) != 0 || FastNormCDF64(math.Inf(1)) != 1 {
+		t.Error("FastNormCDF special values")
+	}
+}
+
+func TestNormCDFInto(t *testing.T) {
+	t.Parallel()
+
+	z := make([]float64, 101)
+	for i := range z {
+		z[i] = -5 + 0.1*float64(i)
+	}
+
+	for _, prec := range []Precision{PrecisionAuto, PrecisionFast, PrecisionHigh} {
+		dst := make([]float64, len(z))
+		NormCDFIntoPrec(dst, z, prec)
+
+		for i, x := range z {
+			if want := FastNormCDFPrec(x, prec); dst[i] != want {
+				t.Fatalf("prec %v: dst[%d] = %v, want %v", prec, i, dst[i], want)
+			}
+		}
+	}
+
+	z32 := []float32{-1, 0, 1}
+	NormCDFInto(z32, z32)
+
+	if z32[1] != 0.5 || math.Abs(float64(z32[0]+z32[2])-1) > 1e-7 {
+		t.Errorf("in-place float32 NormCDFInto = %v", z32)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("length mismatch did not panic")
+		}
+	}()
+
+	NormCDFInto(make([]float64, 2), z)
+}
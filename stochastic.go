@@ -0,0 +1,127 @@
+package approx
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// Stochastic rounding rounds x up to the next representable value with
+// probability proportional to its distance from the value below, so the
+// expected result equals x and accumulated quantization error does not
+// drift in one direction. The kernels below draw exactly one r.Uint32 per
+// element, whatever its value, so a generator from Seeded reproduces the
+// same output for the same input, and splitting a slice into chunks with
+// their own SeededStream generators keeps results independent of
+// scheduling. A nil r draws from Default.
+
+// F32ToF16Stochastic stores the binary16 encoding of src[i] in dst[i] with
+// stochastic rounding, using r for the random bits.
+//
+// Finite values at or above 2^16 become ±Inf, values between 65504 and 2^16
+// round up to Inf with the proportional probability, and NaN converts as in
+// Float16Bits. It panics with ErrLengthMismatch if the slices differ in
+// length.
+func F32ToF16Stochastic(dst []uint16, src []float32, r *rand.Rand) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("F32ToF16Stochastic")
+	}
+
+	r = orDefault(r)
+
+	for i, x := range src {
+		dst[i] = float16Stochastic(x, r.Uint32())
+	}
+}
+
+// F32ToBF16Stochastic stores the bfloat16 encoding of src[i] in dst[i] with
+// stochastic rounding, using r for the random bits. NaN converts as in
+// BFloat16Bits. It panics with ErrLengthMismatch if the slices differ in
+// length.
+func F32ToBF16Stochastic(dst []uint16, src []float32, r *rand.Rand) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("F32ToBF16Stochastic")
+	}
+
+	r = orDefault(r)
+
+	for i, x := range src {
+		bits := math.Float32bits(x)
+		if bits&^0x80000000 > f32ExpMask {
+			r.Uint32()
+
+			dst[i] = BFloat16Bits(x)
+
+			continue
+		}
+
+		// Adding uniform bits below the kept half carries into it with
+		// probability equal to the dropped fraction.
+		dst[i] = uint16((bits + r.Uint32()&0xffff) >> 16) //nolint:gosec
+	}
+}
+
+// QuantizeInt8Stochastic is QuantizeInt8 with stochastic instead of
+// round-to-nearest-even rounding of src[i]/scale, using r for the random
+// bits. Saturation and NaN handling match QuantizeInt8.
+func QuantizeInt8Stochastic(dst []int8, src []float32, scale float32, zp int8, r *rand.Rand) {
+	if len(dst) != len(src) {
+		panicLengthMismatch("QuantizeInt8Stochastic")
+	}
+
+	r = orDefault(r)
+
+	checkPositive("QuantizeInt8Stochastic", scale, PrecisionAuto)
+
+	inv := 1 / float64(scale)
+	z := float64(zp)
+
+	for i, x := range src {
+		q := stochasticRound(float64(x)*inv, r.Uint32()) + z
+
+		switch {
+		case q != q: //nolint:gocritic
+			dst[i] = zp
+		case q <= math.MinInt8:
+			dst[i] = math.MinInt8
+		case q >= math.MaxInt8:
+			dst[i] = math.MaxInt8
+		default:
+			dst[i] = int8(q)
+		}
+	}
+}
+
+// float16Stochastic converts x to binary16, rounding the magnitude up with
+// probability equal to the dropped fraction, taken from the uniform bits u.
+func float16Stochastic(x float32, u uint32) uint16 {
+	bits := math.Float32bits(x)
+	sign := uint16(bits>>16) & 0x8000 //nolint:gosec
+	abs := bits &^ 0x80000000
+
+	switch {
+	case abs > f32ExpMask:
+		return Float16Bits(x)
+	case abs >= f16InfBits:
+		return sign | f16ExpMask
+	case abs < f16MinNormalBits:
+		// Subnormal step 2^-24: the scaled magnitude is exact in float64.
+		return sign | uint16(stochasticRound(float64(math.Float32frombits(abs))*0x1p24, u)) //nolint:gosec
+	default:
+		return sign | uint16((abs+f16RebiasBits+u&0x1fff)>>13) //nolint:gosec
+	}
+}
+
+// stochasticRound rounds v to floor(v) or floor(v)+1, choosing the latter
+// when the 32-bit uniform u falls below the fractional part.
+func stochasticRound(v float64, u uint32) float64 {
+	if math.Abs(v) >= 1<<52 || v != v { //nolint:gocritic
+		return v
+	}
+
+	fl := math.Floor(v)
+	if float64(u)*0x1p-32 < v-fl {
+		fl++
+	}
+
+	return fl
+}
@@ -0,0 +1,130 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStochasticRounding_Unbiased(t *testing.T) {
+	t.Parallel()
+
+	const n = 100000
+
+	// A binary16 subnormal, a normal value in each format and an int8 step.
+	inputs := []float32{3e-7, 1.0001, -123.456, 0.3}
+	src := make([]float32, n)
+	h := make([]uint16, n)
+	q := make([]int8, n)
+
+	for _, x := range inputs {
+		for i := range src {
+			src[i] = x
+		}
+
+		r := Seeded(uint64(math.Float32bits(x)))
+
+		F32ToF16Stochastic(h, src, r)
+		checkStochasticMean(t, "F32ToF16Stochastic", x, h, Float16Frombits)
+
+		F32ToBF16Stochastic(h, src, r)
+		checkStochasticMean(t, "F32ToBF16Stochastic", x, h, BFloat16Frombits)
+
+		QuantizeInt8Stochastic(q, src, 1, 0, r)
+
+		sum := 0.0
+		for _, v := range q {
+			sum += float64(v)
+		}
+
+		if mean := sum / n; math.Abs(mean-float64(x)) > 5/math.Sqrt(n) {
+			t.Fatalf("QuantizeInt8Stochastic(%g) has mean %g", x, mean)
+		}
+	}
+}
+
+func checkStochasticMean(t *testing.T, name string, x float32, h []uint16, decode func(uint16) float32) {
+	t.Helper()
+
+	lo, hi := decode(h[0]), decode(h[0])
+	sum := 0.0
+
+	for _, v := range h {
+		f := decode(v)
+		lo, hi = min(lo, f), max(hi, f)
+		sum += float64(f)
+	}
+
+	if !(lo <= x && x <= hi) || (lo != hi && decode(h[0]) != lo && decode(h[0]) != hi) {
+		t.Fatalf("%s(%g): results span [%g, %g]", name, x, lo, hi)
+	}
+
+	step := float64(hi - lo)
+	if mean := sum / float64(len(h)); math.Abs(mean-float64(x)) > 5*step/math.Sqrt(float64(len(h))) {
+		t.Fatalf("%s(%g): mean %g, neighbours %g and %g", name, x, mean, lo, hi)
+	}
+}
+
+func TestStochasticRounding_ExactAndSpecial(t *testing.T) {
+	t.Parallel()
+
+	src := []float32{0, 1, -2.5, 65504, 1e6, float32(math.Inf(-1)), float32(math.NaN())}
+	h := make([]uint16, len(src))
+	r := Seeded(4)
+
+	F32ToF16Stochastic(h, src, r)
+
+	for i, want := range []uint16{0, 0x3c00, 0xc100, 0x7bff, 0x7c00, 0xfc00} {
+		if h[i] != want {
+			t.Fatalf("F32ToF16Stochastic(%g) = %#04x, want %#04x", src[i], h[i], want)
+		}
+	}
+
+	if h[6]&0x7c00 != 0x7c00 || h[6]&0x3ff == 0 {
+		t.Fatalf("NaN converted to %#04x", h[6])
+	}
+
+	F32ToBF16Stochastic(h[:3], src[:3], r)
+
+	if h[0] != 0 || h[1] != 0x3f80 || h[2] != 0xc020 {
+		t.Fatalf("exact bfloat16 values changed: %#04x", h[:3])
+	}
+
+	q := make([]int8, 3)
+	QuantizeInt8Stochastic(q, []float32{1e9, -1e9, float32(math.NaN())}, 1, 5, r)
+
+	if q[0] != 127 || q[1] != -128 || q[2] != 5 {
+		t.Fatalf("QuantizeInt8Stochastic saturation/NaN = %v", q)
+	}
+}
+
+func TestStochasticRounding_Reproducible(t *testing.T) {
+	t.Parallel()
+
+	src := make([]float32, 512)
+	for i := range src {
+		src[i] = float32(i) * 0.0137
+	}
+
+	a := make([]uint16, len(src))
+	b := make([]uint16, len(src))
+
+	F32ToF16Stochastic(a, src, Seeded(99))
+	F32ToF16Stochastic(b, src, Seeded(99))
+
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("element %d differs under the same seed", i)
+		}
+	}
+
+	// One draw per element: a NaN in the input does not shift later draws.
+	withNaN := append([]float32(nil), src...)
+	withNaN[0] = float32(math.NaN())
+	F32ToF16Stochastic(b, withNaN, Seeded(99))
+
+	for i := 1; i < len(a); i++ {
+		if a[i] != b[i] {
+			t.Fatalf("element %d changed after a NaN earlier in the slice", i)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+// Package stream applies approx functions to numeric text streams, for shell
+// pipelines and ETL jobs: a Transformer reads whitespace- or CSV-separated
+// floats, runs a Chain of functions over the selected columns and writes the
+// results with the same line structure. cmd/approx-cli wraps it as
+// approx-cli eval.
+package stream
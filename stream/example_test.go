@@ -0,0 +1,30 @@
+package stream_test
+
+import (
+	"os"
+	"strings"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/stream"
+)
+
+func ExampleTransformer() {
+	// Log-transform the second column of a CSV feed, keeping the header.
+	tr := &stream.Transformer{
+		Chain:    stream.Chain{approx.FastLog[float64]},
+		Comma:    ',',
+		Columns:  []int{1},
+		Format:   'f',
+		Prec:     2,
+		PassText: true,
+	}
+
+	in := "user,bytes\nalice,1000\nbob,1\n"
+	if err := tr.Transform(os.Stdout, strings.NewReader(in)); err != nil {
+		panic(err)
+	}
+	// Output:
+	// user,bytes
+	// alice,6.91
+	// bob,0.00
+}
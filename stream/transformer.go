@@ -0,0 +1,190 @@
+package stream
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	approx "github.com/meko-christian/algo-approx"
+	"github.com/meko-christian/algo-approx/internal/reference"
+)
+
+// Chain is a sequence of functions applied left to right.
+type Chain []func(float64) float64
+
+// Apply returns x passed through every function of c in order.
+func (c Chain) Apply(x float64) float64 {
+	for _, f := range c {
+		x = f(x)
+	}
+
+	return x
+}
+
+// BudgetChain returns the Chain applying fns in order, each at the tier
+// approx.BudgetSplit picks so that the whole chain stays within the relative
+// error budget. An unknown Function yields an error wrapping
+// approx.ErrDomainError.
+func BudgetChain(budget float64, fns ...approx.Function) (Chain, error) {
+	tiers := approx.BudgetSplit(budget, fns)
+	c := make(Chain, len(fns))
+
+	for i, fn := range fns {
+		pair, ok := reference.ForFunction(fn)
+		if !ok {
+			return nil, fmt.Errorf("stream: budget chain: %v: %w", fn, approx.ErrDomainError)
+		}
+
+		prec := tiers[i]
+		c[i] = func(x float64) float64 { return pair.Approx(x, prec) }
+	}
+
+	return c, nil
+}
+
+// Transformer rewrites numeric text records. The zero value passes every
+// number through unchanged, splitting on whitespace.
+//
+// A Transformer is safe for concurrent use once configured, as long as its
+// fields are not modified.
+type Transformer struct {
+	// Chain is applied to every selected field.
+	Chain Chain
+
+	// Comma selects CSV input and output with that separator, handled by
+	// encoding/csv including quoting. Zero splits lines on runs of
+	// whitespace and joins the output fields with single spaces.
+	Comma rune
+
+	// Columns lists the zero-based fields to transform; nil selects all.
+	// Other fields are copied verbatim.
+	Columns []int
+
+	// Format and Prec are the strconv.FormatFloat arguments for results; a
+	// zero Format means 'g' with the shortest exact representation.
+	Format byte
+	Prec   int
+
+	// PassText copies selected fields that are not numbers verbatim, such
+	// as a header line. Otherwise they stop the transformation with an
+	// error wrapping approx.ErrDomainError that names the line and field.
+	PassText bool
+}
+
+// Transform reads records from src until EOF and writes the transformed
+// records to dst. On an error, the records before the failing one have
+// already been written to dst.
+func (t *Transformer) Transform(dst io.Writer, src io.Reader) error {
+	if t.Comma != 0 {
+		return t.transformCSV(dst, src)
+	}
+
+	bw := bufio.NewWriter(dst)
+	sc := bufio.NewScanner(src)
+	sc.Buffer(make([]byte, 0, 64<<10), 16<<20)
+
+	for line := 1; sc.Scan(); line++ {
+		fields := strings.Fields(sc.Text())
+		if err := t.apply(fields, line); err != nil {
+			_ = bw.Flush() // the record error takes precedence
+
+			return err
+		}
+
+		if _, err := bw.WriteString(strings.Join(fields, " ") + "\n"); err != nil {
+			return err
+		}
+	}
+
+	if err := sc.Err(); err != nil {
+		_ = bw.Flush()
+
+		return err
+	}
+
+	return bw.Flush()
+}
+
+func (t *Transformer) transformCSV(dst io.Writer, src io.Reader) error {
+	cr := csv.NewReader(src)
+	cr.Comma = t.Comma
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+
+	cw := csv.NewWriter(dst)
+	cw.Comma = t.Comma
+
+	for line := 1; ; line++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			cw.Flush()
+
+			return err
+		}
+
+		if err := t.apply(record, line); err != nil {
+			cw.Flush()
+
+			return err
+		}
+
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// apply rewrites the selected fields of one record in place.
+func (t *Transformer) apply(fields []string, line int) error {
+	format := t.Format
+	prec := t.Prec
+
+	if format == 0 {
+		format, prec = 'g', -1
+	}
+
+	for i, f := range fields {
+		if t.Columns != nil && !slices.Contains(t.Columns, i) {
+			continue
+		}
+
+		x, err := strconv.ParseFloat(strings.TrimSpace(f), 64)
+		if err != nil {
+			if t.PassText {
+				continue
+			}
+
+			return fmt.Errorf("stream: line %d, field %d: %q is not a number: %w", line, i+1, f, approx.ErrDomainError)
+		}
+
+		fields[i] = strconv.FormatFloat(t.Chain.Apply(x), format, prec, 64)
+	}
+
+	return nil
+}
+
+// Reader returns a reader of src's transformed records, for composing with
+// bufio and other io.Reader based pipelines. A transformation error is
+// returned by Read once the output before it has been consumed. The
+// transformation runs in a goroutine that ends when src is exhausted or the
+// returned reader is closed.
+func (t *Transformer) Reader(src io.Reader) io.ReadCloser {
+	pr, pw := io.Pipe()
+
+	go func() { pw.CloseWithError(t.Transform(pw, src)) }()
+
+	return pr
+}
@@ -0,0 +1,105 @@
+package stream
+
+import (
+	"errors"
+	"io"
+	"math"
+	"strings"
+	"testing"
+
+	approx "github.com/meko-christian/algo-approx"
+)
+
+func TestTransformWhitespace(t *testing.T) {
+	t.Parallel()
+
+	tr := &Transformer{Chain: Chain{func(x float64) float64 { return 2 * x }, math.Sqrt}}
+
+	var out strings.Builder
+	if err := tr.Transform(&out, strings.NewReader("8 2\t 0.5\n\n  18\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := out.String(), "4 2 1\n\n6\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestTransformCSVColumnsAndHeader(t *testing.T) {
+	t.Parallel()
+
+	tr := &Transformer{
+		Chain:    Chain{func(x float64) float64 { return approx.FastExpPrec(x, approx.PrecisionHigh) }},
+		Comma:    ',',
+		Columns:  []int{1},
+		Format:   'f',
+		Prec:     3,
+		PassText: true,
+	}
+
+	in := "id,rate\n\"a,b\",0\nc,1\n"
+
+	var out strings.Builder
+	if err := tr.Transform(&out, strings.NewReader(in)); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := out.String(), "id,rate\n\"a,b\",1.000\nc,2.718\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestTransformRejectsText(t *testing.T) {
+	t.Parallel()
+
+	var tr Transformer
+
+	err := tr.Transform(io.Discard, strings.NewReader("1 2\n3 x\n"))
+	if !errors.Is(err, approx.ErrDomainError) || !strings.Contains(err.Error(), "line 2, field 2") {
+		t.Fatalf("err = %v, want a domain error naming line 2, field 2", err)
+	}
+}
+
+func TestReader(t *testing.T) {
+	t.Parallel()
+
+	tr := &Transformer{Chain: Chain{func(x float64) float64 { return -x }}}
+
+	got, err := io.ReadAll(tr.Reader(strings.NewReader("1\n2\n")))
+	if err != nil || string(got) != "-1\n-2\n" {
+		t.Fatalf("ReadAll = %q, %v", got, err)
+	}
+
+	// Output before the bad record arrives first, then the error.
+	got, err = io.ReadAll(tr.Reader(strings.NewReader("1 2\n3 4\nfoo\n")))
+	if !errors.Is(err, approx.ErrDomainError) || string(got) != "-1 -2\n-3 -4\n" {
+		t.Fatalf("ReadAll = %q, %v; want %q and a domain error", got, err, "-1 -2\n-3 -4\n")
+	}
+
+	tr.Comma = ','
+
+	got, err = io.ReadAll(tr.Reader(strings.NewReader("1,2\nfoo\n")))
+	if !errors.Is(err, approx.ErrDomainError) || string(got) != "-1,-2\n" {
+		t.Fatalf("CSV ReadAll = %q, %v; want %q and a domain error", got, err, "-1,-2\n")
+	}
+}
+
+func TestBudgetChain(t *testing.T) {
+	t.Parallel()
+
+	// sqrt∘exp∘log is √x, with three errors to share the budget.
+	c, err := BudgetChain(1e-5, approx.FuncLog, approx.FuncExp, approx.FuncSqrt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, x := range []float64{0.3, 2, 17} {
+		if got, want := c.Apply(x), math.Sqrt(x); math.Abs(got-want) > 1e-5*want {
+			t.Errorf("chain(%g) = %v, want %v", x, got, want)
+		}
+	}
+
+	if _, err := BudgetChain(1e-5, approx.Function(0)); !errors.Is(err, approx.ErrDomainError) {
+		t.Errorf("unknown function: err = %v", err)
+	}
+}
@@ -0,0 +1,273 @@
+package approx
+
+import (
+	"math"
+
+	iapprox "github.com/meko-christian/algo-approx/internal/approx"
+)
+
+// SymEigen2 returns the eigenvalues of the symmetric 2x2 matrix m in ascending
+// order and the matching unit eigenvectors (vectors[k] belongs to values[k]),
+// using the closed form mean ± hypot((a-d)/2, b) and the default precision.
+//
+// Only m[0][1] is read for the off-diagonal element.
+func SymEigen2[T Float](m [2][2]T) (values [2]T, vectors [2][2]T) {
+	return SymEigen2Prec(m, PrecisionAuto)
+}
+
+// SymEigen2Prec is SymEigen2 using the requested precision.
+func SymEigen2Prec[T Float](m [2][2]T, prec Precision) (values [2]T, vectors [2][2]T) {
+	p := iapprox.Precision(normalizePrecision(prec))
+	a, b, d := float64(m[0][0]), float64(m[0][1]), float64(m[1][1])
+
+	if b == 0 {
+		if a <= d {
+			return [2]T{T(a), T(d)}, [2][2]T{{1, 0}, {0, 1}}
+		}
+
+		return [2]T{T(d), T(a)}, [2][2]T{{0, 1}, {1, 0}}
+	}
+
+	mean := 0.5 * (a + d)
+	rad := iapprox.Hypot(0.5*(a-d), b, p)
+	hi := mean + rad
+
+	// Eigenvector of the larger eigenvalue; pick the better-conditioned row.
+	vx, vy := b, hi-a
+	if ux, uy := hi-d, b; ux*ux+uy*uy > vx*vx+vy*vy {
+		vx, vy = ux, uy
+	}
+
+	inv := iapprox.InvSqrt(vx*vx+vy*vy, p)
+	vx, vy = vx*inv, vy*inv
+
+	return [2]T{T(mean - rad), T(hi)}, [2][2]T{{T(-vy), T(vx)}, {T(vx), T(vy)}}
+}
+
+// SymEigen3 returns the eigenvalues of the symmetric 3x3 matrix m in ascending
+// order and the matching unit eigenvectors (vectors[k] belongs to values[k]),
+// using the default precision.
+//
+// The eigenvalues come from the trigonometric solution of the characteristic
+// cubic (one arccos and two cosines); the eigenvectors from cross products of
+// the rows of m - λI. Only the upper triangle of m is read.
+func SymEigen3[T Float](m [3][3]T) (values [3]T, vectors [3][3]T) {
+	return SymEigen3Prec(m, PrecisionAuto)
+}
+
+// SymEigen3Prec is SymEigen3 using the requested precision.
+//
+//nolint:varnamelen
+func SymEigen3Prec[T Float](m [3][3]T, prec Precision) (values [3]T, vectors [3][3]T) {
+	p := iapprox.Precision(normalizePrecision(prec))
+
+	var a [3][3]float64
+
+	for i := range 3 {
+		for j := i; j < 3; j++ {
+			a[i][j] = float64(m[i][j])
+			a[j][i] = a[i][j]
+		}
+	}
+
+	l := symEigenvalues3(&a, p)
+
+	var v [3][3]float64
+
+	// Solve for the most isolated eigenvalue first: its null space is one
+	// dimensional even when the other two eigenvalues coincide.
+	iso, other := 0, 2
+	if l[2]-l[1] > l[1]-l[0] {
+		iso, other = 2, 0
+	}
+
+	var ok bool
+
+	if v[iso], ok = symNullVector3(&a, l[iso], p); !ok {
+		// All three eigenvalues are equal: m is a multiple of the identity.
+		return [3]T{T(l[0]), T(l[1]), T(l[2])}, [3][3]T{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+	}
+
+	if v[other], ok = symNullVector3(&a, l[other], p); !ok || math.Abs(dot3(v[iso], v[other])) > 0.5 {
+		v[other] = anyOrthogonal3(v[iso], p)
+	}
+
+	v[1] = cross3(v[2], v[0])
+
+	for k := range 3 {
+		values[k] = T(l[k])
+		vectors[k] = [3]T{T(v[k][0]), T(v[k][1]), T(v[k][2])}
+	}
+
+	return values, vectors
+}
+
+// SymSqrt2 returns the principal square root of the symmetric positive
+// semi-definite 2x2 matrix m using the default precision. Negative
+// eigenvalues caused by rounding are clamped to zero.
+func SymSqrt2[T Float](m [2][2]T) [2][2]T { return SymSqrt2Prec(m, PrecisionAuto) }
+
+// SymSqrt2Prec is SymSqrt2 using the requested precision.
+func SymSqrt2Prec[T Float](m [2][2]T, prec Precision) [2][2]T {
+	vals, vecs := SymEigen2Prec(m, prec)
+	p := iapprox.Precision(normalizePrecision(prec))
+
+	return spectral2(vals, vecs, func(x T) T { return iapprox.Sqrt(max(x, 0), p) })
+}
+
+// SymInvSqrt2 returns the inverse principal square root m^(-1/2) of the
+// symmetric positive definite 2x2 matrix m using the default precision. This
+// is the whitening transform for a 2D covariance matrix.
+func SymInvSqrt2[T Float](m [2][2]T) [2][2]T { return SymInvSqrt2Prec(m, PrecisionAuto) }
+
+// SymInvSqrt2Prec is SymInvSqrt2 using the requested precision.
+func SymInvSqrt2Prec[T Float](m [2][2]T, prec Precision) [2][2]T {
+	vals, vecs := SymEigen2Prec(m, prec)
+	p := iapprox.Precision(normalizePrecision(prec))
+
+	return spectral2(vals, vecs, func(x T) T { return iapprox.InvSqrt(max(x, 0), p) })
+}
+
+// SymSqrt3 returns the principal square root of the symmetric positive
+// semi-definite 3x3 matrix m using the default precision. Negative
+// eigenvalues caused by rounding are clamped to zero.
+func SymSqrt3[T Float](m [3][3]T) [3][3]T { return SymSqrt3Prec(m, PrecisionAuto) }
+
+// SymSqrt3Prec is SymSqrt3 using the requested precision.
+func SymSqrt3Prec[T Float](m [3][3]T, prec Precision) [3][3]T {
+	vals, vecs := SymEigen3Prec(m, prec)
+	p := iapprox.Precision(normalizePrecision(prec))
+
+	return spectral3(vals, vecs, func(x T) T { return iapprox.Sqrt(max(x, 0), p) })
+}
+
+// SymInvSqrt3 returns the inverse principal square root m^(-1/2) of the
+// symmetric positive definite 3x3 matrix m using the default precision. This
+// is the whitening transform for a 3D covariance matrix.
+func SymInvSqrt3[T Float](m [3][3]T) [3][3]T { return SymInvSqrt3Prec(m, PrecisionAuto) }
+
+// SymInvSqrt3Prec is SymInvSqrt3 using the requested precision.
+func SymInvSqrt3Prec[T Float](m [3][3]T, prec Precision) [3][3]T {
+	vals, vecs := SymEigen3Prec(m, prec)
+	p := iapprox.Precision(normalizePrecision(prec))
+
+	return spectral3(vals, vecs, func(x T) T { return iapprox.InvSqrt(max(x, 0), p) })
+}
+
+// symEigenvalues3 returns the eigenvalues of the symmetric matrix a in
+// ascending order.
+func symEigenvalues3(a *[3][3]float64, prec iapprox.Precision) [3]float64 {
+	off := a[0][1]*a[0][1] + a[0][2]*a[0][2] + a[1][2]*a[1][2]
+	if off == 0 {
+		l := [3]float64{a[0][0], a[1][1], a[2][2]}
+		sort3(&l)
+
+		return l
+	}
+
+	q := (a[0][0] + a[1][1] + a[2][2]) / 3
+	d0, d1, d2 := a[0][0]-q, a[1][1]-q, a[2][2]-q
+	p := iapprox.Sqrt((d0*d0+d1*d1+d2*d2+2*off)/6, prec)
+
+	// r = det((A - qI) / p) / 2, clamped against rounding outside [-1, 1].
+	inv := 1 / p
+	b00, b11, b22 := d0*inv, d1*inv, d2*inv
+	b01, b02, b12 := a[0][1]*inv, a[0][2]*inv, a[1][2]*inv
+	r := 0.5 * (b00*(b11*b22-b12*b12) - b01*(b01*b22-b12*b02) + b02*(b01*b12-b11*b02))
+	r = min(max(r, -1), 1)
+
+	phi := iapprox.Arccos(r, prec) / 3
+	hi := q + 2*p*iapprox.Cos(phi, prec)
+	lo := q + 2*p*iapprox.Cos(phi+2*math.Pi/3, prec)
+
+	return [3]float64{lo, 3*q - hi - lo, hi}
+}
+
+// symNullVector3 returns a unit vector spanning the null space of a - λI,
+// or false if the null space is more than one dimensional.
+func symNullVector3(a *[3][3]float64, lambda float64, prec iapprox.Precision) ([3]float64, bool) {
+	r0 := [3]float64{a[0][0] - lambda, a[0][1], a[0][2]}
+	r1 := [3]float64{a[1][0], a[1][1] - lambda, a[1][2]}
+	r2 := [3]float64{a[2][0], a[2][1], a[2][2] - lambda}
+
+	best := cross3(r0, r1)
+	bestN := dot3(best, best)
+
+	for _, c := range [2][3]float64{cross3(r0, r2), cross3(r1, r2)} {
+		if n := dot3(c, c); n > bestN {
+			best, bestN = c, n
+		}
+	}
+
+	scale := dot3(r0, r0) + dot3(r1, r1) + dot3(r2, r2)
+	if bestN <= 1e-24*scale*scale || bestN == 0 {
+		return [3]float64{}, false
+	}
+
+	inv := iapprox.InvSqrt(bestN, prec)
+
+	return [3]float64{best[0] * inv, best[1] * inv, best[2] * inv}, true
+}
+
+// anyOrthogonal3 returns a unit vector orthogonal to the unit vector v.
+func anyOrthogonal3(v [3]float64, prec iapprox.Precision) [3]float64 {
+	axis := [3]float64{1, 0, 0}
+	if math.Abs(v[0]) > 0.5 {
+		axis = [3]float64{0, 1, 0}
+	}
+
+	c := cross3(v, axis)
+	inv := iapprox.InvSqrt(dot3(c, c), prec)
+
+	return [3]float64{c[0] * inv, c[1] * inv, c[2] * inv}
+}
+
+func spectral2[T Float](vals [2]T, vecs [2][2]T, f func(T) T) [2][2]T {
+	var out [2][2]T
+
+	for k := range 2 {
+		fk := f(vals[k])
+		for i := range 2 {
+			for j := range 2 {
+				out[i][j] += fk * vecs[k][i] * vecs[k][j]
+			}
+		}
+	}
+
+	return out
+}
+
+func spectral3[T Float](vals [3]T, vecs [3][3]T, f func(T) T) [3][3]T {
+	var out [3][3]T
+
+	for k := range 3 {
+		fk := f(vals[k])
+		for i := range 3 {
+			for j := range 3 {
+				out[i][j] += fk * vecs[k][i] * vecs[k][j]
+			}
+		}
+	}
+
+	return out
+}
+
+func sort3(l *[3]float64) {
+	if l[1] < l[0] {
+		l[0], l[1] = l[1], l[0]
+	}
+
+	if l[2] < l[1] {
+		l[1], l[2] = l[2], l[1]
+	}
+
+	if l[1] < l[0] {
+		l[0], l[1] = l[1], l[0]
+	}
+}
+
+func dot3(a, b [3]float64) float64 { return a[0]*b[0] + a[1]*b[1] + a[2]*b[2] }
+
+func cross3(a, b [3]float64) [3]float64 {
+	return [3]float64{a[1]*b[2] - a[2]*b[1], a[2]*b[0] - a[0]*b[2], a[0]*b[1] - a[1]*b[0]}
+}
@@ -0,0 +1,129 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSymEigen2(t *testing.T) {
+	t.Parallel()
+
+	for _, m := range [][2][2]float64{
+		{{2, 1}, {1, 2}},
+		{{5, -2}, {-2, 1}},
+		{{3, 0}, {0, -1}},
+		{{1e-3, 4e-4}, {4e-4, 2e-3}},
+	} {
+		vals, vecs := SymEigen2Prec(m, PrecisionHigh)
+		if vals[0] > vals[1] {
+			t.Fatalf("SymEigen2(%v) values not ascending: %v", m, vals)
+		}
+
+		for k := range 2 {
+			v := vecs[k]
+			mv0 := m[0][0]*v[0] + m[0][1]*v[1]
+			mv1 := m[0][1]*v[0] + m[1][1]*v[1]
+
+			tol := 1e-9 * (math.Abs(vals[0]) + math.Abs(vals[1]))
+			if math.Abs(mv0-vals[k]*v[0]) > tol || math.Abs(mv1-vals[k]*v[1]) > tol {
+				t.Errorf("SymEigen2(%v): vector %d = %v is not an eigenvector for %g", m, k, v, vals[k])
+			}
+		}
+	}
+}
+
+func TestSymEigen3(t *testing.T) {
+	t.Parallel()
+
+	for _, m := range [][3][3]float64{
+		{{4, 1, 0.5}, {1, 3, 0.25}, {0.5, 0.25, 1}},
+		{{2, -1, 0}, {-1, 2, -1}, {0, -1, 2}},
+		{{2, 1, 1}, {1, 2, 1}, {1, 1, 2}}, // eigenvalues 1, 1, 4
+		{{1, 0, 0}, {0, 3, 0}, {0, 0, 2}},
+	} {
+		vals, vecs := SymEigen3Prec(m, PrecisionHigh)
+		wantVals, _ := JacobiEigen3Prec(m, 8, PrecisionHigh)
+
+		for k := range 3 {
+			if math.Abs(vals[k]-wantVals[k]) > 1e-4 {
+				t.Fatalf("SymEigen3(%v) values %v, Jacobi gives %v", m, vals, wantVals)
+			}
+
+			v := vecs[k]
+			if n := v[0]*v[0] + v[1]*v[1] + v[2]*v[2]; math.Abs(n-1) > 1e-6 {
+				t.Fatalf("SymEigen3(%v): vector %d not unit: %v", m, k, v)
+			}
+
+			for i := range 3 {
+				mv := m[i][0]*v[0] + m[i][1]*v[1] + m[i][2]*v[2]
+				if math.Abs(mv-vals[k]*v[i]) > 1e-3 {
+					t.Fatalf("SymEigen3(%v): vector %d = %v is not an eigenvector for %g", m, k, v, vals[k])
+				}
+			}
+		}
+	}
+}
+
+func TestSymEigen3_Identity(t *testing.T) {
+	t.Parallel()
+
+	vals, vecs := SymEigen3([3][3]float32{{2, 0, 0}, {0, 2, 0}, {0, 0, 2}})
+	if vals != [3]float32{2, 2, 2} || vecs != [3][3]float32{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}} {
+		t.Fatalf("SymEigen3(2I) = %v, %v", vals, vecs)
+	}
+}
+
+func TestSymSqrtAndInvSqrt3(t *testing.T) {
+	t.Parallel()
+
+	m := [3][3]float64{{4, 1, 0.5}, {1, 3, 0.25}, {0.5, 0.25, 1}}
+	s := SymSqrt3Prec(m, PrecisionHigh)
+	w := SymInvSqrt3Prec(m, PrecisionHigh)
+
+	for i := range 3 {
+		for j := range 3 {
+			var ss, ws float64
+			for k := range 3 {
+				ss += s[i][k] * s[k][j]
+				ws += w[i][k] * s[k][j]
+			}
+
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+
+			if math.Abs(ss-m[i][j]) > 1e-3 {
+				t.Fatalf("sqrt(m)^2 [%d][%d] = %g, want %g", i, j, ss, m[i][j])
+			}
+
+			if math.Abs(ws-want) > 1e-3 {
+				t.Fatalf("m^-1/2 * m^1/2 [%d][%d] = %g, want %g", i, j, ws, want)
+			}
+		}
+	}
+}
+
+func TestSymSqrtAndInvSqrt2(t *testing.T) {
+	t.Parallel()
+
+	m := [2][2]float64{{5, 2}, {2, 2}}
+	s := SymSqrt2Prec(m, PrecisionHigh)
+	w := SymInvSqrt2Prec(m, PrecisionHigh)
+
+	for i := range 2 {
+		for j := range 2 {
+			ss := s[i][0]*s[0][j] + s[i][1]*s[1][j]
+			ws := w[i][0]*s[0][j] + w[i][1]*s[1][j]
+
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+
+			if math.Abs(ss-m[i][j]) > 1e-6 || math.Abs(ws-want) > 1e-6 {
+				t.Fatalf("[%d][%d]: sqrt^2 = %g (want %g), invsqrt*sqrt = %g (want %g)", i, j, ss, m[i][j], ws, want)
+			}
+		}
+	}
+}
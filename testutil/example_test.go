@@ -0,0 +1,22 @@
+package testutil_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+func ExampleAssertWithinULP() {
+	var t recorder // a *testing.T in a real test
+
+	testutil.AssertWithinULP(&t, 0.1+0.2, 0.3, 1)
+	testutil.AssertClose(&t, math.Sqrt(2), 1.41421356, 1e-6)
+	testutil.AssertMonotone(&t, []float64{1, 2, 2, 1}, true)
+
+	for _, msg := range t.msgs {
+		fmt.Println(msg)
+	}
+	// Output:
+	// not increasing at [3]: 1 after 2
+}
@@ -0,0 +1,196 @@
+// Package testutil provides the tolerance checks the approx tests use, for
+// downstream tests of numeric code: closeness to a relative tolerance,
+// element-wise over slices, monotonicity and distance in ulps.
+//
+// It imports only the standard library and not testing, so it adds nothing
+// to a module's dependencies; the Assert functions report through the TB
+// subset of testing.TB and return whether the check passed, so a caller
+// can stop with t.FailNow when later checks depend on it.
+package testutil
+
+import "math"
+
+// Float matches the floating-point types the helpers accept.
+type Float interface {
+	~float32 | ~float64
+}
+
+// TB is the part of testing.TB that the Assert functions use.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// Close reports whether got is within tol of want, relative to |want|, or
+// absolutely when want is zero. Two NaNs are close, as are infinities of
+// the same sign; NaN or an infinity against anything else is not.
+func Close[T Float](got, want T, tol float64) bool {
+	g, w := float64(got), float64(want)
+
+	switch {
+	case g != g || w != w: //nolint:gocritic
+		return g != g && w != w //nolint:gocritic
+	case math.IsInf(g, 0) || math.IsInf(w, 0):
+		return g == w
+	}
+
+	d := math.Abs(g - w)
+	if w == 0 {
+		return d <= tol
+	}
+
+	return d <= tol*math.Abs(w)
+}
+
+// AssertClose reports an error through t unless Close(got, want, tol).
+func AssertClose[T Float](t TB, got, want T, tol float64) bool {
+	t.Helper()
+
+	if Close(got, want, tol) {
+		return true
+	}
+
+	t.Errorf("got %v, want %v within relative %g (off by %g)", got, want, tol, relErr(got, want))
+
+	return false
+}
+
+// AssertSliceClose reports an error through t unless got and want have the
+// same length and Close holds element-wise. The message names the first
+// mismatch and how many there are.
+func AssertSliceClose[T Float](t TB, got, want []T, tol float64) bool {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Errorf("got %d values, want %d", len(got), len(want))
+
+		return false
+	}
+
+	first, n := -1, 0
+
+	for i := range got {
+		if !Close(got[i], want[i], tol) {
+			if first < 0 {
+				first = i
+			}
+
+			n++
+		}
+	}
+
+	if n == 0 {
+		return true
+	}
+
+	t.Errorf("%d of %d values differ; first at [%d]: got %v, want %v within relative %g",
+		n, len(got), first, got[first], want[first], tol)
+
+	return false
+}
+
+// AssertMonotone reports an error through t unless ys never decreases, or
+// never increases when increasing is false. Equal neighbours are allowed; a
+// NaN fails.
+func AssertMonotone[T Float](t TB, ys []T, increasing bool) bool {
+	t.Helper()
+
+	for i, y := range ys {
+		if y != y { //nolint:gocritic
+			t.Errorf("[%d] is NaN", i)
+
+			return false
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		if prev := ys[i-1]; (increasing && y < prev) || (!increasing && y > prev) {
+			dir := "increasing"
+			if !increasing {
+				dir = "decreasing"
+			}
+
+			t.Errorf("not %s at [%d]: %v after %v", dir, i, y, prev)
+
+			return false
+		}
+	}
+
+	return true
+}
+
+// ULPDiff returns the number of representable values of T between a and b:
+// 0 for equal values, the two zeros included, and 1 for neighbours. It
+// returns math.MaxUint64 if either is NaN.
+func ULPDiff[T Float](a, b T) uint64 {
+	if a != a || b != b { //nolint:gocritic
+		return math.MaxUint64
+	}
+
+	var ia, ib int64
+
+	if isFloat32(a) {
+		ia, ib = ordered32(float32(a)), ordered32(float32(b))
+	} else {
+		ia, ib = ordered64(float64(a)), ordered64(float64(b))
+	}
+
+	if ia > ib {
+		ia, ib = ib, ia
+	}
+
+	return uint64(ib) - uint64(ia)
+}
+
+// AssertWithinULP reports an error through t unless got is at most ulps
+// representable values of T away from want.
+func AssertWithinULP[T Float](t TB, got, want T, ulps uint64) bool {
+	t.Helper()
+
+	d := ULPDiff(got, want)
+	if d <= ulps {
+		return true
+	}
+
+	t.Errorf("got %v, want %v within %d ulps (off by %d)", got, want, ulps, d)
+
+	return false
+}
+
+func relErr[T Float](got, want T) float64 {
+	d := math.Abs(float64(got) - float64(want))
+	if want == 0 {
+		return d
+	}
+
+	return d / math.Abs(float64(want))
+}
+
+// isFloat32 reports whether T has float32 precision: 1 + 2^-30 rounds to 1.
+func isFloat32[T Float](T) bool {
+	one := T(1)
+
+	return one+T(0x1p-30) == one
+}
+
+// ordered32 and ordered64 map the bits of a float onto integers that
+// order like the values, with both zeros at 0.
+func ordered32(x float32) int64 {
+	b := int64(int32(math.Float32bits(x))) //nolint:gosec // reinterpreting the bits
+	if b < 0 {
+		b = math.MinInt32 - b
+	}
+
+	return b
+}
+
+func ordered64(x float64) int64 {
+	b := int64(math.Float64bits(x)) //nolint:gosec // reinterpreting the bits
+	if b < 0 {
+		b = math.MinInt64 - b
+	}
+
+	return b
+}
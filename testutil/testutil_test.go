@@ -0,0 +1,158 @@
+package testutil_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+// recorder is a TB that keeps the reported messages.
+type recorder struct{ msgs []string }
+
+func (*recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.msgs = append(r.msgs, fmt.Sprintf(format, args...))
+}
+
+func TestClose(t *testing.T) {
+	t.Parallel()
+
+	nan, inf := math.NaN(), math.Inf(1)
+
+	cases := []struct {
+		got, want, tol float64
+		ok             bool
+	}{
+		{1.0001, 1, 1e-3, true},
+		{1.01, 1, 1e-3, false},
+		{-2e6, -2e6 * (1 + 1e-10), 1e-9, true},
+		{1e-10, 0, 1e-9, true},
+		{1e-8, 0, 1e-9, false},
+		{nan, nan, 0, true},
+		{nan, 1, 1, false},
+		{1, nan, 1, false},
+		{inf, inf, 0, true},
+		{inf, -inf, 1, false},
+		{math.MaxFloat64, inf, 1, false},
+	}
+
+	for _, c := range cases {
+		if got := testutil.Close(c.got, c.want, c.tol); got != c.ok {
+			t.Errorf("Close(%v, %v, %v) = %v, want %v", c.got, c.want, c.tol, got, c.ok)
+		}
+	}
+}
+
+func TestAssertClose_Reports(t *testing.T) {
+	t.Parallel()
+
+	var r recorder
+
+	if !testutil.AssertClose(&r, float32(1.5), 1.5, 0) || len(r.msgs) != 0 {
+		t.Fatalf("equal values failed: %q", r.msgs)
+	}
+
+	if testutil.AssertClose(&r, 2.0, 1, 0.5) || len(r.msgs) != 1 {
+		t.Fatalf("AssertClose(2, 1, 0.5) passed: %q", r.msgs)
+	}
+
+	if want := "got 2, want 1 within relative 0.5 (off by 1)"; r.msgs[0] != want {
+		t.Errorf("message %q, want %q", r.msgs[0], want)
+	}
+}
+
+func TestAssertSliceClose(t *testing.T) {
+	t.Parallel()
+
+	var r recorder
+
+	if !testutil.AssertSliceClose(&r, []float64{1, 2, 3}, []float64{1, 2, 3 + 1e-12}, 1e-9) {
+		t.Fatalf("close slices failed: %q", r.msgs)
+	}
+
+	if testutil.AssertSliceClose(&r, []float64{1}, []float64{1, 2}, 1) {
+		t.Fatal("length mismatch passed")
+	}
+
+	if testutil.AssertSliceClose(&r, []float64{1, 5, 3, 7}, []float64{1, 2, 3, 4}, 1e-9) {
+		t.Fatal("mismatch passed")
+	}
+
+	if want := "2 of 4 values differ; first at [1]: got 5, want 2 within relative 1e-09"; r.msgs[1] != want {
+		t.Errorf("message %q, want %q", r.msgs[1], want)
+	}
+}
+
+func TestAssertMonotone(t *testing.T) {
+	t.Parallel()
+
+	var r recorder
+
+	if !testutil.AssertMonotone(&r, []float64{-1, 0, 0, 2}, true) ||
+		!testutil.AssertMonotone(&r, []float32{3, 1, 1, -4}, false) ||
+		!testutil.AssertMonotone(&r, []float64{}, true) {
+		t.Fatalf("monotone sequence failed: %q", r.msgs)
+	}
+
+	if testutil.AssertMonotone(&r, []float64{0, 2, 1}, true) {
+		t.Fatal("decrease passed as increasing")
+	}
+
+	if testutil.AssertMonotone(&r, []float64{0, math.NaN()}, true) {
+		t.Fatal("NaN passed")
+	}
+
+	if want := "not increasing at [2]: 1 after 2"; r.msgs[0] != want {
+		t.Errorf("message %q, want %q", r.msgs[0], want)
+	}
+}
+
+type celsius float32
+
+func TestULPDiff(t *testing.T) {
+	t.Parallel()
+
+	one32 := float32(1)
+
+	cases := []struct {
+		name string
+		got  uint64
+		want uint64
+	}{
+		{"equal", testutil.ULPDiff(1.0, 1.0), 0},
+		{"zeros", testutil.ULPDiff(0.0, math.Copysign(0, -1)), 0},
+		{"neighbour", testutil.ULPDiff(1.0, math.Nextafter(1, 2)), 1},
+		{"across zero", testutil.ULPDiff(-math.SmallestNonzeroFloat64, math.SmallestNonzeroFloat64), 2},
+		{"float32", testutil.ULPDiff(one32, math.Nextafter32(math.Nextafter32(one32, 2), 2)), 2},
+		{"named float32", testutil.ULPDiff(celsius(1), celsius(math.Nextafter32(1, 0))), 1},
+		{"inf", testutil.ULPDiff(math.MaxFloat64, math.Inf(1)), 1},
+		{"NaN", testutil.ULPDiff(math.NaN(), 1), math.MaxUint64},
+	}
+
+	for _, c := range cases {
+		if c.got != c.want {
+			t.Errorf("%s: ULPDiff = %d, want %d", c.name, c.got, c.want)
+		}
+	}
+}
+
+func TestAssertWithinULP(t *testing.T) {
+	t.Parallel()
+
+	var r recorder
+
+	if !testutil.AssertWithinULP(&r, 0.1+0.2, 0.3, 1) {
+		t.Fatalf("0.1+0.2 not within 1 ulp of 0.3: %q", r.msgs)
+	}
+
+	if testutil.AssertWithinULP(&r, 1.0, 1+0x1p-50, 3) {
+		t.Fatal("4 ulps passed as 3")
+	}
+
+	if want := "got 1, want 1.0000000000000009 within 3 ulps (off by 4)"; r.msgs[0] != want {
+		t.Errorf("message %q, want %q", r.msgs[0], want)
+	}
+}
@@ -0,0 +1,153 @@
+package approx
+
+import iapprox "github.com/meko-christian/algo-approx/internal/approx"
+
+// Vec2 is a 2D vector. Its underlying type is [2]T, so it converts to and
+// from plain arrays with Vec2[T](a) and [2]T(v). Methods have value
+// receivers and never allocate.
+type Vec2[T Float] [2]T
+
+// Vec3 is a 3D vector convertible to and from [3]T.
+type Vec3[T Float] [3]T
+
+// Vec4 is a 4D vector convertible to and from [4]T.
+type Vec4[T Float] [4]T
+
+// Add returns v + o.
+func (v Vec2[T]) Add(o Vec2[T]) Vec2[T] { return Vec2[T]{v[0] + o[0], v[1] + o[1]} }
+
+// Sub returns v - o.
+func (v Vec2[T]) Sub(o Vec2[T]) Vec2[T] { return Vec2[T]{v[0] - o[0], v[1] - o[1]} }
+
+// Scale returns s·v.
+func (v Vec2[T]) Scale(s T) Vec2[T] { return Vec2[T]{s * v[0], s * v[1]} }
+
+// Dot returns the dot product v·o.
+func (v Vec2[T]) Dot(o Vec2[T]) T { return v[0]*o[0] + v[1]*o[1] }
+
+// Cross returns the z component of the 3D cross product of v and o, i.e.
+// the signed area of the parallelogram they span.
+func (v Vec2[T]) Cross(o Vec2[T]) T { return v[0]*o[1] - v[1]*o[0] }
+
+// Length returns |v| using FastSqrt.
+func (v Vec2[T]) Length() T { return v.LengthPrec(PrecisionAuto) }
+
+// LengthPrec is Length using the requested precision.
+func (v Vec2[T]) LengthPrec(prec Precision) T { return FastSqrtPrec(v.Dot(v), prec) }
+
+// Normalize returns v scaled to unit length using FastInvSqrt. The zero
+// vector is returned unchanged.
+func (v Vec2[T]) Normalize() Vec2[T] { return v.NormalizePrec(PrecisionAuto) }
+
+// NormalizePrec is Normalize using the requested precision.
+func (v Vec2[T]) NormalizePrec(prec Precision) Vec2[T] {
+	return v.Scale(invLength(v.Dot(v), prec))
+}
+
+// Angle returns the angle in radians in [0, π] between v and o; see
+// AngleBetween2.
+func (v Vec2[T]) Angle(o Vec2[T]) T { return AngleBetween2Prec([2]T(v), [2]T(o), PrecisionAuto) }
+
+// AnglePrec is Angle using the requested precision.
+func (v Vec2[T]) AnglePrec(o Vec2[T], prec Precision) T {
+	return AngleBetween2Prec([2]T(v), [2]T(o), prec)
+}
+
+// Add returns v + o.
+func (v Vec3[T]) Add(o Vec3[T]) Vec3[T] { return Vec3[T]{v[0] + o[0], v[1] + o[1], v[2] + o[2]} }
+
+// Sub returns v - o.
+func (v Vec3[T]) Sub(o Vec3[T]) Vec3[T] { return Vec3[T]{v[0] - o[0], v[1] - o[1], v[2] - o[2]} }
+
+// Scale returns s·v.
+func (v Vec3[T]) Scale(s T) Vec3[T] { return Vec3[T]{s * v[0], s * v[1], s * v[2]} }
+
+// Dot returns the dot product v·o.
+func (v Vec3[T]) Dot(o Vec3[T]) T { return v[0]*o[0] + v[1]*o[1] + v[2]*o[2] }
+
+// Cross returns the cross product v×o.
+func (v Vec3[T]) Cross(o Vec3[T]) Vec3[T] {
+	return Vec3[T]{
+		v[1]*o[2] - v[2]*o[1],
+		v[2]*o[0] - v[0]*o[2],
+		v[0]*o[1] - v[1]*o[0],
+	}
+}
+
+// Length returns |v| using FastSqrt.
+func (v Vec3[T]) Length() T { return v.LengthPrec(PrecisionAuto) }
+
+// LengthPrec is Length using the requested precision.
+func (v Vec3[T]) LengthPrec(prec Precision) T { return FastSqrtPrec(v.Dot(v), prec) }
+
+// Normalize returns v scaled to unit length using FastInvSqrt. The zero
+// vector is returned unchanged.
+func (v Vec3[T]) Normalize() Vec3[T] { return v.NormalizePrec(PrecisionAuto) }
+
+// NormalizePrec is Normalize using the requested precision.
+func (v Vec3[T]) NormalizePrec(prec Precision) Vec3[T] {
+	return v.Scale(invLength(v.Dot(v), prec))
+}
+
+// Angle returns the angle in radians in [0, π] between v and o; see
+// AngleBetween3.
+func (v Vec3[T]) Angle(o Vec3[T]) T { return AngleBetween3Prec([3]T(v), [3]T(o), PrecisionAuto) }
+
+// AnglePrec is Angle using the requested precision.
+func (v Vec3[T]) AnglePrec(o Vec3[T], prec Precision) T {
+	return AngleBetween3Prec([3]T(v), [3]T(o), prec)
+}
+
+// Add returns v + o.
+func (v Vec4[T]) Add(o Vec4[T]) Vec4[T] {
+	return Vec4[T]{v[0] + o[0], v[1] + o[1], v[2] + o[2], v[3] + o[3]}
+}
+
+// Sub returns v - o.
+func (v Vec4[T]) Sub(o Vec4[T]) Vec4[T] {
+	return Vec4[T]{v[0] - o[0], v[1] - o[1], v[2] - o[2], v[3] - o[3]}
+}
+
+// Scale returns s·v.
+func (v Vec4[T]) Scale(s T) Vec4[T] { return Vec4[T]{s * v[0], s * v[1], s * v[2], s * v[3]} }
+
+// Dot returns the dot product v·o.
+func (v Vec4[T]) Dot(o Vec4[T]) T { return v[0]*o[0] + v[1]*o[1] + v[2]*o[2] + v[3]*o[3] }
+
+// Length returns |v| using FastSqrt.
+func (v Vec4[T]) Length() T { return v.LengthPrec(PrecisionAuto) }
+
+// LengthPrec is Length using the requested precision.
+func (v Vec4[T]) LengthPrec(prec Precision) T { return FastSqrtPrec(v.Dot(v), prec) }
+
+// Normalize returns v scaled to unit length using FastInvSqrt. The zero
+// vector is returned unchanged.
+func (v Vec4[T]) Normalize() Vec4[T] { return v.NormalizePrec(PrecisionAuto) }
+
+// NormalizePrec is Normalize using the requested precision.
+func (v Vec4[T]) NormalizePrec(prec Precision) Vec4[T] {
+	return v.Scale(invLength(v.Dot(v), prec))
+}
+
+// Angle returns the angle in radians in [0, π] between v and o, computed
+// like AngleBetween3.
+func (v Vec4[T]) Angle(o Vec4[T]) T { return v.AnglePrec(o, PrecisionAuto) }
+
+// AnglePrec is Angle using the requested precision.
+func (v Vec4[T]) AnglePrec(o Vec4[T], prec Precision) T {
+	ab := compensatedDot(v[:], o[:])
+	aa := compensatedDot(v[:], v[:])
+	bb := compensatedDot(o[:], o[:])
+
+	return T(angleFromDots(ab, aa, bb, iapprox.Precision(normalizePrecision(prec))))
+}
+
+// invLength returns 1/sqrt(sq) for the squared length sq, or 0 for a zero
+// vector so that normalizing it is a no-op.
+func invLength[T Float](sq T, prec Precision) T {
+	if sq == 0 {
+		return 0
+	}
+
+	return FastInvSqrtPrec(sq, prec)
+}
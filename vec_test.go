@@ -0,0 +1,93 @@
+package approx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+func TestVec3_Methods(t *testing.T) {
+	t.Parallel()
+
+	a := Vec3[float64]{1, 2, 2}
+	b := Vec3[float64]{0, 0, 3}
+
+	if got := a.Add(b); got != (Vec3[float64]{1, 2, 5}) {
+		t.Fatalf("Add = %v", got)
+	}
+
+	if got := a.Sub(b); got != (Vec3[float64]{1, 2, -1}) {
+		t.Fatalf("Sub = %v", got)
+	}
+
+	if got := a.Dot(b); got != 6 {
+		t.Fatalf("Dot = %g", got)
+	}
+
+	if got := (Vec3[float64]{1, 0, 0}).Cross(Vec3[float64]{0, 1, 0}); got != (Vec3[float64]{0, 0, 1}) {
+		t.Fatalf("Cross = %v", got)
+	}
+
+	if got := a.LengthPrec(PrecisionHigh); !testutil.Close(got, 3, 1e-12) {
+		t.Fatalf("Length = %g", got)
+	}
+
+	n := a.NormalizePrec(PrecisionHigh)
+	if !testutil.Close(n.LengthPrec(PrecisionHigh), 1, 1e-9) {
+		t.Fatalf("Normalize length = %g", n.Length())
+	}
+
+	if got := (Vec3[float64]{1, 0, 0}).AnglePrec(Vec3[float64]{0, 1, 0}, PrecisionHigh); !testutil.Close(got, math.Pi/2, 1e-4) {
+		t.Fatalf("Angle = %g", got)
+	}
+
+	if got := (Vec3[float64]{}).Normalize(); got != (Vec3[float64]{}) {
+		t.Fatalf("Normalize(0) = %v", got)
+	}
+}
+
+func TestVec2Vec4_Methods(t *testing.T) {
+	t.Parallel()
+
+	v := Vec2[float32]{3, 4}
+	if got := v.Length(); math.Abs(float64(got)-5) > 1e-3 {
+		t.Fatalf("Vec2 Length = %g", got)
+	}
+
+	if got := v.Cross(Vec2[float32]{1, 0}); got != -4 {
+		t.Fatalf("Vec2 Cross = %g", got)
+	}
+
+	if got := (Vec2[float64]{1, 0}).AnglePrec(Vec2[float64]{-1, 1}, PrecisionHigh); !testutil.Close(got, 3*math.Pi/4, 1e-4) {
+		t.Fatalf("Vec2 Angle = %g", got)
+	}
+
+	w := Vec4[float64]{1, 1, 1, 1}
+	if got := w.LengthPrec(PrecisionHigh); !testutil.Close(got, 2, 1e-12) {
+		t.Fatalf("Vec4 Length = %g", got)
+	}
+
+	if got := w.AnglePrec(Vec4[float64]{1, 0, 0, 0}, PrecisionHigh); !testutil.Close(got, math.Pi/3, 1e-4) {
+		t.Fatalf("Vec4 Angle = %g", got)
+	}
+
+	if got := w.Scale(2).Sub(w); got != w {
+		t.Fatalf("Vec4 Scale/Sub = %v", got)
+	}
+}
+
+func TestVec_ArrayConversion(t *testing.T) {
+	t.Parallel()
+
+	arr := [3]float64{1, 2, 3}
+	v := Vec3[float64](arr)
+
+	if [3]float64(v) != arr {
+		t.Fatal("round trip through Vec3 changed the array")
+	}
+
+	if got := Vec3[float64](Slerp3(arr, arr, 0.5)); !testutil.Close(got[2], 3, 1e-6) {
+		t.Fatalf("Slerp3 via Vec3 = %v", got)
+	}
+}
@@ -0,0 +1,39 @@
+package approx
+
+import iapprox "github.com/meko-christian/algo-approx/internal/approx"
+
+// FastVersin returns an approximate versine 1 - cos(x) using the default
+// precision.
+func FastVersin[T Float](x T) T { return FastVersinPrec(x, PrecisionAuto) }
+
+// FastVersinPrec returns an approximate versine 1 - cos(x) using the
+// requested precision. It is 2·FastHavPrec(x, prec) and keeps its relative
+// accuracy for tiny x, where 1 - FastCos(x) returns exactly 0.
+func FastVersinPrec[T Float](x T, prec Precision) T {
+	checkFinite("FastVersin", x, prec)
+	noteReduction("FastVersin", x, prec)
+
+	return iapprox.Versin(x, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastVersin32(x float32) float32 { return FastVersin[float32](x) }
+func FastVersin64(x float64) float64 { return FastVersin[float64](x) }
+
+// FastHav returns an approximate haversine sin²(x/2) using the default
+// precision.
+func FastHav[T Float](x T) T { return FastHavPrec(x, PrecisionAuto) }
+
+// FastHavPrec returns an approximate haversine sin²(x/2) using the requested
+// precision. Near zero it squares the sine series instead of forming
+// 1 - cos(x), so the haversine formula for great-circle distances and
+// small-angle physics keep full relative accuracy at separations of 1e-8
+// radians and below. The error is that of FastSinCosPrec.
+func FastHavPrec[T Float](x T, prec Precision) T {
+	checkFinite("FastHav", x, prec)
+	noteReduction("FastHav", x, prec)
+
+	return iapprox.Hav(x, iapprox.Precision(normalizePrecision(prec)))
+}
+
+func FastHav32(x float32) float32 { return FastHav[float32](x) }
+func FastHav64(x float64) float64 { return FastHav[float64](x) }
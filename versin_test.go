@@ -0,0 +1,61 @@
+package approx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/meko-christian/algo-approx/testutil"
+)
+
+// TestFastHav_TinyAngles checks the angles where the naive 1 - cos(x)
+// composition has already cancelled to exactly 0.
+func TestFastHav_TinyAngles(t *testing.T) {
+	t.Parallel()
+
+	for _, x := range []float64{1e-8, -1e-8, 3e-9, 1e-12} {
+		if naive := 1 - FastCos(x); naive != 0 {
+			t.Fatalf("1 - FastCos(%g) = %g; the test no longer covers the cancellation", x, naive)
+		}
+
+		want := math.Pow(math.Sin(x/2), 2)
+		if got := FastHav(x); !testutil.Close(got, want, 1e-12) {
+			t.Errorf("FastHav(%g) = %g, want %g", x, got, want)
+		}
+
+		if got := FastVersinPrec(x, PrecisionFast); !testutil.Close(got, 2*want, 1e-12) {
+			t.Errorf("FastVersin(%g) = %g, want %g", x, got, 2*want)
+		}
+	}
+
+	const x32 = float32(1e-8)
+	if naive := 1 - FastCos32(x32); naive != 0 {
+		t.Fatalf("1 - FastCos32(%g) = %g", x32, naive)
+	}
+
+	if got, want := FastHav32(x32), 0.25*float64(x32)*float64(x32); !testutil.Close(float64(got), want, 1e-6) {
+		t.Errorf("FastHav32(%g) = %g, want %g", x32, got, want)
+	}
+}
+
+func TestFastVersin(t *testing.T) {
+	t.Parallel()
+
+	tols := map[Precision]float64{PrecisionFast: 4e-4, PrecisionBalanced: 4e-8, PrecisionHigh: 2e-12}
+
+	for prec, tol := range tols {
+		for x := -10.0; x <= 10; x += 0.05 {
+			want := 1 - math.Cos(x)
+			if got := FastVersinPrec(x, prec); math.Abs(got-want) > tol {
+				t.Fatalf("%v: FastVersin(%g) = %g, want %g", prec, x, got, want)
+			}
+
+			if got := FastHavPrec(x, prec); math.Abs(got-want/2) > tol/2 {
+				t.Fatalf("%v: FastHav(%g) = %g, want %g", prec, x, got, want/2)
+			}
+		}
+	}
+
+	if got := FastVersin64(math.Pi); math.Abs(got-2) > 1e-7 {
+		t.Errorf("FastVersin64(π) = %g, want 2", got)
+	}
+}